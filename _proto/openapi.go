@@ -0,0 +1,9 @@
+package __
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI document of the REST gateway, generated from
+// service.proto along with the gateway.
+//
+//go:embed service.swagger.json
+var OpenAPI []byte
@@ -7,8 +7,10 @@
 package __
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -346,7 +348,15 @@ type ListArticlesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ElementCount int32 `protobuf:"varint,1,opt,name=elementCount,proto3" json:"elementCount,omitempty"`
+	// Deprecated: use pageSize.
+	ElementCount   int32                  `protobuf:"varint,1,opt,name=elementCount,proto3" json:"elementCount,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy         string                 `protobuf:"bytes,4,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending     bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	JournalistId   string                 `protobuf:"bytes,6,opt,name=journalistId,proto3" json:"journalistId,omitempty"`
+	ReleasedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=releasedAfter,proto3" json:"releasedAfter,omitempty"`
+	ReleasedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=releasedBefore,proto3" json:"releasedBefore,omitempty"`
 }
 
 func (x *ListArticlesRequest) Reset() {
@@ -388,17 +398,69 @@ func (x *ListArticlesRequest) GetElementCount() int32 {
 	return 0
 }
 
-type UploadArticlePostersRequest struct {
+func (x *ListArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListArticlesRequest) GetJournalistId() string {
+	if x != nil {
+		return x.JournalistId
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetReleasedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetReleasedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+type ListCelebritiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArticleId   string   `protobuf:"bytes,1,opt,name=articleId,proto3" json:"articleId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	PageSize   int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy     string `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending bool   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	Occupation string `protobuf:"bytes,5,opt,name=occupation,proto3" json:"occupation,omitempty"`
 }
 
-func (x *UploadArticlePostersRequest) Reset() {
-	*x = UploadArticlePostersRequest{}
+func (x *ListCelebritiesRequest) Reset() {
+	*x = ListCelebritiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,13 +468,13 @@ func (x *UploadArticlePostersRequest) Reset() {
 	}
 }
 
-func (x *UploadArticlePostersRequest) String() string {
+func (x *ListCelebritiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadArticlePostersRequest) ProtoMessage() {}
+func (*ListCelebritiesRequest) ProtoMessage() {}
 
-func (x *UploadArticlePostersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCelebritiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,36 +486,61 @@ func (x *UploadArticlePostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadArticlePostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadArticlePostersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCelebritiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCelebritiesRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UploadArticlePostersRequest) GetArticleId() string {
+func (x *ListCelebritiesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ArticleId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCelebritiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *UploadArticlePostersRequest) GetPostersPath() []string {
+func (x *ListCelebritiesRequest) GetSortBy() string {
 	if x != nil {
-		return x.PostersPath
+		return x.SortBy
 	}
-	return nil
+	return ""
 }
 
-type UploadSeriesPostersRequest struct {
+func (x *ListCelebritiesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListCelebritiesRequest) GetOccupation() string {
+	if x != nil {
+		return x.Occupation
+	}
+	return ""
+}
+
+type ListEpisodesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeriesId    string   `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	PageSize   int32   `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string  `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy     string  `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending bool    `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	SeasonId   string  `protobuf:"bytes,5,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	MinRating  float64 `protobuf:"fixed64,6,opt,name=minRating,proto3" json:"minRating,omitempty"`
 }
 
-func (x *UploadSeriesPostersRequest) Reset() {
-	*x = UploadSeriesPostersRequest{}
+func (x *ListEpisodesRequest) Reset() {
+	*x = ListEpisodesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,13 +548,13 @@ func (x *UploadSeriesPostersRequest) Reset() {
 	}
 }
 
-func (x *UploadSeriesPostersRequest) String() string {
+func (x *ListEpisodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSeriesPostersRequest) ProtoMessage() {}
+func (*ListEpisodesRequest) ProtoMessage() {}
 
-func (x *UploadSeriesPostersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,36 +566,71 @@ func (x *UploadSeriesPostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSeriesPostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadSeriesPostersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodesRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UploadSeriesPostersRequest) GetSeriesId() string {
+func (x *ListEpisodesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.SeriesId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEpisodesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *UploadSeriesPostersRequest) GetPostersPath() []string {
+func (x *ListEpisodesRequest) GetSortBy() string {
 	if x != nil {
-		return x.PostersPath
+		return x.SortBy
 	}
-	return nil
+	return ""
 }
 
-type DeleteArticlePosterRequest struct {
+func (x *ListEpisodesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListEpisodesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListEpisodesRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+type ListShowsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArticleId string `protobuf:"bytes,1,opt,name=articleId,proto3" json:"articleId,omitempty"`
-	Image     string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy         string                 `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending     bool                   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	Type           string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	GenreId        string                 `protobuf:"bytes,6,opt,name=genreId,proto3" json:"genreId,omitempty"`
+	ReleasedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=releasedAfter,proto3" json:"releasedAfter,omitempty"`
+	ReleasedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=releasedBefore,proto3" json:"releasedBefore,omitempty"`
+	MinRating      float64                `protobuf:"fixed64,9,opt,name=minRating,proto3" json:"minRating,omitempty"`
 }
 
-func (x *DeleteArticlePosterRequest) Reset() {
-	*x = DeleteArticlePosterRequest{}
+func (x *ListShowsRequest) Reset() {
+	*x = ListShowsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -516,13 +638,13 @@ func (x *DeleteArticlePosterRequest) Reset() {
 	}
 }
 
-func (x *DeleteArticlePosterRequest) String() string {
+func (x *ListShowsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticlePosterRequest) ProtoMessage() {}
+func (*ListShowsRequest) ProtoMessage() {}
 
-func (x *DeleteArticlePosterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListShowsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,36 +656,87 @@ func (x *DeleteArticlePosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticlePosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteArticlePosterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListShowsRequest.ProtoReflect.Descriptor instead.
+func (*ListShowsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteArticlePosterRequest) GetArticleId() string {
+func (x *ListShowsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ArticleId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShowsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *DeleteArticlePosterRequest) GetImage() string {
+func (x *ListShowsRequest) GetSortBy() string {
 	if x != nil {
-		return x.Image
+		return x.SortBy
 	}
 	return ""
 }
 
-type UploadMoviePostersRequest struct {
+func (x *ListShowsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListShowsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListShowsRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *ListShowsRequest) GetReleasedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *ListShowsRequest) GetReleasedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+func (x *ListShowsRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+type ListGenresRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MovieId     string   `protobuf:"bytes,1,opt,name=movieId,proto3" json:"movieId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	PageSize   int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy     string `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending bool   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *UploadMoviePostersRequest) Reset() {
-	*x = UploadMoviePostersRequest{}
+func (x *ListGenresRequest) Reset() {
+	*x = ListGenresRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,13 +744,13 @@ func (x *UploadMoviePostersRequest) Reset() {
 	}
 }
 
-func (x *UploadMoviePostersRequest) String() string {
+func (x *ListGenresRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadMoviePostersRequest) ProtoMessage() {}
+func (*ListGenresRequest) ProtoMessage() {}
 
-func (x *UploadMoviePostersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,36 +762,56 @@ func (x *UploadMoviePostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadMoviePostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadMoviePostersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
+func (*ListGenresRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UploadMoviePostersRequest) GetMovieId() string {
+func (x *ListGenresRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGenresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *UploadMoviePostersRequest) GetPostersPath() []string {
+func (x *ListGenresRequest) GetSortBy() string {
 	if x != nil {
-		return x.PostersPath
+		return x.SortBy
 	}
-	return nil
+	return ""
 }
 
-type DeleteSeriesPosterRequest struct {
+func (x *ListGenresRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListSeasonsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeriesId string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
-	Image    string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy         string                 `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending     bool                   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	ShowId         string                 `protobuf:"bytes,5,opt,name=showId,proto3" json:"showId,omitempty"`
+	ReleasedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=releasedAfter,proto3" json:"releasedAfter,omitempty"`
+	ReleasedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=releasedBefore,proto3" json:"releasedBefore,omitempty"`
+	MinRating      float64                `protobuf:"fixed64,8,opt,name=minRating,proto3" json:"minRating,omitempty"`
 }
 
-func (x *DeleteSeriesPosterRequest) Reset() {
-	*x = DeleteSeriesPosterRequest{}
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,13 +819,13 @@ func (x *DeleteSeriesPosterRequest) Reset() {
 	}
 }
 
-func (x *DeleteSeriesPosterRequest) String() string {
+func (x *ListSeasonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSeriesPosterRequest) ProtoMessage() {}
+func (*ListSeasonsRequest) ProtoMessage() {}
 
-func (x *DeleteSeriesPosterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,36 +837,80 @@ func (x *DeleteSeriesPosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSeriesPosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSeriesPosterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteSeriesPosterRequest) GetSeriesId() string {
+func (x *ListSeasonsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.SeriesId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSeasonsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *DeleteSeriesPosterRequest) GetImage() string {
+func (x *ListSeasonsRequest) GetSortBy() string {
 	if x != nil {
-		return x.Image
+		return x.SortBy
 	}
 	return ""
 }
 
-type DeleteMoviePosterRequest struct {
+func (x *ListSeasonsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSeasonsRequest) GetShowId() string {
+	if x != nil {
+		return x.ShowId
+	}
+	return ""
+}
+
+func (x *ListSeasonsRequest) GetReleasedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *ListSeasonsRequest) GetReleasedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+func (x *ListSeasonsRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+type ListJournalistsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MovieId string `protobuf:"bytes,1,opt,name=movieId,proto3" json:"movieId,omitempty"`
-	Image   string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	PageSize   int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortBy     string `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Descending bool   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *DeleteMoviePosterRequest) Reset() {
-	*x = DeleteMoviePosterRequest{}
+func (x *ListJournalistsRequest) Reset() {
+	*x = ListJournalistsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +918,13 @@ func (x *DeleteMoviePosterRequest) Reset() {
 	}
 }
 
-func (x *DeleteMoviePosterRequest) String() string {
+func (x *ListJournalistsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMoviePosterRequest) ProtoMessage() {}
+func (*ListJournalistsRequest) ProtoMessage() {}
 
-func (x *DeleteMoviePosterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListJournalistsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,41 +936,50 @@ func (x *DeleteMoviePosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMoviePosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMoviePosterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListJournalistsRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalistsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteMoviePosterRequest) GetMovieId() string {
+func (x *ListJournalistsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.MovieId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJournalistsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *DeleteMoviePosterRequest) GetImage() string {
+func (x *ListJournalistsRequest) GetSortBy() string {
 	if x != nil {
-		return x.Image
+		return x.SortBy
 	}
 	return ""
 }
 
-//------ARTICLES------
-type Article struct {
+func (x *ListJournalistsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type UploadArticlePostersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Journalist  *ShortJournalist       `protobuf:"bytes,6,opt,name=journalist,proto3" json:"journalist,omitempty"`
+	ArticleId   string   `protobuf:"bytes,1,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *Article) Reset() {
-	*x = Article{}
+func (x *UploadArticlePostersRequest) Reset() {
+	*x = UploadArticlePostersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -741,13 +987,13 @@ func (x *Article) Reset() {
 	}
 }
 
-func (x *Article) String() string {
+func (x *UploadArticlePostersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Article) ProtoMessage() {}
+func (*UploadArticlePostersRequest) ProtoMessage() {}
 
-func (x *Article) ProtoReflect() protoreflect.Message {
+func (x *UploadArticlePostersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,67 +1005,36 @@ func (x *Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Article.ProtoReflect.Descriptor instead.
-func (*Article) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadArticlePostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadArticlePostersRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Article) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Article) GetTitle() string {
+func (x *UploadArticlePostersRequest) GetArticleId() string {
 	if x != nil {
-		return x.Title
+		return x.ArticleId
 	}
 	return ""
 }
 
-func (x *Article) GetReleaseDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseDate
-	}
-	return nil
-}
-
-func (x *Article) GetPostersPath() []string {
+func (x *UploadArticlePostersRequest) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *Article) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Article) GetJournalist() *ShortJournalist {
-	if x != nil {
-		return x.Journalist
-	}
-	return nil
-}
-
-type CreateArticleRequest struct {
+type UploadSeriesPostersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ReleaseDate *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	PostersPath []string                 `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	Description string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Journalist  *CreateJournalistRequest `protobuf:"bytes,5,opt,name=journalist,proto3" json:"journalist,omitempty"`
+	SeriesId    string   `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *CreateArticleRequest) Reset() {
-	*x = CreateArticleRequest{}
+func (x *UploadSeriesPostersRequest) Reset() {
+	*x = UploadSeriesPostersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,13 +1042,13 @@ func (x *CreateArticleRequest) Reset() {
 	}
 }
 
-func (x *CreateArticleRequest) String() string {
+func (x *UploadSeriesPostersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest) ProtoMessage() {}
+func (*UploadSeriesPostersRequest) ProtoMessage() {}
 
-func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadSeriesPostersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,56 +1060,36 @@ func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadSeriesPostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadSeriesPostersRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateArticleRequest) GetTitle() string {
+func (x *UploadSeriesPostersRequest) GetSeriesId() string {
 	if x != nil {
-		return x.Title
+		return x.SeriesId
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest) GetReleaseDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseDate
-	}
-	return nil
-}
-
-func (x *CreateArticleRequest) GetPostersPath() []string {
+func (x *UploadSeriesPostersRequest) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *CreateArticleRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateArticleRequest) GetJournalist() *CreateJournalistRequest {
-	if x != nil {
-		return x.Journalist
-	}
-	return nil
-}
-
-type ArticleListResponse struct {
+type DeleteArticlePosterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Articles []*Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	ArticleId string `protobuf:"bytes,1,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	Image     string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (x *ArticleListResponse) Reset() {
-	*x = ArticleListResponse{}
+func (x *DeleteArticlePosterRequest) Reset() {
+	*x = DeleteArticlePosterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,13 +1097,13 @@ func (x *ArticleListResponse) Reset() {
 	}
 }
 
-func (x *ArticleListResponse) String() string {
+func (x *DeleteArticlePosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArticleListResponse) ProtoMessage() {}
+func (*DeleteArticlePosterRequest) ProtoMessage() {}
 
-func (x *ArticleListResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteArticlePosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,30 +1115,36 @@ func (x *ArticleListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArticleListResponse.ProtoReflect.Descriptor instead.
-func (*ArticleListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteArticlePosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticlePosterRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ArticleListResponse) GetArticles() []*Article {
+func (x *DeleteArticlePosterRequest) GetArticleId() string {
 	if x != nil {
-		return x.Articles
+		return x.ArticleId
 	}
-	return nil
+	return ""
 }
 
-//------JOURNALISTS------
-type Journalist struct {
+func (x *DeleteArticlePosterRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type UploadMoviePostersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MovieId     string   `protobuf:"bytes,1,opt,name=movieId,proto3" json:"movieId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *Journalist) Reset() {
-	*x = Journalist{}
+func (x *UploadMoviePostersRequest) Reset() {
+	*x = UploadMoviePostersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,13 +1152,13 @@ func (x *Journalist) Reset() {
 	}
 }
 
-func (x *Journalist) String() string {
+func (x *UploadMoviePostersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Journalist) ProtoMessage() {}
+func (*UploadMoviePostersRequest) ProtoMessage() {}
 
-func (x *Journalist) ProtoReflect() protoreflect.Message {
+func (x *UploadMoviePostersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -969,35 +1170,36 @@ func (x *Journalist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Journalist.ProtoReflect.Descriptor instead.
-func (*Journalist) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadMoviePostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadMoviePostersRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Journalist) GetId() string {
+func (x *UploadMoviePostersRequest) GetMovieId() string {
 	if x != nil {
-		return x.Id
+		return x.MovieId
 	}
 	return ""
 }
 
-func (x *Journalist) GetName() string {
+func (x *UploadMoviePostersRequest) GetPostersPath() []string {
 	if x != nil {
-		return x.Name
+		return x.PostersPath
 	}
-	return ""
+	return nil
 }
 
-type CreateJournalistRequest struct {
+type DeleteSeriesPosterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SeriesId string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
+	Image    string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (x *CreateJournalistRequest) Reset() {
-	*x = CreateJournalistRequest{}
+func (x *DeleteSeriesPosterRequest) Reset() {
+	*x = DeleteSeriesPosterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,13 +1207,13 @@ func (x *CreateJournalistRequest) Reset() {
 	}
 }
 
-func (x *CreateJournalistRequest) String() string {
+func (x *DeleteSeriesPosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJournalistRequest) ProtoMessage() {}
+func (*DeleteSeriesPosterRequest) ProtoMessage() {}
 
-func (x *CreateJournalistRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSeriesPosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1023,28 +1225,36 @@ func (x *CreateJournalistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJournalistRequest.ProtoReflect.Descriptor instead.
-func (*CreateJournalistRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSeriesPosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeriesPosterRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateJournalistRequest) GetName() string {
+func (x *DeleteSeriesPosterRequest) GetSeriesId() string {
 	if x != nil {
-		return x.Name
+		return x.SeriesId
 	}
 	return ""
 }
 
-type JournalistListResponse struct {
+func (x *DeleteSeriesPosterRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type DeleteMoviePosterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Journalists []*Journalist `protobuf:"bytes,1,rep,name=journalists,proto3" json:"journalists,omitempty"`
+	MovieId string `protobuf:"bytes,1,opt,name=movieId,proto3" json:"movieId,omitempty"`
+	Image   string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (x *JournalistListResponse) Reset() {
-	*x = JournalistListResponse{}
+func (x *DeleteMoviePosterRequest) Reset() {
+	*x = DeleteMoviePosterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,13 +1262,13 @@ func (x *JournalistListResponse) Reset() {
 	}
 }
 
-func (x *JournalistListResponse) String() string {
+func (x *DeleteMoviePosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JournalistListResponse) ProtoMessage() {}
+func (*DeleteMoviePosterRequest) ProtoMessage() {}
 
-func (x *JournalistListResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteMoviePosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,28 +1280,41 @@ func (x *JournalistListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JournalistListResponse.ProtoReflect.Descriptor instead.
-func (*JournalistListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMoviePosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMoviePosterRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *JournalistListResponse) GetJournalists() []*Journalist {
+func (x *DeleteMoviePosterRequest) GetMovieId() string {
 	if x != nil {
-		return x.Journalists
+		return x.MovieId
 	}
-	return nil
+	return ""
 }
 
-type ShortJournalist struct {
+func (x *DeleteMoviePosterRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// ------ARTICLES------
+type Article struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Journalist  *ShortJournalist       `protobuf:"bytes,6,opt,name=journalist,proto3" json:"journalist,omitempty"`
 }
 
-func (x *ShortJournalist) Reset() {
-	*x = ShortJournalist{}
+func (x *Article) Reset() {
+	*x = Article{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,13 +1322,13 @@ func (x *ShortJournalist) Reset() {
 	}
 }
 
-func (x *ShortJournalist) String() string {
+func (x *Article) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortJournalist) ProtoMessage() {}
+func (*Article) ProtoMessage() {}
 
-func (x *ShortJournalist) ProtoReflect() protoreflect.Message {
+func (x *Article) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1117,37 +1340,67 @@ func (x *ShortJournalist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortJournalist.ProtoReflect.Descriptor instead.
-func (*ShortJournalist) Descriptor() ([]byte, []int) {
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ShortJournalist) GetId() string {
+func (x *Article) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-//------CELEBRITIES------
-type Celebrity struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
 
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PostersPath  []string               `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	DateOfDeath  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dateOfDeath,proto3" json:"dateOfDeath,omitempty"`
-	PlaceOfBirth string                 `protobuf:"bytes,6,opt,name=placeOfBirth,proto3" json:"placeOfBirth,omitempty"`
-	Gender       string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
-	Bio          string                 `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
-	Occupation   []string               `protobuf:"bytes,9,rep,name=occupation,proto3" json:"occupation,omitempty"`
+func (x *Article) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
 }
 
-func (x *Celebrity) Reset() {
-	*x = Celebrity{}
+func (x *Article) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+func (x *Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Article) GetJournalist() *ShortJournalist {
+	if x != nil {
+		return x.Journalist
+	}
+	return nil
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	PostersPath []string                 `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Description string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Journalist  *CreateJournalistRequest `protobuf:"bytes,5,opt,name=journalist,proto3" json:"journalist,omitempty"`
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,13 +1408,13 @@ func (x *Celebrity) Reset() {
 	}
 }
 
-func (x *Celebrity) String() string {
+func (x *CreateArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Celebrity) ProtoMessage() {}
+func (*CreateArticleRequest) ProtoMessage() {}
 
-func (x *Celebrity) ProtoReflect() protoreflect.Message {
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1173,91 +1426,58 @@ func (x *Celebrity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Celebrity.ProtoReflect.Descriptor instead.
-func (*Celebrity) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Celebrity) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Celebrity) GetName() string {
+func (x *CreateArticleRequest) GetTitle() string {
 	if x != nil {
-		return x.Name
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Celebrity) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
-}
-
-func (x *Celebrity) GetDateOfBirth() *timestamppb.Timestamp {
+func (x *CreateArticleRequest) GetReleaseDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DateOfBirth
+		return x.ReleaseDate
 	}
 	return nil
 }
 
-func (x *Celebrity) GetDateOfDeath() *timestamppb.Timestamp {
+func (x *CreateArticleRequest) GetPostersPath() []string {
 	if x != nil {
-		return x.DateOfDeath
+		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *Celebrity) GetPlaceOfBirth() string {
-	if x != nil {
-		return x.PlaceOfBirth
-	}
-	return ""
-}
-
-func (x *Celebrity) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
-}
-
-func (x *Celebrity) GetBio() string {
+func (x *CreateArticleRequest) GetDescription() string {
 	if x != nil {
-		return x.Bio
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Celebrity) GetOccupation() []string {
+func (x *CreateArticleRequest) GetJournalist() *CreateJournalistRequest {
 	if x != nil {
-		return x.Occupation
+		return x.Journalist
 	}
 	return nil
 }
 
-type CreateCelebrityRequest struct {
+type ArticleListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PostersPath  []string               `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	DateOfDeath  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateOfDeath,proto3" json:"dateOfDeath,omitempty"`
-	PlaceOfBirth string                 `protobuf:"bytes,5,opt,name=placeOfBirth,proto3" json:"placeOfBirth,omitempty"`
-	Gender       string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
-	Bio          string                 `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`
-	Occupation   []string               `protobuf:"bytes,8,rep,name=occupation,proto3" json:"occupation,omitempty"`
+	Articles      []*Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32      `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *CreateCelebrityRequest) Reset() {
-	*x = CreateCelebrityRequest{}
+func (x *ArticleListResponse) Reset() {
+	*x = ArticleListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,13 +1485,13 @@ func (x *CreateCelebrityRequest) Reset() {
 	}
 }
 
-func (x *CreateCelebrityRequest) String() string {
+func (x *ArticleListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCelebrityRequest) ProtoMessage() {}
+func (*ArticleListResponse) ProtoMessage() {}
 
-func (x *CreateCelebrityRequest) ProtoReflect() protoreflect.Message {
+func (x *ArticleListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,77 +1503,44 @@ func (x *CreateCelebrityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCelebrityRequest.ProtoReflect.Descriptor instead.
-func (*CreateCelebrityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArticleListResponse.ProtoReflect.Descriptor instead.
+func (*ArticleListResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateCelebrityRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCelebrityRequest) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
-}
-
-func (x *CreateCelebrityRequest) GetDateOfBirth() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return nil
-}
-
-func (x *CreateCelebrityRequest) GetDateOfDeath() *timestamppb.Timestamp {
+func (x *ArticleListResponse) GetArticles() []*Article {
 	if x != nil {
-		return x.DateOfDeath
+		return x.Articles
 	}
 	return nil
 }
 
-func (x *CreateCelebrityRequest) GetPlaceOfBirth() string {
-	if x != nil {
-		return x.PlaceOfBirth
-	}
-	return ""
-}
-
-func (x *CreateCelebrityRequest) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
-}
-
-func (x *CreateCelebrityRequest) GetBio() string {
+func (x *ArticleListResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Bio
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *CreateCelebrityRequest) GetOccupation() []string {
+func (x *ArticleListResponse) GetTotalSize() int32 {
 	if x != nil {
-		return x.Occupation
+		return x.TotalSize
 	}
-	return nil
+	return 0
 }
 
-type CelebrityListResponse struct {
+// ------JOURNALISTS------
+type Journalist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Celebrities []*Celebrity `protobuf:"bytes,1,rep,name=celebrities,proto3" json:"celebrities,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CelebrityListResponse) Reset() {
-	*x = CelebrityListResponse{}
+func (x *Journalist) Reset() {
+	*x = Journalist{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1361,13 +1548,13 @@ func (x *CelebrityListResponse) Reset() {
 	}
 }
 
-func (x *CelebrityListResponse) String() string {
+func (x *Journalist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CelebrityListResponse) ProtoMessage() {}
+func (*Journalist) ProtoMessage() {}
 
-func (x *CelebrityListResponse) ProtoReflect() protoreflect.Message {
+func (x *Journalist) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,28 +1566,35 @@ func (x *CelebrityListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CelebrityListResponse.ProtoReflect.Descriptor instead.
-func (*CelebrityListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Journalist.ProtoReflect.Descriptor instead.
+func (*Journalist) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CelebrityListResponse) GetCelebrities() []*Celebrity {
+func (x *Journalist) GetId() string {
 	if x != nil {
-		return x.Celebrities
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetByIDRequest struct {
+func (x *Journalist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateJournalistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetByIDRequest) Reset() {
-	*x = GetByIDRequest{}
+func (x *CreateJournalistRequest) Reset() {
+	*x = CreateJournalistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1408,13 +1602,13 @@ func (x *GetByIDRequest) Reset() {
 	}
 }
 
-func (x *GetByIDRequest) String() string {
+func (x *CreateJournalistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIDRequest) ProtoMessage() {}
+func (*CreateJournalistRequest) ProtoMessage() {}
 
-func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateJournalistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1426,28 +1620,30 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJournalistRequest.ProtoReflect.Descriptor instead.
+func (*CreateJournalistRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetByIDRequest) GetId() string {
+func (x *CreateJournalistRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type GetByNameRequest struct {
+type JournalistListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Journalists   []*Journalist `protobuf:"bytes,1,rep,name=journalists,proto3" json:"journalists,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32         `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *GetByNameRequest) Reset() {
-	*x = GetByNameRequest{}
+func (x *JournalistListResponse) Reset() {
+	*x = JournalistListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1455,13 +1651,13 @@ func (x *GetByNameRequest) Reset() {
 	}
 }
 
-func (x *GetByNameRequest) String() string {
+func (x *JournalistListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByNameRequest) ProtoMessage() {}
+func (*JournalistListResponse) ProtoMessage() {}
 
-func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *JournalistListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,29 +1669,42 @@ func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JournalistListResponse.ProtoReflect.Descriptor instead.
+func (*JournalistListResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetByNameRequest) GetName() string {
+func (x *JournalistListResponse) GetJournalists() []*Journalist {
 	if x != nil {
-		return x.Name
+		return x.Journalists
+	}
+	return nil
+}
+
+func (x *JournalistListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type UploadCelebrityPostersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JournalistListResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
 
-	CelebrityId string   `protobuf:"bytes,1,opt,name=celebrityId,proto3" json:"celebrityId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+type ShortJournalist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UploadCelebrityPostersRequest) Reset() {
-	*x = UploadCelebrityPostersRequest{}
+func (x *ShortJournalist) Reset() {
+	*x = ShortJournalist{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1503,13 +1712,13 @@ func (x *UploadCelebrityPostersRequest) Reset() {
 	}
 }
 
-func (x *UploadCelebrityPostersRequest) String() string {
+func (x *ShortJournalist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadCelebrityPostersRequest) ProtoMessage() {}
+func (*ShortJournalist) ProtoMessage() {}
 
-func (x *UploadCelebrityPostersRequest) ProtoReflect() protoreflect.Message {
+func (x *ShortJournalist) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1521,36 +1730,37 @@ func (x *UploadCelebrityPostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadCelebrityPostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadCelebrityPostersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortJournalist.ProtoReflect.Descriptor instead.
+func (*ShortJournalist) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UploadCelebrityPostersRequest) GetCelebrityId() string {
+func (x *ShortJournalist) GetId() string {
 	if x != nil {
-		return x.CelebrityId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UploadCelebrityPostersRequest) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
-}
-
-type DeleteCelebrityPosterRequest struct {
+// ------CELEBRITIES------
+type Celebrity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CelebrityId string `protobuf:"bytes,1,opt,name=celebrityId,proto3" json:"celebrityId,omitempty"`
-	Image       string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PostersPath  []string               `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	DateOfDeath  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dateOfDeath,proto3" json:"dateOfDeath,omitempty"`
+	PlaceOfBirth string                 `protobuf:"bytes,6,opt,name=placeOfBirth,proto3" json:"placeOfBirth,omitempty"`
+	Gender       string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	Bio          string                 `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
+	Occupation   []string               `protobuf:"bytes,9,rep,name=occupation,proto3" json:"occupation,omitempty"`
 }
 
-func (x *DeleteCelebrityPosterRequest) Reset() {
-	*x = DeleteCelebrityPosterRequest{}
+func (x *Celebrity) Reset() {
+	*x = Celebrity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,13 +1768,13 @@ func (x *DeleteCelebrityPosterRequest) Reset() {
 	}
 }
 
-func (x *DeleteCelebrityPosterRequest) String() string {
+func (x *Celebrity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCelebrityPosterRequest) ProtoMessage() {}
+func (*Celebrity) ProtoMessage() {}
 
-func (x *DeleteCelebrityPosterRequest) ProtoReflect() protoreflect.Message {
+func (x *Celebrity) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1576,181 +1786,147 @@ func (x *DeleteCelebrityPosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCelebrityPosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCelebrityPosterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Celebrity.ProtoReflect.Descriptor instead.
+func (*Celebrity) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteCelebrityPosterRequest) GetCelebrityId() string {
+func (x *Celebrity) GetId() string {
 	if x != nil {
-		return x.CelebrityId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteCelebrityPosterRequest) GetImage() string {
+func (x *Celebrity) GetName() string {
 	if x != nil {
-		return x.Image
+		return x.Name
 	}
 	return ""
 }
 
-//------EPISODES------
-type Episode struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	PostersPath []string          `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	TrailerUrl  string            `protobuf:"bytes,4,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	ShowLength  *ShowLength       `protobuf:"bytes,5,opt,name=showLength,proto3" json:"showLength,omitempty"`
-	Rating      float64           `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
-	Resume      string            `protobuf:"bytes,7,opt,name=resume,proto3" json:"resume,omitempty"`
-	WrittenBy   *FilmCrew         `protobuf:"bytes,8,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	ProducedBy  *FilmCrew         `protobuf:"bytes,9,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	DirectedBy  *FilmCrew         `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	Starring    *ShortCelebrities `protobuf:"bytes,11,opt,name=starring,proto3" json:"starring,omitempty"`
-	SeasonId    string            `protobuf:"bytes,12,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
-}
-
-func (x *Episode) Reset() {
-	*x = Episode{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Celebrity) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
 	}
+	return nil
 }
 
-func (x *Episode) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Episode) ProtoMessage() {}
-
-func (x *Episode) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Celebrity) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Episode.ProtoReflect.Descriptor instead.
-func (*Episode) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{27}
+	return nil
 }
 
-func (x *Episode) GetId() string {
+func (x *Celebrity) GetDateOfDeath() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.DateOfDeath
 	}
-	return ""
+	return nil
 }
 
-func (x *Episode) GetTitle() string {
+func (x *Celebrity) GetPlaceOfBirth() string {
 	if x != nil {
-		return x.Title
+		return x.PlaceOfBirth
 	}
 	return ""
 }
 
-func (x *Episode) GetPostersPath() []string {
+func (x *Celebrity) GetGender() string {
 	if x != nil {
-		return x.PostersPath
+		return x.Gender
 	}
-	return nil
+	return ""
 }
 
-func (x *Episode) GetTrailerUrl() string {
+func (x *Celebrity) GetBio() string {
 	if x != nil {
-		return x.TrailerUrl
+		return x.Bio
 	}
 	return ""
 }
 
-func (x *Episode) GetShowLength() *ShowLength {
+func (x *Celebrity) GetOccupation() []string {
 	if x != nil {
-		return x.ShowLength
+		return x.Occupation
 	}
 	return nil
 }
 
-func (x *Episode) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+type PatchCelebrityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Celebrity *Celebrity `protobuf:"bytes,1,opt,name=celebrity,proto3" json:"celebrity,omitempty"`
+	// Top level fields to write. An empty mask writes every field.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
-func (x *Episode) GetResume() string {
-	if x != nil {
-		return x.Resume
+func (x *PatchCelebrityRequest) Reset() {
+	*x = PatchCelebrityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Episode) GetWrittenBy() *FilmCrew {
-	if x != nil {
-		return x.WrittenBy
-	}
-	return nil
+func (x *PatchCelebrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Episode) GetProducedBy() *FilmCrew {
-	if x != nil {
-		return x.ProducedBy
+func (*PatchCelebrityRequest) ProtoMessage() {}
+
+func (x *PatchCelebrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Episode) GetDirectedBy() *FilmCrew {
-	if x != nil {
-		return x.DirectedBy
-	}
-	return nil
+// Deprecated: Use PatchCelebrityRequest.ProtoReflect.Descriptor instead.
+func (*PatchCelebrityRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Episode) GetStarring() *ShortCelebrities {
+func (x *PatchCelebrityRequest) GetCelebrity() *Celebrity {
 	if x != nil {
-		return x.Starring
+		return x.Celebrity
 	}
 	return nil
 }
 
-func (x *Episode) GetSeasonId() string {
+func (x *PatchCelebrityRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.SeasonId
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-type CreateEpisodeRequest struct {
+type CreateCelebrityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	PostersPath []string          `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	TrailerUrl  string            `protobuf:"bytes,3,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	ShowLength  *ShowLength       `protobuf:"bytes,4,opt,name=showLength,proto3" json:"showLength,omitempty"`
-	Rating      float64           `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
-	Resume      string            `protobuf:"bytes,6,opt,name=resume,proto3" json:"resume,omitempty"`
-	WrittenBy   *FilmCrew         `protobuf:"bytes,7,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	ProducedBy  *FilmCrew         `protobuf:"bytes,8,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	DirectedBy  *FilmCrew         `protobuf:"bytes,9,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	Starring    *ShortCelebrities `protobuf:"bytes,10,opt,name=starring,proto3" json:"starring,omitempty"`
-	SeasonId    string            `protobuf:"bytes,11,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PostersPath  []string               `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	DateOfDeath  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateOfDeath,proto3" json:"dateOfDeath,omitempty"`
+	PlaceOfBirth string                 `protobuf:"bytes,5,opt,name=placeOfBirth,proto3" json:"placeOfBirth,omitempty"`
+	Gender       string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	Bio          string                 `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`
+	Occupation   []string               `protobuf:"bytes,8,rep,name=occupation,proto3" json:"occupation,omitempty"`
 }
 
-func (x *CreateEpisodeRequest) Reset() {
-	*x = CreateEpisodeRequest{}
+func (x *CreateCelebrityRequest) Reset() {
+	*x = CreateCelebrityRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1758,13 +1934,13 @@ func (x *CreateEpisodeRequest) Reset() {
 	}
 }
 
-func (x *CreateEpisodeRequest) String() string {
+func (x *CreateCelebrityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEpisodeRequest) ProtoMessage() {}
+func (*CreateCelebrityRequest) ProtoMessage() {}
 
-func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCelebrityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1776,98 +1952,79 @@ func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEpisodeRequest.ProtoReflect.Descriptor instead.
-func (*CreateEpisodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCelebrityRequest.ProtoReflect.Descriptor instead.
+func (*CreateCelebrityRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateEpisodeRequest) GetTitle() string {
+func (x *CreateCelebrityRequest) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateEpisodeRequest) GetPostersPath() []string {
+func (x *CreateCelebrityRequest) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *CreateEpisodeRequest) GetTrailerUrl() string {
+func (x *CreateCelebrityRequest) GetDateOfBirth() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TrailerUrl
+		return x.DateOfBirth
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateEpisodeRequest) GetShowLength() *ShowLength {
+func (x *CreateCelebrityRequest) GetDateOfDeath() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ShowLength
+		return x.DateOfDeath
 	}
 	return nil
 }
 
-func (x *CreateEpisodeRequest) GetRating() float64 {
+func (x *CreateCelebrityRequest) GetPlaceOfBirth() string {
 	if x != nil {
-		return x.Rating
+		return x.PlaceOfBirth
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateEpisodeRequest) GetResume() string {
+func (x *CreateCelebrityRequest) GetGender() string {
 	if x != nil {
-		return x.Resume
+		return x.Gender
 	}
 	return ""
 }
 
-func (x *CreateEpisodeRequest) GetWrittenBy() *FilmCrew {
-	if x != nil {
-		return x.WrittenBy
-	}
-	return nil
-}
-
-func (x *CreateEpisodeRequest) GetProducedBy() *FilmCrew {
-	if x != nil {
-		return x.ProducedBy
-	}
-	return nil
-}
-
-func (x *CreateEpisodeRequest) GetDirectedBy() *FilmCrew {
+func (x *CreateCelebrityRequest) GetBio() string {
 	if x != nil {
-		return x.DirectedBy
+		return x.Bio
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateEpisodeRequest) GetStarring() *ShortCelebrities {
+func (x *CreateCelebrityRequest) GetOccupation() []string {
 	if x != nil {
-		return x.Starring
+		return x.Occupation
 	}
 	return nil
 }
 
-func (x *CreateEpisodeRequest) GetSeasonId() string {
-	if x != nil {
-		return x.SeasonId
-	}
-	return ""
-}
-
-type ListEpisodeResponse struct {
+type CelebrityListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Episodes []*Episode `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	Celebrities   []*Celebrity `protobuf:"bytes,1,rep,name=celebrities,proto3" json:"celebrities,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32        `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *ListEpisodeResponse) Reset() {
-	*x = ListEpisodeResponse{}
+func (x *CelebrityListResponse) Reset() {
+	*x = CelebrityListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1875,13 +2032,13 @@ func (x *ListEpisodeResponse) Reset() {
 	}
 }
 
-func (x *ListEpisodeResponse) String() string {
+func (x *CelebrityListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEpisodeResponse) ProtoMessage() {}
+func (*CelebrityListResponse) ProtoMessage() {}
 
-func (x *ListEpisodeResponse) ProtoReflect() protoreflect.Message {
+func (x *CelebrityListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1893,29 +2050,42 @@ func (x *ListEpisodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEpisodeResponse.ProtoReflect.Descriptor instead.
-func (*ListEpisodeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CelebrityListResponse.ProtoReflect.Descriptor instead.
+func (*CelebrityListResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListEpisodeResponse) GetEpisodes() []*Episode {
+func (x *CelebrityListResponse) GetCelebrities() []*Celebrity {
 	if x != nil {
-		return x.Episodes
+		return x.Celebrities
 	}
 	return nil
 }
 
-type ShowLength struct {
+func (x *CelebrityListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *CelebrityListResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hours   int32 `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
-	Minutes int32 `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ShowLength) Reset() {
-	*x = ShowLength{}
+func (x *GetByIDRequest) Reset() {
+	*x = GetByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,13 +2093,13 @@ func (x *ShowLength) Reset() {
 	}
 }
 
-func (x *ShowLength) String() string {
+func (x *GetByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowLength) ProtoMessage() {}
+func (*GetByIDRequest) ProtoMessage() {}
 
-func (x *ShowLength) ProtoReflect() protoreflect.Message {
+func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,37 +2111,28 @@ func (x *ShowLength) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowLength.ProtoReflect.Descriptor instead.
-func (*ShowLength) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetByIDRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ShowLength) GetHours() int32 {
-	if x != nil {
-		return x.Hours
-	}
-	return 0
-}
-
-func (x *ShowLength) GetMinutes() int32 {
+func (x *GetByIDRequest) GetId() string {
 	if x != nil {
-		return x.Minutes
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type FilmStaff struct {
+type GetByNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *FilmStaff) Reset() {
-	*x = FilmStaff{}
+func (x *GetByNameRequest) Reset() {
+	*x = GetByNameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1979,13 +2140,13 @@ func (x *FilmStaff) Reset() {
 	}
 }
 
-func (x *FilmStaff) String() string {
+func (x *GetByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilmStaff) ProtoMessage() {}
+func (*GetByNameRequest) ProtoMessage() {}
 
-func (x *FilmStaff) ProtoReflect() protoreflect.Message {
+func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1997,42 +2158,29 @@ func (x *FilmStaff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilmStaff.ProtoReflect.Descriptor instead.
-func (*FilmStaff) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetByNameRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FilmStaff) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *FilmStaff) GetName() string {
+func (x *GetByNameRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FilmStaff) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
-}
-
-type FilmCrew struct {
+type UploadCelebrityPostersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilmCrew []*FilmStaff `protobuf:"bytes,1,rep,name=filmCrew,proto3" json:"filmCrew,omitempty"`
+	CelebrityId string   `protobuf:"bytes,1,opt,name=celebrityId,proto3" json:"celebrityId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *FilmCrew) Reset() {
-	*x = FilmCrew{}
+func (x *UploadCelebrityPostersRequest) Reset() {
+	*x = UploadCelebrityPostersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,13 +2188,13 @@ func (x *FilmCrew) Reset() {
 	}
 }
 
-func (x *FilmCrew) String() string {
+func (x *UploadCelebrityPostersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilmCrew) ProtoMessage() {}
+func (*UploadCelebrityPostersRequest) ProtoMessage() {}
 
-func (x *FilmCrew) ProtoReflect() protoreflect.Message {
+func (x *UploadCelebrityPostersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2058,31 +2206,36 @@ func (x *FilmCrew) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilmCrew.ProtoReflect.Descriptor instead.
-func (*FilmCrew) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadCelebrityPostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadCelebrityPostersRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FilmCrew) GetFilmCrew() []*FilmStaff {
+func (x *UploadCelebrityPostersRequest) GetCelebrityId() string {
 	if x != nil {
-		return x.FilmCrew
+		return x.CelebrityId
+	}
+	return ""
+}
+
+func (x *UploadCelebrityPostersRequest) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
 	}
 	return nil
 }
 
-type ShortCelebrity struct {
+type DeleteCelebrityPosterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RoleName    string   `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName,omitempty"`
-	PostersPath []string `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	CelebrityId string `protobuf:"bytes,1,opt,name=celebrityId,proto3" json:"celebrityId,omitempty"`
+	Image       string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (x *ShortCelebrity) Reset() {
-	*x = ShortCelebrity{}
+func (x *DeleteCelebrityPosterRequest) Reset() {
+	*x = DeleteCelebrityPosterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2090,13 +2243,13 @@ func (x *ShortCelebrity) Reset() {
 	}
 }
 
-func (x *ShortCelebrity) String() string {
+func (x *DeleteCelebrityPosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortCelebrity) ProtoMessage() {}
+func (*DeleteCelebrityPosterRequest) ProtoMessage() {}
 
-func (x *ShortCelebrity) ProtoReflect() protoreflect.Message {
+func (x *DeleteCelebrityPosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,49 +2261,47 @@ func (x *ShortCelebrity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortCelebrity.ProtoReflect.Descriptor instead.
-func (*ShortCelebrity) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCelebrityPosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCelebrityPosterRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ShortCelebrity) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ShortCelebrity) GetName() string {
+func (x *DeleteCelebrityPosterRequest) GetCelebrityId() string {
 	if x != nil {
-		return x.Name
+		return x.CelebrityId
 	}
 	return ""
 }
 
-func (x *ShortCelebrity) GetRoleName() string {
+func (x *DeleteCelebrityPosterRequest) GetImage() string {
 	if x != nil {
-		return x.RoleName
+		return x.Image
 	}
 	return ""
 }
 
-func (x *ShortCelebrity) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
-}
-
-type ShortCelebrities struct {
+// ------EPISODES------
+type Episode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShortCelebs []*ShortCelebrity `protobuf:"bytes,1,rep,name=shortCelebs,proto3" json:"shortCelebs,omitempty"`
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PostersPath []string          `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	TrailerUrl  string            `protobuf:"bytes,4,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	ShowLength  *ShowLength       `protobuf:"bytes,5,opt,name=showLength,proto3" json:"showLength,omitempty"`
+	Rating      float64           `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	Resume      string            `protobuf:"bytes,7,opt,name=resume,proto3" json:"resume,omitempty"`
+	WrittenBy   *FilmCrew         `protobuf:"bytes,8,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  *FilmCrew         `protobuf:"bytes,9,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  *FilmCrew         `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Starring    *ShortCelebrities `protobuf:"bytes,11,opt,name=starring,proto3" json:"starring,omitempty"`
+	SeasonId    string            `protobuf:"bytes,12,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
 }
 
-func (x *ShortCelebrities) Reset() {
-	*x = ShortCelebrities{}
+func (x *Episode) Reset() {
+	*x = Episode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,13 +2309,13 @@ func (x *ShortCelebrities) Reset() {
 	}
 }
 
-func (x *ShortCelebrities) String() string {
+func (x *Episode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortCelebrities) ProtoMessage() {}
+func (*Episode) ProtoMessage() {}
 
-func (x *ShortCelebrities) ProtoReflect() protoreflect.Message {
+func (x *Episode) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2176,122 +2327,123 @@ func (x *ShortCelebrities) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortCelebrities.ProtoReflect.Descriptor instead.
-func (*ShortCelebrities) Descriptor() ([]byte, []int) {
+// Deprecated: Use Episode.ProtoReflect.Descriptor instead.
+func (*Episode) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ShortCelebrities) GetShortCelebs() []*ShortCelebrity {
+func (x *Episode) GetId() string {
 	if x != nil {
-		return x.ShortCelebs
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ShortEpisode struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	Rating      float64  `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
-	Resume      string   `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
+func (x *Episode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *ShortEpisode) Reset() {
-	*x = ShortEpisode{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Episode) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
 	}
+	return nil
 }
 
-func (x *ShortEpisode) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Episode) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
 }
 
-func (*ShortEpisode) ProtoMessage() {}
-
-func (x *ShortEpisode) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Episode) GetShowLength() *ShowLength {
+	if x != nil {
+		return x.ShowLength
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ShortEpisode.ProtoReflect.Descriptor instead.
-func (*ShortEpisode) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{35}
+func (x *Episode) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
 }
 
-func (x *ShortEpisode) GetId() string {
+func (x *Episode) GetResume() string {
 	if x != nil {
-		return x.Id
+		return x.Resume
 	}
 	return ""
 }
 
-func (x *ShortEpisode) GetTitle() string {
+func (x *Episode) GetWrittenBy() *FilmCrew {
 	if x != nil {
-		return x.Title
+		return x.WrittenBy
 	}
-	return ""
+	return nil
 }
 
-func (x *ShortEpisode) GetPostersPath() []string {
+func (x *Episode) GetProducedBy() *FilmCrew {
 	if x != nil {
-		return x.PostersPath
+		return x.ProducedBy
 	}
 	return nil
 }
 
-func (x *ShortEpisode) GetRating() float64 {
+func (x *Episode) GetDirectedBy() *FilmCrew {
 	if x != nil {
-		return x.Rating
+		return x.DirectedBy
 	}
-	return 0
+	return nil
 }
 
-func (x *ShortEpisode) GetResume() string {
+func (x *Episode) GetStarring() *ShortCelebrities {
 	if x != nil {
-		return x.Resume
+		return x.Starring
+	}
+	return nil
+}
+
+func (x *Episode) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
 	}
 	return ""
 }
 
-type ShortEpisodeList struct {
+type PatchEpisodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShortEpisodes []*ShortEpisode `protobuf:"bytes,1,rep,name=shortEpisodes,proto3" json:"shortEpisodes,omitempty"`
+	Episode *Episode `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	// Fields to write, top level or a field of showLength such as
+	// showLength.hours. An empty mask writes every field.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
-func (x *ShortEpisodeList) Reset() {
-	*x = ShortEpisodeList{}
+func (x *PatchEpisodeRequest) Reset() {
+	*x = PatchEpisodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[36]
+		mi := &file_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShortEpisodeList) String() string {
+func (x *PatchEpisodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortEpisodeList) ProtoMessage() {}
+func (*PatchEpisodeRequest) ProtoMessage() {}
 
-func (x *ShortEpisodeList) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[36]
+func (x *PatchEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,44 +2454,60 @@ func (x *ShortEpisodeList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortEpisodeList.ProtoReflect.Descriptor instead.
-func (*ShortEpisodeList) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use PatchEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*PatchEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ShortEpisodeList) GetShortEpisodes() []*ShortEpisode {
+func (x *PatchEpisodeRequest) GetEpisode() *Episode {
 	if x != nil {
-		return x.ShortEpisodes
+		return x.Episode
 	}
 	return nil
 }
 
-type UploadEpisodePostersRequest struct {
+func (x *PatchEpisodeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CreateEpisodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EpisodeId   string   `protobuf:"bytes,1,opt,name=episodeId,proto3" json:"episodeId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Title       string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PostersPath []string          `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	TrailerUrl  string            `protobuf:"bytes,3,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	ShowLength  *ShowLength       `protobuf:"bytes,4,opt,name=showLength,proto3" json:"showLength,omitempty"`
+	Rating      float64           `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Resume      string            `protobuf:"bytes,6,opt,name=resume,proto3" json:"resume,omitempty"`
+	WrittenBy   *FilmCrew         `protobuf:"bytes,7,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  *FilmCrew         `protobuf:"bytes,8,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  *FilmCrew         `protobuf:"bytes,9,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Starring    *ShortCelebrities `protobuf:"bytes,10,opt,name=starring,proto3" json:"starring,omitempty"`
+	SeasonId    string            `protobuf:"bytes,11,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
 }
 
-func (x *UploadEpisodePostersRequest) Reset() {
-	*x = UploadEpisodePostersRequest{}
+func (x *CreateEpisodeRequest) Reset() {
+	*x = CreateEpisodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[37]
+		mi := &file_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadEpisodePostersRequest) String() string {
+func (x *CreateEpisodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadEpisodePostersRequest) ProtoMessage() {}
+func (*CreateEpisodeRequest) ProtoMessage() {}
 
-func (x *UploadEpisodePostersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[37]
+func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2350,137 +2518,115 @@ func (x *UploadEpisodePostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadEpisodePostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadEpisodePostersRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CreateEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UploadEpisodePostersRequest) GetEpisodeId() string {
+func (x *CreateEpisodeRequest) GetTitle() string {
 	if x != nil {
-		return x.EpisodeId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *UploadEpisodePostersRequest) GetPostersPath() []string {
+func (x *CreateEpisodeRequest) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-type DeleteEpisodePosterRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SeriesId  string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
-	SeasonId  string `protobuf:"bytes,2,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
-	EpisodeId string `protobuf:"bytes,3,opt,name=episodeId,proto3" json:"episodeId,omitempty"`
-	Image     string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+func (x *CreateEpisodeRequest) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
 }
 
-func (x *DeleteEpisodePosterRequest) Reset() {
-	*x = DeleteEpisodePosterRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateEpisodeRequest) GetShowLength() *ShowLength {
+	if x != nil {
+		return x.ShowLength
 	}
+	return nil
 }
 
-func (x *DeleteEpisodePosterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateEpisodeRequest) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
 }
 
-func (*DeleteEpisodePosterRequest) ProtoMessage() {}
-
-func (x *DeleteEpisodePosterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateEpisodeRequest) GetResume() string {
+	if x != nil {
+		return x.Resume
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteEpisodePosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEpisodePosterRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{38}
+func (x *CreateEpisodeRequest) GetWrittenBy() *FilmCrew {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
 }
 
-func (x *DeleteEpisodePosterRequest) GetSeriesId() string {
+func (x *CreateEpisodeRequest) GetProducedBy() *FilmCrew {
 	if x != nil {
-		return x.SeriesId
+		return x.ProducedBy
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteEpisodePosterRequest) GetSeasonId() string {
+func (x *CreateEpisodeRequest) GetDirectedBy() *FilmCrew {
 	if x != nil {
-		return x.SeasonId
+		return x.DirectedBy
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteEpisodePosterRequest) GetEpisodeId() string {
+func (x *CreateEpisodeRequest) GetStarring() *ShortCelebrities {
 	if x != nil {
-		return x.EpisodeId
+		return x.Starring
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteEpisodePosterRequest) GetImage() string {
+func (x *CreateEpisodeRequest) GetSeasonId() string {
 	if x != nil {
-		return x.Image
+		return x.SeasonId
 	}
 	return ""
 }
 
-//------SHOWS------
-type Show struct {
+type ListEpisodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	EndDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Rating      float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`
-	Length      *ShowLength            `protobuf:"bytes,8,opt,name=length,proto3" json:"length,omitempty"`
-	TrailerUrl  string                 `protobuf:"bytes,9,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	Genres      *ShortGenres           `protobuf:"bytes,10,opt,name=genres,proto3" json:"genres,omitempty"`
-	DirectedBy  *FilmCrew              `protobuf:"bytes,11,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	ProducedBy  *FilmCrew              `protobuf:"bytes,12,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	WrittenBy   *FilmCrew              `protobuf:"bytes,13,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	Starring    *ShortCelebrities      `protobuf:"bytes,14,opt,name=starring,proto3" json:"starring,omitempty"`
-	Description string                 `protobuf:"bytes,15,opt,name=description,proto3" json:"description,omitempty"`
-	Seasons     *ShortSeasons          `protobuf:"bytes,16,opt,name=seasons,proto3" json:"seasons,omitempty"`
+	Episodes      []*Episode `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32      `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *Show) Reset() {
-	*x = Show{}
+func (x *ListEpisodeResponse) Reset() {
+	*x = ListEpisodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[39]
+		mi := &file_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Show) String() string {
+func (x *ListEpisodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Show) ProtoMessage() {}
+func (*ListEpisodeResponse) ProtoMessage() {}
 
-func (x *Show) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[39]
+func (x *ListEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,133 +2637,160 @@ func (x *Show) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Show.ProtoReflect.Descriptor instead.
-func (*Show) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ListEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Show) GetId() string {
+func (x *ListEpisodeResponse) GetEpisodes() []*Episode {
 	if x != nil {
-		return x.Id
+		return x.Episodes
 	}
-	return ""
+	return nil
 }
 
-func (x *Show) GetTitle() string {
+func (x *ListEpisodeResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Title
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Show) GetType() string {
+func (x *ListEpisodeResponse) GetTotalSize() int32 {
 	if x != nil {
-		return x.Type
+		return x.TotalSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Show) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
+type ShowLength struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hours   int32 `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Minutes int32 `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
 }
 
-func (x *Show) GetReleaseDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseDate
+func (x *ShowLength) Reset() {
+	*x = ShowLength{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Show) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
+func (x *ShowLength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowLength) ProtoMessage() {}
+
+func (x *ShowLength) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Show) GetRating() float64 {
+// Deprecated: Use ShowLength.ProtoReflect.Descriptor instead.
+func (*ShowLength) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ShowLength) GetHours() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Hours
 	}
 	return 0
 }
 
-func (x *Show) GetLength() *ShowLength {
+func (x *ShowLength) GetMinutes() int32 {
 	if x != nil {
-		return x.Length
+		return x.Minutes
 	}
-	return nil
+	return 0
 }
 
-func (x *Show) GetTrailerUrl() string {
-	if x != nil {
-		return x.TrailerUrl
-	}
-	return ""
+type FilmStaff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *Show) GetGenres() *ShortGenres {
-	if x != nil {
-		return x.Genres
+func (x *FilmStaff) Reset() {
+	*x = FilmStaff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Show) GetDirectedBy() *FilmCrew {
-	if x != nil {
-		return x.DirectedBy
-	}
-	return nil
+func (x *FilmStaff) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Show) GetProducedBy() *FilmCrew {
-	if x != nil {
-		return x.ProducedBy
+func (*FilmStaff) ProtoMessage() {}
+
+func (x *FilmStaff) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Show) GetWrittenBy() *FilmCrew {
-	if x != nil {
-		return x.WrittenBy
-	}
-	return nil
+// Deprecated: Use FilmStaff.ProtoReflect.Descriptor instead.
+func (*FilmStaff) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Show) GetStarring() *ShortCelebrities {
+func (x *FilmStaff) GetId() string {
 	if x != nil {
-		return x.Starring
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Show) GetDescription() string {
+func (x *FilmStaff) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Show) GetSeasons() *ShortSeasons {
+func (x *FilmStaff) GetPostersPath() []string {
 	if x != nil {
-		return x.Seasons
+		return x.PostersPath
 	}
 	return nil
 }
 
-type ShortGenres struct {
+type FilmCrew struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Genres []*ShortGenre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	FilmCrew []*FilmStaff `protobuf:"bytes,1,rep,name=filmCrew,proto3" json:"filmCrew,omitempty"`
 }
 
-func (x *ShortGenres) Reset() {
-	*x = ShortGenres{}
+func (x *FilmCrew) Reset() {
+	*x = FilmCrew{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2625,13 +2798,13 @@ func (x *ShortGenres) Reset() {
 	}
 }
 
-func (x *ShortGenres) String() string {
+func (x *FilmCrew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortGenres) ProtoMessage() {}
+func (*FilmCrew) ProtoMessage() {}
 
-func (x *ShortGenres) ProtoReflect() protoreflect.Message {
+func (x *FilmCrew) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2643,29 +2816,31 @@ func (x *ShortGenres) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortGenres.ProtoReflect.Descriptor instead.
-func (*ShortGenres) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilmCrew.ProtoReflect.Descriptor instead.
+func (*FilmCrew) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ShortGenres) GetGenres() []*ShortGenre {
+func (x *FilmCrew) GetFilmCrew() []*FilmStaff {
 	if x != nil {
-		return x.Genres
+		return x.FilmCrew
 	}
 	return nil
 }
 
-type ShortGenre struct {
+type ShortCelebrity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RoleName    string   `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	PostersPath []string `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
 }
 
-func (x *ShortGenre) Reset() {
-	*x = ShortGenre{}
+func (x *ShortCelebrity) Reset() {
+	*x = ShortCelebrity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2673,13 +2848,13 @@ func (x *ShortGenre) Reset() {
 	}
 }
 
-func (x *ShortGenre) String() string {
+func (x *ShortCelebrity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortGenre) ProtoMessage() {}
+func (*ShortCelebrity) ProtoMessage() {}
 
-func (x *ShortGenre) ProtoReflect() protoreflect.Message {
+func (x *ShortCelebrity) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2691,38 +2866,49 @@ func (x *ShortGenre) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortGenre.ProtoReflect.Descriptor instead.
-func (*ShortGenre) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortCelebrity.ProtoReflect.Descriptor instead.
+func (*ShortCelebrity) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ShortGenre) GetId() string {
+func (x *ShortCelebrity) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ShortGenre) GetName() string {
+func (x *ShortCelebrity) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ShortSeason struct {
+func (x *ShortCelebrity) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *ShortCelebrity) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+type ShortCelebrities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	Rating      float64  `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	ShortCelebs []*ShortCelebrity `protobuf:"bytes,1,rep,name=shortCelebs,proto3" json:"shortCelebs,omitempty"`
 }
 
-func (x *ShortSeason) Reset() {
-	*x = ShortSeason{}
+func (x *ShortCelebrities) Reset() {
+	*x = ShortCelebrities{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2730,13 +2916,13 @@ func (x *ShortSeason) Reset() {
 	}
 }
 
-func (x *ShortSeason) String() string {
+func (x *ShortCelebrities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortSeason) ProtoMessage() {}
+func (*ShortCelebrities) ProtoMessage() {}
 
-func (x *ShortSeason) ProtoReflect() protoreflect.Message {
+func (x *ShortCelebrities) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2748,49 +2934,32 @@ func (x *ShortSeason) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortSeason.ProtoReflect.Descriptor instead.
-func (*ShortSeason) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortCelebrities.ProtoReflect.Descriptor instead.
+func (*ShortCelebrities) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ShortSeason) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ShortSeason) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *ShortSeason) GetPostersPath() []string {
+func (x *ShortCelebrities) GetShortCelebs() []*ShortCelebrity {
 	if x != nil {
-		return x.PostersPath
+		return x.ShortCelebs
 	}
 	return nil
 }
 
-func (x *ShortSeason) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-type ShortSeasons struct {
+type ShortEpisode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seasons []*ShortSeason `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Rating      float64  `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Resume      string   `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
 }
 
-func (x *ShortSeasons) Reset() {
-	*x = ShortSeasons{}
+func (x *ShortEpisode) Reset() {
+	*x = ShortEpisode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2798,13 +2967,13 @@ func (x *ShortSeasons) Reset() {
 	}
 }
 
-func (x *ShortSeasons) String() string {
+func (x *ShortEpisode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortSeasons) ProtoMessage() {}
+func (*ShortEpisode) ProtoMessage() {}
 
-func (x *ShortSeasons) ProtoReflect() protoreflect.Message {
+func (x *ShortEpisode) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2816,30 +2985,56 @@ func (x *ShortSeasons) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortSeasons.ProtoReflect.Descriptor instead.
-func (*ShortSeasons) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortEpisode.ProtoReflect.Descriptor instead.
+func (*ShortEpisode) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ShortSeasons) GetSeasons() []*ShortSeason {
+func (x *ShortEpisode) GetId() string {
 	if x != nil {
-		return x.Seasons
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShortEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortEpisode) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
 	}
 	return nil
 }
 
-type Genre struct {
+func (x *ShortEpisode) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ShortEpisode) GetResume() string {
+	if x != nil {
+		return x.Resume
+	}
+	return ""
+}
+
+type ShortEpisodeList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ShortEpisodes []*ShortEpisode `protobuf:"bytes,1,rep,name=shortEpisodes,proto3" json:"shortEpisodes,omitempty"`
 }
 
-func (x *Genre) Reset() {
-	*x = Genre{}
+func (x *ShortEpisodeList) Reset() {
+	*x = ShortEpisodeList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,13 +3042,13 @@ func (x *Genre) Reset() {
 	}
 }
 
-func (x *Genre) String() string {
+func (x *ShortEpisodeList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Genre) ProtoMessage() {}
+func (*ShortEpisodeList) ProtoMessage() {}
 
-func (x *Genre) ProtoReflect() protoreflect.Message {
+func (x *ShortEpisodeList) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2865,57 +3060,101 @@ func (x *Genre) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
-func (*Genre) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortEpisodeList.ProtoReflect.Descriptor instead.
+func (*ShortEpisodeList) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Genre) GetId() string {
+func (x *ShortEpisodeList) GetShortEpisodes() []*ShortEpisode {
 	if x != nil {
-		return x.Id
+		return x.ShortEpisodes
 	}
-	return ""
+	return nil
 }
 
-func (x *Genre) GetName() string {
+type UploadEpisodePostersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpisodeId   string   `protobuf:"bytes,1,opt,name=episodeId,proto3" json:"episodeId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+}
+
+func (x *UploadEpisodePostersRequest) Reset() {
+	*x = UploadEpisodePostersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadEpisodePostersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadEpisodePostersRequest) ProtoMessage() {}
+
+func (x *UploadEpisodePostersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadEpisodePostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadEpisodePostersRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UploadEpisodePostersRequest) GetEpisodeId() string {
 	if x != nil {
-		return x.Name
+		return x.EpisodeId
 	}
 	return ""
 }
 
-func (x *Genre) GetDescription() string {
+func (x *UploadEpisodePostersRequest) GetPostersPath() []string {
 	if x != nil {
-		return x.Description
+		return x.PostersPath
 	}
-	return ""
+	return nil
 }
 
-type GenreListResponse struct {
+type DeleteEpisodePosterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Genres []*Genre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	SeriesId  string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
+	SeasonId  string `protobuf:"bytes,2,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	EpisodeId string `protobuf:"bytes,3,opt,name=episodeId,proto3" json:"episodeId,omitempty"`
+	Image     string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (x *GenreListResponse) Reset() {
-	*x = GenreListResponse{}
+func (x *DeleteEpisodePosterRequest) Reset() {
+	*x = DeleteEpisodePosterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[45]
+		mi := &file_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenreListResponse) String() string {
+func (x *DeleteEpisodePosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenreListResponse) ProtoMessage() {}
+func (*DeleteEpisodePosterRequest) ProtoMessage() {}
 
-func (x *GenreListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[45]
+func (x *DeleteEpisodePosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2926,57 +3165,80 @@ func (x *GenreListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenreListResponse.ProtoReflect.Descriptor instead.
-func (*GenreListResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DeleteEpisodePosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEpisodePosterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GenreListResponse) GetGenres() []*Genre {
+func (x *DeleteEpisodePosterRequest) GetSeriesId() string {
 	if x != nil {
-		return x.Genres
+		return x.SeriesId
 	}
-	return nil
+	return ""
 }
 
-type CreateShowRequest struct {
+func (x *DeleteEpisodePosterRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *DeleteEpisodePosterRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *DeleteEpisodePosterRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// ------SHOWS------
+type Show struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	PostersPath []string               `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	EndDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Rating      float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
-	Length      *ShowLength            `protobuf:"bytes,7,opt,name=length,proto3" json:"length,omitempty"`
-	TrailerUrl  string                 `protobuf:"bytes,8,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	Genres      *ShortGenres           `protobuf:"bytes,9,opt,name=genres,proto3" json:"genres,omitempty"`
-	DirectedBy  *FilmCrew              `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	ProducedBy  *FilmCrew              `protobuf:"bytes,11,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	WrittenBy   *FilmCrew              `protobuf:"bytes,12,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	Starring    *ShortCelebrities      `protobuf:"bytes,13,opt,name=starring,proto3" json:"starring,omitempty"`
-	Description string                 `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	Seasons     *ShortSeasons          `protobuf:"bytes,15,opt,name=seasons,proto3" json:"seasons,omitempty"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Rating      float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`
+	Length      *ShowLength            `protobuf:"bytes,8,opt,name=length,proto3" json:"length,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,9,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	Genres      *ShortGenres           `protobuf:"bytes,10,opt,name=genres,proto3" json:"genres,omitempty"`
+	DirectedBy  *FilmCrew              `protobuf:"bytes,11,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	ProducedBy  *FilmCrew              `protobuf:"bytes,12,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	WrittenBy   *FilmCrew              `protobuf:"bytes,13,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	Starring    *ShortCelebrities      `protobuf:"bytes,14,opt,name=starring,proto3" json:"starring,omitempty"`
+	Description string                 `protobuf:"bytes,15,opt,name=description,proto3" json:"description,omitempty"`
+	Seasons     *ShortSeasons          `protobuf:"bytes,16,opt,name=seasons,proto3" json:"seasons,omitempty"`
 }
 
-func (x *CreateShowRequest) Reset() {
-	*x = CreateShowRequest{}
+func (x *Show) Reset() {
+	*x = Show{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[46]
+		mi := &file_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateShowRequest) String() string {
+func (x *Show) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShowRequest) ProtoMessage() {}
+func (*Show) ProtoMessage() {}
 
-func (x *CreateShowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[46]
+func (x *Show) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,141 +3249,151 @@ func (x *CreateShowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShowRequest.ProtoReflect.Descriptor instead.
-func (*CreateShowRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Show.ProtoReflect.Descriptor instead.
+func (*Show) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateShowRequest) GetTitle() string {
+func (x *Show) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Show) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateShowRequest) GetType() string {
+func (x *Show) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateShowRequest) GetPostersPath() []string {
+func (x *Show) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetReleaseDate() *timestamppb.Timestamp {
+func (x *Show) GetReleaseDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ReleaseDate
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *Show) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndDate
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetRating() float64 {
+func (x *Show) GetRating() float64 {
 	if x != nil {
 		return x.Rating
 	}
 	return 0
 }
 
-func (x *CreateShowRequest) GetLength() *ShowLength {
+func (x *Show) GetLength() *ShowLength {
 	if x != nil {
 		return x.Length
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetTrailerUrl() string {
+func (x *Show) GetTrailerUrl() string {
 	if x != nil {
 		return x.TrailerUrl
 	}
 	return ""
 }
 
-func (x *CreateShowRequest) GetGenres() *ShortGenres {
+func (x *Show) GetGenres() *ShortGenres {
 	if x != nil {
 		return x.Genres
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetDirectedBy() *FilmCrew {
+func (x *Show) GetDirectedBy() *FilmCrew {
 	if x != nil {
 		return x.DirectedBy
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetProducedBy() *FilmCrew {
+func (x *Show) GetProducedBy() *FilmCrew {
 	if x != nil {
 		return x.ProducedBy
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetWrittenBy() *FilmCrew {
+func (x *Show) GetWrittenBy() *FilmCrew {
 	if x != nil {
 		return x.WrittenBy
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetStarring() *ShortCelebrities {
+func (x *Show) GetStarring() *ShortCelebrities {
 	if x != nil {
 		return x.Starring
 	}
 	return nil
 }
 
-func (x *CreateShowRequest) GetDescription() string {
+func (x *Show) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateShowRequest) GetSeasons() *ShortSeasons {
+func (x *Show) GetSeasons() *ShortSeasons {
 	if x != nil {
 		return x.Seasons
 	}
 	return nil
 }
 
-type ShowListResponse struct {
+type PatchShowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shows []*Show `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
+	Show *Show `protobuf:"bytes,1,opt,name=show,proto3" json:"show,omitempty"`
+	// Fields to write, top level or a field of length such as
+	// length.minutes. An empty mask writes every field.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
-func (x *ShowListResponse) Reset() {
-	*x = ShowListResponse{}
+func (x *PatchShowRequest) Reset() {
+	*x = PatchShowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[47]
+		mi := &file_service_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShowListResponse) String() string {
+func (x *PatchShowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowListResponse) ProtoMessage() {}
+func (*PatchShowRequest) ProtoMessage() {}
 
-func (x *ShowListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[47]
+func (x *PatchShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3132,44 +3404,50 @@ func (x *ShowListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowListResponse.ProtoReflect.Descriptor instead.
-func (*ShowListResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use PatchShowRequest.ProtoReflect.Descriptor instead.
+func (*PatchShowRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ShowListResponse) GetShows() []*Show {
+func (x *PatchShowRequest) GetShow() *Show {
 	if x != nil {
-		return x.Shows
+		return x.Show
 	}
 	return nil
 }
 
-type CreateGenreRequest struct {
+func (x *PatchShowRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ShortGenres struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Genres []*ShortGenre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
 }
 
-func (x *CreateGenreRequest) Reset() {
-	*x = CreateGenreRequest{}
+func (x *ShortGenres) Reset() {
+	*x = ShortGenres{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[48]
+		mi := &file_service_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGenreRequest) String() string {
+func (x *ShortGenres) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGenreRequest) ProtoMessage() {}
+func (*ShortGenres) ProtoMessage() {}
 
-func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[48]
+func (x *ShortGenres) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3180,62 +3458,44 @@ func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
-func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *CreateGenreRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ShortGenres.ProtoReflect.Descriptor instead.
+func (*ShortGenres) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateGenreRequest) GetDescription() string {
+func (x *ShortGenres) GetGenres() []*ShortGenre {
 	if x != nil {
-		return x.Description
+		return x.Genres
 	}
-	return ""
+	return nil
 }
 
-//------SEASONS------
-type Season struct {
+type ShortGenre struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	TrailerUrl  string                 `protobuf:"bytes,3,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	Resume      string                 `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
-	Rating      float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	WrittenBy   *FilmCrew              `protobuf:"bytes,8,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	ProducedBy  *FilmCrew              `protobuf:"bytes,9,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	DirectedBy  *FilmCrew              `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	Episodes    *ShortEpisodeList      `protobuf:"bytes,11,opt,name=episodes,proto3" json:"episodes,omitempty"`
-	ShowId      string                 `protobuf:"bytes,12,opt,name=showId,proto3" json:"showId,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Season) Reset() {
-	*x = Season{}
+func (x *ShortGenre) Reset() {
+	*x = ShortGenre{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[49]
+		mi := &file_service_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Season) String() string {
+func (x *ShortGenre) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Season) ProtoMessage() {}
+func (*ShortGenre) ProtoMessage() {}
 
-func (x *Season) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[49]
+func (x *ShortGenre) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,130 +3506,121 @@ func (x *Season) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Season.ProtoReflect.Descriptor instead.
-func (*Season) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ShortGenre.ProtoReflect.Descriptor instead.
+func (*ShortGenre) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Season) GetId() string {
+func (x *ShortGenre) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Season) GetTitle() string {
+func (x *ShortGenre) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Season) GetTrailerUrl() string {
-	if x != nil {
-		return x.TrailerUrl
-	}
-	return ""
-}
+type ShortSeason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Season) GetPostersPath() []string {
-	if x != nil {
-		return x.PostersPath
-	}
-	return nil
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PostersPath []string `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Rating      float64  `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
 }
 
-func (x *Season) GetResume() string {
-	if x != nil {
-		return x.Resume
+func (x *ShortSeason) Reset() {
+	*x = ShortSeason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Season) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+func (x *ShortSeason) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Season) GetReleaseDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseDate
+func (*ShortSeason) ProtoMessage() {}
+
+func (x *ShortSeason) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Season) GetWrittenBy() *FilmCrew {
-	if x != nil {
-		return x.WrittenBy
-	}
-	return nil
+// Deprecated: Use ShortSeason.ProtoReflect.Descriptor instead.
+func (*ShortSeason) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Season) GetProducedBy() *FilmCrew {
+func (x *ShortSeason) GetId() string {
 	if x != nil {
-		return x.ProducedBy
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Season) GetDirectedBy() *FilmCrew {
+func (x *ShortSeason) GetTitle() string {
 	if x != nil {
-		return x.DirectedBy
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *Season) GetEpisodes() *ShortEpisodeList {
+func (x *ShortSeason) GetPostersPath() []string {
 	if x != nil {
-		return x.Episodes
+		return x.PostersPath
 	}
 	return nil
 }
 
-func (x *Season) GetShowId() string {
+func (x *ShortSeason) GetRating() float64 {
 	if x != nil {
-		return x.ShowId
+		return x.Rating
 	}
-	return ""
+	return 0
 }
 
-type CreateSeasonRequest struct {
+type ShortSeasons struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	TrailerUrl  string                 `protobuf:"bytes,2,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
-	Resume      string                 `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
-	Rating      float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	WrittenBy   *FilmCrew              `protobuf:"bytes,6,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
-	ProducedBy  *FilmCrew              `protobuf:"bytes,7,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
-	DirectedBy  *FilmCrew              `protobuf:"bytes,8,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
-	Episodes    *ShortEpisodeList      `protobuf:"bytes,9,opt,name=episodes,proto3" json:"episodes,omitempty"`
-	PostersPath []string               `protobuf:"bytes,10,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
-	ShowId      string                 `protobuf:"bytes,11,opt,name=showId,proto3" json:"showId,omitempty"`
+	Seasons []*ShortSeason `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
 }
 
-func (x *CreateSeasonRequest) Reset() {
-	*x = CreateSeasonRequest{}
+func (x *ShortSeasons) Reset() {
+	*x = ShortSeasons{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[50]
+		mi := &file_service_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSeasonRequest) String() string {
+func (x *ShortSeasons) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSeasonRequest) ProtoMessage() {}
+func (*ShortSeasons) ProtoMessage() {}
 
-func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[50]
+func (x *ShortSeasons) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3380,113 +3631,108 @@ func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
-func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ShortSeasons.ProtoReflect.Descriptor instead.
+func (*ShortSeasons) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateSeasonRequest) GetTitle() string {
+func (x *ShortSeasons) GetSeasons() []*ShortSeason {
 	if x != nil {
-		return x.Title
+		return x.Seasons
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSeasonRequest) GetTrailerUrl() string {
-	if x != nil {
-		return x.TrailerUrl
-	}
-	return ""
-}
+type Genre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CreateSeasonRequest) GetResume() string {
-	if x != nil {
-		return x.Resume
-	}
-	return ""
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *CreateSeasonRequest) GetRating() float64 {
-	if x != nil {
-		return x.Rating
+func (x *Genre) Reset() {
+	*x = Genre{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CreateSeasonRequest) GetReleaseDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseDate
-	}
-	return nil
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateSeasonRequest) GetWrittenBy() *FilmCrew {
-	if x != nil {
-		return x.WrittenBy
-	}
-	return nil
-}
+func (*Genre) ProtoMessage() {}
 
-func (x *CreateSeasonRequest) GetProducedBy() *FilmCrew {
-	if x != nil {
-		return x.ProducedBy
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSeasonRequest) GetDirectedBy() *FilmCrew {
-	if x != nil {
-		return x.DirectedBy
-	}
-	return nil
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CreateSeasonRequest) GetEpisodes() *ShortEpisodeList {
+func (x *Genre) GetId() string {
 	if x != nil {
-		return x.Episodes
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSeasonRequest) GetPostersPath() []string {
+func (x *Genre) GetName() string {
 	if x != nil {
-		return x.PostersPath
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSeasonRequest) GetShowId() string {
+func (x *Genre) GetDescription() string {
 	if x != nil {
-		return x.ShowId
+		return x.Description
 	}
 	return ""
 }
 
-type ListSeasonResponse struct {
+type GenreListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seasons []*Season `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	Genres        []*Genre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32    `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *ListSeasonResponse) Reset() {
-	*x = ListSeasonResponse{}
+func (x *GenreListResponse) Reset() {
+	*x = GenreListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[51]
+		mi := &file_service_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSeasonResponse) String() string {
+func (x *GenreListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSeasonResponse) ProtoMessage() {}
+func (*GenreListResponse) ProtoMessage() {}
 
-func (x *ListSeasonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[51]
+func (x *GenreListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,44 +3743,71 @@ func (x *ListSeasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSeasonResponse.ProtoReflect.Descriptor instead.
-func (*ListSeasonResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GenreListResponse.ProtoReflect.Descriptor instead.
+func (*GenreListResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListSeasonResponse) GetSeasons() []*Season {
+func (x *GenreListResponse) GetGenres() []*Genre {
 	if x != nil {
-		return x.Seasons
+		return x.Genres
 	}
 	return nil
 }
 
-type UploadSeasonPostersRequest struct {
+func (x *GenreListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GenreListResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateShowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeasonId    string   `protobuf:"bytes,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
-	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PostersPath []string               `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Rating      float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	Length      *ShowLength            `protobuf:"bytes,7,opt,name=length,proto3" json:"length,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,8,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	Genres      *ShortGenres           `protobuf:"bytes,9,opt,name=genres,proto3" json:"genres,omitempty"`
+	DirectedBy  *FilmCrew              `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	ProducedBy  *FilmCrew              `protobuf:"bytes,11,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	WrittenBy   *FilmCrew              `protobuf:"bytes,12,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	Starring    *ShortCelebrities      `protobuf:"bytes,13,opt,name=starring,proto3" json:"starring,omitempty"`
+	Description string                 `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Seasons     *ShortSeasons          `protobuf:"bytes,15,opt,name=seasons,proto3" json:"seasons,omitempty"`
 }
 
-func (x *UploadSeasonPostersRequest) Reset() {
-	*x = UploadSeasonPostersRequest{}
+func (x *CreateShowRequest) Reset() {
+	*x = CreateShowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[52]
+		mi := &file_service_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadSeasonPostersRequest) String() string {
+func (x *CreateShowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSeasonPostersRequest) ProtoMessage() {}
+func (*CreateShowRequest) ProtoMessage() {}
 
-func (x *UploadSeasonPostersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[52]
+func (x *CreateShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3545,52 +3818,143 @@ func (x *UploadSeasonPostersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSeasonPostersRequest.ProtoReflect.Descriptor instead.
-func (*UploadSeasonPostersRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use CreateShowRequest.ProtoReflect.Descriptor instead.
+func (*CreateShowRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UploadSeasonPostersRequest) GetSeasonId() string {
+func (x *CreateShowRequest) GetTitle() string {
 	if x != nil {
-		return x.SeasonId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *UploadSeasonPostersRequest) GetPostersPath() []string {
+func (x *CreateShowRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateShowRequest) GetPostersPath() []string {
 	if x != nil {
 		return x.PostersPath
 	}
 	return nil
 }
 
-type DeleteSeasonPosterRequest struct {
+func (x *CreateShowRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateShowRequest) GetLength() *ShowLength {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *CreateShowRequest) GetGenres() *ShortGenres {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetDirectedBy() *FilmCrew {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetProducedBy() *FilmCrew {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetWrittenBy() *FilmCrew {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetStarring() *ShortCelebrities {
+	if x != nil {
+		return x.Starring
+	}
+	return nil
+}
+
+func (x *CreateShowRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateShowRequest) GetSeasons() *ShortSeasons {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type ShowListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeriesId string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
-	SeasonId string `protobuf:"bytes,2,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
-	Image    string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Shows         []*Show `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32   `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *DeleteSeasonPosterRequest) Reset() {
-	*x = DeleteSeasonPosterRequest{}
+func (x *ShowListResponse) Reset() {
+	*x = ShowListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_proto_msgTypes[53]
+		mi := &file_service_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSeasonPosterRequest) String() string {
+func (x *ShowListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSeasonPosterRequest) ProtoMessage() {}
+func (*ShowListResponse) ProtoMessage() {}
 
-func (x *DeleteSeasonPosterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[53]
+func (x *ShowListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3601,972 +3965,5529 @@ func (x *DeleteSeasonPosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSeasonPosterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSeasonPosterRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ShowListResponse.ProtoReflect.Descriptor instead.
+func (*ShowListResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteSeasonPosterRequest) GetSeriesId() string {
+func (x *ShowListResponse) GetShows() []*Show {
 	if x != nil {
-		return x.SeriesId
+		return x.Shows
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteSeasonPosterRequest) GetSeasonId() string {
+func (x *ShowListResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.SeasonId
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *DeleteSeasonPosterRequest) GetImage() string {
+func (x *ShowListResponse) GetTotalSize() int32 {
 	if x != nil {
-		return x.Image
+		return x.TotalSize
 	}
-	return ""
+	return 0
 }
 
-var File_service_proto protoreflect.FileDescriptor
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_service_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x74,
-	0x68, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63,
-	0x6c, 0x6f, 0x74, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x63, 0x6c, 0x6f, 0x74, 0x68, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x22, 0x50, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x19, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6c, 0x69, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0a,
-	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d,
-	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a,
-	0x16, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73,
-	0x74, 0x52, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x21,
-	0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42,
-	0x69, 0x72, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69,
-	0x72, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74,
-	0x68, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66,
-	0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x62, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12,
-	0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0xb8, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x3c,
-	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63,
-	0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x15, 0x43, 0x65,
-	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x63, 0x65,
-	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x6c,
-	0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74,
-	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62,
-	0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x56, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x65,
-	0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x22, 0xc0, 0x03, 0x0a, 0x07, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
-	0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0a, 0x73,
-	0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52,
-	0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
-	0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d,
-	0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73,
-	0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0xbd, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55,
-	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
-	0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0a, 0x73,
-	0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52,
-	0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
-	0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d,
-	0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73,
-	0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x08,
-	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x77,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
-	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x6d, 0x53, 0x74,
-	0x61, 0x66, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
-	0x6d, 0x43, 0x72, 0x65, 0x77, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
-	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x6d, 0x43, 0x72, 0x65, 0x77, 0x22, 0x72, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65,
-	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x10, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a,
-	0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d,
-	0x65, 0x22, 0x4f, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69,
-	0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x8a, 0x05, 0x0a,
-	0x04, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a,
-	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72,
-	0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x65,
-	0x6e, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73,
-	0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72,
-	0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2f,
-	0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d,
-	0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12,
-	0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72,
-	0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73, 0x74,
-	0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x0a, 0x0b, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x67,
-	0x65, 0x6e, 0x72, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65,
-	0x6e, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x0b, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3e, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x65,
-	0x6e, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72,
-	0x65, 0x73, 0x22, 0x87, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a,
-	0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e,
-	0x72, 0x65, 0x73, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72,
-	0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46,
-	0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
-	0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x73,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x10,
-	0x53, 0x68, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x05,
-	0x73, 0x68, 0x6f, 0x77, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x65, 0x6e, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xc4, 0x03, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55,
-	0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65,
-	0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42,
-	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x70, 0x69,
-	0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x22, 0xc1, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
-	0x72, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69,
-	0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74,
-	0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x64, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x65,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69,
-	0x73, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x12,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a,
-	0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x69, 0x0a, 0x19, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x32, 0xe3, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e,
-	0x67, 0x53, 0x76, 0x63, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c,
-	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c,
-	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8d, 0x04, 0x0a, 0x0a, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x76, 0x63, 0x12, 0x42, 0x0a, 0x0d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12,
-	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
-	0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4a,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x50, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xd3, 0x03, 0x0a, 0x0c, 0x43,
-	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x53, 0x76, 0x63, 0x12, 0x48, 0x0a, 0x0f, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
-	0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x65,
-	0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65,
-	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x56, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x6c, 0x65, 0x62,
-	0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x6c, 0x65,
-	0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65,
-	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62,
-	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
-	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x32, 0x8b, 0x04, 0x0a, 0x0a, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x53, 0x76, 0x63, 0x12,
-	0x42, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
-	0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x00, 0x12, 0x35,
-	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
-	0x65, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69,
-	0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa7,
-	0x04, 0x0a, 0x07, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x76, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x68, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77,
-	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x22, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x12, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x68, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xb3, 0x02, 0x0a, 0x08, 0x47, 0x65, 0x6e,
-	0x72, 0x65, 0x53, 0x76, 0x63, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x65, 0x6e, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
-	0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12,
-	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x00, 0x32, 0xf7,
-	0x03, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x53, 0x76, 0x63, 0x12, 0x3f, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x13, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
-	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf4, 0x02, 0x0a, 0x0d, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x76, 0x63, 0x12, 0x4b, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x20,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42,
-	0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-var (
-	file_service_proto_rawDescOnce sync.Once
-	file_service_proto_rawDescData = file_service_proto_rawDesc
-)
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_service_proto_rawDescGZIP() []byte {
-	file_service_proto_rawDescOnce.Do(func() {
-		file_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_proto_rawDescData)
-	})
-	return file_service_proto_rawDescData
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
-var file_service_proto_goTypes = []interface{}{
-	(*CreateClothingRequest)(nil),         // 0: service.CreateClothingRequest
-	(*Clothing)(nil),                      // 1: service.Clothing
-	(*DeleteClothingRequest)(nil),         // 2: service.DeleteClothingRequest
-	(*ClothingListResponse)(nil),          // 3: service.ClothingListResponse
-	(*EmptyResponse)(nil),                 // 4: service.EmptyResponse
-	(*GetAllRequest)(nil),                 // 5: service.GetAllRequest
-	(*ListArticlesRequest)(nil),           // 6: service.ListArticlesRequest
-	(*UploadArticlePostersRequest)(nil),   // 7: service.UploadArticlePostersRequest
-	(*UploadSeriesPostersRequest)(nil),    // 8: service.UploadSeriesPostersRequest
-	(*DeleteArticlePosterRequest)(nil),    // 9: service.DeleteArticlePosterRequest
-	(*UploadMoviePostersRequest)(nil),     // 10: service.UploadMoviePostersRequest
-	(*DeleteSeriesPosterRequest)(nil),     // 11: service.DeleteSeriesPosterRequest
-	(*DeleteMoviePosterRequest)(nil),      // 12: service.DeleteMoviePosterRequest
-	(*Article)(nil),                       // 13: service.Article
-	(*CreateArticleRequest)(nil),          // 14: service.CreateArticleRequest
-	(*ArticleListResponse)(nil),           // 15: service.ArticleListResponse
-	(*Journalist)(nil),                    // 16: service.Journalist
-	(*CreateJournalistRequest)(nil),       // 17: service.CreateJournalistRequest
-	(*JournalistListResponse)(nil),        // 18: service.JournalistListResponse
-	(*ShortJournalist)(nil),               // 19: service.ShortJournalist
-	(*Celebrity)(nil),                     // 20: service.Celebrity
-	(*CreateCelebrityRequest)(nil),        // 21: service.CreateCelebrityRequest
-	(*CelebrityListResponse)(nil),         // 22: service.CelebrityListResponse
-	(*GetByIDRequest)(nil),                // 23: service.GetByIDRequest
-	(*GetByNameRequest)(nil),              // 24: service.GetByNameRequest
-	(*UploadCelebrityPostersRequest)(nil), // 25: service.UploadCelebrityPostersRequest
-	(*DeleteCelebrityPosterRequest)(nil),  // 26: service.DeleteCelebrityPosterRequest
-	(*Episode)(nil),                       // 27: service.Episode
-	(*CreateEpisodeRequest)(nil),          // 28: service.CreateEpisodeRequest
-	(*ListEpisodeResponse)(nil),           // 29: service.ListEpisodeResponse
-	(*ShowLength)(nil),                    // 30: service.ShowLength
-	(*FilmStaff)(nil),                     // 31: service.FilmStaff
-	(*FilmCrew)(nil),                      // 32: service.FilmCrew
-	(*ShortCelebrity)(nil),                // 33: service.ShortCelebrity
-	(*ShortCelebrities)(nil),              // 34: service.ShortCelebrities
-	(*ShortEpisode)(nil),                  // 35: service.ShortEpisode
-	(*ShortEpisodeList)(nil),              // 36: service.ShortEpisodeList
-	(*UploadEpisodePostersRequest)(nil),   // 37: service.UploadEpisodePostersRequest
-	(*DeleteEpisodePosterRequest)(nil),    // 38: service.DeleteEpisodePosterRequest
-	(*Show)(nil),                          // 39: service.Show
-	(*ShortGenres)(nil),                   // 40: service.ShortGenres
-	(*ShortGenre)(nil),                    // 41: service.ShortGenre
-	(*ShortSeason)(nil),                   // 42: service.ShortSeason
-	(*ShortSeasons)(nil),                  // 43: service.ShortSeasons
-	(*Genre)(nil),                         // 44: service.Genre
-	(*GenreListResponse)(nil),             // 45: service.GenreListResponse
-	(*CreateShowRequest)(nil),             // 46: service.CreateShowRequest
-	(*ShowListResponse)(nil),              // 47: service.ShowListResponse
-	(*CreateGenreRequest)(nil),            // 48: service.CreateGenreRequest
-	(*Season)(nil),                        // 49: service.Season
-	(*CreateSeasonRequest)(nil),           // 50: service.CreateSeasonRequest
-	(*ListSeasonResponse)(nil),            // 51: service.ListSeasonResponse
-	(*UploadSeasonPostersRequest)(nil),    // 52: service.UploadSeasonPostersRequest
-	(*DeleteSeasonPosterRequest)(nil),     // 53: service.DeleteSeasonPosterRequest
-	(*timestamppb.Timestamp)(nil),         // 54: google.protobuf.Timestamp
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_service_proto_depIdxs = []int32{
-	1,   // 0: service.ClothingListResponse.clothes:type_name -> service.Clothing
-	54,  // 1: service.Article.releaseDate:type_name -> google.protobuf.Timestamp
-	19,  // 2: service.Article.journalist:type_name -> service.ShortJournalist
-	54,  // 3: service.CreateArticleRequest.releaseDate:type_name -> google.protobuf.Timestamp
-	17,  // 4: service.CreateArticleRequest.journalist:type_name -> service.CreateJournalistRequest
-	13,  // 5: service.ArticleListResponse.articles:type_name -> service.Article
-	16,  // 6: service.JournalistListResponse.journalists:type_name -> service.Journalist
-	54,  // 7: service.Celebrity.dateOfBirth:type_name -> google.protobuf.Timestamp
-	54,  // 8: service.Celebrity.dateOfDeath:type_name -> google.protobuf.Timestamp
-	54,  // 9: service.CreateCelebrityRequest.dateOfBirth:type_name -> google.protobuf.Timestamp
-	54,  // 10: service.CreateCelebrityRequest.dateOfDeath:type_name -> google.protobuf.Timestamp
-	20,  // 11: service.CelebrityListResponse.celebrities:type_name -> service.Celebrity
-	30,  // 12: service.Episode.showLength:type_name -> service.ShowLength
-	32,  // 13: service.Episode.writtenBy:type_name -> service.FilmCrew
-	32,  // 14: service.Episode.producedBy:type_name -> service.FilmCrew
-	32,  // 15: service.Episode.directedBy:type_name -> service.FilmCrew
-	34,  // 16: service.Episode.starring:type_name -> service.ShortCelebrities
-	30,  // 17: service.CreateEpisodeRequest.showLength:type_name -> service.ShowLength
-	32,  // 18: service.CreateEpisodeRequest.writtenBy:type_name -> service.FilmCrew
-	32,  // 19: service.CreateEpisodeRequest.producedBy:type_name -> service.FilmCrew
-	32,  // 20: service.CreateEpisodeRequest.directedBy:type_name -> service.FilmCrew
-	34,  // 21: service.CreateEpisodeRequest.starring:type_name -> service.ShortCelebrities
-	27,  // 22: service.ListEpisodeResponse.episodes:type_name -> service.Episode
-	31,  // 23: service.FilmCrew.filmCrew:type_name -> service.FilmStaff
-	33,  // 24: service.ShortCelebrities.shortCelebs:type_name -> service.ShortCelebrity
-	35,  // 25: service.ShortEpisodeList.shortEpisodes:type_name -> service.ShortEpisode
-	54,  // 26: service.Show.releaseDate:type_name -> google.protobuf.Timestamp
-	54,  // 27: service.Show.endDate:type_name -> google.protobuf.Timestamp
-	30,  // 28: service.Show.length:type_name -> service.ShowLength
-	40,  // 29: service.Show.genres:type_name -> service.ShortGenres
-	32,  // 30: service.Show.directedBy:type_name -> service.FilmCrew
-	32,  // 31: service.Show.producedBy:type_name -> service.FilmCrew
-	32,  // 32: service.Show.writtenBy:type_name -> service.FilmCrew
-	34,  // 33: service.Show.starring:type_name -> service.ShortCelebrities
-	43,  // 34: service.Show.seasons:type_name -> service.ShortSeasons
-	41,  // 35: service.ShortGenres.genres:type_name -> service.ShortGenre
-	42,  // 36: service.ShortSeasons.seasons:type_name -> service.ShortSeason
-	44,  // 37: service.GenreListResponse.genres:type_name -> service.Genre
-	54,  // 38: service.CreateShowRequest.releaseDate:type_name -> google.protobuf.Timestamp
-	54,  // 39: service.CreateShowRequest.endDate:type_name -> google.protobuf.Timestamp
-	30,  // 40: service.CreateShowRequest.length:type_name -> service.ShowLength
-	40,  // 41: service.CreateShowRequest.genres:type_name -> service.ShortGenres
-	32,  // 42: service.CreateShowRequest.directedBy:type_name -> service.FilmCrew
-	32,  // 43: service.CreateShowRequest.producedBy:type_name -> service.FilmCrew
-	32,  // 44: service.CreateShowRequest.writtenBy:type_name -> service.FilmCrew
-	34,  // 45: service.CreateShowRequest.starring:type_name -> service.ShortCelebrities
-	43,  // 46: service.CreateShowRequest.seasons:type_name -> service.ShortSeasons
-	39,  // 47: service.ShowListResponse.shows:type_name -> service.Show
-	54,  // 48: service.Season.releaseDate:type_name -> google.protobuf.Timestamp
-	32,  // 49: service.Season.writtenBy:type_name -> service.FilmCrew
-	32,  // 50: service.Season.producedBy:type_name -> service.FilmCrew
-	32,  // 51: service.Season.directedBy:type_name -> service.FilmCrew
-	36,  // 52: service.Season.episodes:type_name -> service.ShortEpisodeList
-	54,  // 53: service.CreateSeasonRequest.releaseDate:type_name -> google.protobuf.Timestamp
-	32,  // 54: service.CreateSeasonRequest.writtenBy:type_name -> service.FilmCrew
-	32,  // 55: service.CreateSeasonRequest.producedBy:type_name -> service.FilmCrew
-	32,  // 56: service.CreateSeasonRequest.directedBy:type_name -> service.FilmCrew
-	36,  // 57: service.CreateSeasonRequest.episodes:type_name -> service.ShortEpisodeList
-	49,  // 58: service.ListSeasonResponse.seasons:type_name -> service.Season
-	0,   // 59: service.ClothingSvc.CreateClothing:input_type -> service.CreateClothingRequest
-	2,   // 60: service.ClothingSvc.DeleteClothing:input_type -> service.DeleteClothingRequest
-	5,   // 61: service.ClothingSvc.GetAll:input_type -> service.GetAllRequest
-	14,  // 62: service.ArticleSvc.CreateArticle:input_type -> service.CreateArticleRequest
-	23,  // 63: service.ArticleSvc.GetArticle:input_type -> service.GetByIDRequest
-	13,  // 64: service.ArticleSvc.UpdateArticle:input_type -> service.Article
-	6,   // 65: service.ArticleSvc.ListArticles:input_type -> service.ListArticlesRequest
-	23,  // 66: service.ArticleSvc.ListArticlesByJournalist:input_type -> service.GetByIDRequest
-	7,   // 67: service.ArticleSvc.UploadArticlePosters:input_type -> service.UploadArticlePostersRequest
-	9,   // 68: service.ArticleSvc.DeleteArticlePoster:input_type -> service.DeleteArticlePosterRequest
-	21,  // 69: service.CelebritySvc.CreateCelebrity:input_type -> service.CreateCelebrityRequest
-	23,  // 70: service.CelebritySvc.GetCelebrity:input_type -> service.GetByIDRequest
-	20,  // 71: service.CelebritySvc.UpdateCelebrity:input_type -> service.Celebrity
-	25,  // 72: service.CelebritySvc.UploadCelebrityPosters:input_type -> service.UploadCelebrityPostersRequest
-	26,  // 73: service.CelebritySvc.DeleteCelebrityPoster:input_type -> service.DeleteCelebrityPosterRequest
-	5,   // 74: service.CelebritySvc.ListCelebrities:input_type -> service.GetAllRequest
-	28,  // 75: service.EpisodeSvc.CreateEpisode:input_type -> service.CreateEpisodeRequest
-	23,  // 76: service.EpisodeSvc.GetEpisode:input_type -> service.GetByIDRequest
-	27,  // 77: service.EpisodeSvc.UpdateEpisode:input_type -> service.Episode
-	37,  // 78: service.EpisodeSvc.UploadEpisodePosters:input_type -> service.UploadEpisodePostersRequest
-	38,  // 79: service.EpisodeSvc.DeleteEpisodePoster:input_type -> service.DeleteEpisodePosterRequest
-	23,  // 80: service.EpisodeSvc.ListSeasonEpisodes:input_type -> service.GetByIDRequest
-	5,   // 81: service.EpisodeSvc.ListCollectionEpisodes:input_type -> service.GetAllRequest
-	46,  // 82: service.ShowSvc.CreateShow:input_type -> service.CreateShowRequest
-	23,  // 83: service.ShowSvc.GetShow:input_type -> service.GetByIDRequest
-	39,  // 84: service.ShowSvc.UpdateShow:input_type -> service.Show
-	5,   // 85: service.ShowSvc.ListShows:input_type -> service.GetAllRequest
-	8,   // 86: service.ShowSvc.UploadSeriesPosters:input_type -> service.UploadSeriesPostersRequest
-	11,  // 87: service.ShowSvc.DeleteSeriesPoster:input_type -> service.DeleteSeriesPosterRequest
-	10,  // 88: service.ShowSvc.UploadMoviePosters:input_type -> service.UploadMoviePostersRequest
-	12,  // 89: service.ShowSvc.DeleteMoviePoster:input_type -> service.DeleteMoviePosterRequest
-	48,  // 90: service.GenreSvc.CreateGenre:input_type -> service.CreateGenreRequest
-	23,  // 91: service.GenreSvc.GetGenre:input_type -> service.GetByIDRequest
-	44,  // 92: service.GenreSvc.UpdateGenre:input_type -> service.Genre
-	5,   // 93: service.GenreSvc.ListGenres:input_type -> service.GetAllRequest
-	24,  // 94: service.GenreSvc.GetGenreByName:input_type -> service.GetByNameRequest
-	50,  // 95: service.SeasonSvc.CreateSeason:input_type -> service.CreateSeasonRequest
-	23,  // 96: service.SeasonSvc.GetSeason:input_type -> service.GetByIDRequest
-	49,  // 97: service.SeasonSvc.UpdateSeason:input_type -> service.Season
-	52,  // 98: service.SeasonSvc.UploadSeasonPosters:input_type -> service.UploadSeasonPostersRequest
-	53,  // 99: service.SeasonSvc.DeleteSeasonPoster:input_type -> service.DeleteSeasonPosterRequest
-	23,  // 100: service.SeasonSvc.ListShowSeasons:input_type -> service.GetByIDRequest
-	5,   // 101: service.SeasonSvc.ListSeasonsCollection:input_type -> service.GetAllRequest
-	17,  // 102: service.JournalistSvc.CreateJournalist:input_type -> service.CreateJournalistRequest
-	23,  // 103: service.JournalistSvc.GetJournalist:input_type -> service.GetByIDRequest
-	16,  // 104: service.JournalistSvc.UpdateJournalist:input_type -> service.Journalist
-	5,   // 105: service.JournalistSvc.ListJournalists:input_type -> service.GetAllRequest
-	24,  // 106: service.JournalistSvc.GetJournalistByName:input_type -> service.GetByNameRequest
-	1,   // 107: service.ClothingSvc.CreateClothing:output_type -> service.Clothing
-	4,   // 108: service.ClothingSvc.DeleteClothing:output_type -> service.EmptyResponse
-	3,   // 109: service.ClothingSvc.GetAll:output_type -> service.ClothingListResponse
-	13,  // 110: service.ArticleSvc.CreateArticle:output_type -> service.Article
-	13,  // 111: service.ArticleSvc.GetArticle:output_type -> service.Article
-	13,  // 112: service.ArticleSvc.UpdateArticle:output_type -> service.Article
-	15,  // 113: service.ArticleSvc.ListArticles:output_type -> service.ArticleListResponse
-	15,  // 114: service.ArticleSvc.ListArticlesByJournalist:output_type -> service.ArticleListResponse
-	13,  // 115: service.ArticleSvc.UploadArticlePosters:output_type -> service.Article
-	4,   // 116: service.ArticleSvc.DeleteArticlePoster:output_type -> service.EmptyResponse
-	20,  // 117: service.CelebritySvc.CreateCelebrity:output_type -> service.Celebrity
-	20,  // 118: service.CelebritySvc.GetCelebrity:output_type -> service.Celebrity
-	20,  // 119: service.CelebritySvc.UpdateCelebrity:output_type -> service.Celebrity
-	20,  // 120: service.CelebritySvc.UploadCelebrityPosters:output_type -> service.Celebrity
-	4,   // 121: service.CelebritySvc.DeleteCelebrityPoster:output_type -> service.EmptyResponse
-	22,  // 122: service.CelebritySvc.ListCelebrities:output_type -> service.CelebrityListResponse
-	27,  // 123: service.EpisodeSvc.CreateEpisode:output_type -> service.Episode
-	27,  // 124: service.EpisodeSvc.GetEpisode:output_type -> service.Episode
-	27,  // 125: service.EpisodeSvc.UpdateEpisode:output_type -> service.Episode
-	27,  // 126: service.EpisodeSvc.UploadEpisodePosters:output_type -> service.Episode
-	4,   // 127: service.EpisodeSvc.DeleteEpisodePoster:output_type -> service.EmptyResponse
-	29,  // 128: service.EpisodeSvc.ListSeasonEpisodes:output_type -> service.ListEpisodeResponse
-	29,  // 129: service.EpisodeSvc.ListCollectionEpisodes:output_type -> service.ListEpisodeResponse
-	39,  // 130: service.ShowSvc.CreateShow:output_type -> service.Show
-	39,  // 131: service.ShowSvc.GetShow:output_type -> service.Show
-	39,  // 132: service.ShowSvc.UpdateShow:output_type -> service.Show
-	47,  // 133: service.ShowSvc.ListShows:output_type -> service.ShowListResponse
-	39,  // 134: service.ShowSvc.UploadSeriesPosters:output_type -> service.Show
-	4,   // 135: service.ShowSvc.DeleteSeriesPoster:output_type -> service.EmptyResponse
-	39,  // 136: service.ShowSvc.UploadMoviePosters:output_type -> service.Show
-	4,   // 137: service.ShowSvc.DeleteMoviePoster:output_type -> service.EmptyResponse
-	44,  // 138: service.GenreSvc.CreateGenre:output_type -> service.Genre
-	44,  // 139: service.GenreSvc.GetGenre:output_type -> service.Genre
-	44,  // 140: service.GenreSvc.UpdateGenre:output_type -> service.Genre
-	45,  // 141: service.GenreSvc.ListGenres:output_type -> service.GenreListResponse
-	44,  // 142: service.GenreSvc.GetGenreByName:output_type -> service.Genre
-	49,  // 143: service.SeasonSvc.CreateSeason:output_type -> service.Season
-	49,  // 144: service.SeasonSvc.GetSeason:output_type -> service.Season
-	49,  // 145: service.SeasonSvc.UpdateSeason:output_type -> service.Season
-	49,  // 146: service.SeasonSvc.UploadSeasonPosters:output_type -> service.Season
-	4,   // 147: service.SeasonSvc.DeleteSeasonPoster:output_type -> service.EmptyResponse
-	51,  // 148: service.SeasonSvc.ListShowSeasons:output_type -> service.ListSeasonResponse
-	51,  // 149: service.SeasonSvc.ListSeasonsCollection:output_type -> service.ListSeasonResponse
-	16,  // 150: service.JournalistSvc.CreateJournalist:output_type -> service.Journalist
-	16,  // 151: service.JournalistSvc.GetJournalist:output_type -> service.Journalist
-	16,  // 152: service.JournalistSvc.UpdateJournalist:output_type -> service.Journalist
-	18,  // 153: service.JournalistSvc.ListJournalists:output_type -> service.JournalistListResponse
-	16,  // 154: service.JournalistSvc.GetJournalistByName:output_type -> service.Journalist
-	107, // [107:155] is the sub-list for method output_type
-	59,  // [59:107] is the sub-list for method input_type
-	59,  // [59:59] is the sub-list for extension type_name
-	59,  // [59:59] is the sub-list for extension extendee
-	0,   // [0:59] is the sub-list for field type_name
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{57}
 }
 
-func init() { file_service_proto_init() }
-func file_service_proto_init() {
-	if File_service_proto != nil {
-		return
+func (x *CreateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateClothingRequest); i {
+	return ""
+}
+
+func (x *CreateGenreRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ------SEASONS------
+type Season struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,3,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	PostersPath []string               `protobuf:"bytes,4,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	Resume      string                 `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
+	Rating      float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	WrittenBy   *FilmCrew              `protobuf:"bytes,8,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  *FilmCrew              `protobuf:"bytes,9,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  *FilmCrew              `protobuf:"bytes,10,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Episodes    *ShortEpisodeList      `protobuf:"bytes,11,opt,name=episodes,proto3" json:"episodes,omitempty"`
+	ShowId      string                 `protobuf:"bytes,12,opt,name=showId,proto3" json:"showId,omitempty"`
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Season) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Season) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Season) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *Season) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+func (x *Season) GetResume() string {
+	if x != nil {
+		return x.Resume
+	}
+	return ""
+}
+
+func (x *Season) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Season) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *Season) GetWrittenBy() *FilmCrew {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *Season) GetProducedBy() *FilmCrew {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *Season) GetDirectedBy() *FilmCrew {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *Season) GetEpisodes() *ShortEpisodeList {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *Season) GetShowId() string {
+	if x != nil {
+		return x.ShowId
+	}
+	return ""
+}
+
+type PatchSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Season *Season `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	// Top level fields to write. An empty mask writes every field.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *PatchSeasonRequest) Reset() {
+	*x = PatchSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSeasonRequest) ProtoMessage() {}
+
+func (x *PatchSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSeasonRequest.ProtoReflect.Descriptor instead.
+func (*PatchSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PatchSeasonRequest) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *PatchSeasonRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CreateSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,2,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	Resume      string                 `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	Rating      float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	WrittenBy   *FilmCrew              `protobuf:"bytes,6,opt,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  *FilmCrew              `protobuf:"bytes,7,opt,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  *FilmCrew              `protobuf:"bytes,8,opt,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Episodes    *ShortEpisodeList      `protobuf:"bytes,9,opt,name=episodes,proto3" json:"episodes,omitempty"`
+	PostersPath []string               `protobuf:"bytes,10,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	ShowId      string                 `protobuf:"bytes,11,opt,name=showId,proto3" json:"showId,omitempty"`
+}
+
+func (x *CreateSeasonRequest) Reset() {
+	*x = CreateSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonRequest) ProtoMessage() {}
+
+func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateSeasonRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetResume() string {
+	if x != nil {
+		return x.Resume
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetWrittenBy() *FilmCrew {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetProducedBy() *FilmCrew {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetDirectedBy() *FilmCrew {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetEpisodes() *ShortEpisodeList {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetShowId() string {
+	if x != nil {
+		return x.ShowId
+	}
+	return ""
+}
+
+type ListSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seasons       []*Season `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32     `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *ListSeasonResponse) Reset() {
+	*x = ListSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonResponse) ProtoMessage() {}
+
+func (x *ListSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListSeasonResponse) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *ListSeasonResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSeasonResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UploadSeasonPostersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeasonId    string   `protobuf:"bytes,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	PostersPath []string `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+}
+
+func (x *UploadSeasonPostersRequest) Reset() {
+	*x = UploadSeasonPostersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSeasonPostersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSeasonPostersRequest) ProtoMessage() {}
+
+func (x *UploadSeasonPostersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSeasonPostersRequest.ProtoReflect.Descriptor instead.
+func (*UploadSeasonPostersRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UploadSeasonPostersRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *UploadSeasonPostersRequest) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+type DeleteSeasonPosterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeriesId string `protobuf:"bytes,1,opt,name=seriesId,proto3" json:"seriesId,omitempty"`
+	SeasonId string `protobuf:"bytes,2,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	Image    string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *DeleteSeasonPosterRequest) Reset() {
+	*x = DeleteSeasonPosterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSeasonPosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeasonPosterRequest) ProtoMessage() {}
+
+func (x *DeleteSeasonPosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeasonPosterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeasonPosterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteSeasonPosterRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *DeleteSeasonPosterRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *DeleteSeasonPosterRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// ------SEARCH------
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// One of show, celebrity, episode or article. Empty searches every type.
+	Types     []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	PageSize  int32    `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string   `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Text around the first match, with the matched words wrapped in <em> tags.
+	Snippet string  `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Score   float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SearchHit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32        `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CheckConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rewrite the stale and dangling embedded documents after checking them.
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CheckConsistencyRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type ConsistencyIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Collection and id of the document holding the embedded copy.
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	DocumentId string `protobuf:"bytes,2,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	// Field of the embedded list, such as seasons, genres or starring.
+	Field       string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	ReferenceId string `protobuf:"bytes,4,opt,name=referenceId,proto3" json:"referenceId,omitempty"`
+	// Property of the copy that differs from its source, empty when the
+	// source does not exist anymore.
+	Property string `protobuf:"bytes,5,opt,name=property,proto3" json:"property,omitempty"`
+	Expected string `protobuf:"bytes,6,opt,name=expected,proto3" json:"expected,omitempty"`
+	Found    string `protobuf:"bytes,7,opt,name=found,proto3" json:"found,omitempty"`
+	Missing  bool   `protobuf:"varint,8,opt,name=missing,proto3" json:"missing,omitempty"`
+}
+
+func (x *ConsistencyIssue) Reset() {
+	*x = ConsistencyIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyIssue) ProtoMessage() {}
+
+func (x *ConsistencyIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyIssue.ProtoReflect.Descriptor instead.
+func (*ConsistencyIssue) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ConsistencyIssue) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetFound() string {
+	if x != nil {
+		return x.Found
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+type ConsistencyReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checked  int32               `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Issues   []*ConsistencyIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	Repaired int32               `protobuf:"varint,3,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *ConsistencyReport) Reset() {
+	*x = ConsistencyReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyReport) ProtoMessage() {}
+
+func (x *ConsistencyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyReport.ProtoReflect.Descriptor instead.
+func (*ConsistencyReport) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ConsistencyReport) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetIssues() []*ConsistencyIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+// ------IMPORT------
+// CelebrityRef references a celebrity by name and date of birth. Without a
+// date of birth, the name has to match a single celebrity.
+type CelebrityRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	// Role played, for the starring celebrities.
+	RoleName string `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName,omitempty"`
+}
+
+func (x *CelebrityRef) Reset() {
+	*x = CelebrityRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CelebrityRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CelebrityRef) ProtoMessage() {}
+
+func (x *CelebrityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CelebrityRef.ProtoReflect.Descriptor instead.
+func (*CelebrityRef) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CelebrityRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CelebrityRef) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *CelebrityRef) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type ImportEpisode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PostersPath []string        `protobuf:"bytes,2,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	TrailerUrl  string          `protobuf:"bytes,3,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	ShowLength  *ShowLength     `protobuf:"bytes,4,opt,name=showLength,proto3" json:"showLength,omitempty"`
+	Rating      float64         `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Resume      string          `protobuf:"bytes,6,opt,name=resume,proto3" json:"resume,omitempty"`
+	WrittenBy   []*CelebrityRef `protobuf:"bytes,7,rep,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  []*CelebrityRef `protobuf:"bytes,8,rep,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  []*CelebrityRef `protobuf:"bytes,9,rep,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Starring    []*CelebrityRef `protobuf:"bytes,10,rep,name=starring,proto3" json:"starring,omitempty"`
+}
+
+func (x *ImportEpisode) Reset() {
+	*x = ImportEpisode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEpisode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEpisode) ProtoMessage() {}
+
+func (x *ImportEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEpisode.ProtoReflect.Descriptor instead.
+func (*ImportEpisode) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportEpisode) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+func (x *ImportEpisode) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *ImportEpisode) GetShowLength() *ShowLength {
+	if x != nil {
+		return x.ShowLength
+	}
+	return nil
+}
+
+func (x *ImportEpisode) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ImportEpisode) GetResume() string {
+	if x != nil {
+		return x.Resume
+	}
+	return ""
+}
+
+func (x *ImportEpisode) GetWrittenBy() []*CelebrityRef {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *ImportEpisode) GetProducedBy() []*CelebrityRef {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *ImportEpisode) GetDirectedBy() []*CelebrityRef {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *ImportEpisode) GetStarring() []*CelebrityRef {
+	if x != nil {
+		return x.Starring
+	}
+	return nil
+}
+
+type ImportSeason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,2,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	Resume      string                 `protobuf:"bytes,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	Rating      float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	WrittenBy   []*CelebrityRef        `protobuf:"bytes,6,rep,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	ProducedBy  []*CelebrityRef        `protobuf:"bytes,7,rep,name=producedBy,proto3" json:"producedBy,omitempty"`
+	DirectedBy  []*CelebrityRef        `protobuf:"bytes,8,rep,name=directedBy,proto3" json:"directedBy,omitempty"`
+	Episodes    []*ImportEpisode       `protobuf:"bytes,9,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	PostersPath []string               `protobuf:"bytes,10,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+}
+
+func (x *ImportSeason) Reset() {
+	*x = ImportSeason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSeason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSeason) ProtoMessage() {}
+
+func (x *ImportSeason) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSeason.ProtoReflect.Descriptor instead.
+func (*ImportSeason) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ImportSeason) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportSeason) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *ImportSeason) GetResume() string {
+	if x != nil {
+		return x.Resume
+	}
+	return ""
+}
+
+func (x *ImportSeason) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ImportSeason) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *ImportSeason) GetWrittenBy() []*CelebrityRef {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *ImportSeason) GetProducedBy() []*CelebrityRef {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *ImportSeason) GetDirectedBy() []*CelebrityRef {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *ImportSeason) GetEpisodes() []*ImportEpisode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *ImportSeason) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+type ImportShow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PostersPath []string               `protobuf:"bytes,3,rep,name=postersPath,proto3" json:"postersPath,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Rating      float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	Length      *ShowLength            `protobuf:"bytes,7,opt,name=length,proto3" json:"length,omitempty"`
+	TrailerUrl  string                 `protobuf:"bytes,8,opt,name=trailerUrl,proto3" json:"trailerUrl,omitempty"`
+	// Names of the genres, created when they do not exist.
+	Genres      []string        `protobuf:"bytes,9,rep,name=genres,proto3" json:"genres,omitempty"`
+	DirectedBy  []*CelebrityRef `protobuf:"bytes,10,rep,name=directedBy,proto3" json:"directedBy,omitempty"`
+	ProducedBy  []*CelebrityRef `protobuf:"bytes,11,rep,name=producedBy,proto3" json:"producedBy,omitempty"`
+	WrittenBy   []*CelebrityRef `protobuf:"bytes,12,rep,name=writtenBy,proto3" json:"writtenBy,omitempty"`
+	Starring    []*CelebrityRef `protobuf:"bytes,13,rep,name=starring,proto3" json:"starring,omitempty"`
+	Description string          `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Seasons     []*ImportSeason `protobuf:"bytes,15,rep,name=seasons,proto3" json:"seasons,omitempty"`
+}
+
+func (x *ImportShow) Reset() {
+	*x = ImportShow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportShow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShow) ProtoMessage() {}
+
+func (x *ImportShow) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShow.ProtoReflect.Descriptor instead.
+func (*ImportShow) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportShow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportShow) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImportShow) GetPostersPath() []string {
+	if x != nil {
+		return x.PostersPath
+	}
+	return nil
+}
+
+func (x *ImportShow) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *ImportShow) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ImportShow) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ImportShow) GetLength() *ShowLength {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *ImportShow) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *ImportShow) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *ImportShow) GetDirectedBy() []*CelebrityRef {
+	if x != nil {
+		return x.DirectedBy
+	}
+	return nil
+}
+
+func (x *ImportShow) GetProducedBy() []*CelebrityRef {
+	if x != nil {
+		return x.ProducedBy
+	}
+	return nil
+}
+
+func (x *ImportShow) GetWrittenBy() []*CelebrityRef {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+func (x *ImportShow) GetStarring() []*CelebrityRef {
+	if x != nil {
+		return x.Starring
+	}
+	return nil
+}
+
+func (x *ImportShow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportShow) GetSeasons() []*ImportSeason {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type BulkImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Celebrities []*CreateCelebrityRequest `protobuf:"bytes,1,rep,name=celebrities,proto3" json:"celebrities,omitempty"`
+	Shows       []*ImportShow             `protobuf:"bytes,2,rep,name=shows,proto3" json:"shows,omitempty"`
+	// Report what would be imported without writing anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *BulkImportRequest) Reset() {
+	*x = BulkImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRequest) ProtoMessage() {}
+
+func (x *BulkImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BulkImportRequest) GetCelebrities() []*CreateCelebrityRequest {
+	if x != nil {
+		return x.Celebrities
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetShows() []*ImportShow {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of show, season, episode, celebrity or genre.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Natural key of the record, such as its title and release date.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Id  string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// One of created, existing or failed.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImportResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ImportResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ImportResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results  []*ImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Created  int32           `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Existing int32           `protobuf:"varint,3,opt,name=existing,proto3" json:"existing,omitempty"`
+	Failed   int32           `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun   bool            `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *BulkImportResponse) Reset() {
+	*x = BulkImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportResponse) ProtoMessage() {}
+
+func (x *BulkImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportResponse.ProtoReflect.Descriptor instead.
+func (*BulkImportResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BulkImportResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkImportResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetExisting() int32 {
+	if x != nil {
+		return x.Existing
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type WatchChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types of the documents to watch, among show, season, episode, celebrity,
+	// article, genre and journalist. Every type is watched when empty.
+	EntityTypes []string `protobuf:"bytes,1,rep,name=entityTypes,proto3" json:"entityTypes,omitempty"`
+	// Resume token of the last event received, to get the events that
+	// followed it. The stream starts with the next change when empty.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesRequest) ProtoMessage() {}
+
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WatchChangesRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *WatchChangesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	// One of created, updated or deleted.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// The document after the change, unset for deleted documents.
+	//
+	// Types that are assignable to Document:
+	//	*ChangeEvent_Show
+	//	*ChangeEvent_Season
+	//	*ChangeEvent_Episode
+	//	*ChangeEvent_Celebrity
+	//	*ChangeEvent_Article
+	//	*ChangeEvent_Genre
+	//	*ChangeEvent_Journalist
+	Document    isChangeEvent_Document `protobuf_oneof:"document"`
+	ResumeToken string                 `protobuf:"bytes,11,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ChangeEvent) Reset() {
+	*x = ChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEvent) ProtoMessage() {}
+
+func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEvent.ProtoReflect.Descriptor instead.
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ChangeEvent) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetDocument() isChangeEvent_Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetShow() *Show {
+	if x, ok := x.GetDocument().(*ChangeEvent_Show); ok {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetSeason() *Season {
+	if x, ok := x.GetDocument().(*ChangeEvent_Season); ok {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetEpisode() *Episode {
+	if x, ok := x.GetDocument().(*ChangeEvent_Episode); ok {
+		return x.Episode
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetCelebrity() *Celebrity {
+	if x, ok := x.GetDocument().(*ChangeEvent_Celebrity); ok {
+		return x.Celebrity
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetArticle() *Article {
+	if x, ok := x.GetDocument().(*ChangeEvent_Article); ok {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetGenre() *Genre {
+	if x, ok := x.GetDocument().(*ChangeEvent_Genre); ok {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetJournalist() *Journalist {
+	if x, ok := x.GetDocument().(*ChangeEvent_Journalist); ok {
+		return x.Journalist
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type isChangeEvent_Document interface {
+	isChangeEvent_Document()
+}
+
+type ChangeEvent_Show struct {
+	Show *Show `protobuf:"bytes,4,opt,name=show,proto3,oneof"`
+}
+
+type ChangeEvent_Season struct {
+	Season *Season `protobuf:"bytes,5,opt,name=season,proto3,oneof"`
+}
+
+type ChangeEvent_Episode struct {
+	Episode *Episode `protobuf:"bytes,6,opt,name=episode,proto3,oneof"`
+}
+
+type ChangeEvent_Celebrity struct {
+	Celebrity *Celebrity `protobuf:"bytes,7,opt,name=celebrity,proto3,oneof"`
+}
+
+type ChangeEvent_Article struct {
+	Article *Article `protobuf:"bytes,8,opt,name=article,proto3,oneof"`
+}
+
+type ChangeEvent_Genre struct {
+	Genre *Genre `protobuf:"bytes,9,opt,name=genre,proto3,oneof"`
+}
+
+type ChangeEvent_Journalist struct {
+	Journalist *Journalist `protobuf:"bytes,10,opt,name=journalist,proto3,oneof"`
+}
+
+func (*ChangeEvent_Show) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Season) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Episode) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Celebrity) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Article) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Genre) isChangeEvent_Document() {}
+
+func (*ChangeEvent_Journalist) isChangeEvent_Document() {}
+
+type RegisterWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Key of the HMAC signature of the requests, generated when empty.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Types of the events to send, such as article.created or
+	// episode.updated. Every event is sent when empty.
+	EventTypes []string `protobuf:"bytes,3,rep,name=eventTypes,proto3" json:"eventTypes,omitempty"`
+}
+
+func (x *RegisterWebhookRequest) Reset() {
+	*x = RegisterWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookRequest) ProtoMessage() {}
+
+func (x *RegisterWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RegisterWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Only returned by RegisterWebhook.
+	Secret     string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes []string               `protobuf:"bytes,4,rep,name=eventTypes,proto3" json:"eventTypes,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{81}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string `protobuf:"bytes,1,opt,name=webhookId,proto3" json:"webhookId,omitempty"`
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// One of pending, delivered or dead.
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts  int32  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// DeadLetter is an event that could not be delivered to at least one of its
+// webhooks.
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	EntityType string `protobuf:"bytes,3,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityId   string `protobuf:"bytes,4,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	// The JSON body posted to the webhooks.
+	Payload    string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Deliveries []*WebhookDelivery     `protobuf:"bytes,7,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters   []*DeadLetter `protobuf:"bytes,1,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32         `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDeadLettersResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// TrashItem is a deleted document, restorable until it is purged.
+type TrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	// The title or the name of the document.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The show of a season, the season of an episode or the journalist of an
+	// article.
+	ParentId  string                 `protobuf:"bytes,4,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,6,opt,name=deletedBy,proto3" json:"deletedBy,omitempty"`
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *TrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashItem) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32        `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTrashResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityId   string `protobuf:"bytes,2,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListRevisionsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Revision is the state of a document after one of its creates or updates.
+type Revision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityId   string `protobuf:"bytes,3,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	// The revisions of a document are numbered from 1.
+	Number int32 `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	// The subject of the token of the caller, empty without authentication.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The full gRPC method of the call, such as /service.ShowSvc/UpdateShow.
+	Method    string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// The JSON of the whole document.
+	Snapshot string `protobuf:"bytes,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Revision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Revision) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *Revision) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *Revision) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Revision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Revision) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Revision) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+// ListRevisionsResponse lists the revisions of a document, latest first.
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions     []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32       `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRevisionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetRevisionDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromRevisionId string `protobuf:"bytes,1,opt,name=fromRevisionId,proto3" json:"fromRevisionId,omitempty"`
+	ToRevisionId   string `protobuf:"bytes,2,opt,name=toRevisionId,proto3" json:"toRevisionId,omitempty"`
+}
+
+func (x *GetRevisionDiffRequest) Reset() {
+	*x = GetRevisionDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRevisionDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionDiffRequest) ProtoMessage() {}
+
+func (x *GetRevisionDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionDiffRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetRevisionDiffRequest) GetFromRevisionId() string {
+	if x != nil {
+		return x.FromRevisionId
+	}
+	return ""
+}
+
+func (x *GetRevisionDiffRequest) GetToRevisionId() string {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return ""
+}
+
+// FieldChange is a field whose value differs between two revisions, with
+// the JSON of both values. A value is empty when the field is absent.
+type FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From  string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RevisionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    *Revision      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *Revision      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Changes []*FieldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *RevisionDiff) Reset() {
+	*x = RevisionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionDiff) ProtoMessage() {}
+
+func (x *RevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionDiff.ProtoReflect.Descriptor instead.
+func (*RevisionDiff) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RevisionDiff) GetFrom() *Revision {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RevisionDiff) GetTo() *Revision {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RevisionDiff) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RevertToRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevisionId string `protobuf:"bytes,1,opt,name=revisionId,proto3" json:"revisionId,omitempty"`
+}
+
+func (x *RevertToRevisionRequest) Reset() {
+	*x = RevertToRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertToRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertToRevisionRequest) ProtoMessage() {}
+
+func (x *RevertToRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertToRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RevertToRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RevertToRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+var File_service_proto protoreflect.FileDescriptor
+
+var file_service_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x08, 0x43, 0x6c, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63,
+	0x6c, 0x6f, 0x74, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x63, 0x6c, 0x6f, 0x74, 0x68, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd5, 0x02, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x42,
+	0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xc1, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x22, 0xd6, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x85, 0x01, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0xc2, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x68, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x5d, 0x0a, 0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0x50, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x76,
+	0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x30, 0x0a, 0x0a, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x16, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbb, 0x02, 0x0a,
+	0x09, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x3c,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xb8, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74,
+	0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x69,
+	0x72, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01,
+	0x0a, 0x15, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x1d, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x56, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x03, 0x0a, 0x07, 0x45, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a,
+	0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x13, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x3a,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xbd, 0x03, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42,
+	0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0x51, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
+	0x77, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x6d, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
+	0x77, 0x22, 0x72, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65,
+	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65,
+	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65,
+	0x6c, 0x65, 0x62, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x22, 0x4f, 0x0a,
+	0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52,
+	0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x5d,
+	0x0a, 0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x88, 0x01,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x8a, 0x05, 0x0a, 0x04, 0x53, 0x68, 0x6f,
+	0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x55, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x52, 0x06, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77,
+	0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x71, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x53, 0x68,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x68, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3a, 0x0a, 0x0b, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x47, 0x65, 0x6e,
+	0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x0b, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3e, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x65, 0x6e,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x87, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68,
+	0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c,
+	0x12, 0x2c, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
+	0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22,
+	0x7b, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
+	0x77, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4a, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc4, 0x03, 0x0a, 0x06, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
+	0x77, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d,
+	0x43, 0x72, 0x65, 0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x22,
+	0x79, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3a,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xc1, 0x03, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65,
+	0x6e, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65, 0x77, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x72, 0x65,
+	0x77, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x22, 0x83,
+	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x5a, 0x0a, 0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x69, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x75, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x31, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0xf2, 0x01, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22,
+	0x7c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7c, 0x0a,
+	0x0c, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa2, 0x03, 0x0a, 0x0d,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0a,
+	0x73, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x66, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12,
+	0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65,
+	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x66, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x31, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0xab, 0x03, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x42, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0xf2,
+	0x04, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x77, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x72, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x55,
+	0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x66, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x31, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x68, 0x6f, 0x77,
+	0x52, 0x05, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x72, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x59, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf8, 0x03, 0x0a,
+	0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x68,
+	0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x48, 0x00, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x65,
+	0x62, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x07,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x48, 0x00, 0x52, 0x05, 0x67, 0x65, 0x6e,
+	0x72, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x07,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x52, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x93, 0x01, 0x0a,
+	0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xfa, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x94, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8c,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf2, 0x01,
+	0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x64, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66,
+	0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x88, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x17, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x32, 0xe3, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x53, 0x76, 0x63, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x06, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc2, 0x06, 0x0a,
+	0x0a, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x76, 0x63, 0x12, 0x59, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x51, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x1a, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x60, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12,
+	0x78, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x42,
+	0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x7b, 0x0a, 0x14, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x2f, 0x7b, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x2a, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x2f, 0x7b, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x32, 0x82, 0x07, 0x0a, 0x0c, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x53,
+	0x76, 0x63, 0x12, 0x62, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65,
+	0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x5a, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a,
+	0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x32, 0x1e, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x65,
+	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x69, 0x64, 0x7d, 0x3a, 0x09, 0x63, 0x65, 0x6c,
+	0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x85, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x2a, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x7d, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65,
+	0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x32, 0xc1, 0x07, 0x0a, 0x0a, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x53, 0x76, 0x63, 0x12, 0x6c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a,
+	0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x51, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x0c, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x32, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
+	0x2f, 0x7b, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x64, 0x7d, 0x3a, 0x07, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x6e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x6a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
+	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x5b, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x32, 0xc7, 0x07, 0x0a, 0x07, 0x53,
+	0x68, 0x6f, 0x77, 0x53, 0x76, 0x63, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x6f, 0x77, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22,
+	0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f,
+	0x77, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x49, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x77,
+	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x1a, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x1a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x68, 0x6f, 0x77, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x32, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77,
+	0x73, 0x2f, 0x7b, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x69, 0x64, 0x7d, 0x3a, 0x04, 0x73, 0x68, 0x6f,
+	0x77, 0x12, 0x54, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x12, 0x73, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x23,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68,
+	0x6f, 0x77, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64,
+	0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x77, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x70, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x76,
+	0x69, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x32, 0x89, 0x04, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x76,
+	0x63, 0x12, 0x51, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x49, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x2f, 0x62, 0x79, 0x2d, 0x6e, 0x61, 0x6d, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x57, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x32, 0x95, 0x07, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x53, 0x76, 0x63, 0x12, 0x64,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73,
+	0x2f, 0x7b, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a,
+	0x01, 0x2a, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x64, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x32, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x69,
+	0x64, 0x7d, 0x3a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x13, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22,
+	0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x78, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x32, 0xf2, 0x04, 0x0a, 0x0d, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x76, 0x63, 0x12, 0x65, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5d,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x6d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x62, 0x79, 0x2d, 0x6e,
+	0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x61, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x32, 0x5a, 0x0a,
+	0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x76, 0x63, 0x12, 0x4d, 0x0a, 0x06, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x32, 0x5e, 0x0a, 0x08, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x76, 0x63, 0x12, 0x52, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x32, 0x69, 0x0a, 0x09, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x76, 0x63, 0x12, 0x5c, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42,
+	0x75, 0x6c, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x32, 0xa4, 0x03, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x76, 0x63, 0x12, 0x5d, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x64,
+	0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x32, 0x65, 0x0a, 0x08, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x76, 0x63, 0x12, 0x59, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x13, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x30, 0x01, 0x32, 0xb8, 0x0b, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x73, 0x68, 0x53, 0x76, 0x63, 0x12,
+	0x62, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x68,
+	0x6f, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x73, 0x68,
+	0x6f, 0x77, 0x73, 0x12, 0x5b, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x68,
+	0x6f, 0x77, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x73, 0x68,
+	0x6f, 0x77, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x66, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68,
+	0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x61, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1e, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x65, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x6e, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f,
+	0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x10, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f,
+	0x63, 0x65, 0x6c, 0x65, 0x62, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12,
+	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x73, 0x68, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x5e,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22,
+	0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x6e,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x73, 0x68, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x6d,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x72, 0x61, 0x73, 0x68, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x32, 0x88, 0x03,
+	0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x76, 0x63, 0x12, 0x7d, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x7d, 0x12, 0x85, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
+	0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x7d,
+	0x2f, 0x64, 0x69, 0x66, 0x66, 0x2f, 0x7b, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x7d, 0x12, 0x72, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_proto_rawDescOnce sync.Once
+	file_service_proto_rawDescData = file_service_proto_rawDesc
+)
+
+func file_service_proto_rawDescGZIP() []byte {
+	file_service_proto_rawDescOnce.Do(func() {
+		file_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_proto_rawDescData)
+	})
+	return file_service_proto_rawDescData
+}
+
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_service_proto_goTypes = []interface{}{
+	(*CreateClothingRequest)(nil),         // 0: service.CreateClothingRequest
+	(*Clothing)(nil),                      // 1: service.Clothing
+	(*DeleteClothingRequest)(nil),         // 2: service.DeleteClothingRequest
+	(*ClothingListResponse)(nil),          // 3: service.ClothingListResponse
+	(*EmptyResponse)(nil),                 // 4: service.EmptyResponse
+	(*GetAllRequest)(nil),                 // 5: service.GetAllRequest
+	(*ListArticlesRequest)(nil),           // 6: service.ListArticlesRequest
+	(*ListCelebritiesRequest)(nil),        // 7: service.ListCelebritiesRequest
+	(*ListEpisodesRequest)(nil),           // 8: service.ListEpisodesRequest
+	(*ListShowsRequest)(nil),              // 9: service.ListShowsRequest
+	(*ListGenresRequest)(nil),             // 10: service.ListGenresRequest
+	(*ListSeasonsRequest)(nil),            // 11: service.ListSeasonsRequest
+	(*ListJournalistsRequest)(nil),        // 12: service.ListJournalistsRequest
+	(*UploadArticlePostersRequest)(nil),   // 13: service.UploadArticlePostersRequest
+	(*UploadSeriesPostersRequest)(nil),    // 14: service.UploadSeriesPostersRequest
+	(*DeleteArticlePosterRequest)(nil),    // 15: service.DeleteArticlePosterRequest
+	(*UploadMoviePostersRequest)(nil),     // 16: service.UploadMoviePostersRequest
+	(*DeleteSeriesPosterRequest)(nil),     // 17: service.DeleteSeriesPosterRequest
+	(*DeleteMoviePosterRequest)(nil),      // 18: service.DeleteMoviePosterRequest
+	(*Article)(nil),                       // 19: service.Article
+	(*CreateArticleRequest)(nil),          // 20: service.CreateArticleRequest
+	(*ArticleListResponse)(nil),           // 21: service.ArticleListResponse
+	(*Journalist)(nil),                    // 22: service.Journalist
+	(*CreateJournalistRequest)(nil),       // 23: service.CreateJournalistRequest
+	(*JournalistListResponse)(nil),        // 24: service.JournalistListResponse
+	(*ShortJournalist)(nil),               // 25: service.ShortJournalist
+	(*Celebrity)(nil),                     // 26: service.Celebrity
+	(*PatchCelebrityRequest)(nil),         // 27: service.PatchCelebrityRequest
+	(*CreateCelebrityRequest)(nil),        // 28: service.CreateCelebrityRequest
+	(*CelebrityListResponse)(nil),         // 29: service.CelebrityListResponse
+	(*GetByIDRequest)(nil),                // 30: service.GetByIDRequest
+	(*GetByNameRequest)(nil),              // 31: service.GetByNameRequest
+	(*UploadCelebrityPostersRequest)(nil), // 32: service.UploadCelebrityPostersRequest
+	(*DeleteCelebrityPosterRequest)(nil),  // 33: service.DeleteCelebrityPosterRequest
+	(*Episode)(nil),                       // 34: service.Episode
+	(*PatchEpisodeRequest)(nil),           // 35: service.PatchEpisodeRequest
+	(*CreateEpisodeRequest)(nil),          // 36: service.CreateEpisodeRequest
+	(*ListEpisodeResponse)(nil),           // 37: service.ListEpisodeResponse
+	(*ShowLength)(nil),                    // 38: service.ShowLength
+	(*FilmStaff)(nil),                     // 39: service.FilmStaff
+	(*FilmCrew)(nil),                      // 40: service.FilmCrew
+	(*ShortCelebrity)(nil),                // 41: service.ShortCelebrity
+	(*ShortCelebrities)(nil),              // 42: service.ShortCelebrities
+	(*ShortEpisode)(nil),                  // 43: service.ShortEpisode
+	(*ShortEpisodeList)(nil),              // 44: service.ShortEpisodeList
+	(*UploadEpisodePostersRequest)(nil),   // 45: service.UploadEpisodePostersRequest
+	(*DeleteEpisodePosterRequest)(nil),    // 46: service.DeleteEpisodePosterRequest
+	(*Show)(nil),                          // 47: service.Show
+	(*PatchShowRequest)(nil),              // 48: service.PatchShowRequest
+	(*ShortGenres)(nil),                   // 49: service.ShortGenres
+	(*ShortGenre)(nil),                    // 50: service.ShortGenre
+	(*ShortSeason)(nil),                   // 51: service.ShortSeason
+	(*ShortSeasons)(nil),                  // 52: service.ShortSeasons
+	(*Genre)(nil),                         // 53: service.Genre
+	(*GenreListResponse)(nil),             // 54: service.GenreListResponse
+	(*CreateShowRequest)(nil),             // 55: service.CreateShowRequest
+	(*ShowListResponse)(nil),              // 56: service.ShowListResponse
+	(*CreateGenreRequest)(nil),            // 57: service.CreateGenreRequest
+	(*Season)(nil),                        // 58: service.Season
+	(*PatchSeasonRequest)(nil),            // 59: service.PatchSeasonRequest
+	(*CreateSeasonRequest)(nil),           // 60: service.CreateSeasonRequest
+	(*ListSeasonResponse)(nil),            // 61: service.ListSeasonResponse
+	(*UploadSeasonPostersRequest)(nil),    // 62: service.UploadSeasonPostersRequest
+	(*DeleteSeasonPosterRequest)(nil),     // 63: service.DeleteSeasonPosterRequest
+	(*SearchRequest)(nil),                 // 64: service.SearchRequest
+	(*SearchHit)(nil),                     // 65: service.SearchHit
+	(*SearchResponse)(nil),                // 66: service.SearchResponse
+	(*CheckConsistencyRequest)(nil),       // 67: service.CheckConsistencyRequest
+	(*ConsistencyIssue)(nil),              // 68: service.ConsistencyIssue
+	(*ConsistencyReport)(nil),             // 69: service.ConsistencyReport
+	(*CelebrityRef)(nil),                  // 70: service.CelebrityRef
+	(*ImportEpisode)(nil),                 // 71: service.ImportEpisode
+	(*ImportSeason)(nil),                  // 72: service.ImportSeason
+	(*ImportShow)(nil),                    // 73: service.ImportShow
+	(*BulkImportRequest)(nil),             // 74: service.BulkImportRequest
+	(*ImportResult)(nil),                  // 75: service.ImportResult
+	(*BulkImportResponse)(nil),            // 76: service.BulkImportResponse
+	(*WatchChangesRequest)(nil),           // 77: service.WatchChangesRequest
+	(*ChangeEvent)(nil),                   // 78: service.ChangeEvent
+	(*RegisterWebhookRequest)(nil),        // 79: service.RegisterWebhookRequest
+	(*Webhook)(nil),                       // 80: service.Webhook
+	(*ListWebhooksRequest)(nil),           // 81: service.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 82: service.ListWebhooksResponse
+	(*ListDeadLettersRequest)(nil),        // 83: service.ListDeadLettersRequest
+	(*WebhookDelivery)(nil),               // 84: service.WebhookDelivery
+	(*DeadLetter)(nil),                    // 85: service.DeadLetter
+	(*ListDeadLettersResponse)(nil),       // 86: service.ListDeadLettersResponse
+	(*ListTrashRequest)(nil),              // 87: service.ListTrashRequest
+	(*TrashItem)(nil),                     // 88: service.TrashItem
+	(*ListTrashResponse)(nil),             // 89: service.ListTrashResponse
+	(*ListRevisionsRequest)(nil),          // 90: service.ListRevisionsRequest
+	(*Revision)(nil),                      // 91: service.Revision
+	(*ListRevisionsResponse)(nil),         // 92: service.ListRevisionsResponse
+	(*GetRevisionDiffRequest)(nil),        // 93: service.GetRevisionDiffRequest
+	(*FieldChange)(nil),                   // 94: service.FieldChange
+	(*RevisionDiff)(nil),                  // 95: service.RevisionDiff
+	(*RevertToRevisionRequest)(nil),       // 96: service.RevertToRevisionRequest
+	(*timestamppb.Timestamp)(nil),         // 97: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 98: google.protobuf.FieldMask
+}
+var file_service_proto_depIdxs = []int32{
+	1,   // 0: service.ClothingListResponse.clothes:type_name -> service.Clothing
+	97,  // 1: service.ListArticlesRequest.releasedAfter:type_name -> google.protobuf.Timestamp
+	97,  // 2: service.ListArticlesRequest.releasedBefore:type_name -> google.protobuf.Timestamp
+	97,  // 3: service.ListShowsRequest.releasedAfter:type_name -> google.protobuf.Timestamp
+	97,  // 4: service.ListShowsRequest.releasedBefore:type_name -> google.protobuf.Timestamp
+	97,  // 5: service.ListSeasonsRequest.releasedAfter:type_name -> google.protobuf.Timestamp
+	97,  // 6: service.ListSeasonsRequest.releasedBefore:type_name -> google.protobuf.Timestamp
+	97,  // 7: service.Article.releaseDate:type_name -> google.protobuf.Timestamp
+	25,  // 8: service.Article.journalist:type_name -> service.ShortJournalist
+	97,  // 9: service.CreateArticleRequest.releaseDate:type_name -> google.protobuf.Timestamp
+	23,  // 10: service.CreateArticleRequest.journalist:type_name -> service.CreateJournalistRequest
+	19,  // 11: service.ArticleListResponse.articles:type_name -> service.Article
+	22,  // 12: service.JournalistListResponse.journalists:type_name -> service.Journalist
+	97,  // 13: service.Celebrity.dateOfBirth:type_name -> google.protobuf.Timestamp
+	97,  // 14: service.Celebrity.dateOfDeath:type_name -> google.protobuf.Timestamp
+	26,  // 15: service.PatchCelebrityRequest.celebrity:type_name -> service.Celebrity
+	98,  // 16: service.PatchCelebrityRequest.updateMask:type_name -> google.protobuf.FieldMask
+	97,  // 17: service.CreateCelebrityRequest.dateOfBirth:type_name -> google.protobuf.Timestamp
+	97,  // 18: service.CreateCelebrityRequest.dateOfDeath:type_name -> google.protobuf.Timestamp
+	26,  // 19: service.CelebrityListResponse.celebrities:type_name -> service.Celebrity
+	38,  // 20: service.Episode.showLength:type_name -> service.ShowLength
+	40,  // 21: service.Episode.writtenBy:type_name -> service.FilmCrew
+	40,  // 22: service.Episode.producedBy:type_name -> service.FilmCrew
+	40,  // 23: service.Episode.directedBy:type_name -> service.FilmCrew
+	42,  // 24: service.Episode.starring:type_name -> service.ShortCelebrities
+	34,  // 25: service.PatchEpisodeRequest.episode:type_name -> service.Episode
+	98,  // 26: service.PatchEpisodeRequest.updateMask:type_name -> google.protobuf.FieldMask
+	38,  // 27: service.CreateEpisodeRequest.showLength:type_name -> service.ShowLength
+	40,  // 28: service.CreateEpisodeRequest.writtenBy:type_name -> service.FilmCrew
+	40,  // 29: service.CreateEpisodeRequest.producedBy:type_name -> service.FilmCrew
+	40,  // 30: service.CreateEpisodeRequest.directedBy:type_name -> service.FilmCrew
+	42,  // 31: service.CreateEpisodeRequest.starring:type_name -> service.ShortCelebrities
+	34,  // 32: service.ListEpisodeResponse.episodes:type_name -> service.Episode
+	39,  // 33: service.FilmCrew.filmCrew:type_name -> service.FilmStaff
+	41,  // 34: service.ShortCelebrities.shortCelebs:type_name -> service.ShortCelebrity
+	43,  // 35: service.ShortEpisodeList.shortEpisodes:type_name -> service.ShortEpisode
+	97,  // 36: service.Show.releaseDate:type_name -> google.protobuf.Timestamp
+	97,  // 37: service.Show.endDate:type_name -> google.protobuf.Timestamp
+	38,  // 38: service.Show.length:type_name -> service.ShowLength
+	49,  // 39: service.Show.genres:type_name -> service.ShortGenres
+	40,  // 40: service.Show.directedBy:type_name -> service.FilmCrew
+	40,  // 41: service.Show.producedBy:type_name -> service.FilmCrew
+	40,  // 42: service.Show.writtenBy:type_name -> service.FilmCrew
+	42,  // 43: service.Show.starring:type_name -> service.ShortCelebrities
+	52,  // 44: service.Show.seasons:type_name -> service.ShortSeasons
+	47,  // 45: service.PatchShowRequest.show:type_name -> service.Show
+	98,  // 46: service.PatchShowRequest.updateMask:type_name -> google.protobuf.FieldMask
+	50,  // 47: service.ShortGenres.genres:type_name -> service.ShortGenre
+	51,  // 48: service.ShortSeasons.seasons:type_name -> service.ShortSeason
+	53,  // 49: service.GenreListResponse.genres:type_name -> service.Genre
+	97,  // 50: service.CreateShowRequest.releaseDate:type_name -> google.protobuf.Timestamp
+	97,  // 51: service.CreateShowRequest.endDate:type_name -> google.protobuf.Timestamp
+	38,  // 52: service.CreateShowRequest.length:type_name -> service.ShowLength
+	49,  // 53: service.CreateShowRequest.genres:type_name -> service.ShortGenres
+	40,  // 54: service.CreateShowRequest.directedBy:type_name -> service.FilmCrew
+	40,  // 55: service.CreateShowRequest.producedBy:type_name -> service.FilmCrew
+	40,  // 56: service.CreateShowRequest.writtenBy:type_name -> service.FilmCrew
+	42,  // 57: service.CreateShowRequest.starring:type_name -> service.ShortCelebrities
+	52,  // 58: service.CreateShowRequest.seasons:type_name -> service.ShortSeasons
+	47,  // 59: service.ShowListResponse.shows:type_name -> service.Show
+	97,  // 60: service.Season.releaseDate:type_name -> google.protobuf.Timestamp
+	40,  // 61: service.Season.writtenBy:type_name -> service.FilmCrew
+	40,  // 62: service.Season.producedBy:type_name -> service.FilmCrew
+	40,  // 63: service.Season.directedBy:type_name -> service.FilmCrew
+	44,  // 64: service.Season.episodes:type_name -> service.ShortEpisodeList
+	58,  // 65: service.PatchSeasonRequest.season:type_name -> service.Season
+	98,  // 66: service.PatchSeasonRequest.updateMask:type_name -> google.protobuf.FieldMask
+	97,  // 67: service.CreateSeasonRequest.releaseDate:type_name -> google.protobuf.Timestamp
+	40,  // 68: service.CreateSeasonRequest.writtenBy:type_name -> service.FilmCrew
+	40,  // 69: service.CreateSeasonRequest.producedBy:type_name -> service.FilmCrew
+	40,  // 70: service.CreateSeasonRequest.directedBy:type_name -> service.FilmCrew
+	44,  // 71: service.CreateSeasonRequest.episodes:type_name -> service.ShortEpisodeList
+	58,  // 72: service.ListSeasonResponse.seasons:type_name -> service.Season
+	65,  // 73: service.SearchResponse.hits:type_name -> service.SearchHit
+	68,  // 74: service.ConsistencyReport.issues:type_name -> service.ConsistencyIssue
+	97,  // 75: service.CelebrityRef.dateOfBirth:type_name -> google.protobuf.Timestamp
+	38,  // 76: service.ImportEpisode.showLength:type_name -> service.ShowLength
+	70,  // 77: service.ImportEpisode.writtenBy:type_name -> service.CelebrityRef
+	70,  // 78: service.ImportEpisode.producedBy:type_name -> service.CelebrityRef
+	70,  // 79: service.ImportEpisode.directedBy:type_name -> service.CelebrityRef
+	70,  // 80: service.ImportEpisode.starring:type_name -> service.CelebrityRef
+	97,  // 81: service.ImportSeason.releaseDate:type_name -> google.protobuf.Timestamp
+	70,  // 82: service.ImportSeason.writtenBy:type_name -> service.CelebrityRef
+	70,  // 83: service.ImportSeason.producedBy:type_name -> service.CelebrityRef
+	70,  // 84: service.ImportSeason.directedBy:type_name -> service.CelebrityRef
+	71,  // 85: service.ImportSeason.episodes:type_name -> service.ImportEpisode
+	97,  // 86: service.ImportShow.releaseDate:type_name -> google.protobuf.Timestamp
+	97,  // 87: service.ImportShow.endDate:type_name -> google.protobuf.Timestamp
+	38,  // 88: service.ImportShow.length:type_name -> service.ShowLength
+	70,  // 89: service.ImportShow.directedBy:type_name -> service.CelebrityRef
+	70,  // 90: service.ImportShow.producedBy:type_name -> service.CelebrityRef
+	70,  // 91: service.ImportShow.writtenBy:type_name -> service.CelebrityRef
+	70,  // 92: service.ImportShow.starring:type_name -> service.CelebrityRef
+	72,  // 93: service.ImportShow.seasons:type_name -> service.ImportSeason
+	28,  // 94: service.BulkImportRequest.celebrities:type_name -> service.CreateCelebrityRequest
+	73,  // 95: service.BulkImportRequest.shows:type_name -> service.ImportShow
+	75,  // 96: service.BulkImportResponse.results:type_name -> service.ImportResult
+	47,  // 97: service.ChangeEvent.show:type_name -> service.Show
+	58,  // 98: service.ChangeEvent.season:type_name -> service.Season
+	34,  // 99: service.ChangeEvent.episode:type_name -> service.Episode
+	26,  // 100: service.ChangeEvent.celebrity:type_name -> service.Celebrity
+	19,  // 101: service.ChangeEvent.article:type_name -> service.Article
+	53,  // 102: service.ChangeEvent.genre:type_name -> service.Genre
+	22,  // 103: service.ChangeEvent.journalist:type_name -> service.Journalist
+	97,  // 104: service.ChangeEvent.time:type_name -> google.protobuf.Timestamp
+	97,  // 105: service.Webhook.createdAt:type_name -> google.protobuf.Timestamp
+	80,  // 106: service.ListWebhooksResponse.webhooks:type_name -> service.Webhook
+	97,  // 107: service.DeadLetter.createdAt:type_name -> google.protobuf.Timestamp
+	84,  // 108: service.DeadLetter.deliveries:type_name -> service.WebhookDelivery
+	85,  // 109: service.ListDeadLettersResponse.deadLetters:type_name -> service.DeadLetter
+	97,  // 110: service.TrashItem.deletedAt:type_name -> google.protobuf.Timestamp
+	88,  // 111: service.ListTrashResponse.items:type_name -> service.TrashItem
+	97,  // 112: service.Revision.createdAt:type_name -> google.protobuf.Timestamp
+	91,  // 113: service.ListRevisionsResponse.revisions:type_name -> service.Revision
+	91,  // 114: service.RevisionDiff.from:type_name -> service.Revision
+	91,  // 115: service.RevisionDiff.to:type_name -> service.Revision
+	94,  // 116: service.RevisionDiff.changes:type_name -> service.FieldChange
+	0,   // 117: service.ClothingSvc.CreateClothing:input_type -> service.CreateClothingRequest
+	2,   // 118: service.ClothingSvc.DeleteClothing:input_type -> service.DeleteClothingRequest
+	5,   // 119: service.ClothingSvc.GetAll:input_type -> service.GetAllRequest
+	20,  // 120: service.ArticleSvc.CreateArticle:input_type -> service.CreateArticleRequest
+	30,  // 121: service.ArticleSvc.GetArticle:input_type -> service.GetByIDRequest
+	19,  // 122: service.ArticleSvc.UpdateArticle:input_type -> service.Article
+	6,   // 123: service.ArticleSvc.ListArticles:input_type -> service.ListArticlesRequest
+	30,  // 124: service.ArticleSvc.ListArticlesByJournalist:input_type -> service.GetByIDRequest
+	13,  // 125: service.ArticleSvc.UploadArticlePosters:input_type -> service.UploadArticlePostersRequest
+	15,  // 126: service.ArticleSvc.DeleteArticlePoster:input_type -> service.DeleteArticlePosterRequest
+	30,  // 127: service.ArticleSvc.DeleteArticle:input_type -> service.GetByIDRequest
+	28,  // 128: service.CelebritySvc.CreateCelebrity:input_type -> service.CreateCelebrityRequest
+	30,  // 129: service.CelebritySvc.GetCelebrity:input_type -> service.GetByIDRequest
+	26,  // 130: service.CelebritySvc.UpdateCelebrity:input_type -> service.Celebrity
+	27,  // 131: service.CelebritySvc.PatchCelebrity:input_type -> service.PatchCelebrityRequest
+	32,  // 132: service.CelebritySvc.UploadCelebrityPosters:input_type -> service.UploadCelebrityPostersRequest
+	33,  // 133: service.CelebritySvc.DeleteCelebrityPoster:input_type -> service.DeleteCelebrityPosterRequest
+	7,   // 134: service.CelebritySvc.ListCelebrities:input_type -> service.ListCelebritiesRequest
+	30,  // 135: service.CelebritySvc.DeleteCelebrity:input_type -> service.GetByIDRequest
+	36,  // 136: service.EpisodeSvc.CreateEpisode:input_type -> service.CreateEpisodeRequest
+	30,  // 137: service.EpisodeSvc.GetEpisode:input_type -> service.GetByIDRequest
+	34,  // 138: service.EpisodeSvc.UpdateEpisode:input_type -> service.Episode
+	35,  // 139: service.EpisodeSvc.PatchEpisode:input_type -> service.PatchEpisodeRequest
+	45,  // 140: service.EpisodeSvc.UploadEpisodePosters:input_type -> service.UploadEpisodePostersRequest
+	46,  // 141: service.EpisodeSvc.DeleteEpisodePoster:input_type -> service.DeleteEpisodePosterRequest
+	30,  // 142: service.EpisodeSvc.ListSeasonEpisodes:input_type -> service.GetByIDRequest
+	8,   // 143: service.EpisodeSvc.ListCollectionEpisodes:input_type -> service.ListEpisodesRequest
+	30,  // 144: service.EpisodeSvc.DeleteEpisode:input_type -> service.GetByIDRequest
+	55,  // 145: service.ShowSvc.CreateShow:input_type -> service.CreateShowRequest
+	30,  // 146: service.ShowSvc.GetShow:input_type -> service.GetByIDRequest
+	47,  // 147: service.ShowSvc.UpdateShow:input_type -> service.Show
+	48,  // 148: service.ShowSvc.PatchShow:input_type -> service.PatchShowRequest
+	9,   // 149: service.ShowSvc.ListShows:input_type -> service.ListShowsRequest
+	14,  // 150: service.ShowSvc.UploadSeriesPosters:input_type -> service.UploadSeriesPostersRequest
+	17,  // 151: service.ShowSvc.DeleteSeriesPoster:input_type -> service.DeleteSeriesPosterRequest
+	16,  // 152: service.ShowSvc.UploadMoviePosters:input_type -> service.UploadMoviePostersRequest
+	18,  // 153: service.ShowSvc.DeleteMoviePoster:input_type -> service.DeleteMoviePosterRequest
+	30,  // 154: service.ShowSvc.DeleteShow:input_type -> service.GetByIDRequest
+	57,  // 155: service.GenreSvc.CreateGenre:input_type -> service.CreateGenreRequest
+	30,  // 156: service.GenreSvc.GetGenre:input_type -> service.GetByIDRequest
+	53,  // 157: service.GenreSvc.UpdateGenre:input_type -> service.Genre
+	10,  // 158: service.GenreSvc.ListGenres:input_type -> service.ListGenresRequest
+	31,  // 159: service.GenreSvc.GetGenreByName:input_type -> service.GetByNameRequest
+	30,  // 160: service.GenreSvc.DeleteGenre:input_type -> service.GetByIDRequest
+	60,  // 161: service.SeasonSvc.CreateSeason:input_type -> service.CreateSeasonRequest
+	30,  // 162: service.SeasonSvc.GetSeason:input_type -> service.GetByIDRequest
+	58,  // 163: service.SeasonSvc.UpdateSeason:input_type -> service.Season
+	59,  // 164: service.SeasonSvc.PatchSeason:input_type -> service.PatchSeasonRequest
+	62,  // 165: service.SeasonSvc.UploadSeasonPosters:input_type -> service.UploadSeasonPostersRequest
+	63,  // 166: service.SeasonSvc.DeleteSeasonPoster:input_type -> service.DeleteSeasonPosterRequest
+	30,  // 167: service.SeasonSvc.ListShowSeasons:input_type -> service.GetByIDRequest
+	11,  // 168: service.SeasonSvc.ListSeasonsCollection:input_type -> service.ListSeasonsRequest
+	30,  // 169: service.SeasonSvc.DeleteSeason:input_type -> service.GetByIDRequest
+	23,  // 170: service.JournalistSvc.CreateJournalist:input_type -> service.CreateJournalistRequest
+	30,  // 171: service.JournalistSvc.GetJournalist:input_type -> service.GetByIDRequest
+	22,  // 172: service.JournalistSvc.UpdateJournalist:input_type -> service.Journalist
+	12,  // 173: service.JournalistSvc.ListJournalists:input_type -> service.ListJournalistsRequest
+	31,  // 174: service.JournalistSvc.GetJournalistByName:input_type -> service.GetByNameRequest
+	30,  // 175: service.JournalistSvc.DeleteJournalist:input_type -> service.GetByIDRequest
+	64,  // 176: service.SearchSvc.Search:input_type -> service.SearchRequest
+	67,  // 177: service.AdminSvc.CheckConsistency:input_type -> service.CheckConsistencyRequest
+	74,  // 178: service.ImportSvc.BulkImport:input_type -> service.BulkImportRequest
+	79,  // 179: service.WebhookSvc.RegisterWebhook:input_type -> service.RegisterWebhookRequest
+	81,  // 180: service.WebhookSvc.ListWebhooks:input_type -> service.ListWebhooksRequest
+	30,  // 181: service.WebhookSvc.DeleteWebhook:input_type -> service.GetByIDRequest
+	83,  // 182: service.WebhookSvc.ListDeadLetters:input_type -> service.ListDeadLettersRequest
+	77,  // 183: service.WatchSvc.WatchChanges:input_type -> service.WatchChangesRequest
+	87,  // 184: service.TrashSvc.ListTrashedShows:input_type -> service.ListTrashRequest
+	30,  // 185: service.TrashSvc.RestoreShow:input_type -> service.GetByIDRequest
+	87,  // 186: service.TrashSvc.ListTrashedSeasons:input_type -> service.ListTrashRequest
+	30,  // 187: service.TrashSvc.RestoreSeason:input_type -> service.GetByIDRequest
+	87,  // 188: service.TrashSvc.ListTrashedEpisodes:input_type -> service.ListTrashRequest
+	30,  // 189: service.TrashSvc.RestoreEpisode:input_type -> service.GetByIDRequest
+	87,  // 190: service.TrashSvc.ListTrashedCelebrities:input_type -> service.ListTrashRequest
+	30,  // 191: service.TrashSvc.RestoreCelebrity:input_type -> service.GetByIDRequest
+	87,  // 192: service.TrashSvc.ListTrashedArticles:input_type -> service.ListTrashRequest
+	30,  // 193: service.TrashSvc.RestoreArticle:input_type -> service.GetByIDRequest
+	87,  // 194: service.TrashSvc.ListTrashedGenres:input_type -> service.ListTrashRequest
+	30,  // 195: service.TrashSvc.RestoreGenre:input_type -> service.GetByIDRequest
+	87,  // 196: service.TrashSvc.ListTrashedJournalists:input_type -> service.ListTrashRequest
+	30,  // 197: service.TrashSvc.RestoreJournalist:input_type -> service.GetByIDRequest
+	90,  // 198: service.RevisionSvc.ListRevisions:input_type -> service.ListRevisionsRequest
+	93,  // 199: service.RevisionSvc.GetRevisionDiff:input_type -> service.GetRevisionDiffRequest
+	96,  // 200: service.RevisionSvc.RevertToRevision:input_type -> service.RevertToRevisionRequest
+	1,   // 201: service.ClothingSvc.CreateClothing:output_type -> service.Clothing
+	4,   // 202: service.ClothingSvc.DeleteClothing:output_type -> service.EmptyResponse
+	3,   // 203: service.ClothingSvc.GetAll:output_type -> service.ClothingListResponse
+	19,  // 204: service.ArticleSvc.CreateArticle:output_type -> service.Article
+	19,  // 205: service.ArticleSvc.GetArticle:output_type -> service.Article
+	19,  // 206: service.ArticleSvc.UpdateArticle:output_type -> service.Article
+	21,  // 207: service.ArticleSvc.ListArticles:output_type -> service.ArticleListResponse
+	21,  // 208: service.ArticleSvc.ListArticlesByJournalist:output_type -> service.ArticleListResponse
+	19,  // 209: service.ArticleSvc.UploadArticlePosters:output_type -> service.Article
+	4,   // 210: service.ArticleSvc.DeleteArticlePoster:output_type -> service.EmptyResponse
+	4,   // 211: service.ArticleSvc.DeleteArticle:output_type -> service.EmptyResponse
+	26,  // 212: service.CelebritySvc.CreateCelebrity:output_type -> service.Celebrity
+	26,  // 213: service.CelebritySvc.GetCelebrity:output_type -> service.Celebrity
+	26,  // 214: service.CelebritySvc.UpdateCelebrity:output_type -> service.Celebrity
+	26,  // 215: service.CelebritySvc.PatchCelebrity:output_type -> service.Celebrity
+	26,  // 216: service.CelebritySvc.UploadCelebrityPosters:output_type -> service.Celebrity
+	4,   // 217: service.CelebritySvc.DeleteCelebrityPoster:output_type -> service.EmptyResponse
+	29,  // 218: service.CelebritySvc.ListCelebrities:output_type -> service.CelebrityListResponse
+	4,   // 219: service.CelebritySvc.DeleteCelebrity:output_type -> service.EmptyResponse
+	34,  // 220: service.EpisodeSvc.CreateEpisode:output_type -> service.Episode
+	34,  // 221: service.EpisodeSvc.GetEpisode:output_type -> service.Episode
+	34,  // 222: service.EpisodeSvc.UpdateEpisode:output_type -> service.Episode
+	34,  // 223: service.EpisodeSvc.PatchEpisode:output_type -> service.Episode
+	34,  // 224: service.EpisodeSvc.UploadEpisodePosters:output_type -> service.Episode
+	4,   // 225: service.EpisodeSvc.DeleteEpisodePoster:output_type -> service.EmptyResponse
+	37,  // 226: service.EpisodeSvc.ListSeasonEpisodes:output_type -> service.ListEpisodeResponse
+	37,  // 227: service.EpisodeSvc.ListCollectionEpisodes:output_type -> service.ListEpisodeResponse
+	4,   // 228: service.EpisodeSvc.DeleteEpisode:output_type -> service.EmptyResponse
+	47,  // 229: service.ShowSvc.CreateShow:output_type -> service.Show
+	47,  // 230: service.ShowSvc.GetShow:output_type -> service.Show
+	47,  // 231: service.ShowSvc.UpdateShow:output_type -> service.Show
+	47,  // 232: service.ShowSvc.PatchShow:output_type -> service.Show
+	56,  // 233: service.ShowSvc.ListShows:output_type -> service.ShowListResponse
+	47,  // 234: service.ShowSvc.UploadSeriesPosters:output_type -> service.Show
+	4,   // 235: service.ShowSvc.DeleteSeriesPoster:output_type -> service.EmptyResponse
+	47,  // 236: service.ShowSvc.UploadMoviePosters:output_type -> service.Show
+	4,   // 237: service.ShowSvc.DeleteMoviePoster:output_type -> service.EmptyResponse
+	4,   // 238: service.ShowSvc.DeleteShow:output_type -> service.EmptyResponse
+	53,  // 239: service.GenreSvc.CreateGenre:output_type -> service.Genre
+	53,  // 240: service.GenreSvc.GetGenre:output_type -> service.Genre
+	53,  // 241: service.GenreSvc.UpdateGenre:output_type -> service.Genre
+	54,  // 242: service.GenreSvc.ListGenres:output_type -> service.GenreListResponse
+	53,  // 243: service.GenreSvc.GetGenreByName:output_type -> service.Genre
+	4,   // 244: service.GenreSvc.DeleteGenre:output_type -> service.EmptyResponse
+	58,  // 245: service.SeasonSvc.CreateSeason:output_type -> service.Season
+	58,  // 246: service.SeasonSvc.GetSeason:output_type -> service.Season
+	58,  // 247: service.SeasonSvc.UpdateSeason:output_type -> service.Season
+	58,  // 248: service.SeasonSvc.PatchSeason:output_type -> service.Season
+	58,  // 249: service.SeasonSvc.UploadSeasonPosters:output_type -> service.Season
+	4,   // 250: service.SeasonSvc.DeleteSeasonPoster:output_type -> service.EmptyResponse
+	61,  // 251: service.SeasonSvc.ListShowSeasons:output_type -> service.ListSeasonResponse
+	61,  // 252: service.SeasonSvc.ListSeasonsCollection:output_type -> service.ListSeasonResponse
+	4,   // 253: service.SeasonSvc.DeleteSeason:output_type -> service.EmptyResponse
+	22,  // 254: service.JournalistSvc.CreateJournalist:output_type -> service.Journalist
+	22,  // 255: service.JournalistSvc.GetJournalist:output_type -> service.Journalist
+	22,  // 256: service.JournalistSvc.UpdateJournalist:output_type -> service.Journalist
+	24,  // 257: service.JournalistSvc.ListJournalists:output_type -> service.JournalistListResponse
+	22,  // 258: service.JournalistSvc.GetJournalistByName:output_type -> service.Journalist
+	4,   // 259: service.JournalistSvc.DeleteJournalist:output_type -> service.EmptyResponse
+	66,  // 260: service.SearchSvc.Search:output_type -> service.SearchResponse
+	69,  // 261: service.AdminSvc.CheckConsistency:output_type -> service.ConsistencyReport
+	76,  // 262: service.ImportSvc.BulkImport:output_type -> service.BulkImportResponse
+	80,  // 263: service.WebhookSvc.RegisterWebhook:output_type -> service.Webhook
+	82,  // 264: service.WebhookSvc.ListWebhooks:output_type -> service.ListWebhooksResponse
+	4,   // 265: service.WebhookSvc.DeleteWebhook:output_type -> service.EmptyResponse
+	86,  // 266: service.WebhookSvc.ListDeadLetters:output_type -> service.ListDeadLettersResponse
+	78,  // 267: service.WatchSvc.WatchChanges:output_type -> service.ChangeEvent
+	89,  // 268: service.TrashSvc.ListTrashedShows:output_type -> service.ListTrashResponse
+	47,  // 269: service.TrashSvc.RestoreShow:output_type -> service.Show
+	89,  // 270: service.TrashSvc.ListTrashedSeasons:output_type -> service.ListTrashResponse
+	58,  // 271: service.TrashSvc.RestoreSeason:output_type -> service.Season
+	89,  // 272: service.TrashSvc.ListTrashedEpisodes:output_type -> service.ListTrashResponse
+	34,  // 273: service.TrashSvc.RestoreEpisode:output_type -> service.Episode
+	89,  // 274: service.TrashSvc.ListTrashedCelebrities:output_type -> service.ListTrashResponse
+	26,  // 275: service.TrashSvc.RestoreCelebrity:output_type -> service.Celebrity
+	89,  // 276: service.TrashSvc.ListTrashedArticles:output_type -> service.ListTrashResponse
+	19,  // 277: service.TrashSvc.RestoreArticle:output_type -> service.Article
+	89,  // 278: service.TrashSvc.ListTrashedGenres:output_type -> service.ListTrashResponse
+	53,  // 279: service.TrashSvc.RestoreGenre:output_type -> service.Genre
+	89,  // 280: service.TrashSvc.ListTrashedJournalists:output_type -> service.ListTrashResponse
+	22,  // 281: service.TrashSvc.RestoreJournalist:output_type -> service.Journalist
+	92,  // 282: service.RevisionSvc.ListRevisions:output_type -> service.ListRevisionsResponse
+	95,  // 283: service.RevisionSvc.GetRevisionDiff:output_type -> service.RevisionDiff
+	91,  // 284: service.RevisionSvc.RevertToRevision:output_type -> service.Revision
+	201, // [201:285] is the sub-list for method output_type
+	117, // [117:201] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
+}
+
+func init() { file_service_proto_init() }
+func file_service_proto_init() {
+	if File_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClothingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clothing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteClothingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClothingListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArticlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCelebritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpisodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListShowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGenresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeasonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJournalistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadArticlePostersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSeriesPostersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteArticlePosterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMoviePostersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSeriesPosterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMoviePosterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Article); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateArticleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Journalist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJournalistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalistListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortJournalist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Celebrity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchCelebrityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCelebrityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CelebrityListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCelebrityPostersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCelebrityPosterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4577,8 +9498,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clothing); i {
+		file_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Episode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4589,8 +9510,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteClothingRequest); i {
+		file_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchEpisodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4601,8 +9522,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClothingListResponse); i {
+		file_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEpisodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4613,8 +9534,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
+		file_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpisodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4625,8 +9546,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllRequest); i {
+		file_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowLength); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4637,8 +9558,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListArticlesRequest); i {
+		file_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilmStaff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4649,8 +9570,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadArticlePostersRequest); i {
+		file_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilmCrew); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4661,8 +9582,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadSeriesPostersRequest); i {
+		file_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortCelebrity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4673,8 +9594,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteArticlePosterRequest); i {
+		file_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortCelebrities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4685,8 +9606,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadMoviePostersRequest); i {
+		file_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortEpisode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4697,8 +9618,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSeriesPosterRequest); i {
+		file_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortEpisodeList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4709,8 +9630,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMoviePosterRequest); i {
+		file_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadEpisodePostersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4721,8 +9642,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Article); i {
+		file_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEpisodePosterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4733,8 +9654,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateArticleRequest); i {
+		file_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Show); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4745,8 +9666,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleListResponse); i {
+		file_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4757,8 +9678,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Journalist); i {
+		file_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortGenres); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4769,8 +9690,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJournalistRequest); i {
+		file_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortGenre); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4781,8 +9702,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JournalistListResponse); i {
+		file_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortSeason); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4793,8 +9714,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortJournalist); i {
+		file_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortSeasons); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4805,8 +9726,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Celebrity); i {
+		file_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Genre); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4817,8 +9738,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCelebrityRequest); i {
+		file_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenreListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4829,8 +9750,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CelebrityListResponse); i {
+		file_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4841,8 +9762,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByIDRequest); i {
+		file_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4853,8 +9774,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByNameRequest); i {
+		file_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGenreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4865,8 +9786,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadCelebrityPostersRequest); i {
+		file_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Season); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4877,8 +9798,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCelebrityPosterRequest); i {
+		file_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchSeasonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4889,8 +9810,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Episode); i {
+		file_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSeasonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4901,8 +9822,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEpisodeRequest); i {
+		file_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeasonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4913,8 +9834,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEpisodeResponse); i {
+		file_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSeasonPostersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4925,8 +9846,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowLength); i {
+		file_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSeasonPosterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4937,8 +9858,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilmStaff); i {
+		file_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4949,8 +9870,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilmCrew); i {
+		file_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4961,8 +9882,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortCelebrity); i {
+		file_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4973,8 +9894,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortCelebrities); i {
+		file_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4985,8 +9906,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortEpisode); i {
+		file_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistencyIssue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4997,8 +9918,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortEpisodeList); i {
+		file_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistencyReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5009,8 +9930,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadEpisodePostersRequest); i {
+		file_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CelebrityRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5021,8 +9942,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEpisodePosterRequest); i {
+		file_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEpisode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5033,8 +9954,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Show); i {
+		file_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSeason); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5045,8 +9966,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortGenres); i {
+		file_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportShow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5057,8 +9978,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortGenre); i {
+		file_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5069,8 +9990,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortSeason); i {
+		file_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5081,8 +10002,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortSeasons); i {
+		file_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5093,8 +10014,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Genre); i {
+		file_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5105,8 +10026,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenreListResponse); i {
+		file_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5117,8 +10038,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShowRequest); i {
+		file_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5129,8 +10050,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowListResponse); i {
+		file_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5141,8 +10062,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGenreRequest); i {
+		file_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5153,8 +10074,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Season); i {
+		file_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5165,8 +10086,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSeasonRequest); i {
+		file_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5177,8 +10098,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSeasonResponse); i {
+		file_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5189,8 +10110,8 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadSeasonPostersRequest); i {
+		file_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5201,8 +10122,128 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
-		file_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSeasonPosterRequest); i {
+		file_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Revision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRevisionDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertToRevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5214,15 +10255,24 @@ func file_service_proto_init() {
 			}
 		}
 	}
+	file_service_proto_msgTypes[78].OneofWrappers = []interface{}{
+		(*ChangeEvent_Show)(nil),
+		(*ChangeEvent_Season)(nil),
+		(*ChangeEvent_Episode)(nil),
+		(*ChangeEvent_Celebrity)(nil),
+		(*ChangeEvent_Article)(nil),
+		(*ChangeEvent_Genre)(nil),
+		(*ChangeEvent_Journalist)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   54,
+			NumMessages:   97,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   15,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
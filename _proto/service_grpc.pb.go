@@ -187,6 +187,7 @@ type ArticleSvcClient interface {
 	ListArticlesByJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*ArticleListResponse, error)
 	UploadArticlePosters(ctx context.Context, in *UploadArticlePostersRequest, opts ...grpc.CallOption) (*Article, error)
 	DeleteArticlePoster(ctx context.Context, in *DeleteArticlePosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	DeleteArticle(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type articleSvcClient struct {
@@ -260,6 +261,15 @@ func (c *articleSvcClient) DeleteArticlePoster(ctx context.Context, in *DeleteAr
 	return out, nil
 }
 
+func (c *articleSvcClient) DeleteArticle(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.ArticleSvc/DeleteArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleSvcServer is the server API for ArticleSvc service.
 // All implementations must embed UnimplementedArticleSvcServer
 // for forward compatibility
@@ -271,6 +281,7 @@ type ArticleSvcServer interface {
 	ListArticlesByJournalist(context.Context, *GetByIDRequest) (*ArticleListResponse, error)
 	UploadArticlePosters(context.Context, *UploadArticlePostersRequest) (*Article, error)
 	DeleteArticlePoster(context.Context, *DeleteArticlePosterRequest) (*EmptyResponse, error)
+	DeleteArticle(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedArticleSvcServer()
 }
 
@@ -299,6 +310,9 @@ func (UnimplementedArticleSvcServer) UploadArticlePosters(context.Context, *Uplo
 func (UnimplementedArticleSvcServer) DeleteArticlePoster(context.Context, *DeleteArticlePosterRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticlePoster not implemented")
 }
+func (UnimplementedArticleSvcServer) DeleteArticle(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
+}
 func (UnimplementedArticleSvcServer) mustEmbedUnimplementedArticleSvcServer() {}
 
 // UnsafeArticleSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -438,6 +452,24 @@ func _ArticleSvc_DeleteArticlePoster_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleSvc_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleSvcServer).DeleteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.ArticleSvc/DeleteArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleSvcServer).DeleteArticle(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArticleSvc_ServiceDesc is the grpc.ServiceDesc for ArticleSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -473,6 +505,10 @@ var ArticleSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArticlePoster",
 			Handler:    _ArticleSvc_DeleteArticlePoster_Handler,
 		},
+		{
+			MethodName: "DeleteArticle",
+			Handler:    _ArticleSvc_DeleteArticle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -484,10 +520,14 @@ var ArticleSvc_ServiceDesc = grpc.ServiceDesc{
 type CelebritySvcClient interface {
 	CreateCelebrity(ctx context.Context, in *CreateCelebrityRequest, opts ...grpc.CallOption) (*Celebrity, error)
 	GetCelebrity(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Celebrity, error)
+	// UpdateCelebrity writes every field of the celebrity.
 	UpdateCelebrity(ctx context.Context, in *Celebrity, opts ...grpc.CallOption) (*Celebrity, error)
+	// PatchCelebrity writes the fields of the update mask.
+	PatchCelebrity(ctx context.Context, in *PatchCelebrityRequest, opts ...grpc.CallOption) (*Celebrity, error)
 	UploadCelebrityPosters(ctx context.Context, in *UploadCelebrityPostersRequest, opts ...grpc.CallOption) (*Celebrity, error)
 	DeleteCelebrityPoster(ctx context.Context, in *DeleteCelebrityPosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
-	ListCelebrities(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*CelebrityListResponse, error)
+	ListCelebrities(ctx context.Context, in *ListCelebritiesRequest, opts ...grpc.CallOption) (*CelebrityListResponse, error)
+	DeleteCelebrity(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type celebritySvcClient struct {
@@ -525,6 +565,15 @@ func (c *celebritySvcClient) UpdateCelebrity(ctx context.Context, in *Celebrity,
 	return out, nil
 }
 
+func (c *celebritySvcClient) PatchCelebrity(ctx context.Context, in *PatchCelebrityRequest, opts ...grpc.CallOption) (*Celebrity, error) {
+	out := new(Celebrity)
+	err := c.cc.Invoke(ctx, "/service.CelebritySvc/PatchCelebrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *celebritySvcClient) UploadCelebrityPosters(ctx context.Context, in *UploadCelebrityPostersRequest, opts ...grpc.CallOption) (*Celebrity, error) {
 	out := new(Celebrity)
 	err := c.cc.Invoke(ctx, "/service.CelebritySvc/UploadCelebrityPosters", in, out, opts...)
@@ -543,7 +592,7 @@ func (c *celebritySvcClient) DeleteCelebrityPoster(ctx context.Context, in *Dele
 	return out, nil
 }
 
-func (c *celebritySvcClient) ListCelebrities(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*CelebrityListResponse, error) {
+func (c *celebritySvcClient) ListCelebrities(ctx context.Context, in *ListCelebritiesRequest, opts ...grpc.CallOption) (*CelebrityListResponse, error) {
 	out := new(CelebrityListResponse)
 	err := c.cc.Invoke(ctx, "/service.CelebritySvc/ListCelebrities", in, out, opts...)
 	if err != nil {
@@ -552,16 +601,29 @@ func (c *celebritySvcClient) ListCelebrities(ctx context.Context, in *GetAllRequ
 	return out, nil
 }
 
+func (c *celebritySvcClient) DeleteCelebrity(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.CelebritySvc/DeleteCelebrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CelebritySvcServer is the server API for CelebritySvc service.
 // All implementations must embed UnimplementedCelebritySvcServer
 // for forward compatibility
 type CelebritySvcServer interface {
 	CreateCelebrity(context.Context, *CreateCelebrityRequest) (*Celebrity, error)
 	GetCelebrity(context.Context, *GetByIDRequest) (*Celebrity, error)
+	// UpdateCelebrity writes every field of the celebrity.
 	UpdateCelebrity(context.Context, *Celebrity) (*Celebrity, error)
+	// PatchCelebrity writes the fields of the update mask.
+	PatchCelebrity(context.Context, *PatchCelebrityRequest) (*Celebrity, error)
 	UploadCelebrityPosters(context.Context, *UploadCelebrityPostersRequest) (*Celebrity, error)
 	DeleteCelebrityPoster(context.Context, *DeleteCelebrityPosterRequest) (*EmptyResponse, error)
-	ListCelebrities(context.Context, *GetAllRequest) (*CelebrityListResponse, error)
+	ListCelebrities(context.Context, *ListCelebritiesRequest) (*CelebrityListResponse, error)
+	DeleteCelebrity(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedCelebritySvcServer()
 }
 
@@ -578,15 +640,21 @@ func (UnimplementedCelebritySvcServer) GetCelebrity(context.Context, *GetByIDReq
 func (UnimplementedCelebritySvcServer) UpdateCelebrity(context.Context, *Celebrity) (*Celebrity, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCelebrity not implemented")
 }
+func (UnimplementedCelebritySvcServer) PatchCelebrity(context.Context, *PatchCelebrityRequest) (*Celebrity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCelebrity not implemented")
+}
 func (UnimplementedCelebritySvcServer) UploadCelebrityPosters(context.Context, *UploadCelebrityPostersRequest) (*Celebrity, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadCelebrityPosters not implemented")
 }
 func (UnimplementedCelebritySvcServer) DeleteCelebrityPoster(context.Context, *DeleteCelebrityPosterRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCelebrityPoster not implemented")
 }
-func (UnimplementedCelebritySvcServer) ListCelebrities(context.Context, *GetAllRequest) (*CelebrityListResponse, error) {
+func (UnimplementedCelebritySvcServer) ListCelebrities(context.Context, *ListCelebritiesRequest) (*CelebrityListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCelebrities not implemented")
 }
+func (UnimplementedCelebritySvcServer) DeleteCelebrity(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCelebrity not implemented")
+}
 func (UnimplementedCelebritySvcServer) mustEmbedUnimplementedCelebritySvcServer() {}
 
 // UnsafeCelebritySvcServer may be embedded to opt out of forward compatibility for this service.
@@ -654,6 +722,24 @@ func _CelebritySvc_UpdateCelebrity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CelebritySvc_PatchCelebrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCelebrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CelebritySvcServer).PatchCelebrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.CelebritySvc/PatchCelebrity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CelebritySvcServer).PatchCelebrity(ctx, req.(*PatchCelebrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CelebritySvc_UploadCelebrityPosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadCelebrityPostersRequest)
 	if err := dec(in); err != nil {
@@ -691,7 +777,7 @@ func _CelebritySvc_DeleteCelebrityPoster_Handler(srv interface{}, ctx context.Co
 }
 
 func _CelebritySvc_ListCelebrities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListCelebritiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -703,7 +789,25 @@ func _CelebritySvc_ListCelebrities_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/service.CelebritySvc/ListCelebrities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CelebritySvcServer).ListCelebrities(ctx, req.(*GetAllRequest))
+		return srv.(CelebritySvcServer).ListCelebrities(ctx, req.(*ListCelebritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CelebritySvc_DeleteCelebrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CelebritySvcServer).DeleteCelebrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.CelebritySvc/DeleteCelebrity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CelebritySvcServer).DeleteCelebrity(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -727,6 +831,10 @@ var CelebritySvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCelebrity",
 			Handler:    _CelebritySvc_UpdateCelebrity_Handler,
 		},
+		{
+			MethodName: "PatchCelebrity",
+			Handler:    _CelebritySvc_PatchCelebrity_Handler,
+		},
 		{
 			MethodName: "UploadCelebrityPosters",
 			Handler:    _CelebritySvc_UploadCelebrityPosters_Handler,
@@ -739,6 +847,10 @@ var CelebritySvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCelebrities",
 			Handler:    _CelebritySvc_ListCelebrities_Handler,
 		},
+		{
+			MethodName: "DeleteCelebrity",
+			Handler:    _CelebritySvc_DeleteCelebrity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -750,11 +862,15 @@ var CelebritySvc_ServiceDesc = grpc.ServiceDesc{
 type EpisodeSvcClient interface {
 	CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
 	GetEpisode(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Episode, error)
+	// UpdateEpisode writes every field of the episode.
 	UpdateEpisode(ctx context.Context, in *Episode, opts ...grpc.CallOption) (*Episode, error)
+	// PatchEpisode writes the fields of the update mask.
+	PatchEpisode(ctx context.Context, in *PatchEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
 	UploadEpisodePosters(ctx context.Context, in *UploadEpisodePostersRequest, opts ...grpc.CallOption) (*Episode, error)
 	DeleteEpisodePoster(ctx context.Context, in *DeleteEpisodePosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ListSeasonEpisodes(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*ListEpisodeResponse, error)
-	ListCollectionEpisodes(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ListEpisodeResponse, error)
+	ListCollectionEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodeResponse, error)
+	DeleteEpisode(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type episodeSvcClient struct {
@@ -792,6 +908,15 @@ func (c *episodeSvcClient) UpdateEpisode(ctx context.Context, in *Episode, opts
 	return out, nil
 }
 
+func (c *episodeSvcClient) PatchEpisode(ctx context.Context, in *PatchEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, "/service.EpisodeSvc/PatchEpisode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *episodeSvcClient) UploadEpisodePosters(ctx context.Context, in *UploadEpisodePostersRequest, opts ...grpc.CallOption) (*Episode, error) {
 	out := new(Episode)
 	err := c.cc.Invoke(ctx, "/service.EpisodeSvc/UploadEpisodePosters", in, out, opts...)
@@ -819,7 +944,7 @@ func (c *episodeSvcClient) ListSeasonEpisodes(ctx context.Context, in *GetByIDRe
 	return out, nil
 }
 
-func (c *episodeSvcClient) ListCollectionEpisodes(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ListEpisodeResponse, error) {
+func (c *episodeSvcClient) ListCollectionEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodeResponse, error) {
 	out := new(ListEpisodeResponse)
 	err := c.cc.Invoke(ctx, "/service.EpisodeSvc/ListCollectionEpisodes", in, out, opts...)
 	if err != nil {
@@ -828,17 +953,30 @@ func (c *episodeSvcClient) ListCollectionEpisodes(ctx context.Context, in *GetAl
 	return out, nil
 }
 
+func (c *episodeSvcClient) DeleteEpisode(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.EpisodeSvc/DeleteEpisode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EpisodeSvcServer is the server API for EpisodeSvc service.
 // All implementations must embed UnimplementedEpisodeSvcServer
 // for forward compatibility
 type EpisodeSvcServer interface {
 	CreateEpisode(context.Context, *CreateEpisodeRequest) (*Episode, error)
 	GetEpisode(context.Context, *GetByIDRequest) (*Episode, error)
+	// UpdateEpisode writes every field of the episode.
 	UpdateEpisode(context.Context, *Episode) (*Episode, error)
+	// PatchEpisode writes the fields of the update mask.
+	PatchEpisode(context.Context, *PatchEpisodeRequest) (*Episode, error)
 	UploadEpisodePosters(context.Context, *UploadEpisodePostersRequest) (*Episode, error)
 	DeleteEpisodePoster(context.Context, *DeleteEpisodePosterRequest) (*EmptyResponse, error)
 	ListSeasonEpisodes(context.Context, *GetByIDRequest) (*ListEpisodeResponse, error)
-	ListCollectionEpisodes(context.Context, *GetAllRequest) (*ListEpisodeResponse, error)
+	ListCollectionEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodeResponse, error)
+	DeleteEpisode(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedEpisodeSvcServer()
 }
 
@@ -855,6 +993,9 @@ func (UnimplementedEpisodeSvcServer) GetEpisode(context.Context, *GetByIDRequest
 func (UnimplementedEpisodeSvcServer) UpdateEpisode(context.Context, *Episode) (*Episode, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpisode not implemented")
 }
+func (UnimplementedEpisodeSvcServer) PatchEpisode(context.Context, *PatchEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchEpisode not implemented")
+}
 func (UnimplementedEpisodeSvcServer) UploadEpisodePosters(context.Context, *UploadEpisodePostersRequest) (*Episode, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadEpisodePosters not implemented")
 }
@@ -864,9 +1005,12 @@ func (UnimplementedEpisodeSvcServer) DeleteEpisodePoster(context.Context, *Delet
 func (UnimplementedEpisodeSvcServer) ListSeasonEpisodes(context.Context, *GetByIDRequest) (*ListEpisodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonEpisodes not implemented")
 }
-func (UnimplementedEpisodeSvcServer) ListCollectionEpisodes(context.Context, *GetAllRequest) (*ListEpisodeResponse, error) {
+func (UnimplementedEpisodeSvcServer) ListCollectionEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionEpisodes not implemented")
 }
+func (UnimplementedEpisodeSvcServer) DeleteEpisode(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpisode not implemented")
+}
 func (UnimplementedEpisodeSvcServer) mustEmbedUnimplementedEpisodeSvcServer() {}
 
 // UnsafeEpisodeSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -934,6 +1078,24 @@ func _EpisodeSvc_UpdateEpisode_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EpisodeSvc_PatchEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EpisodeSvcServer).PatchEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.EpisodeSvc/PatchEpisode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EpisodeSvcServer).PatchEpisode(ctx, req.(*PatchEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EpisodeSvc_UploadEpisodePosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadEpisodePostersRequest)
 	if err := dec(in); err != nil {
@@ -989,7 +1151,7 @@ func _EpisodeSvc_ListSeasonEpisodes_Handler(srv interface{}, ctx context.Context
 }
 
 func _EpisodeSvc_ListCollectionEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListEpisodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1001,7 +1163,25 @@ func _EpisodeSvc_ListCollectionEpisodes_Handler(srv interface{}, ctx context.Con
 		FullMethod: "/service.EpisodeSvc/ListCollectionEpisodes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EpisodeSvcServer).ListCollectionEpisodes(ctx, req.(*GetAllRequest))
+		return srv.(EpisodeSvcServer).ListCollectionEpisodes(ctx, req.(*ListEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EpisodeSvc_DeleteEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EpisodeSvcServer).DeleteEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.EpisodeSvc/DeleteEpisode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EpisodeSvcServer).DeleteEpisode(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1025,6 +1205,10 @@ var EpisodeSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEpisode",
 			Handler:    _EpisodeSvc_UpdateEpisode_Handler,
 		},
+		{
+			MethodName: "PatchEpisode",
+			Handler:    _EpisodeSvc_PatchEpisode_Handler,
+		},
 		{
 			MethodName: "UploadEpisodePosters",
 			Handler:    _EpisodeSvc_UploadEpisodePosters_Handler,
@@ -1041,6 +1225,10 @@ var EpisodeSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCollectionEpisodes",
 			Handler:    _EpisodeSvc_ListCollectionEpisodes_Handler,
 		},
+		{
+			MethodName: "DeleteEpisode",
+			Handler:    _EpisodeSvc_DeleteEpisode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -1052,12 +1240,16 @@ var EpisodeSvc_ServiceDesc = grpc.ServiceDesc{
 type ShowSvcClient interface {
 	CreateShow(ctx context.Context, in *CreateShowRequest, opts ...grpc.CallOption) (*Show, error)
 	GetShow(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Show, error)
+	// UpdateShow writes every field of the show.
 	UpdateShow(ctx context.Context, in *Show, opts ...grpc.CallOption) (*Show, error)
-	ListShows(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ShowListResponse, error)
+	// PatchShow writes the fields of the update mask.
+	PatchShow(ctx context.Context, in *PatchShowRequest, opts ...grpc.CallOption) (*Show, error)
+	ListShows(ctx context.Context, in *ListShowsRequest, opts ...grpc.CallOption) (*ShowListResponse, error)
 	UploadSeriesPosters(ctx context.Context, in *UploadSeriesPostersRequest, opts ...grpc.CallOption) (*Show, error)
 	DeleteSeriesPoster(ctx context.Context, in *DeleteSeriesPosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	UploadMoviePosters(ctx context.Context, in *UploadMoviePostersRequest, opts ...grpc.CallOption) (*Show, error)
 	DeleteMoviePoster(ctx context.Context, in *DeleteMoviePosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	DeleteShow(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type showSvcClient struct {
@@ -1095,7 +1287,16 @@ func (c *showSvcClient) UpdateShow(ctx context.Context, in *Show, opts ...grpc.C
 	return out, nil
 }
 
-func (c *showSvcClient) ListShows(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ShowListResponse, error) {
+func (c *showSvcClient) PatchShow(ctx context.Context, in *PatchShowRequest, opts ...grpc.CallOption) (*Show, error) {
+	out := new(Show)
+	err := c.cc.Invoke(ctx, "/service.ShowSvc/PatchShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *showSvcClient) ListShows(ctx context.Context, in *ListShowsRequest, opts ...grpc.CallOption) (*ShowListResponse, error) {
 	out := new(ShowListResponse)
 	err := c.cc.Invoke(ctx, "/service.ShowSvc/ListShows", in, out, opts...)
 	if err != nil {
@@ -1140,18 +1341,31 @@ func (c *showSvcClient) DeleteMoviePoster(ctx context.Context, in *DeleteMoviePo
 	return out, nil
 }
 
+func (c *showSvcClient) DeleteShow(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.ShowSvc/DeleteShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShowSvcServer is the server API for ShowSvc service.
 // All implementations must embed UnimplementedShowSvcServer
 // for forward compatibility
 type ShowSvcServer interface {
 	CreateShow(context.Context, *CreateShowRequest) (*Show, error)
 	GetShow(context.Context, *GetByIDRequest) (*Show, error)
+	// UpdateShow writes every field of the show.
 	UpdateShow(context.Context, *Show) (*Show, error)
-	ListShows(context.Context, *GetAllRequest) (*ShowListResponse, error)
+	// PatchShow writes the fields of the update mask.
+	PatchShow(context.Context, *PatchShowRequest) (*Show, error)
+	ListShows(context.Context, *ListShowsRequest) (*ShowListResponse, error)
 	UploadSeriesPosters(context.Context, *UploadSeriesPostersRequest) (*Show, error)
 	DeleteSeriesPoster(context.Context, *DeleteSeriesPosterRequest) (*EmptyResponse, error)
 	UploadMoviePosters(context.Context, *UploadMoviePostersRequest) (*Show, error)
 	DeleteMoviePoster(context.Context, *DeleteMoviePosterRequest) (*EmptyResponse, error)
+	DeleteShow(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedShowSvcServer()
 }
 
@@ -1168,7 +1382,10 @@ func (UnimplementedShowSvcServer) GetShow(context.Context, *GetByIDRequest) (*Sh
 func (UnimplementedShowSvcServer) UpdateShow(context.Context, *Show) (*Show, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateShow not implemented")
 }
-func (UnimplementedShowSvcServer) ListShows(context.Context, *GetAllRequest) (*ShowListResponse, error) {
+func (UnimplementedShowSvcServer) PatchShow(context.Context, *PatchShowRequest) (*Show, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchShow not implemented")
+}
+func (UnimplementedShowSvcServer) ListShows(context.Context, *ListShowsRequest) (*ShowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListShows not implemented")
 }
 func (UnimplementedShowSvcServer) UploadSeriesPosters(context.Context, *UploadSeriesPostersRequest) (*Show, error) {
@@ -1183,6 +1400,9 @@ func (UnimplementedShowSvcServer) UploadMoviePosters(context.Context, *UploadMov
 func (UnimplementedShowSvcServer) DeleteMoviePoster(context.Context, *DeleteMoviePosterRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMoviePoster not implemented")
 }
+func (UnimplementedShowSvcServer) DeleteShow(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShow not implemented")
+}
 func (UnimplementedShowSvcServer) mustEmbedUnimplementedShowSvcServer() {}
 
 // UnsafeShowSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -1250,8 +1470,26 @@ func _ShowSvc_UpdateShow_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShowSvc_PatchShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShowSvcServer).PatchShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.ShowSvc/PatchShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShowSvcServer).PatchShow(ctx, req.(*PatchShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShowSvc_ListShows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListShowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1263,7 +1501,7 @@ func _ShowSvc_ListShows_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: "/service.ShowSvc/ListShows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShowSvcServer).ListShows(ctx, req.(*GetAllRequest))
+		return srv.(ShowSvcServer).ListShows(ctx, req.(*ListShowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1340,6 +1578,24 @@ func _ShowSvc_DeleteMoviePoster_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShowSvc_DeleteShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShowSvcServer).DeleteShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.ShowSvc/DeleteShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShowSvcServer).DeleteShow(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShowSvc_ServiceDesc is the grpc.ServiceDesc for ShowSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1359,6 +1615,10 @@ var ShowSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateShow",
 			Handler:    _ShowSvc_UpdateShow_Handler,
 		},
+		{
+			MethodName: "PatchShow",
+			Handler:    _ShowSvc_PatchShow_Handler,
+		},
 		{
 			MethodName: "ListShows",
 			Handler:    _ShowSvc_ListShows_Handler,
@@ -1379,6 +1639,10 @@ var ShowSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMoviePoster",
 			Handler:    _ShowSvc_DeleteMoviePoster_Handler,
 		},
+		{
+			MethodName: "DeleteShow",
+			Handler:    _ShowSvc_DeleteShow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -1391,8 +1655,9 @@ type GenreSvcClient interface {
 	CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*Genre, error)
 	GetGenre(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Genre, error)
 	UpdateGenre(ctx context.Context, in *Genre, opts ...grpc.CallOption) (*Genre, error)
-	ListGenres(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GenreListResponse, error)
+	ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*GenreListResponse, error)
 	GetGenreByName(ctx context.Context, in *GetByNameRequest, opts ...grpc.CallOption) (*Genre, error)
+	DeleteGenre(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type genreSvcClient struct {
@@ -1430,7 +1695,7 @@ func (c *genreSvcClient) UpdateGenre(ctx context.Context, in *Genre, opts ...grp
 	return out, nil
 }
 
-func (c *genreSvcClient) ListGenres(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GenreListResponse, error) {
+func (c *genreSvcClient) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*GenreListResponse, error) {
 	out := new(GenreListResponse)
 	err := c.cc.Invoke(ctx, "/service.GenreSvc/ListGenres", in, out, opts...)
 	if err != nil {
@@ -1448,6 +1713,15 @@ func (c *genreSvcClient) GetGenreByName(ctx context.Context, in *GetByNameReques
 	return out, nil
 }
 
+func (c *genreSvcClient) DeleteGenre(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.GenreSvc/DeleteGenre", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GenreSvcServer is the server API for GenreSvc service.
 // All implementations must embed UnimplementedGenreSvcServer
 // for forward compatibility
@@ -1455,8 +1729,9 @@ type GenreSvcServer interface {
 	CreateGenre(context.Context, *CreateGenreRequest) (*Genre, error)
 	GetGenre(context.Context, *GetByIDRequest) (*Genre, error)
 	UpdateGenre(context.Context, *Genre) (*Genre, error)
-	ListGenres(context.Context, *GetAllRequest) (*GenreListResponse, error)
+	ListGenres(context.Context, *ListGenresRequest) (*GenreListResponse, error)
 	GetGenreByName(context.Context, *GetByNameRequest) (*Genre, error)
+	DeleteGenre(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedGenreSvcServer()
 }
 
@@ -1473,12 +1748,15 @@ func (UnimplementedGenreSvcServer) GetGenre(context.Context, *GetByIDRequest) (*
 func (UnimplementedGenreSvcServer) UpdateGenre(context.Context, *Genre) (*Genre, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
 }
-func (UnimplementedGenreSvcServer) ListGenres(context.Context, *GetAllRequest) (*GenreListResponse, error) {
+func (UnimplementedGenreSvcServer) ListGenres(context.Context, *ListGenresRequest) (*GenreListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
 }
 func (UnimplementedGenreSvcServer) GetGenreByName(context.Context, *GetByNameRequest) (*Genre, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGenreByName not implemented")
 }
+func (UnimplementedGenreSvcServer) DeleteGenre(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
 func (UnimplementedGenreSvcServer) mustEmbedUnimplementedGenreSvcServer() {}
 
 // UnsafeGenreSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -1547,7 +1825,7 @@ func _GenreSvc_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec fun
 }
 
 func _GenreSvc_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListGenresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1559,7 +1837,7 @@ func _GenreSvc_ListGenres_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: "/service.GenreSvc/ListGenres",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GenreSvcServer).ListGenres(ctx, req.(*GetAllRequest))
+		return srv.(GenreSvcServer).ListGenres(ctx, req.(*ListGenresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1582,6 +1860,24 @@ func _GenreSvc_GetGenreByName_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GenreSvc_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreSvcServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.GenreSvc/DeleteGenre",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreSvcServer).DeleteGenre(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GenreSvc_ServiceDesc is the grpc.ServiceDesc for GenreSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1609,6 +1905,10 @@ var GenreSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGenreByName",
 			Handler:    _GenreSvc_GetGenreByName_Handler,
 		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _GenreSvc_DeleteGenre_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -1620,11 +1920,15 @@ var GenreSvc_ServiceDesc = grpc.ServiceDesc{
 type SeasonSvcClient interface {
 	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
 	GetSeason(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Season, error)
+	// UpdateSeason writes every field of the season.
 	UpdateSeason(ctx context.Context, in *Season, opts ...grpc.CallOption) (*Season, error)
+	// PatchSeason writes the fields of the update mask.
+	PatchSeason(ctx context.Context, in *PatchSeasonRequest, opts ...grpc.CallOption) (*Season, error)
 	UploadSeasonPosters(ctx context.Context, in *UploadSeasonPostersRequest, opts ...grpc.CallOption) (*Season, error)
 	DeleteSeasonPoster(ctx context.Context, in *DeleteSeasonPosterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ListShowSeasons(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*ListSeasonResponse, error)
-	ListSeasonsCollection(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ListSeasonResponse, error)
+	ListSeasonsCollection(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonResponse, error)
+	DeleteSeason(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type seasonSvcClient struct {
@@ -1662,6 +1966,15 @@ func (c *seasonSvcClient) UpdateSeason(ctx context.Context, in *Season, opts ...
 	return out, nil
 }
 
+func (c *seasonSvcClient) PatchSeason(ctx context.Context, in *PatchSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	out := new(Season)
+	err := c.cc.Invoke(ctx, "/service.SeasonSvc/PatchSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *seasonSvcClient) UploadSeasonPosters(ctx context.Context, in *UploadSeasonPostersRequest, opts ...grpc.CallOption) (*Season, error) {
 	out := new(Season)
 	err := c.cc.Invoke(ctx, "/service.SeasonSvc/UploadSeasonPosters", in, out, opts...)
@@ -1689,7 +2002,7 @@ func (c *seasonSvcClient) ListShowSeasons(ctx context.Context, in *GetByIDReques
 	return out, nil
 }
 
-func (c *seasonSvcClient) ListSeasonsCollection(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*ListSeasonResponse, error) {
+func (c *seasonSvcClient) ListSeasonsCollection(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonResponse, error) {
 	out := new(ListSeasonResponse)
 	err := c.cc.Invoke(ctx, "/service.SeasonSvc/ListSeasonsCollection", in, out, opts...)
 	if err != nil {
@@ -1698,17 +2011,30 @@ func (c *seasonSvcClient) ListSeasonsCollection(ctx context.Context, in *GetAllR
 	return out, nil
 }
 
+func (c *seasonSvcClient) DeleteSeason(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.SeasonSvc/DeleteSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SeasonSvcServer is the server API for SeasonSvc service.
 // All implementations must embed UnimplementedSeasonSvcServer
 // for forward compatibility
 type SeasonSvcServer interface {
 	CreateSeason(context.Context, *CreateSeasonRequest) (*Season, error)
 	GetSeason(context.Context, *GetByIDRequest) (*Season, error)
+	// UpdateSeason writes every field of the season.
 	UpdateSeason(context.Context, *Season) (*Season, error)
+	// PatchSeason writes the fields of the update mask.
+	PatchSeason(context.Context, *PatchSeasonRequest) (*Season, error)
 	UploadSeasonPosters(context.Context, *UploadSeasonPostersRequest) (*Season, error)
 	DeleteSeasonPoster(context.Context, *DeleteSeasonPosterRequest) (*EmptyResponse, error)
 	ListShowSeasons(context.Context, *GetByIDRequest) (*ListSeasonResponse, error)
-	ListSeasonsCollection(context.Context, *GetAllRequest) (*ListSeasonResponse, error)
+	ListSeasonsCollection(context.Context, *ListSeasonsRequest) (*ListSeasonResponse, error)
+	DeleteSeason(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedSeasonSvcServer()
 }
 
@@ -1725,6 +2051,9 @@ func (UnimplementedSeasonSvcServer) GetSeason(context.Context, *GetByIDRequest)
 func (UnimplementedSeasonSvcServer) UpdateSeason(context.Context, *Season) (*Season, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeason not implemented")
 }
+func (UnimplementedSeasonSvcServer) PatchSeason(context.Context, *PatchSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSeason not implemented")
+}
 func (UnimplementedSeasonSvcServer) UploadSeasonPosters(context.Context, *UploadSeasonPostersRequest) (*Season, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadSeasonPosters not implemented")
 }
@@ -1734,9 +2063,12 @@ func (UnimplementedSeasonSvcServer) DeleteSeasonPoster(context.Context, *DeleteS
 func (UnimplementedSeasonSvcServer) ListShowSeasons(context.Context, *GetByIDRequest) (*ListSeasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListShowSeasons not implemented")
 }
-func (UnimplementedSeasonSvcServer) ListSeasonsCollection(context.Context, *GetAllRequest) (*ListSeasonResponse, error) {
+func (UnimplementedSeasonSvcServer) ListSeasonsCollection(context.Context, *ListSeasonsRequest) (*ListSeasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonsCollection not implemented")
 }
+func (UnimplementedSeasonSvcServer) DeleteSeason(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeason not implemented")
+}
 func (UnimplementedSeasonSvcServer) mustEmbedUnimplementedSeasonSvcServer() {}
 
 // UnsafeSeasonSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -1804,6 +2136,24 @@ func _SeasonSvc_UpdateSeason_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeasonSvc_PatchSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonSvcServer).PatchSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.SeasonSvc/PatchSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonSvcServer).PatchSeason(ctx, req.(*PatchSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SeasonSvc_UploadSeasonPosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadSeasonPostersRequest)
 	if err := dec(in); err != nil {
@@ -1859,7 +2209,7 @@ func _SeasonSvc_ListShowSeasons_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _SeasonSvc_ListSeasonsCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListSeasonsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1871,7 +2221,25 @@ func _SeasonSvc_ListSeasonsCollection_Handler(srv interface{}, ctx context.Conte
 		FullMethod: "/service.SeasonSvc/ListSeasonsCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SeasonSvcServer).ListSeasonsCollection(ctx, req.(*GetAllRequest))
+		return srv.(SeasonSvcServer).ListSeasonsCollection(ctx, req.(*ListSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonSvc_DeleteSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonSvcServer).DeleteSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.SeasonSvc/DeleteSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonSvcServer).DeleteSeason(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1895,6 +2263,10 @@ var SeasonSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSeason",
 			Handler:    _SeasonSvc_UpdateSeason_Handler,
 		},
+		{
+			MethodName: "PatchSeason",
+			Handler:    _SeasonSvc_PatchSeason_Handler,
+		},
 		{
 			MethodName: "UploadSeasonPosters",
 			Handler:    _SeasonSvc_UploadSeasonPosters_Handler,
@@ -1911,6 +2283,10 @@ var SeasonSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSeasonsCollection",
 			Handler:    _SeasonSvc_ListSeasonsCollection_Handler,
 		},
+		{
+			MethodName: "DeleteSeason",
+			Handler:    _SeasonSvc_DeleteSeason_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -1923,8 +2299,9 @@ type JournalistSvcClient interface {
 	CreateJournalist(ctx context.Context, in *CreateJournalistRequest, opts ...grpc.CallOption) (*Journalist, error)
 	GetJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Journalist, error)
 	UpdateJournalist(ctx context.Context, in *Journalist, opts ...grpc.CallOption) (*Journalist, error)
-	ListJournalists(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*JournalistListResponse, error)
+	ListJournalists(ctx context.Context, in *ListJournalistsRequest, opts ...grpc.CallOption) (*JournalistListResponse, error)
 	GetJournalistByName(ctx context.Context, in *GetByNameRequest, opts ...grpc.CallOption) (*Journalist, error)
+	DeleteJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type journalistSvcClient struct {
@@ -1962,7 +2339,7 @@ func (c *journalistSvcClient) UpdateJournalist(ctx context.Context, in *Journali
 	return out, nil
 }
 
-func (c *journalistSvcClient) ListJournalists(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*JournalistListResponse, error) {
+func (c *journalistSvcClient) ListJournalists(ctx context.Context, in *ListJournalistsRequest, opts ...grpc.CallOption) (*JournalistListResponse, error) {
 	out := new(JournalistListResponse)
 	err := c.cc.Invoke(ctx, "/service.JournalistSvc/ListJournalists", in, out, opts...)
 	if err != nil {
@@ -1980,6 +2357,15 @@ func (c *journalistSvcClient) GetJournalistByName(ctx context.Context, in *GetBy
 	return out, nil
 }
 
+func (c *journalistSvcClient) DeleteJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.JournalistSvc/DeleteJournalist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JournalistSvcServer is the server API for JournalistSvc service.
 // All implementations must embed UnimplementedJournalistSvcServer
 // for forward compatibility
@@ -1987,8 +2373,9 @@ type JournalistSvcServer interface {
 	CreateJournalist(context.Context, *CreateJournalistRequest) (*Journalist, error)
 	GetJournalist(context.Context, *GetByIDRequest) (*Journalist, error)
 	UpdateJournalist(context.Context, *Journalist) (*Journalist, error)
-	ListJournalists(context.Context, *GetAllRequest) (*JournalistListResponse, error)
+	ListJournalists(context.Context, *ListJournalistsRequest) (*JournalistListResponse, error)
 	GetJournalistByName(context.Context, *GetByNameRequest) (*Journalist, error)
+	DeleteJournalist(context.Context, *GetByIDRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedJournalistSvcServer()
 }
 
@@ -2005,12 +2392,15 @@ func (UnimplementedJournalistSvcServer) GetJournalist(context.Context, *GetByIDR
 func (UnimplementedJournalistSvcServer) UpdateJournalist(context.Context, *Journalist) (*Journalist, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournalist not implemented")
 }
-func (UnimplementedJournalistSvcServer) ListJournalists(context.Context, *GetAllRequest) (*JournalistListResponse, error) {
+func (UnimplementedJournalistSvcServer) ListJournalists(context.Context, *ListJournalistsRequest) (*JournalistListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJournalists not implemented")
 }
 func (UnimplementedJournalistSvcServer) GetJournalistByName(context.Context, *GetByNameRequest) (*Journalist, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJournalistByName not implemented")
 }
+func (UnimplementedJournalistSvcServer) DeleteJournalist(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalist not implemented")
+}
 func (UnimplementedJournalistSvcServer) mustEmbedUnimplementedJournalistSvcServer() {}
 
 // UnsafeJournalistSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -2079,7 +2469,7 @@ func _JournalistSvc_UpdateJournalist_Handler(srv interface{}, ctx context.Contex
 }
 
 func _JournalistSvc_ListJournalists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+	in := new(ListJournalistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2091,7 +2481,7 @@ func _JournalistSvc_ListJournalists_Handler(srv interface{}, ctx context.Context
 		FullMethod: "/service.JournalistSvc/ListJournalists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JournalistSvcServer).ListJournalists(ctx, req.(*GetAllRequest))
+		return srv.(JournalistSvcServer).ListJournalists(ctx, req.(*ListJournalistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2114,12 +2504,30 @@ func _JournalistSvc_GetJournalistByName_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-// JournalistSvc_ServiceDesc is the grpc.ServiceDesc for JournalistSvc service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var JournalistSvc_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "service.JournalistSvc",
-	HandlerType: (*JournalistSvcServer)(nil),
+func _JournalistSvc_DeleteJournalist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalistSvcServer).DeleteJournalist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.JournalistSvc/DeleteJournalist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalistSvcServer).DeleteJournalist(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JournalistSvc_ServiceDesc is the grpc.ServiceDesc for JournalistSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JournalistSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.JournalistSvc",
+	HandlerType: (*JournalistSvcServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CreateJournalist",
@@ -2141,6 +2549,1295 @@ var JournalistSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJournalistByName",
 			Handler:    _JournalistSvc_GetJournalistByName_Handler,
 		},
+		{
+			MethodName: "DeleteJournalist",
+			Handler:    _JournalistSvc_DeleteJournalist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// SearchSvcClient is the client API for SearchSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchSvcClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type searchSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchSvcClient(cc grpc.ClientConnInterface) SearchSvcClient {
+	return &searchSvcClient{cc}
+}
+
+func (c *searchSvcClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/service.SearchSvc/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchSvcServer is the server API for SearchSvc service.
+// All implementations must embed UnimplementedSearchSvcServer
+// for forward compatibility
+type SearchSvcServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedSearchSvcServer()
+}
+
+// UnimplementedSearchSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedSearchSvcServer struct {
+}
+
+func (UnimplementedSearchSvcServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchSvcServer) mustEmbedUnimplementedSearchSvcServer() {}
+
+// UnsafeSearchSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchSvcServer will
+// result in compilation errors.
+type UnsafeSearchSvcServer interface {
+	mustEmbedUnimplementedSearchSvcServer()
+}
+
+func RegisterSearchSvcServer(s grpc.ServiceRegistrar, srv SearchSvcServer) {
+	s.RegisterService(&SearchSvc_ServiceDesc, srv)
+}
+
+func _SearchSvc_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchSvcServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.SearchSvc/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchSvcServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchSvc_ServiceDesc is the grpc.ServiceDesc for SearchSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.SearchSvc",
+	HandlerType: (*SearchSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchSvc_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// AdminSvcClient is the client API for AdminSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminSvcClient interface {
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error)
+}
+
+type adminSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminSvcClient(cc grpc.ClientConnInterface) AdminSvcClient {
+	return &adminSvcClient{cc}
+}
+
+func (c *adminSvcClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error) {
+	out := new(ConsistencyReport)
+	err := c.cc.Invoke(ctx, "/service.AdminSvc/CheckConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminSvcServer is the server API for AdminSvc service.
+// All implementations must embed UnimplementedAdminSvcServer
+// for forward compatibility
+type AdminSvcServer interface {
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*ConsistencyReport, error)
+	mustEmbedUnimplementedAdminSvcServer()
+}
+
+// UnimplementedAdminSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminSvcServer struct {
+}
+
+func (UnimplementedAdminSvcServer) CheckConsistency(context.Context, *CheckConsistencyRequest) (*ConsistencyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
+func (UnimplementedAdminSvcServer) mustEmbedUnimplementedAdminSvcServer() {}
+
+// UnsafeAdminSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminSvcServer will
+// result in compilation errors.
+type UnsafeAdminSvcServer interface {
+	mustEmbedUnimplementedAdminSvcServer()
+}
+
+func RegisterAdminSvcServer(s grpc.ServiceRegistrar, srv AdminSvcServer) {
+	s.RegisterService(&AdminSvc_ServiceDesc, srv)
+}
+
+func _AdminSvc_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminSvcServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.AdminSvc/CheckConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminSvcServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminSvc_ServiceDesc is the grpc.ServiceDesc for AdminSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.AdminSvc",
+	HandlerType: (*AdminSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _AdminSvc_CheckConsistency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// ImportSvcClient is the client API for ImportSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImportSvcClient interface {
+	BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error)
+}
+
+type importSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImportSvcClient(cc grpc.ClientConnInterface) ImportSvcClient {
+	return &importSvcClient{cc}
+}
+
+func (c *importSvcClient) BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error) {
+	out := new(BulkImportResponse)
+	err := c.cc.Invoke(ctx, "/service.ImportSvc/BulkImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportSvcServer is the server API for ImportSvc service.
+// All implementations must embed UnimplementedImportSvcServer
+// for forward compatibility
+type ImportSvcServer interface {
+	BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error)
+	mustEmbedUnimplementedImportSvcServer()
+}
+
+// UnimplementedImportSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedImportSvcServer struct {
+}
+
+func (UnimplementedImportSvcServer) BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImport not implemented")
+}
+func (UnimplementedImportSvcServer) mustEmbedUnimplementedImportSvcServer() {}
+
+// UnsafeImportSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImportSvcServer will
+// result in compilation errors.
+type UnsafeImportSvcServer interface {
+	mustEmbedUnimplementedImportSvcServer()
+}
+
+func RegisterImportSvcServer(s grpc.ServiceRegistrar, srv ImportSvcServer) {
+	s.RegisterService(&ImportSvc_ServiceDesc, srv)
+}
+
+func _ImportSvc_BulkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportSvcServer).BulkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.ImportSvc/BulkImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportSvcServer).BulkImport(ctx, req.(*BulkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImportSvc_ServiceDesc is the grpc.ServiceDesc for ImportSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImportSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.ImportSvc",
+	HandlerType: (*ImportSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BulkImport",
+			Handler:    _ImportSvc_BulkImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// WebhookSvcClient is the client API for WebhookSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookSvcClient interface {
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+}
+
+type webhookSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookSvcClient(cc grpc.ClientConnInterface) WebhookSvcClient {
+	return &webhookSvcClient{cc}
+}
+
+func (c *webhookSvcClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/service.WebhookSvc/RegisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookSvcClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/service.WebhookSvc/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookSvcClient) DeleteWebhook(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/service.WebhookSvc/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookSvcClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/service.WebhookSvc/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookSvcServer is the server API for WebhookSvc service.
+// All implementations must embed UnimplementedWebhookSvcServer
+// for forward compatibility
+type WebhookSvcServer interface {
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *GetByIDRequest) (*EmptyResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	mustEmbedUnimplementedWebhookSvcServer()
+}
+
+// UnimplementedWebhookSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookSvcServer struct {
+}
+
+func (UnimplementedWebhookSvcServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedWebhookSvcServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedWebhookSvcServer) DeleteWebhook(context.Context, *GetByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookSvcServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedWebhookSvcServer) mustEmbedUnimplementedWebhookSvcServer() {}
+
+// UnsafeWebhookSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookSvcServer will
+// result in compilation errors.
+type UnsafeWebhookSvcServer interface {
+	mustEmbedUnimplementedWebhookSvcServer()
+}
+
+func RegisterWebhookSvcServer(s grpc.ServiceRegistrar, srv WebhookSvcServer) {
+	s.RegisterService(&WebhookSvc_ServiceDesc, srv)
+}
+
+func _WebhookSvc_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookSvcServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.WebhookSvc/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookSvcServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookSvc_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookSvcServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.WebhookSvc/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookSvcServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookSvc_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookSvcServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.WebhookSvc/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookSvcServer).DeleteWebhook(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookSvc_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookSvcServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.WebhookSvc/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookSvcServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookSvc_ServiceDesc is the grpc.ServiceDesc for WebhookSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.WebhookSvc",
+	HandlerType: (*WebhookSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _WebhookSvc_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookSvc_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookSvc_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _WebhookSvc_ListDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// WatchSvcClient is the client API for WatchSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchSvcClient interface {
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (WatchSvc_WatchChangesClient, error)
+}
+
+type watchSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchSvcClient(cc grpc.ClientConnInterface) WatchSvcClient {
+	return &watchSvcClient{cc}
+}
+
+func (c *watchSvcClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (WatchSvc_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WatchSvc_ServiceDesc.Streams[0], "/service.WatchSvc/WatchChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchSvcWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchSvc_WatchChangesClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type watchSvcWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchSvcWatchChangesClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchSvcServer is the server API for WatchSvc service.
+// All implementations must embed UnimplementedWatchSvcServer
+// for forward compatibility
+type WatchSvcServer interface {
+	WatchChanges(*WatchChangesRequest, WatchSvc_WatchChangesServer) error
+	mustEmbedUnimplementedWatchSvcServer()
+}
+
+// UnimplementedWatchSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedWatchSvcServer struct {
+}
+
+func (UnimplementedWatchSvcServer) WatchChanges(*WatchChangesRequest, WatchSvc_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedWatchSvcServer) mustEmbedUnimplementedWatchSvcServer() {}
+
+// UnsafeWatchSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchSvcServer will
+// result in compilation errors.
+type UnsafeWatchSvcServer interface {
+	mustEmbedUnimplementedWatchSvcServer()
+}
+
+func RegisterWatchSvcServer(s grpc.ServiceRegistrar, srv WatchSvcServer) {
+	s.RegisterService(&WatchSvc_ServiceDesc, srv)
+}
+
+func _WatchSvc_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchSvcServer).WatchChanges(m, &watchSvcWatchChangesServer{stream})
+}
+
+type WatchSvc_WatchChangesServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type watchSvcWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchSvcWatchChangesServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// WatchSvc_ServiceDesc is the grpc.ServiceDesc for WatchSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.WatchSvc",
+	HandlerType: (*WatchSvcServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _WatchSvc_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+// TrashSvcClient is the client API for TrashSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrashSvcClient interface {
+	ListTrashedShows(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreShow(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Show, error)
+	ListTrashedSeasons(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreSeason(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Season, error)
+	ListTrashedEpisodes(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreEpisode(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Episode, error)
+	ListTrashedCelebrities(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreCelebrity(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Celebrity, error)
+	ListTrashedArticles(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreArticle(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Article, error)
+	ListTrashedGenres(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreGenre(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Genre, error)
+	ListTrashedJournalists(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Journalist, error)
+}
+
+type trashSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrashSvcClient(cc grpc.ClientConnInterface) TrashSvcClient {
+	return &trashSvcClient{cc}
+}
+
+func (c *trashSvcClient) ListTrashedShows(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedShows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreShow(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Show, error) {
+	out := new(Show)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedSeasons(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreSeason(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Season, error) {
+	out := new(Season)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedEpisodes(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedEpisodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreEpisode(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Episode, error) {
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreEpisode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedCelebrities(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedCelebrities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreCelebrity(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Celebrity, error) {
+	out := new(Celebrity)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreCelebrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedArticles(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreArticle(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedGenres(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedGenres", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreGenre(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Genre, error) {
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreGenre", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) ListTrashedJournalists(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/ListTrashedJournalists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashSvcClient) RestoreJournalist(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*Journalist, error) {
+	out := new(Journalist)
+	err := c.cc.Invoke(ctx, "/service.TrashSvc/RestoreJournalist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrashSvcServer is the server API for TrashSvc service.
+// All implementations must embed UnimplementedTrashSvcServer
+// for forward compatibility
+type TrashSvcServer interface {
+	ListTrashedShows(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreShow(context.Context, *GetByIDRequest) (*Show, error)
+	ListTrashedSeasons(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreSeason(context.Context, *GetByIDRequest) (*Season, error)
+	ListTrashedEpisodes(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreEpisode(context.Context, *GetByIDRequest) (*Episode, error)
+	ListTrashedCelebrities(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreCelebrity(context.Context, *GetByIDRequest) (*Celebrity, error)
+	ListTrashedArticles(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreArticle(context.Context, *GetByIDRequest) (*Article, error)
+	ListTrashedGenres(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreGenre(context.Context, *GetByIDRequest) (*Genre, error)
+	ListTrashedJournalists(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreJournalist(context.Context, *GetByIDRequest) (*Journalist, error)
+	mustEmbedUnimplementedTrashSvcServer()
+}
+
+// UnimplementedTrashSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedTrashSvcServer struct {
+}
+
+func (UnimplementedTrashSvcServer) ListTrashedShows(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedShows not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreShow(context.Context, *GetByIDRequest) (*Show, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreShow not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedSeasons(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedSeasons not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreSeason(context.Context, *GetByIDRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSeason not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedEpisodes(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedEpisodes not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreEpisode(context.Context, *GetByIDRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEpisode not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedCelebrities(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedCelebrities not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreCelebrity(context.Context, *GetByIDRequest) (*Celebrity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCelebrity not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedArticles(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedArticles not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreArticle(context.Context, *GetByIDRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedGenres(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedGenres not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreGenre(context.Context, *GetByIDRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGenre not implemented")
+}
+func (UnimplementedTrashSvcServer) ListTrashedJournalists(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedJournalists not implemented")
+}
+func (UnimplementedTrashSvcServer) RestoreJournalist(context.Context, *GetByIDRequest) (*Journalist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJournalist not implemented")
+}
+func (UnimplementedTrashSvcServer) mustEmbedUnimplementedTrashSvcServer() {}
+
+// UnsafeTrashSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrashSvcServer will
+// result in compilation errors.
+type UnsafeTrashSvcServer interface {
+	mustEmbedUnimplementedTrashSvcServer()
+}
+
+func RegisterTrashSvcServer(s grpc.ServiceRegistrar, srv TrashSvcServer) {
+	s.RegisterService(&TrashSvc_ServiceDesc, srv)
+}
+
+func _TrashSvc_ListTrashedShows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedShows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedShows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedShows(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreShow(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedSeasons(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreSeason(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedEpisodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedEpisodes(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreEpisode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreEpisode(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedCelebrities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedCelebrities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedCelebrities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedCelebrities(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreCelebrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreCelebrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreCelebrity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreCelebrity(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedArticles(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreArticle(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedGenres",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedGenres(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreGenre",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreGenre(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_ListTrashedJournalists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).ListTrashedJournalists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/ListTrashedJournalists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).ListTrashedJournalists(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashSvc_RestoreJournalist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashSvcServer).RestoreJournalist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TrashSvc/RestoreJournalist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashSvcServer).RestoreJournalist(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrashSvc_ServiceDesc is the grpc.ServiceDesc for TrashSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrashSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.TrashSvc",
+	HandlerType: (*TrashSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrashedShows",
+			Handler:    _TrashSvc_ListTrashedShows_Handler,
+		},
+		{
+			MethodName: "RestoreShow",
+			Handler:    _TrashSvc_RestoreShow_Handler,
+		},
+		{
+			MethodName: "ListTrashedSeasons",
+			Handler:    _TrashSvc_ListTrashedSeasons_Handler,
+		},
+		{
+			MethodName: "RestoreSeason",
+			Handler:    _TrashSvc_RestoreSeason_Handler,
+		},
+		{
+			MethodName: "ListTrashedEpisodes",
+			Handler:    _TrashSvc_ListTrashedEpisodes_Handler,
+		},
+		{
+			MethodName: "RestoreEpisode",
+			Handler:    _TrashSvc_RestoreEpisode_Handler,
+		},
+		{
+			MethodName: "ListTrashedCelebrities",
+			Handler:    _TrashSvc_ListTrashedCelebrities_Handler,
+		},
+		{
+			MethodName: "RestoreCelebrity",
+			Handler:    _TrashSvc_RestoreCelebrity_Handler,
+		},
+		{
+			MethodName: "ListTrashedArticles",
+			Handler:    _TrashSvc_ListTrashedArticles_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _TrashSvc_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "ListTrashedGenres",
+			Handler:    _TrashSvc_ListTrashedGenres_Handler,
+		},
+		{
+			MethodName: "RestoreGenre",
+			Handler:    _TrashSvc_RestoreGenre_Handler,
+		},
+		{
+			MethodName: "ListTrashedJournalists",
+			Handler:    _TrashSvc_ListTrashedJournalists_Handler,
+		},
+		{
+			MethodName: "RestoreJournalist",
+			Handler:    _TrashSvc_RestoreJournalist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+// RevisionSvcClient is the client API for RevisionSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RevisionSvcClient interface {
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevisionDiff(ctx context.Context, in *GetRevisionDiffRequest, opts ...grpc.CallOption) (*RevisionDiff, error)
+	// RevertToRevision updates the document with the fields of the revision,
+	// through the update of its entity type, and returns the revision the
+	// update recorded. Posters and the short copies of child documents are
+	// kept as they are.
+	RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+}
+
+type revisionSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevisionSvcClient(cc grpc.ClientConnInterface) RevisionSvcClient {
+	return &revisionSvcClient{cc}
+}
+
+func (c *revisionSvcClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/service.RevisionSvc/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revisionSvcClient) GetRevisionDiff(ctx context.Context, in *GetRevisionDiffRequest, opts ...grpc.CallOption) (*RevisionDiff, error) {
+	out := new(RevisionDiff)
+	err := c.cc.Invoke(ctx, "/service.RevisionSvc/GetRevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revisionSvcClient) RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*Revision, error) {
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, "/service.RevisionSvc/RevertToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevisionSvcServer is the server API for RevisionSvc service.
+// All implementations must embed UnimplementedRevisionSvcServer
+// for forward compatibility
+type RevisionSvcServer interface {
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevisionDiff(context.Context, *GetRevisionDiffRequest) (*RevisionDiff, error)
+	// RevertToRevision updates the document with the fields of the revision,
+	// through the update of its entity type, and returns the revision the
+	// update recorded. Posters and the short copies of child documents are
+	// kept as they are.
+	RevertToRevision(context.Context, *RevertToRevisionRequest) (*Revision, error)
+	mustEmbedUnimplementedRevisionSvcServer()
+}
+
+// UnimplementedRevisionSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedRevisionSvcServer struct {
+}
+
+func (UnimplementedRevisionSvcServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedRevisionSvcServer) GetRevisionDiff(context.Context, *GetRevisionDiffRequest) (*RevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisionDiff not implemented")
+}
+func (UnimplementedRevisionSvcServer) RevertToRevision(context.Context, *RevertToRevisionRequest) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToRevision not implemented")
+}
+func (UnimplementedRevisionSvcServer) mustEmbedUnimplementedRevisionSvcServer() {}
+
+// UnsafeRevisionSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RevisionSvcServer will
+// result in compilation errors.
+type UnsafeRevisionSvcServer interface {
+	mustEmbedUnimplementedRevisionSvcServer()
+}
+
+func RegisterRevisionSvcServer(s grpc.ServiceRegistrar, srv RevisionSvcServer) {
+	s.RegisterService(&RevisionSvc_ServiceDesc, srv)
+}
+
+func _RevisionSvc_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionSvcServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RevisionSvc/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionSvcServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevisionSvc_GetRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionSvcServer).GetRevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RevisionSvc/GetRevisionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionSvcServer).GetRevisionDiff(ctx, req.(*GetRevisionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevisionSvc_RevertToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionSvcServer).RevertToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RevisionSvc/RevertToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionSvcServer).RevertToRevision(ctx, req.(*RevertToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RevisionSvc_ServiceDesc is the grpc.ServiceDesc for RevisionSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RevisionSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "service.RevisionSvc",
+	HandlerType: (*RevisionSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRevisions",
+			Handler:    _RevisionSvc_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevisionDiff",
+			Handler:    _RevisionSvc_GetRevisionDiff_Handler,
+		},
+		{
+			MethodName: "RevertToRevision",
+			Handler:    _RevisionSvc_RevertToRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
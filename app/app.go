@@ -2,46 +2,162 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	pb "int-service/_proto"
+	"int-service/auth"
 	transport_grpc "int-service/grpc"
+	"int-service/metrics"
 	"int-service/repository"
 	"int-service/service"
-	"net"
+	"int-service/tenant"
+	"int-service/webhook"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+const (
+	MongoStorage  = "mongo"
+	MemoryStorage = "memory"
+	JSONStorage   = "json"
+	XMLStorage    = "xml"
+	SQLiteStorage = "sqlite"
+)
+
 type App struct {
-	logger *logrus.Logger
+	logger      *logrus.Logger
+	config      *Config
+	mongoClient *mongo.Client
+	sqlDB       *sql.DB
+	health      *health.Server
+	metrics     *metrics.Metrics
+	gateway     *http.Server
+	gatewayConn *grpc.ClientConn
+	// localDir holds the Unix socket the gateway calls the gRPC server on.
+	localDir string
+	// prepare runs once the repository is first reachable, to create what the
+	// backend needs before serving, such as the Mongo text indexes.
+	prepare func(ctx context.Context) error
+	ready   bool
+	checked bool
+	// failed receives the error of a server running in the background,
+	// which stops the service.
+	failed chan error
 }
 
-func Initialize(grpcPort string, logger *logrus.Logger) {
+// Initialize serves the service until it is interrupted or one of its
+// servers fails. The repository is closed before it returns.
+func Initialize(cfg *Config, logger *logrus.Logger) error {
 	a := App{}
 	a.logger = logger
+	a.config = cfg
+	a.failed = make(chan error, 1)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	client, err := a.connectMongo()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	a.metrics = metrics.New(registry)
+
+	repo, err := a.createRepository(ctx)
 	if err != nil {
-		panic("Error while connecting to Mongo database")
+		return errors.Wrap(err, "Error while creating the repository")
+	}
+	defer a.closeRepository()
+	if a.config.SeedArchive != "" {
+		if err := a.seed(ctx, repo); err != nil {
+			return errors.Wrap(err, "Error while restoring the seed archive")
+		}
+	}
+	a.metrics.RegisterCatalog(repo, a.catalogTenants())
+
+	metricsServer := a.createMetricsServer(registry)
+	if metricsServer != nil {
+		defer a.shutdownHTTPServer(metricsServer, "metrics")
+	}
+	repo = a.metrics.Repository(webhook.Outbox(repo))
+	for _, tenantID := range a.catalogTenants() {
+		dispatcher := webhook.NewDispatcher(repo, a.logger, a.config.WebhookInterval, a.config.WebhookAttempts)
+		go dispatcher.Run(tenant.ContextWithID(ctx, tenantID))
+		go a.purge(tenant.ContextWithID(ctx, tenantID), repo)
+	}
+	return a.createGprcServer(ctx, repo)
+}
+
+func (a *App) createRepository(ctx context.Context) (repository.ProjectRepository, error) {
+	switch a.config.Storage {
+	case MongoStorage:
+		client, err := a.connectMongo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.mongoClient = client
+		a.prepare = a.prepareMongo
+		return repository.NewMongoDB(client, a.config.MongoNames()), nil
+	case MemoryStorage:
+		a.logger.Warn("Using the in-memory repository, data will be lost on shutdown")
+		return repository.NewMemoryDB(), nil
+	case JSONStorage:
+		return repository.NewProjectFileDB(repository.NewJSON(), a.config.DataDir), nil
+	case XMLStorage:
+		return repository.NewProjectFileDB(repository.NewXML(), a.config.DataDir), nil
+	case SQLiteStorage:
+		db, err := a.openSQLite(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.sqlDB = db
+		return repository.NewSQLDB(db), nil
 	}
-	a.createGprcServer(grpcPort, client)
+	return nil, errors.New("Unknown storage backend: " + a.config.Storage)
 }
 
-func (a *App) createGprcServer(serverPort string, client *mongo.Client) {
-	service := service.NewSvc(a.logger, repository.NewMongoDB(client))
+// createGprcServer serves the gRPC server, and the gateway in front of it,
+// until ctx is done or one of them fails.
+func (a *App) createGprcServer(ctx context.Context, repo repository.ProjectRepository) error {
+	service := service.NewSvc(a.logger, repo)
 	grpcServer := transport_grpc.NewSvc(service, a.logger)
 
-	listen, err := net.Listen("tcp", ":"+serverPort)
+	authenticator, policy, err := a.createAuthenticator()
+	if err != nil {
+		return errors.Wrap(err, "Error while setting up authentication")
+	}
+
+	reloader, clientNames, err := a.serverTLS(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Error while loading the TLS certificates")
+	}
+	listen, err := a.listen(reloader, clientNames)
+	if err != nil {
+		return errors.Wrap(err, "Error while starting grpc server")
+	}
+	local, err := a.listenLocal()
 	if err != nil {
-		a.logger.WithError(err).Fatal("Error while starting grpc server")
+		listen.Close()
+		return errors.Wrap(err, "Error while starting grpc server")
 	}
+	defer os.RemoveAll(a.localDir)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.Creds(transport_grpc.ServerCredentials()),
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{a.metrics.UnaryServerInterceptor}, transport_grpc.UnaryInterceptors(a.logger, authenticator, policy, a.config.TenantIDs())...)...),
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{a.metrics.StreamServerInterceptor}, transport_grpc.StreamInterceptors(a.logger, authenticator, policy, a.config.TenantIDs())...)...),
+	)
 
 	pb.RegisterArticleSvcServer(s, grpcServer)
 	pb.RegisterCelebritySvcServer(s, grpcServer)
@@ -50,27 +166,228 @@ func (a *App) createGprcServer(serverPort string, client *mongo.Client) {
 	pb.RegisterGenreSvcServer(s, grpcServer)
 	pb.RegisterSeasonSvcServer(s, grpcServer)
 	pb.RegisterJournalistSvcServer(s, grpcServer)
+	pb.RegisterSearchSvcServer(s, grpcServer)
+	pb.RegisterAdminSvcServer(s, grpcServer)
+	pb.RegisterImportSvcServer(s, grpcServer)
+	pb.RegisterWatchSvcServer(s, grpcServer)
+	pb.RegisterWebhookSvcServer(s, grpcServer)
+	pb.RegisterTrashSvcServer(s, grpcServer)
+	pb.RegisterRevisionSvcServer(s, grpcServer)
 	reflection.Register(s)
-	a.logger.Info("GRPC server listening on port: " + serverPort)
-	err = s.Serve(listen)
+
+	a.health = health.NewServer()
+	healthpb.RegisterHealthServer(s, a.health)
+	services := []string{}
+	for name := range s.GetServiceInfo() {
+		services = append(services, name)
+	}
+
+	go a.watchHealth(ctx, repo, services)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(listen)
+	}()
+	go s.Serve(local)
+	a.logger.Info("GRPC server listening on port: " + a.config.Port)
+
+	if err := a.createGatewayServer(local.Addr(), reloader, clientNames); err != nil {
+		s.Stop()
+		return errors.Wrap(err, "Error while starting the gateway")
+	}
+
+	var serveErr error
+	select {
+	case err := <-served:
+		serveErr = errors.Wrap(err, "Error while serving grpc server")
+	case serveErr = <-a.failed:
+	case <-ctx.Done():
+	}
+
+	a.logger.Info("Shutting down the GRPC server")
+	a.health.Shutdown()
+	grpcServer.StopStreams()
+	a.shutdownGateway()
+	a.gracefulStop(s)
+	return serveErr
+}
+
+// createAuthenticator returns the authenticator of the configured token
+// keys and the authorization policy, or a nil authenticator, which leaves
+// every method open, when authentication is explicitly disabled.
+func (a *App) createAuthenticator() (*auth.Authenticator, auth.Policy, error) {
+	var authenticator *auth.Authenticator
+	switch {
+	case a.config.AuthHMACSecret != "":
+		authenticator = auth.NewHMACAuthenticator([]byte(a.config.AuthHMACSecret), a.config.AuthIssuer, a.config.AuthAudience)
+	case a.config.AuthJWKSFile != "":
+		var err error
+		authenticator, err = auth.NewJWKSAuthenticator(a.config.AuthJWKSFile, a.config.AuthIssuer, a.config.AuthAudience)
+		if err != nil {
+			return nil, nil, err
+		}
+	case a.config.AuthDisabled:
+		a.logger.Warn("Authentication is disabled, every caller can call every method")
+		return nil, nil, nil
+	default:
+		return nil, nil, errors.New("No token key is configured: set auth-hmac-secret or auth-jwks-file, or auth-disabled to serve without authentication")
+	}
+
+	if a.config.AuthPolicyFile == "" {
+		return authenticator, auth.DefaultPolicy(), nil
+	}
+	policy, err := auth.LoadPolicy(a.config.AuthPolicyFile)
 	if err != nil {
-		a.logger.WithError(err).Fatal("Error while serving grpc server")
+		return nil, nil, err
 	}
+	return authenticator, policy, nil
 }
 
-func (a *App) connectMongo() (*mongo.Client, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+// gracefulStop lets the running calls finish, and cancels them when they
+// take longer than the shutdown timeout.
+func (a *App) gracefulStop(s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(a.config.ShutdownTimeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		a.logger.Warn("Shutdown timeout reached, cancelling the running calls")
+		s.Stop()
+	}
+}
+
+// createMetricsServer serves the metrics of registry on /metrics, unless no
+// metrics port is configured.
+func (a *App) createMetricsServer(registry *prometheus.Registry) *http.Server {
+	if a.config.MetricsPort == "" {
+		return nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: ":" + a.config.MetricsPort, Handler: mux}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			a.fail(errors.Wrap(err, "Error while serving metrics"))
+		}
+	}()
+	a.logger.Info("Metrics server listening on port: " + a.config.MetricsPort)
+	return server
+}
+
+// fail stops the service with the error of a server running in the
+// background, unless it is already stopping with another error.
+func (a *App) fail(err error) {
+	select {
+	case a.failed <- err:
+	default:
+	}
+}
+
+func (a *App) shutdownHTTPServer(server *http.Server, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.ShutdownTimeout)
 	defer cancel()
 
-	clientOptions := options.Client().ApplyURI("mongodb://ArgoXInterns:27017")
+	if err := server.Shutdown(ctx); err != nil {
+		a.logger.WithError(err).Error("Error while shutting down the " + name + " server")
+	}
+}
+
+// catalogTenants returns the tenants whose catalogs the server keeps up, or
+// the default catalog "" when it hosts no tenants.
+func (a *App) catalogTenants() []string {
+	if tenants := a.config.TenantIDs(); len(tenants) > 0 {
+		return tenants
+	}
+	return []string{""}
+}
+
+// prepareMongo applies the pending migrations of every catalog, unless they
+// are run with the migrate command, and creates the text indexes of the
+// search.
+func (a *App) prepareMongo(ctx context.Context) error {
+	names := a.config.MongoNames()
+	for _, tenantID := range a.catalogTenants() {
+		if a.config.MongoMigrate {
+			steps, err := repository.MigrateMongo(ctx, a.mongoClient, names, tenantID, -1)
+			if err != nil {
+				return err
+			}
+			for _, step := range steps {
+				a.logger.WithFields(logrus.Fields{"tenant": tenantID, "version": step.Version, "name": step.Name}).Info("Applied a Mongo migration")
+			}
+		}
+		if err := repository.CreateTextIndexes(ctx, a.mongoClient, names, tenantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectMongo sets up the client without waiting for the deployment, so the
+// service starts while Mongo is down and reports it through health checks.
+// Its TLS certificates are reloaded until ctx is done.
+func (a *App) connectMongo(ctx context.Context) (*mongo.Client, error) {
+	clientOptions := options.Client().ApplyURI(a.config.MongoURI)
+	if a.metrics != nil {
+		clientOptions.SetPoolMonitor(a.metrics.PoolMonitor())
+	}
+	if a.config.MongoTLS {
+		tlsConfig, err := a.mongoTLSConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions.SetTLSConfig(tlsConfig)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error while connecting to Mongo database")
 	}
+	return client, nil
+}
 
-	err = client.Ping(ctx, nil)
+// openSQLite opens the SQLite file and migrates it before anything reads it,
+// as restoring an archive does before the first health check.
+func (a *App) openSQLite(ctx context.Context) (*sql.DB, error) {
+	dsn := "file:" + a.config.SQLiteFile + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while verifying the connection between client and database")
+		return nil, errors.Wrap(err, "Error while opening the SQLite database")
+	}
+	if err := repository.MigrateSQL(ctx, db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// closeRepository releases the connections of the repository, if it has any.
+func (a *App) closeRepository() {
+	a.disconnectMongo()
+	if a.sqlDB != nil {
+		if err := a.sqlDB.Close(); err != nil {
+			a.logger.WithError(err).Error("Error while closing the SQLite database")
+		}
+	}
+}
+
+func (a *App) disconnectMongo() {
+	if a.mongoClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if err := a.mongoClient.Disconnect(ctx); err != nil {
+		a.logger.WithError(err).Error("Error while disconnecting from Mongo database")
 	}
-	return client, nil
 }
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TestInitializeSetupError checks a server that cannot start returns its
+// error instead of exiting, so the caller runs the cleanup first.
+func TestInitializeSetupError(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cfg, _, err := LoadConfig("int-service", []string{"-storage", MemoryStorage, "-port", "0", "-metrics-port", "", "-gateway-port", ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Initialize(cfg, logger); err == nil {
+		t.Fatal("Initialize without a token key returned no error")
+	}
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"int-service/archive"
+	"int-service/repository"
+	"int-service/tenant"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Export writes the catalog of the configured storage backend to the archive
+// file at path and prints the collections it holds to out.
+func Export(cfg *Config, path string, out io.Writer, logger *logrus.Logger) error {
+	a := App{}
+	a.logger = logger
+	a.config = cfg
+
+	ctx, cancel := context.WithCancel(tenant.ContextWithID(context.Background(), cfg.Tenant))
+	defer cancel()
+	repo, err := a.createRepository(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.closeRepository()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "Error while creating the archive file")
+	}
+	manifest, err := archive.Export(ctx, repo, file)
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "Error while writing the archive file")
+	}
+	printManifest(out, "exported", manifest)
+	return nil
+}
+
+// Restore loads the archive file at path into the configured storage
+// backend, which has to be empty, and prints the collections it restored.
+func Restore(cfg *Config, path string, out io.Writer, logger *logrus.Logger) error {
+	a := App{}
+	a.logger = logger
+	a.config = cfg
+
+	ctx, cancel := context.WithCancel(tenant.ContextWithID(context.Background(), cfg.Tenant))
+	defer cancel()
+	repo, err := a.createRepository(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.closeRepository()
+
+	manifest, err := restoreFile(ctx, repo, path)
+	if err != nil {
+		return err
+	}
+	printManifest(out, "restored", manifest)
+	return nil
+}
+
+// seed restores the seed-archive into repo when it is empty, typically to
+// start the in-memory backend with a copy of the production catalog.
+func (a *App) seed(ctx context.Context, repo repository.ProjectRepository) error {
+	manifest, err := restoreFile(ctx, repo, a.config.SeedArchive)
+	if errors.Is(err, archive.ErrNotEmpty) {
+		a.logger.WithError(err).Info("Skipping the seed archive")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, c := range manifest.Collections {
+		a.logger.WithField("count", c.Count).Info("Seeded the " + c.Name)
+	}
+	return nil
+}
+
+func restoreFile(ctx context.Context, repo repository.ProjectRepository, path string) (*archive.Manifest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while opening the archive file")
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while opening the archive file")
+	}
+	return archive.Restore(ctx, repo, file, info.Size())
+}
+
+func printManifest(out io.Writer, action string, manifest *archive.Manifest) {
+	for _, c := range manifest.Collections {
+		fmt.Fprintf(out, "%-12s %6d documents  sha256 %s\n", c.Name, c.Count, c.SHA256)
+	}
+	fmt.Fprintf(out, "Catalog %s, archive version %d created at %s\n", action, manifest.Version, manifest.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
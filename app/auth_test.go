@@ -0,0 +1,55 @@
+package app
+
+import (
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TestCreateAuthenticator checks the server only starts without a token key
+// when authentication is explicitly disabled.
+func TestCreateAuthenticator(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	tests := []struct {
+		name          string
+		args          []string
+		authenticated bool
+		fails         bool
+	}{
+		{name: "no key", fails: true},
+		{name: "disabled", args: []string{"-auth-disabled"}},
+		{name: "HMAC secret", args: []string{"-auth-hmac-secret", "secret"}, authenticated: true},
+		{name: "missing JWKS file", args: []string{"-auth-jwks-file", "missing.json"}, fails: true},
+	}
+	for _, tt := range tests {
+		cfg, _, err := LoadConfig("int-service", tt.args)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		a := &App{logger: logger, config: cfg}
+		authenticator, policy, err := a.createAuthenticator()
+		if tt.fails {
+			if err == nil {
+				t.Errorf("%s: createAuthenticator returned no error", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if (authenticator != nil) != tt.authenticated || (policy != nil) != tt.authenticated {
+			t.Errorf("%s: createAuthenticator returned %v, %v", tt.name, authenticator, policy)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"-auth-disabled", "-auth-hmac-secret", "secret"},
+		{"-auth-disabled", "-auth-jwks-file", "keys.json"},
+	} {
+		if _, _, err := LoadConfig("int-service", args); err == nil {
+			t.Errorf("LoadConfig(%q) returned no error", args)
+		}
+	}
+}
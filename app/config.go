@@ -0,0 +1,300 @@
+package app
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"int-service/repository"
+	"int-service/tenant"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// envPrefix prefixes the environment variable of every flag, written in upper
+// case with underscores: -mongo-uri is read from INT_SERVICE_MONGO_URI.
+const envPrefix = "INT_SERVICE_"
+
+type Config struct {
+	ConfigFile          string
+	Port                string
+	MetricsPort         string
+	GatewayPort         string
+	Storage             string
+	DataDir             string
+	SQLiteFile          string
+	MongoURI            string
+	MongoDatabase       string
+	MongoMigrate        bool
+	MongoClothingDB     string
+	MongoCollections    string
+	Tenants             string
+	Tenant              string
+	LogLevel            string
+	ShutdownTimeout     time.Duration
+	HealthInterval      time.Duration
+	AuthHMACSecret      string
+	AuthJWKSFile        string
+	AuthIssuer          string
+	AuthAudience        string
+	AuthPolicyFile      string
+	AuthDisabled        bool
+	TLSCertFile         string
+	TLSKeyFile          string
+	TLSClientCAFile     string
+	TLSClientNames      string
+	TLSReloadInterval   time.Duration
+	MongoTLS            bool
+	MongoTLSCAFile      string
+	MongoTLSCertFile    string
+	MongoTLSKeyFile     string
+	SeedArchive         string
+	WebhookInterval     time.Duration
+	WebhookAttempts     int
+	TrashRetention      time.Duration
+	TrashPurgeInterval  time.Duration
+	DeadLetterRetention time.Duration
+	RevisionHistory     int
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Port:                "2002",
+		MetricsPort:         "2112",
+		GatewayPort:         "8080",
+		Storage:             MongoStorage,
+		DataDir:             "data",
+		SQLiteFile:          "catalog.db",
+		MongoURI:            "mongodb://ArgoXInterns:27017",
+		MongoDatabase:       "Project",
+		MongoMigrate:        true,
+		MongoClothingDB:     "Clothing",
+		LogLevel:            logrus.InfoLevel.String(),
+		ShutdownTimeout:     30 * time.Second,
+		HealthInterval:      10 * time.Second,
+		TLSReloadInterval:   30 * time.Second,
+		WebhookInterval:     5 * time.Second,
+		WebhookAttempts:     8,
+		TrashRetention:      30 * 24 * time.Hour,
+		TrashPurgeInterval:  time.Hour,
+		DeadLetterRetention: 30 * 24 * time.Hour,
+		RevisionHistory:     100,
+	}
+}
+
+// LoadConfig builds the configuration from the command line arguments, the
+// environment and the config file, in that order of precedence, on top of
+// the defaults. The config file is a JSON object keyed by flag name. It
+// returns the arguments left after the flags.
+func LoadConfig(name string, args []string) (*Config, []string, error) {
+	cfg := DefaultConfig()
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.StringVar(&cfg.ConfigFile, "config", cfg.ConfigFile, "JSON file with the values of any of these flags, keyed by flag name")
+	flags.StringVar(&cfg.Port, "port", cfg.Port, "port of the gRPC server")
+	flags.StringVar(&cfg.MetricsPort, "metrics-port", cfg.MetricsPort, "port of the HTTP server exposing /metrics, empty to disable it")
+	flags.StringVar(&cfg.GatewayPort, "gateway-port", cfg.GatewayPort, "port of the REST gateway, empty to disable it")
+	flags.StringVar(&cfg.Storage, "storage", cfg.Storage, "repository backend to use: "+MongoStorage+", "+MemoryStorage+", "+JSONStorage+", "+XMLStorage+" or "+SQLiteStorage)
+	flags.StringVar(&cfg.DataDir, "data-dir", cfg.DataDir, "directory holding the catalog files of the "+JSONStorage+" and "+XMLStorage+" backends")
+	flags.StringVar(&cfg.SQLiteFile, "sqlite-file", cfg.SQLiteFile, "database file of the "+SQLiteStorage+" backend, created when missing")
+	flags.StringVar(&cfg.MongoURI, "mongo-uri", cfg.MongoURI, "URI of the Mongo deployment")
+	flags.StringVar(&cfg.MongoDatabase, "mongo-database", cfg.MongoDatabase, "name of the Mongo database holding the catalog")
+	flags.StringVar(&cfg.MongoClothingDB, "mongo-clothing-database", cfg.MongoClothingDB, "name of the Mongo database holding the clothes")
+	flags.StringVar(&cfg.MongoCollections, "mongo-collections", cfg.MongoCollections, "comma separated COLLECTION=NAME pairs storing Mongo collections under other names, such as Shows=brand_shows")
+	flags.StringVar(&cfg.Tenants, "tenants", cfg.Tenants, "comma separated tenants hosted by the "+MongoStorage+" backend, each in its own database, chosen per call with the x-tenant-id metadata")
+	flags.StringVar(&cfg.Tenant, "tenant", cfg.Tenant, "tenant whose catalog the consistency, export and restore commands work on")
+	flags.BoolVar(&cfg.MongoMigrate, "mongo-migrate", cfg.MongoMigrate, "apply the pending Mongo migrations at startup, disable it to run them with the migrate command instead")
+	flags.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "minimum level of the logged messages")
+	flags.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "time given to running calls to finish on shutdown")
+	flags.DurationVar(&cfg.HealthInterval, "health-interval", cfg.HealthInterval, "time between two checks of the repository")
+	flags.StringVar(&cfg.AuthHMACSecret, "auth-hmac-secret", cfg.AuthHMACSecret, "secret of the HMAC signed bearer tokens, better set through the environment")
+	flags.StringVar(&cfg.AuthJWKSFile, "auth-jwks-file", cfg.AuthJWKSFile, "JSON Web Key Set file with the public keys of the RSA or EC signed bearer tokens")
+	flags.StringVar(&cfg.AuthIssuer, "auth-issuer", cfg.AuthIssuer, "required issuer of the bearer tokens, if any")
+	flags.StringVar(&cfg.AuthAudience, "auth-audience", cfg.AuthAudience, "required audience of the bearer tokens, if any")
+	flags.StringVar(&cfg.AuthPolicyFile, "auth-policy-file", cfg.AuthPolicyFile, "JSON file mapping roles to the gRPC methods they may call, instead of the default policy")
+	flags.BoolVar(&cfg.AuthDisabled, "auth-disabled", cfg.AuthDisabled, "serve every method to any caller, required to start the server without auth-hmac-secret or auth-jwks-file")
+	flags.StringVar(&cfg.TLSCertFile, "tls-cert-file", cfg.TLSCertFile, "PEM certificate of the gRPC server, which serves TLS when it is set")
+	flags.StringVar(&cfg.TLSKeyFile, "tls-key-file", cfg.TLSKeyFile, "PEM private key of the gRPC server certificate, defaults to tls-cert-file")
+	flags.StringVar(&cfg.TLSClientCAFile, "tls-client-ca-file", cfg.TLSClientCAFile, "PEM CA certificates the client certificates must be signed by, which turns on mutual TLS")
+	flags.StringVar(&cfg.TLSClientNames, "tls-client-names", cfg.TLSClientNames, "comma separated common or DNS names of the allowed client certificates, empty to allow any of them")
+	flags.DurationVar(&cfg.TLSReloadInterval, "tls-reload-interval", cfg.TLSReloadInterval, "time between two checks of the certificate files for changes")
+	flags.BoolVar(&cfg.MongoTLS, "mongo-tls", cfg.MongoTLS, "connect to Mongo over TLS, also turned on by the mongo-tls- files")
+	flags.StringVar(&cfg.MongoTLSCAFile, "mongo-tls-ca-file", cfg.MongoTLSCAFile, "PEM CA certificates the Mongo servers must be signed by, defaults to the system roots")
+	flags.StringVar(&cfg.MongoTLSCertFile, "mongo-tls-cert-file", cfg.MongoTLSCertFile, "PEM client certificate presented to Mongo")
+	flags.StringVar(&cfg.MongoTLSKeyFile, "mongo-tls-key-file", cfg.MongoTLSKeyFile, "PEM private key of the Mongo client certificate, defaults to mongo-tls-cert-file")
+	flags.StringVar(&cfg.SeedArchive, "seed-archive", cfg.SeedArchive, "catalog archive restored at startup when the repository is empty")
+	flags.DurationVar(&cfg.WebhookInterval, "webhook-interval", cfg.WebhookInterval, "time between two passes of the webhook dispatcher over the outbox")
+	flags.IntVar(&cfg.WebhookAttempts, "webhook-attempts", cfg.WebhookAttempts, "attempts of a webhook delivery before it is given up as a dead letter")
+	flags.DurationVar(&cfg.TrashRetention, "trash-retention", cfg.TrashRetention, "time deleted documents stay restorable before they are purged")
+	flags.DurationVar(&cfg.TrashPurgeInterval, "trash-purge-interval", cfg.TrashPurgeInterval, "time between two purges of the expired deleted documents, dead letters and revisions")
+	flags.DurationVar(&cfg.DeadLetterRetention, "dead-letter-retention", cfg.DeadLetterRetention, "time dead letters stay listed before they are purged")
+	flags.IntVar(&cfg.RevisionHistory, "revision-history", cfg.RevisionHistory, "revisions kept of each document, 0 to keep all of them")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage of %s:\n", name)
+		flags.PrintDefaults()
+		fmt.Fprintf(flags.Output(), "Every flag can also be set with an %s environment variable, such as %s.\n", envPrefix, envName("mongo-uri"))
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	fromCommandLine := map[string]string{}
+	flags.Visit(func(f *flag.Flag) {
+		fromCommandLine[f.Name] = f.Value.String()
+	})
+
+	if path := os.Getenv(envName("config")); path != "" && fromCommandLine["config"] == "" {
+		cfg.ConfigFile = path
+	}
+	if cfg.ConfigFile != "" {
+		if err := loadConfigFile(flags, cfg.ConfigFile); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		if value, ok := os.LookupEnv(envName(f.Name)); ok && err == nil {
+			err = errors.Wrap(flags.Set(f.Name, value), "Error while reading "+envName(f.Name))
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range fromCommandLine {
+		if err := flags.Set(name, value); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if cfg.AuthHMACSecret != "" && cfg.AuthJWKSFile != "" {
+		return nil, nil, errors.New("Only one of auth-hmac-secret and auth-jwks-file can be set")
+	}
+	if cfg.AuthDisabled && (cfg.AuthHMACSecret != "" || cfg.AuthJWKSFile != "") {
+		return nil, nil, errors.New("auth-disabled cannot be set with auth-hmac-secret or auth-jwks-file")
+	}
+	if cfg.TLSCertFile == "" && (cfg.TLSKeyFile != "" || cfg.TLSClientCAFile != "" || cfg.TLSClientNames != "") {
+		return nil, nil, errors.New("The tls- settings need tls-cert-file")
+	}
+	if cfg.MongoTLSCAFile != "" || cfg.MongoTLSCertFile != "" {
+		cfg.MongoTLS = true
+	}
+	if cfg.WebhookInterval <= 0 || cfg.WebhookAttempts <= 0 {
+		return nil, nil, errors.New("webhook-interval and webhook-attempts must be positive")
+	}
+	if cfg.TrashRetention <= 0 || cfg.TrashPurgeInterval <= 0 {
+		return nil, nil, errors.New("trash-retention and trash-purge-interval must be positive")
+	}
+	if cfg.DeadLetterRetention <= 0 || cfg.RevisionHistory < 0 {
+		return nil, nil, errors.New("dead-letter-retention must be positive and revision-history cannot be negative")
+	}
+	if _, err := logrus.ParseLevel(cfg.LogLevel); err != nil {
+		return nil, nil, errors.Wrap(err, "Error while reading the log level")
+	}
+	if _, err := parseMongoCollections(cfg.MongoCollections); err != nil {
+		return nil, nil, err
+	}
+	if err := cfg.validateTenants(); err != nil {
+		return nil, nil, err
+	}
+	return &cfg, flags.Args(), nil
+}
+
+// TenantIDs returns the tenants of the tenants setting.
+func (c *Config) TenantIDs() []string {
+	tenants := []string{}
+	for _, tenantID := range strings.Split(c.Tenants, ",") {
+		if tenantID = strings.TrimSpace(tenantID); tenantID != "" {
+			tenants = append(tenants, tenantID)
+		}
+	}
+	return tenants
+}
+
+func (c *Config) validateTenants() error {
+	tenants := c.TenantIDs()
+	if len(tenants) > 0 && c.Storage != MongoStorage {
+		return errors.New("Tenants are only supported by the " + MongoStorage + " storage")
+	}
+	hosted := false
+	for _, tenantID := range tenants {
+		if !tenant.ValidID(tenantID) {
+			return errors.New("Invalid tenant " + tenantID + ", tenants are 1 to 32 lower case letters, digits, dashes or underscores")
+		}
+		hosted = hosted || tenantID == c.Tenant
+	}
+	if c.Tenant != "" && !hosted {
+		return errors.New("The tenant " + c.Tenant + " is not one of the tenants")
+	}
+	return nil
+}
+
+// MongoNames returns the database and collection names of the Mongo backend.
+func (c *Config) MongoNames() repository.MongoNames {
+	names := repository.DefaultMongoNames()
+	names.Database = c.MongoDatabase
+	names.ClothingDatabase = c.MongoClothingDB
+	names.Collections, _ = parseMongoCollections(c.MongoCollections)
+	return names
+}
+
+func parseMongoCollections(value string) (map[string]string, error) {
+	collections := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		collection, name, ok := strings.Cut(pair, "=")
+		collection, name = strings.TrimSpace(collection), strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, errors.New("Invalid mongo-collections pair " + pair + ", expected COLLECTION=NAME")
+		}
+		known := false
+		for _, defaultName := range repository.MongoCollections {
+			known = known || defaultName == collection
+		}
+		if !known {
+			return nil, errors.New("Unknown Mongo collection " + collection + ", expected one of " + strings.Join(repository.MongoCollections, ", "))
+		}
+		collections[collection] = name
+	}
+	return collections, nil
+}
+
+func loadConfigFile(flags *flag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "Error while reading the config file")
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return errors.Wrap(err, "Error while decoding the config file")
+	}
+	for name, value := range values {
+		if name == "config" || flags.Lookup(name) == nil {
+			return errors.New("Unknown setting in the config file: " + name)
+		}
+		if err := flags.Set(name, fmt.Sprint(value)); err != nil {
+			return errors.Wrap(err, "Error while reading "+name+" from the config file")
+		}
+	}
+	return nil
+}
+
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// NewLogger returns the JSON logger of the service, at the configured level.
+func NewLogger(cfg *Config) *logrus.Logger {
+	logger := logrus.New()
+	logger.Out = os.Stdout
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	level, err := logrus.ParseLevel(cfg.LogLevel)
+	if err == nil {
+		logger.SetLevel(level)
+	}
+	return logger
+}
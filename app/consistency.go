@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"int-service/service"
+	"int-service/tenant"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckConsistency runs the consistency check of the service once against the
+// given storage backend and prints every issue it finds to out.
+func CheckConsistency(cfg *Config, repair bool, out io.Writer, logger *logrus.Logger) error {
+	a := App{}
+	a.logger = logger
+	a.config = cfg
+
+	ctx, cancel := context.WithCancel(tenant.ContextWithID(context.Background(), cfg.Tenant))
+	defer cancel()
+	repo, err := a.createRepository(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.closeRepository()
+	report, err := service.NewSvc(logger, repo).CheckConsistency(ctx, repair)
+	if err != nil {
+		return errors.Wrap(err, "Error while checking consistency")
+	}
+
+	for _, issue := range report.Issues {
+		if issue.Missing {
+			fmt.Fprintf(out, "%s/%s %s[%s]: source does not exist\n", issue.Collection, issue.DocumentID, issue.Field, issue.ReferenceID)
+			continue
+		}
+		fmt.Fprintf(out, "%s/%s %s[%s] %s: expected %s, found %s\n", issue.Collection, issue.DocumentID, issue.Field, issue.ReferenceID, issue.Property, issue.Expected, issue.Found)
+	}
+	fmt.Fprintf(out, "%d documents checked, %d issues found, %d documents repaired\n", report.Checked, len(report.Issues), report.Repaired)
+	return nil
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	pb "int-service/_proto"
+	transport_grpc "int-service/grpc"
+	"int-service/tenant"
+	"int-service/tlsconfig"
+	"net"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type gatewayRegistration func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+var gatewayRegistrations = []gatewayRegistration{
+	pb.RegisterArticleSvcHandler,
+	pb.RegisterCelebritySvcHandler,
+	pb.RegisterEpisodeSvcHandler,
+	pb.RegisterShowSvcHandler,
+	pb.RegisterGenreSvcHandler,
+	pb.RegisterSeasonSvcHandler,
+	pb.RegisterJournalistSvcHandler,
+	pb.RegisterSearchSvcHandler,
+	pb.RegisterImportSvcHandler,
+	pb.RegisterWatchSvcHandler,
+	pb.RegisterWebhookSvcHandler,
+	pb.RegisterTrashSvcHandler,
+	pb.RegisterRevisionSvcHandler,
+}
+
+// createGatewayServer serves the REST gateway and its OpenAPI document,
+// unless no gateway port is configured. The gateway calls the gRPC server
+// through the local socket, so REST calls go through the same interceptors
+// as gRPC ones. With TLS it serves the certificates of the gRPC port and
+// checks the client certificates the same way, and passes the name of the
+// client certificate on with each call.
+func (a *App) createGatewayServer(local net.Addr, reloader *tlsconfig.Reloader, clientNames []string) error {
+	if a.config.GatewayPort == "" {
+		return nil
+	}
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, local.Network(), local.String())
+	}
+	conn, err := grpc.Dial("local", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return errors.Wrap(err, "Error while connecting the gateway to the grpc server")
+	}
+
+	gateway := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeader),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeader),
+		runtime.WithMetadata(gatewayClientName),
+	)
+	for _, register := range gatewayRegistrations {
+		if err := register(context.Background(), gateway, conn); err != nil {
+			conn.Close()
+			return errors.Wrap(err, "Error while registering the gateway handlers")
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(pb.OpenAPI)
+	})
+	mux.Handle("/", gateway)
+	a.gateway = &http.Server{Addr: ":" + a.config.GatewayPort, Handler: mux}
+	a.gatewayConn = conn
+
+	serve := a.gateway.ListenAndServe
+	if reloader != nil {
+		a.gateway.TLSConfig = tlsconfig.Server(reloader, clientNames, "h2", "http/1.1")
+		serve = func() error {
+			return a.gateway.ListenAndServeTLS("", "")
+		}
+	}
+	go func() {
+		if err := serve(); err != nil && err != http.ErrServerClosed {
+			a.fail(errors.Wrap(err, "Error while serving the gateway"))
+		}
+	}()
+	a.logger.Info("Gateway listening on port: " + a.config.GatewayPort)
+	return nil
+}
+
+// shutdownGateway lets the running REST calls finish before the gRPC server
+// stops, then closes the connection of the gateway.
+func (a *App) shutdownGateway() {
+	if a.gateway == nil {
+		return
+	}
+	a.shutdownHTTPServer(a.gateway, "gateway")
+	if err := a.gatewayConn.Close(); err != nil {
+		a.logger.WithError(err).Error("Error while closing the gateway connection")
+	}
+}
+
+// gatewayIncomingHeader forwards the request ID and tenant headers to the
+// gRPC server, on top of the headers the gateway forwards by default. The
+// client name only comes from gatewayClientName.
+func gatewayIncomingHeader(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case textproto.CanonicalMIMEHeaderKey(transport_grpc.RequestIDHeader):
+		return transport_grpc.RequestIDHeader, true
+	case textproto.CanonicalMIMEHeaderKey(tenant.Header):
+		return tenant.Header, true
+	}
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && textproto.CanonicalMIMEHeaderKey(name) == textproto.CanonicalMIMEHeaderKey(transport_grpc.ClientNameHeader) {
+		return "", false
+	}
+	return name, ok
+}
+
+// gatewayClientName passes the name of the verified client certificate of a
+// request on to the gRPC server.
+func gatewayClientName(ctx context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+	return metadata.Pairs(transport_grpc.ClientNameHeader, tlsconfig.ClientName(r.TLS.PeerCertificates[0]))
+}
+
+// gatewayOutgoingHeader returns the request ID header as is, and the other
+// gRPC headers with the default prefix of the gateway.
+func gatewayOutgoingHeader(key string) (string, bool) {
+	if key == transport_grpc.RequestIDHeader {
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,366 @@
+package app
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	pb "int-service/_proto"
+	transport_grpc "int-service/grpc"
+	"int-service/repository"
+	"int-service/service"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// testCA issues certificates signed by a self-signed CA, written as PEM
+// files to dir.
+type testCA struct {
+	dir    string
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	ca := &testCA{dir: t.TempDir()}
+	ca.cert, ca.key = ca.issue(t, "ca", nil)
+	writePEM(t, filepath.Join(ca.dir, "ca.pem"), "CERTIFICATE", ca.cert.Raw)
+	return ca
+}
+
+// issue returns a certificate of name signed by the CA, or self-signed when
+// the CA is not created yet.
+func (ca *testCA) issue(t *testing.T, name string, parent *testCA) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// writePair writes the certificate and key of name to name.pem and name.key,
+// and returns their paths.
+func (ca *testCA) writePair(t *testing.T, name string) (string, string) {
+	t.Helper()
+	cert, key := ca.issue(t, name, ca)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(ca.dir, name+".pem"), filepath.Join(ca.dir, name+".key")
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	writePEM(t, certFile, "CERTIFICATE", cert.Raw)
+	return certFile, keyFile
+}
+
+func (ca *testCA) clientConfig(t *testing.T, name string) *tls.Config {
+	t.Helper()
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	config := &tls.Config{RootCAs: pool, ServerName: "localhost"}
+	if name != "" {
+		pair, err := tls.LoadX509KeyPair(ca.writePair(t, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	return config
+}
+
+func writePEM(t *testing.T, file string, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(file, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// clientNames records the client names the gRPC server saw.
+type clientNames struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (c *clientNames) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	c.mu.Lock()
+	c.names = append(c.names, transport_grpc.ClientName(ctx))
+	c.mu.Unlock()
+	return handler(ctx, req)
+}
+
+func (c *clientNames) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.names)
+}
+
+func (c *clientNames) last() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.names) == 0 {
+		return ""
+	}
+	return c.names[len(c.names)-1]
+}
+
+type testServer struct {
+	app      *App
+	ca       *testCA
+	certFile string
+	keyFile  string
+	grpcAddr string
+	gateway  string
+	seen     *clientNames
+}
+
+// startTestServer serves the genres on a gRPC port and a gateway with mutual
+// TLS, accepting the client certificates named frontend.
+func startTestServer(t *testing.T) *testServer {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ts := &testServer{ca: newTestCA(t), seen: &clientNames{}}
+	ts.certFile, ts.keyFile = ts.ca.writePair(t, "server")
+	ts.app = &App{logger: logger, config: &Config{
+		Port:              "0",
+		GatewayPort:       freePort(t),
+		ShutdownTimeout:   time.Second,
+		TLSCertFile:       ts.certFile,
+		TLSKeyFile:        ts.keyFile,
+		TLSClientCAFile:   filepath.Join(ts.ca.dir, "ca.pem"),
+		TLSClientNames:    "frontend",
+		TLSReloadInterval: 10 * time.Millisecond,
+	}}
+
+	reloader, names, err := ts.app.serverTLS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listen, err := ts.app.listen(reloader, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := ts.app.listenLocal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(ts.app.localDir) })
+	ts.grpcAddr = listen.Addr().String()
+
+	s := grpc.NewServer(grpc.Creds(transport_grpc.ServerCredentials()), grpc.UnaryInterceptor(ts.seen.intercept))
+	pb.RegisterGenreSvcServer(s, transport_grpc.NewSvc(service.NewSvc(logger, repository.NewMemoryDB()), logger))
+	go s.Serve(listen)
+	go s.Serve(local)
+	t.Cleanup(s.Stop)
+
+	if err := ts.app.createGatewayServer(local.Addr(), reloader, names); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(ts.app.shutdownGateway)
+	ts.gateway = "localhost:" + ts.app.config.GatewayPort
+	waitListening(t, ts.gateway)
+	return ts
+}
+
+func freePort(t *testing.T) string {
+	t.Helper()
+	listen, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listen.Close()
+	_, port, _ := net.SplitHostPort(listen.Addr().String())
+	return port
+}
+
+func waitListening(t *testing.T, addr string) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			conn.Close()
+			return
+		}
+	}
+	t.Fatalf("%s is not listening", addr)
+}
+
+func (ts *testServer) get(config *tls.Config, url string) (*http.Response, error) {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}, Timeout: 5 * time.Second}
+	resp, err := client.Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestGatewayRejectsPlaintext(t *testing.T) {
+	ts := startTestServer(t)
+
+	resp, err := ts.get(nil, "http://"+ts.gateway+"/v1/genres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("plaintext request answered %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+	if ts.seen.count() != 0 {
+		t.Fatalf("plaintext request reached the gRPC server")
+	}
+}
+
+func TestGRPCRejectsPlaintext(t *testing.T) {
+	ts := startTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, ts.grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := pb.NewGenreSvcClient(conn).ListGenres(ctx, &pb.ListGenresRequest{}); err == nil {
+		t.Fatal("plaintext call succeeded")
+	}
+	if ts.seen.count() != 0 {
+		t.Fatalf("plaintext call reached the gRPC server")
+	}
+}
+
+func TestGatewayChecksClientNames(t *testing.T) {
+	ts := startTestServer(t)
+	url := "https://" + ts.gateway + "/v1/genres"
+
+	if _, err := ts.get(ts.ca.clientConfig(t, ""), url); err == nil {
+		t.Error("request without a client certificate succeeded")
+	}
+	if _, err := ts.get(ts.ca.clientConfig(t, "other"), url); err == nil {
+		t.Error("request with a client certificate not in tls-client-names succeeded")
+	}
+	resp, err := ts.get(ts.ca.clientConfig(t, "frontend"), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("request with an allowed certificate answered %d", resp.StatusCode)
+	}
+	if name := ts.seen.last(); name != "frontend" {
+		t.Fatalf("gRPC server saw client %q, want frontend", name)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Grpc-Metadata-X-Client-Name", "admin")
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: ts.ca.clientConfig(t, "frontend")}, Timeout: 5 * time.Second}
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if name := ts.seen.last(); name != "frontend" {
+		t.Fatalf("gRPC server saw client %q from a header, want frontend", name)
+	}
+}
+
+func TestGRPCChecksClientNames(t *testing.T) {
+	ts := startTestServer(t)
+
+	call := func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		creds := credentials.NewTLS(ts.ca.clientConfig(t, name))
+		conn, err := grpc.DialContext(ctx, ts.grpcAddr, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		_, err = pb.NewGenreSvcClient(conn).ListGenres(ctx, &pb.ListGenresRequest{})
+		return err
+	}
+	if err := call("other"); err == nil {
+		t.Error("call with a client certificate not in tls-client-names succeeded")
+	}
+	if err := call("frontend"); err != nil {
+		t.Fatal(err)
+	}
+	if name := ts.seen.last(); name != "frontend" {
+		t.Fatalf("gRPC server saw client %q, want frontend", name)
+	}
+}
+
+func TestGatewayReloadsCertificates(t *testing.T) {
+	ts := startTestServer(t)
+	serverSerial := func() *big.Int {
+		conn, err := tls.Dial("tcp", ts.gateway, ts.ca.clientConfig(t, "frontend"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		return conn.ConnectionState().PeerCertificates[0].SerialNumber
+	}
+	before := serverSerial()
+
+	certFile, keyFile := ts.ca.writePair(t, "renewed")
+	for _, file := range [][2]string{{certFile, ts.certFile}, {keyFile, ts.keyFile}} {
+		if err := os.Rename(file[0], file[1]); err != nil {
+			t.Fatal(err)
+		}
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(file[1], later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+		if serverSerial().Cmp(before) != 0 {
+			return
+		}
+	}
+	t.Fatal("the gateway still serves the certificate it started with")
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"int-service/repository"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// LivenessService is SERVING for as long as the server runs, whatever the
+	// state of its dependencies, for liveness probes.
+	LivenessService = "liveness"
+	// RepositoryService is SERVING while the repository can be reached. The
+	// overall status and every gRPC service follow it, for readiness probes.
+	RepositoryService = "repository"
+)
+
+func (a *App) watchHealth(ctx context.Context, repo repository.HealthChecker, services []string) {
+	a.health.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	ticker := time.NewTicker(a.config.HealthInterval)
+	defer ticker.Stop()
+	for {
+		a.checkHealth(ctx, repo, services)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) checkHealth(ctx context.Context, repo repository.HealthChecker, services []string) {
+	ctx, cancel := context.WithTimeout(ctx, a.config.HealthInterval)
+	defer cancel()
+
+	err := a.checkRepository(ctx, repo)
+	ready := err == nil
+	if ready && (!a.ready || !a.checked) {
+		a.logger.Info("Repository is reachable")
+	}
+	if !ready && (a.ready || !a.checked) {
+		a.logger.WithError(err).Warn("Repository is not reachable")
+	}
+	a.ready = ready
+	a.checked = true
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if ready {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	a.health.SetServingStatus("", status)
+	a.health.SetServingStatus(RepositoryService, status)
+	for _, service := range services {
+		a.health.SetServingStatus(service, status)
+	}
+}
+
+func (a *App) checkRepository(ctx context.Context, repo repository.HealthChecker) error {
+	if err := repo.Ping(ctx); err != nil {
+		return err
+	}
+	if a.prepare != nil {
+		if err := a.prepare(ctx); err != nil {
+			return err
+		}
+		a.prepare = nil
+	}
+	return nil
+}
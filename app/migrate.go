@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"int-service/repository"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Migrate brings the Mongo database of every catalog to the migration
+// version target, the latest one when target is negative, and prints the
+// migrations it applied or reverted to out.
+func Migrate(cfg *Config, target int, out io.Writer, logger *logrus.Logger) error {
+	if cfg.Storage != MongoStorage {
+		return errors.New("Migrations only apply to the " + MongoStorage + " storage, the " + SQLiteStorage + " one is migrated when opened")
+	}
+	a := App{}
+	a.logger = logger
+	a.config = cfg
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, err := a.connectMongo(ctx)
+	if err != nil {
+		return err
+	}
+	a.mongoClient = client
+	defer a.closeRepository()
+
+	names := cfg.MongoNames()
+	for _, tenantID := range a.catalogTenants() {
+		database := names.TenantDatabase(tenantID)
+		steps, err := repository.MigrateMongo(ctx, client, names, tenantID, target)
+		for _, step := range steps {
+			action := "applied"
+			if step.Reverted {
+				action = "reverted"
+			}
+			fmt.Fprintf(out, "%s %4d %-20s %s\n", database, step.Version, step.Name, action)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s: %d migrations run, the latest is %d\n", database, len(steps), repository.LatestMongoMigration())
+	}
+	return nil
+}
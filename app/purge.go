@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"int-service/repository"
+	"int-service/tenant"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// purge deletes for good, every purge interval until ctx is done, the
+// documents deleted for longer than the trash retention, the dead letters
+// older than their retention and the revisions beyond the history kept of
+// each document.
+func (a *App) purge(ctx context.Context, repo repository.ProjectRepository) {
+	logger := logrus.NewEntry(a.logger)
+	if tenantID := tenant.FromContext(ctx); tenantID != "" {
+		logger = logger.WithField("tenant", tenantID)
+	}
+	report := func(purged int64, err error, failure string, success string) {
+		if err != nil && ctx.Err() == nil {
+			logger.WithError(err).Warn(failure)
+		}
+		if purged > 0 {
+			logger.WithField("purged", purged).Info(success)
+		}
+	}
+	ticker := time.NewTicker(a.config.TrashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		now := time.Now().UTC()
+		purged, err := repo.PurgeTrashItems(ctx, now.Add(-a.config.TrashRetention))
+		report(purged, err, "Error while purging the trash", "Purged the expired deleted documents")
+		purged, err = repo.PurgeDeadLetters(ctx, now.Add(-a.config.DeadLetterRetention))
+		report(purged, err, "Error while purging the dead letters", "Purged the expired dead letters")
+		if a.config.RevisionHistory > 0 {
+			purged, err = repo.PruneRevisions(ctx, a.config.RevisionHistory)
+			report(purged, err, "Error while pruning the revisions", "Pruned the revisions beyond the history")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"int-service/tlsconfig"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// serverTLS loads the certificates of the gRPC port and of the gateway, or
+// returns a nil reloader when no certificate is configured. They are
+// reloaded when their files change, until ctx is done.
+func (a *App) serverTLS(ctx context.Context) (*tlsconfig.Reloader, []string, error) {
+	if a.config.TLSCertFile == "" {
+		return nil, nil, nil
+	}
+	reloader, err := tlsconfig.NewReloader(a.config.TLSCertFile, a.config.TLSKeyFile, a.config.TLSClientCAFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	go reloader.Watch(ctx, a.config.TLSReloadInterval, a.logger)
+
+	clientNames := []string{}
+	for _, name := range strings.Split(a.config.TLSClientNames, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			clientNames = append(clientNames, name)
+		}
+	}
+	if a.config.TLSClientCAFile != "" {
+		a.logger.Info("Serving mutual TLS")
+	} else {
+		a.logger.Info("Serving TLS")
+	}
+	return reloader, clientNames, nil
+}
+
+// listen listens on the gRPC port, with TLS when reloader is set.
+func (a *App) listen(reloader *tlsconfig.Reloader, clientNames []string) (net.Listener, error) {
+	listen, err := net.Listen("tcp", ":"+a.config.Port)
+	if err != nil {
+		return nil, err
+	}
+	if reloader == nil {
+		return listen, nil
+	}
+	return tls.NewListener(listen, tlsconfig.Server(reloader, clientNames, "h2")), nil
+}
+
+// listenLocal listens on a Unix socket in a directory only this user can
+// enter, for the gateway to call the gRPC server in-process. The gateway
+// checked the client certificate of its own connection already, and passes
+// its name on with the call.
+func (a *App) listenLocal() (net.Listener, error) {
+	dir, err := os.MkdirTemp("", "int-service-")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while creating the directory of the local socket")
+	}
+	a.localDir = dir
+	listen, err := net.Listen("unix", filepath.Join(dir, "grpc.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap(err, "Error while listening on the local socket")
+	}
+	return listen, nil
+}
+
+func (a *App) mongoTLSConfig(ctx context.Context) (*tls.Config, error) {
+	reloader, err := tlsconfig.NewReloader(a.config.MongoTLSCertFile, a.config.MongoTLSKeyFile, a.config.MongoTLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+	go reloader.Watch(ctx, a.config.TLSReloadInterval, a.logger)
+	return tlsconfig.Client(reloader), nil
+}
@@ -0,0 +1,262 @@
+// Package archive exports the catalog of a repository to a zip archive and
+// restores it into a repository of any backend. The archive holds one NDJSON
+// file per collection, with a document per line, and a manifest with the
+// format version and the count and SHA-256 checksum of every file.
+package archive
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"int-service/dto"
+	"int-service/repository"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	Format  = "int-service-catalog"
+	Version = 1
+
+	manifestFile = "manifest.json"
+	// pageSize is the number of documents read, or written in one
+	// transaction, at a time.
+	pageSize = 500
+	// maxDocumentSize bounds a line of the NDJSON files, above the 16MB of a
+	// Mongo document.
+	maxDocumentSize = 32 << 20
+)
+
+// ErrNotEmpty is returned by Restore when the repository holds documents.
+var ErrNotEmpty = errors.New("The repository already holds documents")
+
+type Manifest struct {
+	Format      string       `json:"format"`
+	Version     int          `json:"version"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	Collections []Collection `json:"collections"`
+}
+
+type Collection struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Count  int64  `json:"count"`
+	SHA256 string `json:"sha256"`
+}
+
+// Export writes every document of repo to w, a page at a time, and returns
+// the manifest written last. Documents written to Mongo during the export
+// may be missed or exported twice.
+func Export(ctx context.Context, repo repository.ProjectRepository, w io.Writer) (*Manifest, error) {
+	archive := zip.NewWriter(w)
+	manifest := &Manifest{
+		Format:    Format,
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, c := range collections {
+		exported, err := exportCollection(ctx, repo, archive, c, manifest.CreatedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error while exporting the "+c.name)
+		}
+		manifest.Collections = append(manifest.Collections, *exported)
+	}
+
+	file, err := createFile(archive, manifestFile, manifest.CreatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while writing the manifest")
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return nil, errors.Wrap(err, "Error while writing the manifest")
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, "Error while writing the archive")
+	}
+	return manifest, nil
+}
+
+func exportCollection(ctx context.Context, repo repository.ProjectRepository, archive *zip.Writer, c collection, modified time.Time) (*Collection, error) {
+	exported := &Collection{Name: c.name, File: c.name + ".ndjson"}
+	file, err := createFile(archive, exported.File, modified)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	encoder := json.NewEncoder(io.MultiWriter(file, hash))
+	for page := (dto.PageDTO{Limit: pageSize}); ; page.Offset += pageSize {
+		documents, _, err := c.list(ctx, repo, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range documents {
+			if err := encoder.Encode(document); err != nil {
+				return nil, err
+			}
+		}
+		exported.Count += int64(len(documents))
+		if len(documents) < pageSize {
+			break
+		}
+	}
+	exported.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return exported, nil
+}
+
+func createFile(archive *zip.Writer, name string, modified time.Time) (io.Writer, error) {
+	return archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+}
+
+// Restore creates every document of the archive in repo, which has to be
+// empty. The manifest, counts and checksums of the whole archive are checked
+// before anything is written. Documents are then written in transactions of
+// pageSize documents, so a failed restore may leave some of them behind.
+func Restore(ctx context.Context, repo repository.ProjectRepository, r io.ReaderAt, size int64) (*Manifest, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while opening the archive")
+	}
+	manifest, err := readManifest(archive)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range manifest.Collections {
+		if err := verifyCollection(archive, c); err != nil {
+			return nil, errors.Wrap(err, "Error while verifying the "+c.Name)
+		}
+	}
+	if err := checkEmpty(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	for _, c := range collections {
+		for _, restored := range manifest.Collections {
+			if restored.Name != c.name {
+				continue
+			}
+			if err := restoreCollection(ctx, repo, archive, restored, c); err != nil {
+				return nil, errors.Wrap(err, "Error while restoring the "+c.name)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+func readManifest(archive *zip.Reader) (*Manifest, error) {
+	file, err := archive.Open(manifestFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while opening the manifest")
+	}
+	defer file.Close()
+	manifest := &Manifest{}
+	if err := json.NewDecoder(file).Decode(manifest); err != nil {
+		return nil, errors.Wrap(err, "Error while reading the manifest")
+	}
+	if manifest.Format != Format {
+		return nil, errors.New("Unknown archive format: " + manifest.Format)
+	}
+	if manifest.Version < 1 || manifest.Version > Version {
+		return nil, fmt.Errorf("Unsupported archive version %d, expected at most %d", manifest.Version, Version)
+	}
+	known := map[string]bool{}
+	for _, c := range collections {
+		known[c.name] = true
+	}
+	for _, c := range manifest.Collections {
+		if !known[c.Name] {
+			return nil, errors.New("Unknown collection in the manifest: " + c.Name)
+		}
+	}
+	return manifest, nil
+}
+
+// verifyCollection checks the count and checksum of the file of c, and that
+// every line of it is a JSON document.
+func verifyCollection(archive *zip.Reader, c Collection) error {
+	file, err := archive.Open(c.File)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	lines := newLineScanner(io.TeeReader(file, hash))
+	count := int64(0)
+	for lines.Scan() {
+		if !json.Valid(lines.Bytes()) {
+			return fmt.Errorf("Line %d of %s is not a JSON document", count+1, c.File)
+		}
+		count++
+	}
+	if err := lines.Err(); err != nil {
+		return err
+	}
+	if count != c.Count {
+		return fmt.Errorf("%s holds %d documents, the manifest expects %d", c.File, count, c.Count)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != c.SHA256 {
+		return errors.New("Checksum mismatch of " + c.File)
+	}
+	return nil
+}
+
+func checkEmpty(ctx context.Context, repo repository.ProjectRepository) error {
+	for _, c := range collections {
+		_, total, err := c.list(ctx, repo, dto.PageDTO{Limit: 1})
+		if err != nil {
+			return errors.Wrap(err, "Error while counting the "+c.name)
+		}
+		if total > 0 {
+			return errors.Wrap(ErrNotEmpty, fmt.Sprintf("Found %d %s", total, c.name))
+		}
+	}
+	return nil
+}
+
+func restoreCollection(ctx context.Context, repo repository.ProjectRepository, archive *zip.Reader, restored Collection, c collection) error {
+	file, err := archive.Open(restored.File)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	lines := newLineScanner(file)
+	for {
+		batch := [][]byte{}
+		for len(batch) < pageSize && lines.Scan() {
+			batch = append(batch, append([]byte(nil), lines.Bytes()...))
+		}
+		if err := lines.Err(); err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		err := repo.WithTransaction(ctx, func(ctx context.Context) error {
+			for _, data := range batch {
+				if err := c.restore(ctx, repo, data); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxDocumentSize)
+	return scanner
+}
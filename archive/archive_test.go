@@ -0,0 +1,259 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"int-service/dto"
+	"int-service/repository"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// seedCatalog writes a document of each collection, the show, season and
+// episode with posters.
+func seedCatalog(t *testing.T, repo repository.ProjectRepository) {
+	t.Helper()
+	ctx := context.Background()
+	release := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+	star := &dto.ShortCelebrityDTO{ID: "c1", Name: "Louis Hofmann", RoleName: "Jonas", PostersPath: []string{"c1.jpg"}}
+	writes := []func() error{
+		func() error { _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"}); return err },
+		func() error {
+			_, err := repo.CreateJournalist(ctx, &dto.JournalistDTO{ID: "j1", Name: "Ann"})
+			return err
+		},
+		func() error {
+			_, err := repo.CreateCelebrity(ctx, &dto.CelebrityDTO{ID: "c1", Name: "Louis Hofmann", Occupation: []string{"actor"}, DateOfBirth: time.Date(1997, 6, 3, 0, 0, 0, 0, time.UTC), Gender: "male"})
+			return err
+		},
+		func() error { _, err := repo.UploadCelebrityPosters(ctx, "c1", []string{"c1.jpg"}); return err },
+		func() error {
+			_, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark", Type: "series", ReleaseDate: release,
+				Genres: dto.ShortGenresDTO{{ID: "g1", Name: "Mystery"}}, Starring: dto.ShortCelebritiesDTO{star}})
+			return err
+		},
+		func() error {
+			_, err := repo.UploadSeriesPosters(ctx, "s1", []string{"s1.jpg", "s1-wide.jpg"})
+			return err
+		},
+		func() error {
+			_, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "s2", Title: "Hell", Type: "movie", ReleaseDate: release})
+			return err
+		},
+		func() error { _, err := repo.UploadMoviePosters(ctx, "s2", []string{"s2.jpg"}); return err },
+		func() error {
+			_, err := repo.CreateSeason(ctx, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1", ReleaseDate: release})
+			return err
+		},
+		func() error {
+			_, err := repo.AddShortSeason(ctx, "s1", &dto.ShortSeasonDTO{ID: "se1", Title: "Season 1"})
+			return err
+		},
+		func() error {
+			_, err := repo.CreateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", SeasonID: "se1", Title: "Secrets", Starring: dto.ShortCelebritiesDTO{star}})
+			return err
+		},
+		func() error {
+			_, err := repo.AddShortEpisode(ctx, "se1", &dto.ShortEpisodeDTO{ID: "e1", Title: "Secrets", PostersPath: []string{"e1.jpg"}})
+			return err
+		},
+		func() error { _, err := repo.UploadEpisodePosters(ctx, "e1", []string{"e1.jpg"}); return err },
+		func() error {
+			_, err := repo.CreateArticle(ctx, &dto.ArticleDTO{ID: "a1", Title: "Review", ReleaseDate: release, Journalist: dto.ShortJournalistDTO{ID: "j1"}})
+			return err
+		},
+	}
+	for _, write := range writes {
+		if err := write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newSQLite(t *testing.T) repository.ProjectRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "catalog.db")+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := repository.MigrateSQL(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	return repository.NewSQLDB(db)
+}
+
+func export(t *testing.T, repo repository.ProjectRepository) ([]byte, *Manifest) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	manifest, err := Export(context.Background(), repo, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), manifest
+}
+
+// documents decodes the documents of a file of the archive data. Backends
+// read empty lists as null or [], so null fields are dropped.
+func documents(t *testing.T, data []byte, name string) []map[string]interface{} {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := reader.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	decoder := json.NewDecoder(file)
+	documents := []map[string]interface{}{}
+	for decoder.More() {
+		document := map[string]interface{}{}
+		if err := decoder.Decode(&document); err != nil {
+			t.Fatal(err)
+		}
+		documents = append(documents, dropEmpty(document).(map[string]interface{}))
+	}
+	return documents
+}
+
+func dropEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if list, ok := field.([]interface{}); field == nil || ok && len(list) == 0 {
+				delete(v, key)
+				continue
+			}
+			v[key] = dropEmpty(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = dropEmpty(v[i])
+		}
+	}
+	return value
+}
+
+// TestRoundTrip restores the archive of a catalog into another backend, whose
+// export holds the same documents.
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := repository.NewMemoryDB()
+	seedCatalog(t, source)
+	data, exported := export(t, source)
+	counts := map[string]int64{}
+	for _, c := range exported.Collections {
+		counts[c.Name] = c.Count
+	}
+	want := map[string]int64{"genres": 1, "journalists": 1, "celebrities": 1, "shows": 2, "seasons": 1, "episodes": 1, "articles": 1}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("the archive holds %v documents, want %v", counts, want)
+	}
+
+	target := newSQLite(t)
+	restored, err := Restore(ctx, target, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.Collections, exported.Collections) {
+		t.Errorf("the restored manifest is %+v, want %+v", restored.Collections, exported.Collections)
+	}
+	reexported, _ := export(t, target)
+	for _, c := range exported.Collections {
+		if got, want := documents(t, reexported, c.File), documents(t, data, c.File); !reflect.DeepEqual(got, want) {
+			t.Errorf("the restored %s are %v, want %v", c.Name, got, want)
+		}
+	}
+	show, err := target.GetShow(ctx, "s1")
+	if err != nil || !reflect.DeepEqual(show.PostersPath, []string{"s1.jpg", "s1-wide.jpg"}) || len(show.Seasons) != 1 {
+		t.Errorf("the restored show is %+v, %v", show, err)
+	}
+
+	if _, err := Restore(ctx, target, bytes.NewReader(data), int64(len(data))); !errors.Is(err, ErrNotEmpty) {
+		t.Errorf("restoring into a catalog with documents returned %v, want %v", err, ErrNotEmpty)
+	}
+}
+
+// rewrite returns a copy of the archive data with the file name changed by
+// edit.
+func rewrite(t *testing.T, data []byte, name string, edit func([]byte) []byte) []byte {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for _, file := range reader.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file.Name == name {
+			content = edit(content)
+		}
+		w, err := writer.Create(file.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// TestRestoreVerification checks a damaged archive is rejected before any
+// document is written.
+func TestRestoreVerification(t *testing.T) {
+	source := repository.NewMemoryDB()
+	seedCatalog(t, source)
+	data, _ := export(t, source)
+	replace := func(old string, new string) func([]byte) []byte {
+		return func(content []byte) []byte {
+			return bytes.Replace(content, []byte(old), []byte(new), 1)
+		}
+	}
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{name: "not a zip file", data: []byte("catalog"), err: "Error while opening the archive"},
+		{name: "other format", data: rewrite(t, data, manifestFile, replace(Format, "catalog")), err: "Unknown archive format: catalog"},
+		{name: "newer version", data: rewrite(t, data, manifestFile, replace(`"version": 1`, `"version": 2`)), err: "Unsupported archive version 2"},
+		{name: "unknown collection", data: rewrite(t, data, manifestFile, replace(`"name": "genres"`, `"name": "movies"`)), err: "Unknown collection in the manifest: movies"},
+		{name: "edited document", data: rewrite(t, data, "shows.ndjson", replace("Dark", "Dawn")), err: "Checksum mismatch of shows.ndjson"},
+		{name: "missing document", data: rewrite(t, data, "genres.ndjson", func([]byte) []byte { return nil }), err: "genres.ndjson holds 0 documents, the manifest expects 1"},
+		{name: "invalid document", data: rewrite(t, data, "genres.ndjson", func([]byte) []byte { return []byte("{\n") }), err: "Line 1 of genres.ndjson is not a JSON document"},
+	}
+	for _, tt := range tests {
+		target := repository.NewMemoryDB()
+		_, err := Restore(context.Background(), target, bytes.NewReader(tt.data), int64(len(tt.data)))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: Restore returned %v, want an error containing %q", tt.name, err, tt.err)
+			continue
+		}
+		if err := checkEmpty(context.Background(), target); err != nil {
+			t.Errorf("%s: the rejected archive wrote documents: %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"int-service/dto"
+	"int-service/repository"
+)
+
+// collection exports and restores the documents of one collection of the
+// catalog through the repository.
+type collection struct {
+	name string
+	// list returns a page of the documents of the collection and their total.
+	list func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error)
+	// restore creates the document encoded in data, with its ID and posters.
+	restore func(ctx context.Context, repo repository.ProjectRepository, data []byte) error
+}
+
+// collections are in restore order, the referenced documents first.
+var collections = []collection{
+	{
+		name: "genres",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			genres, total, err := repo.ListGenres(ctx, page)
+			documents := []interface{}{}
+			for _, genre := range genres {
+				documents = append(documents, genre)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			genre := &dto.GenreDTO{}
+			if err := json.Unmarshal(data, genre); err != nil {
+				return err
+			}
+			_, err := repo.CreateGenre(ctx, genre)
+			return err
+		},
+	},
+	{
+		name: "journalists",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			journalists, total, err := repo.ListJournalists(ctx, page)
+			documents := []interface{}{}
+			for _, journalist := range journalists {
+				documents = append(documents, journalist)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			journalist := &dto.JournalistDTO{}
+			if err := json.Unmarshal(data, journalist); err != nil {
+				return err
+			}
+			_, err := repo.CreateJournalist(ctx, journalist)
+			return err
+		},
+	},
+	{
+		name: "celebrities",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			celebrities, total, err := repo.ListCelebrities(ctx, dto.CelebrityFilterDTO{}, page)
+			documents := []interface{}{}
+			for _, celebrity := range celebrities {
+				documents = append(documents, celebrity)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			celebrity := &dto.CelebrityDTO{}
+			if err := json.Unmarshal(data, celebrity); err != nil {
+				return err
+			}
+			posters := celebrity.PostersPath
+			if _, err := repo.CreateCelebrity(ctx, celebrity); err != nil || len(posters) == 0 {
+				return err
+			}
+			_, err := repo.UploadCelebrityPosters(ctx, celebrity.ID, posters)
+			return err
+		},
+	},
+	{
+		name: "shows",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			shows, total, err := repo.ListShows(ctx, dto.ShowFilterDTO{}, page)
+			documents := []interface{}{}
+			for _, show := range shows {
+				documents = append(documents, show)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			show := &dto.ShowDTO{}
+			if err := json.Unmarshal(data, show); err != nil {
+				return err
+			}
+			posters := show.PostersPath
+			if _, err := repo.CreateShow(ctx, show); err != nil || len(posters) == 0 {
+				return err
+			}
+			var err error
+			if show.Type == "movie" {
+				_, err = repo.UploadMoviePosters(ctx, show.ID, posters)
+			} else {
+				_, err = repo.UploadSeriesPosters(ctx, show.ID, posters)
+			}
+			return err
+		},
+	},
+	{
+		name: "seasons",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			seasons, total, err := repo.ListSeasonsCollection(ctx, dto.SeasonFilterDTO{}, page)
+			documents := []interface{}{}
+			for _, season := range seasons {
+				documents = append(documents, season)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			season := &dto.SeasonDTO{}
+			if err := json.Unmarshal(data, season); err != nil {
+				return err
+			}
+			posters := season.PostersPath
+			if _, err := repo.CreateSeason(ctx, season); err != nil || len(posters) == 0 {
+				return err
+			}
+			_, err := repo.UploadSeasonPosters(ctx, season.ID, posters)
+			return err
+		},
+	},
+	{
+		name: "episodes",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			episodes, total, err := repo.ListCollectionEpisodes(ctx, dto.EpisodeFilterDTO{}, page)
+			documents := []interface{}{}
+			for _, episode := range episodes {
+				documents = append(documents, episode)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			episode := &dto.EpisodeDTO{}
+			if err := json.Unmarshal(data, episode); err != nil {
+				return err
+			}
+			posters := episode.PostersPath
+			if _, err := repo.CreateEpisode(ctx, episode); err != nil || len(posters) == 0 {
+				return err
+			}
+			_, err := repo.UploadEpisodePosters(ctx, episode.ID, posters)
+			return err
+		},
+	},
+	{
+		name: "articles",
+		list: func(ctx context.Context, repo repository.ProjectRepository, page dto.PageDTO) ([]interface{}, int64, error) {
+			articles, total, err := repo.ListArticles(ctx, dto.ArticleFilterDTO{}, page)
+			documents := []interface{}{}
+			for _, article := range articles {
+				documents = append(documents, article)
+			}
+			return documents, total, err
+		},
+		restore: func(ctx context.Context, repo repository.ProjectRepository, data []byte) error {
+			article := &dto.ArticleDTO{}
+			if err := json.Unmarshal(data, article); err != nil {
+				return err
+			}
+			posters := article.PostersPath
+			if _, err := repo.CreateArticle(ctx, article); err != nil || len(posters) == 0 {
+				return err
+			}
+			_, err := repo.UploadArticlePosters(ctx, article.ID, posters)
+			return err
+		},
+	},
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+)
+
+// Policy maps each role to the patterns of the full gRPC method names it may
+// call, such as "/service.ShowSvc/GetShow". Patterns use path.Match syntax,
+// so "*" does not cross the "/" between service and method. A caller may
+// call a method when one of its roles allows it.
+type Policy map[string][]string
+
+// catalogServices are the services of the catalog documents, whose Get and
+// List methods viewers call. Other services, such as WebhookSvc, are not
+// part of the catalog.
+var catalogServices = []string{
+	"ArticleSvc",
+	"CelebritySvc",
+	"EpisodeSvc",
+	"GenreSvc",
+	"JournalistSvc",
+	"SeasonSvc",
+	"ShowSvc",
+}
+
+// editorServices are the catalog services whose documents editors write.
+// Journalists are managed by admins.
+var editorServices = []string{
+	"ArticleSvc",
+	"CelebritySvc",
+	"EpisodeSvc",
+	"GenreSvc",
+	"SeasonSvc",
+	"ShowSvc",
+}
+
+// editorRestores are the entity types, as named by the TrashSvc Restore
+// methods, whose deleted documents editors restore.
+var editorRestores = []string{
+	"Article",
+	"Celebrity",
+	"Episode",
+	"Genre",
+	"Season",
+	"Show",
+}
+
+var viewerMethods = func() []string {
+	methods := []string{
+		"/service.SearchSvc/Search",
+		"/service.WatchSvc/WatchChanges",
+	}
+	for _, service := range catalogServices {
+		methods = append(methods, "/service."+service+"/Get*", "/service."+service+"/List*")
+	}
+	return methods
+}()
+
+var editorMethods = func() []string {
+	methods := []string{
+		"/service.ImportSvc/BulkImport",
+		"/service.TrashSvc/ListTrashed*",
+		"/service.RevisionSvc/ListRevisions",
+		"/service.RevisionSvc/GetRevisionDiff",
+		"/service.RevisionSvc/RevertToRevision",
+	}
+	for _, service := range editorServices {
+		methods = append(methods,
+			"/service."+service+"/Create*",
+			"/service."+service+"/Update*",
+			"/service."+service+"/Patch*",
+			"/service."+service+"/Upload*",
+			"/service."+service+"/Delete*Poster",
+		)
+	}
+	for _, restore := range editorRestores {
+		methods = append(methods, "/service.TrashSvc/Restore"+restore)
+	}
+	return append(methods, viewerMethods...)
+}()
+
+// DefaultPolicy lets viewers read the catalog, editors also create and
+// update the documents of the catalog services but journalists, and their
+// posters, restore deleted documents and read and revert their revisions,
+// journalists also write and restore articles, and admins call every method.
+// The service layer further restricts journalists to their own articles and
+// the revert of journalist revisions to admins.
+func DefaultPolicy() Policy {
+	return Policy{
+		RoleViewer: viewerMethods,
+		RoleEditor: editorMethods,
+		RoleJournalist: append([]string{
+			"/service.ArticleSvc/*",
+			"/service.TrashSvc/ListTrashedArticles",
+			"/service.TrashSvc/RestoreArticle",
+		}, viewerMethods...),
+		RoleAdmin: {"/*/*"},
+	}
+}
+
+// LoadPolicy reads a policy from a JSON file holding an object of role names
+// to lists of method patterns.
+func LoadPolicy(name string) (Policy, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the policy file")
+	}
+	policy := Policy{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding the policy file")
+	}
+	for role, patterns := range policy {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, errors.Wrap(err, "Error while reading pattern "+pattern+" of role "+role)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// Allows reports whether one of roles may call method.
+func (p Policy) Allows(roles []string, method string) bool {
+	for _, role := range roles {
+		for _, pattern := range p[role] {
+			if ok, _ := path.Match(pattern, method); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	tests := []struct {
+		method string
+		// allowed lists the roles that may call method.
+		allowed []string
+	}{
+		{"/service.ShowSvc/GetShow", []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.ShowSvc/ListShows", []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.SearchSvc/Search", []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.WatchSvc/WatchChanges", []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.ShowSvc/CreateShow", []string{RoleEditor, RoleAdmin}},
+		{"/service.ShowSvc/UpdateShow", []string{RoleEditor, RoleAdmin}},
+		{"/service.ShowSvc/PatchShow", []string{RoleEditor, RoleAdmin}},
+		{"/service.ShowSvc/UploadSeriesPosters", []string{RoleEditor, RoleAdmin}},
+		{"/service.ShowSvc/DeleteSeriesPoster", []string{RoleEditor, RoleAdmin}},
+		{"/service.ShowSvc/DeleteShow", []string{RoleAdmin}},
+		{"/service.ArticleSvc/CreateArticle", []string{RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.ArticleSvc/DeleteArticle", []string{RoleJournalist, RoleAdmin}},
+		{"/service.JournalistSvc/GetJournalist", []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.JournalistSvc/CreateJournalist", []string{RoleAdmin}},
+		{"/service.JournalistSvc/UpdateJournalist", []string{RoleAdmin}},
+		{"/service.TrashSvc/RestoreShow", []string{RoleEditor, RoleAdmin}},
+		{"/service.TrashSvc/RestoreArticle", []string{RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.TrashSvc/RestoreJournalist", []string{RoleAdmin}},
+		{"/service.TrashSvc/ListTrashedArticles", []string{RoleEditor, RoleJournalist, RoleAdmin}},
+		{"/service.RevisionSvc/RevertToRevision", []string{RoleEditor, RoleAdmin}},
+		{"/service.ImportSvc/BulkImport", []string{RoleEditor, RoleAdmin}},
+		{"/service.AdminSvc/CheckConsistency", []string{RoleAdmin}},
+		{"/service.WebhookSvc/CreateWebhook", []string{RoleAdmin}},
+	}
+	policy := DefaultPolicy()
+	for _, tt := range tests {
+		for _, role := range []string{RoleViewer, RoleEditor, RoleJournalist, RoleAdmin, "superuser"} {
+			want := false
+			for _, allowed := range tt.allowed {
+				want = want || allowed == role
+			}
+			if got := policy.Allows([]string{role}, tt.method); got != want {
+				t.Errorf("Allows(%s, %s) = %v, want %v", role, tt.method, got, want)
+			}
+		}
+	}
+	if !policy.Allows([]string{"superuser", RoleViewer}, "/service.ShowSvc/GetShow") {
+		t.Error("a caller with an unknown role and the viewer role may not read shows")
+	}
+	if policy.Allows(nil, "/service.ShowSvc/GetShow") {
+		t.Error("a caller without roles may read shows")
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string) string {
+		t.Helper()
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	policy, err := LoadPolicy(write("policy.json", `{"viewer": ["/service.*/Get*"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !policy.Allows([]string{RoleViewer}, "/service.GenreSvc/GetGenre") || policy.Allows([]string{RoleViewer}, "/service.GenreSvc/ListGenres") {
+		t.Errorf("the loaded policy is %v", policy)
+	}
+	for name, content := range map[string]string{
+		"invalid.json": `{"viewer": "/service.*/Get*"}`,
+		"pattern.json": `{"viewer": ["/service.[/Get*"]}`,
+	} {
+		if _, err := LoadPolicy(write(name, content)); err == nil {
+			t.Errorf("LoadPolicy of %s returned no error", content)
+		}
+	}
+	if _, err := LoadPolicy(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("LoadPolicy of a missing file returned no error")
+	}
+}
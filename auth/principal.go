@@ -0,0 +1,51 @@
+package auth
+
+import "context"
+
+// Roles known to the default policy.
+const (
+	RoleViewer     = "viewer"
+	RoleEditor     = "editor"
+	RoleJournalist = "journalist"
+	RoleAdmin      = "admin"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject string
+	Roles   []string
+	// JournalistID is the journalist the caller writes as, for the
+	// journalist role.
+	JournalistID string
+	// TenantID is the only tenant the caller may call, when not empty.
+	TenantID string
+}
+
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// OwnArticlesOnly reports whether the caller may only write the articles of
+// its own journalist, that is when it is a journalist and neither an editor
+// nor an admin.
+func (p *Principal) OwnArticlesOnly() bool {
+	return p.HasRole(RoleJournalist) && !p.HasRole(RoleEditor) && !p.HasRole(RoleAdmin)
+}
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller of the request of ctx, or nil when
+// authentication is disabled or the call does not come from a client.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
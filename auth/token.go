@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"int-service/models"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+)
+
+var hmacMethods = []string{"HS256", "HS384", "HS512"}
+
+var publicKeyMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Claims are the claims read from the bearer tokens, on top of the
+// registered ones.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles        []string `json:"roles"`
+	JournalistID string   `json:"journalist_id"`
+	TenantID     string   `json:"tenant"`
+}
+
+// Valid checks the validity period of the claims. Unlike the registered
+// claims alone, it requires an expiry, so tokens cannot be valid forever.
+func (c Claims) Valid() error {
+	if err := c.RegisteredClaims.Valid(); err != nil {
+		return err
+	}
+	if !c.VerifyExpiresAt(time.Now(), true) {
+		return errors.New("token has no expiry")
+	}
+	return nil
+}
+
+// Authenticator checks bearer JWTs and returns the principal they carry.
+type Authenticator struct {
+	parser   *jwt.Parser
+	keyFunc  jwt.Keyfunc
+	issuer   string
+	audience string
+}
+
+// NewHMACAuthenticator checks tokens signed with secret. The issuer and
+// audience are only checked when they are not empty.
+func NewHMACAuthenticator(secret []byte, issuer string, audience string) *Authenticator {
+	return &Authenticator{
+		parser: jwt.NewParser(jwt.WithValidMethods(hmacMethods)),
+		keyFunc: func(token *jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		issuer:   issuer,
+		audience: audience,
+	}
+}
+
+// NewJWKSAuthenticator checks tokens signed with one of the RSA or EC keys
+// of the JSON Web Key Set stored in path.
+func NewJWKSAuthenticator(path string, issuer string, audience string) (*Authenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the JWKS file")
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Authenticator{
+		parser:   jwt.NewParser(jwt.WithValidMethods(publicKeyMethods)),
+		keyFunc:  keys.keyFunc,
+		issuer:   issuer,
+		audience: audience,
+	}, nil
+}
+
+// Authenticate checks the signature, expiry, validity period, issuer and
+// audience of token.
+func (a *Authenticator) Authenticate(token string) (*Principal, error) {
+	claims := &Claims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keyFunc); err != nil {
+		return nil, errors.Wrap(models.ErrUnauthenticated, "Invalid token: "+err.Error())
+	}
+	if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+		return nil, errors.Wrap(models.ErrUnauthenticated, "Invalid token issuer")
+	}
+	if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+		return nil, errors.Wrap(models.ErrUnauthenticated, "Invalid token audience")
+	}
+	return &Principal{
+		Subject:      claims.Subject,
+		Roles:        claims.Roles,
+		JournalistID: claims.JournalistID,
+		TenantID:     claims.TenantID,
+	}, nil
+}
+
+// BearerToken returns the token of an authorization header value.
+func BearerToken(authorization string) (string, error) {
+	const prefix = "bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return "", errors.Wrap(models.ErrUnauthenticated, "Missing bearer token")
+	}
+	return strings.TrimSpace(authorization[len(prefix):]), nil
+}
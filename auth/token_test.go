@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"int-service/models"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var testSecret = []byte("token-test-secret")
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, claims Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAuthenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	claims := func(expiresAt time.Time, roles ...string) Claims {
+		registered := jwt.RegisteredClaims{Subject: "ada", Issuer: "catalog", Audience: jwt.ClaimStrings{"int-service"}}
+		if !expiresAt.IsZero() {
+			registered.ExpiresAt = jwt.NewNumericDate(expiresAt)
+		}
+		return Claims{RegisteredClaims: registered, Roles: roles, JournalistID: "j1", TenantID: "acme"}
+	}
+	valid := claims(now.Add(time.Hour), RoleJournalist)
+	notYet := valid
+	notYet.NotBefore = jwt.NewNumericDate(now.Add(time.Hour))
+	otherIssuer := valid
+	otherIssuer.Issuer = "elsewhere"
+	otherAudience := valid
+	otherAudience.Audience = jwt.ClaimStrings{"elsewhere"}
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{name: "valid", token: sign(t, jwt.SigningMethodHS256, testSecret, valid), valid: true},
+		{name: "other HMAC method", token: sign(t, jwt.SigningMethodHS512, testSecret, valid), valid: true},
+		{name: "expired", token: sign(t, jwt.SigningMethodHS256, testSecret, claims(now.Add(-time.Minute), RoleJournalist))},
+		{name: "missing expiry", token: sign(t, jwt.SigningMethodHS256, testSecret, claims(time.Time{}, RoleJournalist))},
+		{name: "not valid yet", token: sign(t, jwt.SigningMethodHS256, testSecret, notYet)},
+		{name: "other secret", token: sign(t, jwt.SigningMethodHS256, []byte("other-secret"), valid)},
+		{name: "RSA method", token: sign(t, jwt.SigningMethodRS256, rsaKey, valid)},
+		{name: "unsigned", token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid)},
+		{name: "other issuer", token: sign(t, jwt.SigningMethodHS256, testSecret, otherIssuer)},
+		{name: "other audience", token: sign(t, jwt.SigningMethodHS256, testSecret, otherAudience)},
+		{name: "malformed", token: "not.a.token"},
+	}
+	authenticator := NewHMACAuthenticator(testSecret, "catalog", "int-service")
+	for _, tt := range tests {
+		principal, err := authenticator.Authenticate(tt.token)
+		if !tt.valid {
+			if !errors.Is(err, models.ErrUnauthenticated) {
+				t.Errorf("%s: Authenticate returned %v, want unauthenticated", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Authenticate returned %v", tt.name, err)
+			continue
+		}
+		want := Principal{Subject: "ada", Roles: []string{RoleJournalist}, JournalistID: "j1", TenantID: "acme"}
+		if principal.Subject != want.Subject || principal.JournalistID != want.JournalistID || principal.TenantID != want.TenantID || !principal.HasRole(RoleJournalist) {
+			t.Errorf("%s: Authenticate returned %+v, want %+v", tt.name, principal, want)
+		}
+	}
+}
+
+// TestUnknownRole checks a valid token whose roles the policy does not know
+// authenticates but is allowed no method.
+func TestUnknownRole(t *testing.T) {
+	token := sign(t, jwt.SigningMethodHS256, testSecret, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "ada", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Roles:            []string{"superuser"},
+	})
+	principal, err := NewHMACAuthenticator(testSecret, "", "").Authenticate(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []string{"/service.ShowSvc/GetShow", "/service.ShowSvc/CreateShow", "/service.AdminSvc/CheckConsistency"} {
+		if DefaultPolicy().Allows(principal.Roles, method) {
+			t.Errorf("the role superuser may call %s", method)
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		authorization string
+		token         string
+		valid         bool
+	}{
+		{authorization: "Bearer abc", token: "abc", valid: true},
+		{authorization: "bearer  abc ", token: "abc", valid: true},
+		{authorization: "Basic abc"},
+		{authorization: "Bearer "},
+		{authorization: ""},
+	}
+	for _, tt := range tests {
+		token, err := BearerToken(tt.authorization)
+		if !tt.valid {
+			if !errors.Is(err, models.ErrUnauthenticated) {
+				t.Errorf("BearerToken(%q) returned %v, want unauthenticated", tt.authorization, err)
+			}
+			continue
+		}
+		if err != nil || token != tt.token {
+			t.Errorf("BearerToken(%q) = %q, %v, want %q", tt.authorization, token, err, tt.token)
+		}
+	}
+}
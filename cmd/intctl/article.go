@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// articleFlags are the fields of an article set by the create and update
+// commands.
+type articleFlags struct {
+	title       string
+	releaseDate string
+	description string
+	journalist  string
+	posters     stringsFlag
+}
+
+func addArticleFlags(flags *flag.FlagSet) *articleFlags {
+	a := &articleFlags{}
+	flags.StringVar(&a.title, "title", "", "title of the article")
+	flags.StringVar(&a.releaseDate, "release-date", "", "release date, 2006-01-02")
+	flags.StringVar(&a.description, "description", "", "text of the article")
+	flags.StringVar(&a.journalist, "journalist", "", "name of the journalist who wrote the article")
+	flags.Var(&a.posters, "poster", "path of a poster, can be repeated")
+	return a
+}
+
+var articleCommands = map[string]command{
+	"list": {"list [--journalist NAME] [--released-after DATE] [--released-before DATE] [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		journalist := flags.String("journalist", "", "only the articles of the journalist with this name")
+		after := flags.String("released-after", "", "only articles released on or after this date")
+		before := flags.String("released-before", "", "only articles released before this date")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			req := &pb.ListArticlesRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending}
+			var err error
+			if req.ReleasedAfter, err = parseDate("released-after", *after); err != nil {
+				return nil, err
+			}
+			if req.ReleasedBefore, err = parseDate("released-before", *before); err != nil {
+				return nil, err
+			}
+			if *journalist != "" {
+				if req.JournalistId, err = c.journalistID(ctx, *journalist); err != nil {
+					return nil, err
+				}
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			return articles.ListArticles(ctx, req)
+		}
+	}},
+	"get": {"get ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			return articles.GetArticle(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --title TITLE --journalist NAME [article flags]", func(flags *flag.FlagSet) runFunc {
+		values := addArticleFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			releaseDate, err := parseDate("release-date", values.releaseDate)
+			if err != nil {
+				return nil, err
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			return articles.CreateArticle(ctx, &pb.CreateArticleRequest{
+				Title:       values.title,
+				ReleaseDate: releaseDate,
+				PostersPath: values.posters,
+				Description: values.description,
+				Journalist:  &pb.CreateJournalistRequest{Name: values.journalist},
+			})
+		}
+	}},
+	"update": {"update ID [article flags], keeping the stored values of the other fields", func(flags *flag.FlagSet) runFunc {
+		values := addArticleFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			article, err := articles.GetArticle(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			flags.Visit(func(f *flag.Flag) {
+				if err != nil {
+					return
+				}
+				switch f.Name {
+				case "title":
+					article.Title = values.title
+				case "release-date":
+					article.ReleaseDate, err = parseDate("release-date", values.releaseDate)
+				case "description":
+					article.Description = values.description
+				case "poster":
+					article.PostersPath = values.posters
+				case "journalist":
+					var ID string
+					ID, err = c.journalistID(ctx, values.journalist)
+					article.Journalist = &pb.ShortJournalist{Id: ID}
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+			return articles.UpdateArticle(ctx, article)
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			_, err = articles.DeleteArticle(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"posters add": {"posters add ID PATH...", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) < 2 {
+				return nil, expectArgs(args, "ID", "PATH...")
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			return articles.UploadArticlePosters(ctx, &pb.UploadArticlePostersRequest{ArticleId: args[0], PostersPath: args[1:]})
+		}
+	}},
+	"posters remove": {"posters remove ID IMAGE", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID", "IMAGE"); err != nil {
+				return nil, err
+			}
+			articles, err := c.articles()
+			if err != nil {
+				return nil, err
+			}
+			_, err = articles.DeleteArticlePoster(ctx, &pb.DeleteArticlePosterRequest{ArticleId: args[0], Image: args[1]})
+			return nil, err
+		}
+	}},
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// celebrityFlags are the fields of a celebrity set by the create and update
+// commands.
+type celebrityFlags struct {
+	name         string
+	dateOfBirth  string
+	dateOfDeath  string
+	placeOfBirth string
+	gender       string
+	bio          string
+	occupations  stringsFlag
+	posters      stringsFlag
+}
+
+var celebrityFields = map[string]string{
+	"name":       "name",
+	"born":       "dateOfBirth",
+	"died":       "dateOfDeath",
+	"birthplace": "placeOfBirth",
+	"gender":     "gender",
+	"bio":        "bio",
+	"occupation": "occupation",
+	"poster":     "postersPath",
+}
+
+func addCelebrityFlags(flags *flag.FlagSet) *celebrityFlags {
+	c := &celebrityFlags{}
+	flags.StringVar(&c.name, "name", "", "name of the celebrity")
+	flags.StringVar(&c.dateOfBirth, "born", "", "date of birth, 2006-01-02")
+	flags.StringVar(&c.dateOfDeath, "died", "", "date of death, 2006-01-02")
+	flags.StringVar(&c.placeOfBirth, "birthplace", "", "place of birth")
+	flags.StringVar(&c.gender, "gender", "", "gender of the celebrity")
+	flags.StringVar(&c.bio, "bio", "", "biography of the celebrity")
+	flags.Var(&c.occupations, "occupation", "occupation, such as actor or director, can be repeated")
+	flags.Var(&c.posters, "poster", "path of a poster, can be repeated")
+	return c
+}
+
+func (c *celebrityFlags) celebrity() (*pb.Celebrity, error) {
+	dateOfBirth, err := parseDate("born", c.dateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+	dateOfDeath, err := parseDate("died", c.dateOfDeath)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Celebrity{
+		Name:         c.name,
+		PostersPath:  c.posters,
+		DateOfBirth:  dateOfBirth,
+		DateOfDeath:  dateOfDeath,
+		PlaceOfBirth: c.placeOfBirth,
+		Gender:       c.gender,
+		Bio:          c.bio,
+		Occupation:   c.occupations,
+	}, nil
+}
+
+var celebrityCommands = map[string]command{
+	"list": {"list [--occupation OCCUPATION] [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		occupation := flags.String("occupation", "", "only celebrities with this occupation")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			return celebrities.ListCelebrities(ctx, &pb.ListCelebritiesRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending, Occupation: *occupation})
+		}
+	}},
+	"get": {"get ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			return celebrities.GetCelebrity(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --name NAME [celebrity flags]", func(flags *flag.FlagSet) runFunc {
+		values := addCelebrityFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			celebrity, err := values.celebrity()
+			if err != nil {
+				return nil, err
+			}
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			return celebrities.CreateCelebrity(ctx, &pb.CreateCelebrityRequest{
+				Name:         celebrity.Name,
+				PostersPath:  celebrity.PostersPath,
+				DateOfBirth:  celebrity.DateOfBirth,
+				DateOfDeath:  celebrity.DateOfDeath,
+				PlaceOfBirth: celebrity.PlaceOfBirth,
+				Gender:       celebrity.Gender,
+				Bio:          celebrity.Bio,
+				Occupation:   celebrity.Occupation,
+			})
+		}
+	}},
+	"update": {"update ID [celebrity flags], writing only the fields of the given flags", func(flags *flag.FlagSet) runFunc {
+		values := addCelebrityFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			mask, err := updateMask(flags, celebrityFields)
+			if err != nil {
+				return nil, err
+			}
+			celebrity, err := values.celebrity()
+			if err != nil {
+				return nil, err
+			}
+			celebrity.Id = args[0]
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			return celebrities.PatchCelebrity(ctx, &pb.PatchCelebrityRequest{Celebrity: celebrity, UpdateMask: mask})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			_, err = celebrities.DeleteCelebrity(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"posters add": {"posters add ID PATH...", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) < 2 {
+				return nil, expectArgs(args, "ID", "PATH...")
+			}
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			return celebrities.UploadCelebrityPosters(ctx, &pb.UploadCelebrityPostersRequest{CelebrityId: args[0], PostersPath: args[1:]})
+		}
+	}},
+	"posters remove": {"posters remove ID IMAGE", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID", "IMAGE"); err != nil {
+				return nil, err
+			}
+			celebrities, err := c.celebrities()
+			if err != nil {
+				return nil, err
+			}
+			_, err = celebrities.DeleteCelebrityPoster(ctx, &pb.DeleteCelebrityPosterRequest{CelebrityId: args[0], Image: args[1]})
+			return nil, err
+		}
+	}},
+}
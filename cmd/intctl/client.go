@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/tenant"
+	"int-service/tlsconfig"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// client connects to the server of the selected profile on first use.
+type client struct {
+	options globalOptions
+	out     io.Writer
+	conn    *grpc.ClientConn
+}
+
+func newClient(options globalOptions, out io.Writer) *client {
+	return &client{options: options, out: out}
+}
+
+func (c *client) connect() (*grpc.ClientConn, error) {
+	if c.conn != nil {
+		return c.conn, nil
+	}
+	p, err := resolveProfile(c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOptions := []grpc.DialOption{}
+	if p.useTLS() {
+		reloader, err := tlsconfig.NewReloader(p.CertFile, p.KeyFile, p.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config := tlsconfig.Client(reloader)
+		config.ServerName = p.ServerName
+		if config.ServerName == "" {
+			config.ServerName, _, _ = net.SplitHostPort(p.Server)
+		}
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	} else {
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if p.Token != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(tokenCredentials(p.Token)))
+	}
+	if p.Tenant != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(tenantCredentials(p.Tenant)))
+	}
+
+	conn, err := grpc.Dial(p.Server, dialOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while connecting to "+p.Server)
+	}
+	c.conn = conn
+	return conn, nil
+}
+
+func (c *client) close() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
+
+func (p *profile) useTLS() bool {
+	return p.TLS || p.CAFile != "" || p.CertFile != ""
+}
+
+// tokenCredentials sends a bearer token with every call.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows tokens over plaintext, for local servers.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// tenantCredentials sends the tenant of the profile with every call.
+type tenantCredentials string
+
+func (t tenantCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{tenant.Header: string(t)}, nil
+}
+
+func (t tenantCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func (c *client) articles() (pb.ArticleSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewArticleSvcClient(conn), err
+}
+
+func (c *client) celebrities() (pb.CelebritySvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewCelebritySvcClient(conn), err
+}
+
+func (c *client) episodes() (pb.EpisodeSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewEpisodeSvcClient(conn), err
+}
+
+func (c *client) genres() (pb.GenreSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewGenreSvcClient(conn), err
+}
+
+func (c *client) journalists() (pb.JournalistSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewJournalistSvcClient(conn), err
+}
+
+func (c *client) seasons() (pb.SeasonSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewSeasonSvcClient(conn), err
+}
+
+func (c *client) shows() (pb.ShowSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewShowSvcClient(conn), err
+}
+
+func (c *client) search() (pb.SearchSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewSearchSvcClient(conn), err
+}
+
+func (c *client) imports() (pb.ImportSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewImportSvcClient(conn), err
+}
+
+func (c *client) watch() (pb.WatchSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewWatchSvcClient(conn), err
+}
+
+func (c *client) webhooks() (pb.WebhookSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewWebhookSvcClient(conn), err
+}
+
+func (c *client) trash() (pb.TrashSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewTrashSvcClient(conn), err
+}
+
+func (c *client) revisions() (pb.RevisionSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewRevisionSvcClient(conn), err
+}
+
+func (c *client) admin() (pb.AdminSvcClient, error) {
+	conn, err := c.connect()
+	return pb.NewAdminSvcClient(conn), err
+}
+
+// genreByName returns the genre named name, as embedded in shows.
+func (c *client) genreByName(ctx context.Context, name string) (*pb.ShortGenre, error) {
+	genres, err := c.genres()
+	if err != nil {
+		return nil, err
+	}
+	genre, err := genres.GetGenreByName(ctx, &pb.GetByNameRequest{Name: name})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while resolving genre "+name)
+	}
+	return &pb.ShortGenre{Id: genre.Id, Name: genre.Name}, nil
+}
+
+func (c *client) journalistID(ctx context.Context, name string) (string, error) {
+	journalists, err := c.journalists()
+	if err != nil {
+		return "", err
+	}
+	journalist, err := journalists.GetJournalistByName(ctx, &pb.GetByNameRequest{Name: name})
+	if err != nil {
+		return "", errors.Wrap(err, "Error while resolving journalist "+name)
+	}
+	return journalist.Id, nil
+}
+
+// filmCrew returns the celebrities of IDs, as embedded in the crews of shows,
+// seasons and episodes.
+func (c *client) filmCrew(ctx context.Context, IDs []string) (*pb.FilmCrew, error) {
+	celebrities, err := c.celebrities()
+	if err != nil {
+		return nil, err
+	}
+	crew := &pb.FilmCrew{FilmCrew: []*pb.FilmStaff{}}
+	for _, ID := range IDs {
+		celebrity, err := celebrities.GetCelebrity(ctx, &pb.GetByIDRequest{Id: ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "Error while resolving celebrity "+ID)
+		}
+		crew.FilmCrew = append(crew.FilmCrew, &pb.FilmStaff{Id: celebrity.Id, Name: celebrity.Name, PostersPath: celebrity.PostersPath})
+	}
+	return crew, nil
+}
+
+// starring returns the celebrities of values, written ID or ID:ROLE.
+func (c *client) starring(ctx context.Context, values []string) (*pb.ShortCelebrities, error) {
+	celebrities, err := c.celebrities()
+	if err != nil {
+		return nil, err
+	}
+	starring := &pb.ShortCelebrities{ShortCelebs: []*pb.ShortCelebrity{}}
+	for _, value := range values {
+		ID, role, _ := strings.Cut(value, ":")
+		celebrity, err := celebrities.GetCelebrity(ctx, &pb.GetByIDRequest{Id: ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "Error while resolving celebrity "+ID)
+		}
+		starring.ShortCelebs = append(starring.ShortCelebs, &pb.ShortCelebrity{Id: celebrity.Id, Name: celebrity.Name, RoleName: role, PostersPath: celebrity.PostersPath})
+	}
+	return starring, nil
+}
+
+// parseDate reads a date written 2006-01-02 or in RFC 3339.
+func parseDate(name string, value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		date, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		return nil, errors.New("invalid --" + name + " " + value + ", expected 2006-01-02 or RFC 3339")
+	}
+	return timestamppb.New(date), nil
+}
+
+// parseLength reads a length written as a duration, such as 1h30m or 45m.
+func parseLength(value string) (*pb.ShowLength, error) {
+	if value == "" {
+		return nil, nil
+	}
+	length, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, errors.New("invalid --length " + value + ", expected a duration such as 1h30m")
+	}
+	return &pb.ShowLength{Hours: int32(length / time.Hour), Minutes: int32(length % time.Hour / time.Minute)}, nil
+}
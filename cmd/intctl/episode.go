@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// episodeFlags are the fields of an episode set by the create and update
+// commands.
+type episodeFlags struct {
+	title      string
+	length     string
+	rating     float64
+	resume     string
+	trailerURL string
+	posters    stringsFlag
+	starring   stringsFlag
+	crew       *crewFlags
+}
+
+var episodeFields = mergeFields(map[string]string{
+	"title":   "title",
+	"length":  "showLength",
+	"rating":  "rating",
+	"resume":  "resume",
+	"trailer": "trailerUrl",
+	"poster":  "postersPath",
+	"star":    "starring",
+}, crewFields)
+
+func addEpisodeFlags(flags *flag.FlagSet) *episodeFlags {
+	e := &episodeFlags{}
+	flags.StringVar(&e.title, "title", "", "title of the episode")
+	flags.StringVar(&e.length, "length", "", "length of the episode, such as 45m")
+	flags.Float64Var(&e.rating, "rating", 0, "rating of the episode")
+	flags.StringVar(&e.resume, "resume", "", "resume of the episode")
+	flags.StringVar(&e.trailerURL, "trailer", "", "URL of the trailer")
+	flags.Var(&e.posters, "poster", "path of a poster, can be repeated")
+	flags.Var(&e.starring, "star", "ID of a starring celebrity, optionally followed by :ROLE, can be repeated")
+	e.crew = addCrewFlags(flags)
+	return e
+}
+
+func (e *episodeFlags) episode(ctx context.Context, c *client) (*pb.Episode, error) {
+	length, err := parseLength(e.length)
+	if err != nil {
+		return nil, err
+	}
+	directedBy, producedBy, writtenBy, err := e.crew.crews(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	starring, err := c.starring(ctx, e.starring)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Episode{
+		Title:       e.title,
+		PostersPath: e.posters,
+		TrailerUrl:  e.trailerURL,
+		ShowLength:  length,
+		Rating:      e.rating,
+		Resume:      e.resume,
+		WrittenBy:   writtenBy,
+		ProducedBy:  producedBy,
+		DirectedBy:  directedBy,
+		Starring:    starring,
+	}, nil
+}
+
+var episodeCommands = map[string]command{
+	"list": {"list [--season ID] [--min-rating N] [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		seasonID := flags.String("season", "", "only episodes of the season of this ID")
+		minRating := flags.Float64("min-rating", 0, "only episodes rated at least this")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			return episodes.ListCollectionEpisodes(ctx, &pb.ListEpisodesRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending, SeasonId: *seasonID, MinRating: *minRating})
+		}
+	}},
+	"get": {"get ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			return episodes.GetEpisode(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --season ID --title TITLE [episode flags]", func(flags *flag.FlagSet) runFunc {
+		seasonID := flags.String("season", "", "ID of the season of the episode")
+		values := addEpisodeFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			episode, err := values.episode(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			return episodes.CreateEpisode(ctx, &pb.CreateEpisodeRequest{
+				Title:       episode.Title,
+				PostersPath: episode.PostersPath,
+				TrailerUrl:  episode.TrailerUrl,
+				ShowLength:  episode.ShowLength,
+				Rating:      episode.Rating,
+				Resume:      episode.Resume,
+				WrittenBy:   episode.WrittenBy,
+				ProducedBy:  episode.ProducedBy,
+				DirectedBy:  episode.DirectedBy,
+				Starring:    episode.Starring,
+				SeasonId:    *seasonID,
+			})
+		}
+	}},
+	"update": {"update ID [episode flags], writing only the fields of the given flags", func(flags *flag.FlagSet) runFunc {
+		values := addEpisodeFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			mask, err := updateMask(flags, episodeFields)
+			if err != nil {
+				return nil, err
+			}
+			episode, err := values.episode(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			stored, err := episodes.GetEpisode(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			episode.Id = args[0]
+			episode.SeasonId = stored.SeasonId
+			return episodes.PatchEpisode(ctx, &pb.PatchEpisodeRequest{Episode: episode, UpdateMask: mask})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			_, err = episodes.DeleteEpisode(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"posters add": {"posters add ID PATH...", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) < 2 {
+				return nil, expectArgs(args, "ID", "PATH...")
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			return episodes.UploadEpisodePosters(ctx, &pb.UploadEpisodePostersRequest{EpisodeId: args[0], PostersPath: args[1:]})
+		}
+	}},
+	"posters remove": {"posters remove ID IMAGE", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID", "IMAGE"); err != nil {
+				return nil, err
+			}
+			episodes, err := c.episodes()
+			if err != nil {
+				return nil, err
+			}
+			episode, err := episodes.GetEpisode(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			season, err := seasons.GetSeason(ctx, &pb.GetByIDRequest{Id: episode.SeasonId})
+			if err != nil {
+				return nil, err
+			}
+			_, err = episodes.DeleteEpisodePoster(ctx, &pb.DeleteEpisodePosterRequest{SeriesId: season.ShowId, SeasonId: season.Id, EpisodeId: args[0], Image: args[1]})
+			return nil, err
+		}
+	}},
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// stringsFlag collects the values of a flag given several times.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// listFlags are the paging and sorting flags of the list commands.
+type listFlags struct {
+	pageSize   int
+	pageToken  string
+	sortBy     string
+	descending bool
+}
+
+func addListFlags(flags *flag.FlagSet) *listFlags {
+	l := &listFlags{}
+	flags.IntVar(&l.pageSize, "page-size", 0, "maximum number of items, 50 by default")
+	flags.StringVar(&l.pageToken, "page-token", "", "token of the page to get, from the previous page")
+	flags.StringVar(&l.sortBy, "sort", "", "field to sort by")
+	flags.BoolVar(&l.descending, "desc", false, "sort in descending order")
+	return l
+}
+
+// updateMask returns the mask of the fields whose flag was set, with fields
+// mapping flag names to proto field names. An empty mask would write every
+// field, so at least one of them must be set.
+func updateMask(flags *flag.FlagSet, fields map[string]string) (*fieldmaskpb.FieldMask, error) {
+	mask := &fieldmaskpb.FieldMask{}
+	flags.Visit(func(f *flag.Flag) {
+		if field, ok := fields[f.Name]; ok {
+			for _, path := range mask.Paths {
+				if path == field {
+					return
+				}
+			}
+			mask.Paths = append(mask.Paths, field)
+		}
+	})
+	if len(mask.Paths) == 0 {
+		return nil, errors.New("nothing to update, set the flags of the fields to write")
+	}
+	return mask, nil
+}
+
+// crewFlags are the flags of the crews of shows, seasons and episodes.
+type crewFlags struct {
+	directors stringsFlag
+	producers stringsFlag
+	writers   stringsFlag
+}
+
+func addCrewFlags(flags *flag.FlagSet) *crewFlags {
+	crew := &crewFlags{}
+	flags.Var(&crew.directors, "director", "ID of a director, can be repeated")
+	flags.Var(&crew.producers, "producer", "ID of a producer, can be repeated")
+	flags.Var(&crew.writers, "writer", "ID of a writer, can be repeated")
+	return crew
+}
+
+var crewFields = map[string]string{
+	"director": "directedBy",
+	"producer": "producedBy",
+	"writer":   "writtenBy",
+}
+
+// crews resolves the celebrities of the crew flags.
+func (crew *crewFlags) crews(ctx context.Context, c *client) (directedBy *pb.FilmCrew, producedBy *pb.FilmCrew, writtenBy *pb.FilmCrew, err error) {
+	if directedBy, err = c.filmCrew(ctx, crew.directors); err != nil {
+		return
+	}
+	if producedBy, err = c.filmCrew(ctx, crew.producers); err != nil {
+		return
+	}
+	writtenBy, err = c.filmCrew(ctx, crew.writers)
+	return
+}
+
+func mergeFields(maps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var genreCommands = map[string]command{
+	"list": {"list [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			genres, err := c.genres()
+			if err != nil {
+				return nil, err
+			}
+			return genres.ListGenres(ctx, &pb.ListGenresRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending})
+		}
+	}},
+	"get": {"get ID, or get --name NAME", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the genre, instead of its ID")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			genres, err := c.genres()
+			if err != nil {
+				return nil, err
+			}
+			if *name != "" {
+				return genres.GetGenreByName(ctx, &pb.GetByNameRequest{Name: *name})
+			}
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			return genres.GetGenre(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --name NAME [--description TEXT]", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the genre")
+		description := flags.String("description", "", "description of the genre")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			genres, err := c.genres()
+			if err != nil {
+				return nil, err
+			}
+			return genres.CreateGenre(ctx, &pb.CreateGenreRequest{Name: *name, Description: *description})
+		}
+	}},
+	"update": {"update ID [--name NAME] [--description TEXT]", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the genre")
+		description := flags.String("description", "", "description of the genre")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			genres, err := c.genres()
+			if err != nil {
+				return nil, err
+			}
+			genre, err := genres.GetGenre(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			flags.Visit(func(f *flag.Flag) {
+				switch f.Name {
+				case "name":
+					genre.Name = *name
+				case "description":
+					genre.Description = *description
+				}
+			})
+			return genres.UpdateGenre(ctx, genre)
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			genres, err := c.genres()
+			if err != nil {
+				return nil, err
+			}
+			_, err = genres.DeleteGenre(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	pb "int-service/_proto"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// importCommands has a single unnamed command, so the file directly follows
+// the resource: intctl import bundle.json.
+var importCommands = map[string]command{
+	"": {"FILE [--dry-run]", func(flags *flag.FlagSet) runFunc {
+		dryRun := flags.Bool("dry-run", false, "report what would be imported without writing anything")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "FILE"); err != nil {
+				return nil, err
+			}
+			req, err := readBundle(args[0])
+			if err != nil {
+				return nil, err
+			}
+			req.DryRun = *dryRun
+			imports, err := c.imports()
+			if err != nil {
+				return nil, err
+			}
+			return imports.BulkImport(ctx, req)
+		}
+	}},
+}
+
+// readBundle reads a BulkImportRequest in its JSON form, or the shows of a
+// CSV file when the name ends in .csv.
+func readBundle(name string) (*pb.BulkImportRequest, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if strings.EqualFold(filepath.Ext(name), ".csv") {
+		return readCSVBundle(file)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.BulkImportRequest{}
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding "+name)
+	}
+	return req, nil
+}
+
+// readCSVBundle reads a CSV file with a row per episode, or per season or
+// show without episodes. Columns are named after the fields of the import
+// messages, prefixed by show., season. or episode., such as show.title or
+// episode.starring. Rows of the same show and season are merged, the first
+// one giving their fields.
+func readCSVBundle(r io.Reader) (*pb.BulkImportRequest, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the CSV file")
+	}
+	if len(records) == 0 {
+		return nil, errors.New("The CSV file is empty")
+	}
+	header := records[0]
+	for _, column := range header {
+		level, _, _ := strings.Cut(column, ".")
+		if level != "show" && level != "season" && level != "episode" {
+			return nil, errors.New("Unknown column " + column + ", expected show., season. or episode. columns")
+		}
+	}
+
+	req := &pb.BulkImportRequest{}
+	shows := map[string]*pb.ImportShow{}
+	seasons := map[string]*pb.ImportSeason{}
+	for i, record := range records[1:] {
+		line := i + 2
+		row := map[string]map[string]string{"show": {}, "season": {}, "episode": {}}
+		for j, column := range header {
+			level, field, _ := strings.Cut(column, ".")
+			if value := strings.TrimSpace(record[j]); value != "" {
+				row[level][field] = value
+			}
+		}
+		if row["show"]["title"] == "" {
+			return nil, fmt.Errorf("line %d: missing show.title", line)
+		}
+		if row["season"]["title"] == "" && len(row["season"])+len(row["episode"]) > 0 {
+			return nil, fmt.Errorf("line %d: missing season.title", line)
+		}
+		if row["episode"]["title"] == "" && len(row["episode"]) > 0 {
+			return nil, fmt.Errorf("line %d: missing episode.title", line)
+		}
+
+		showKey := row["show"]["title"] + "\x00" + row["show"]["releaseDate"]
+		show, ok := shows[showKey]
+		if !ok {
+			show = &pb.ImportShow{}
+			if err := setCSVFields(show, "show.", row["show"], line); err != nil {
+				return nil, err
+			}
+			shows[showKey] = show
+			req.Shows = append(req.Shows, show)
+		}
+		if row["season"]["title"] == "" {
+			continue
+		}
+		seasonKey := showKey + "\x00" + row["season"]["title"]
+		season, ok := seasons[seasonKey]
+		if !ok {
+			season = &pb.ImportSeason{}
+			if err := setCSVFields(season, "season.", row["season"], line); err != nil {
+				return nil, err
+			}
+			seasons[seasonKey] = season
+			show.Seasons = append(show.Seasons, season)
+		}
+		if row["episode"]["title"] == "" {
+			continue
+		}
+		episode := &pb.ImportEpisode{}
+		if err := setCSVFields(episode, "episode.", row["episode"], line); err != nil {
+			return nil, err
+		}
+		season.Episodes = append(season.Episodes, episode)
+	}
+	return req, nil
+}
+
+// setCSVFields sets the fields of m named by the JSON names of values. Lists
+// are separated by semicolons, and celebrities are written NAME, NAME|BIRTH
+// or NAME|BIRTH|ROLE.
+func setCSVFields(m proto.Message, prefix string, values map[string]string, line int) error {
+	message := m.ProtoReflect()
+	for name, value := range values {
+		column := prefix + name
+		field := message.Descriptor().Fields().ByJSONName(name)
+		if field == nil {
+			return fmt.Errorf("line %d: unknown column %s", line, column)
+		}
+		switch {
+		case field.IsList() && field.Kind() == protoreflect.StringKind:
+			list := message.Mutable(field).List()
+			for _, item := range strings.Split(value, ";") {
+				list.Append(protoreflect.ValueOfString(strings.TrimSpace(item)))
+			}
+		case field.IsList() && field.Message().FullName() == "service.CelebrityRef":
+			list := message.Mutable(field).List()
+			for _, item := range strings.Split(value, ";") {
+				ref, err := parseCelebrityRef(strings.TrimSpace(item))
+				if err != nil {
+					return fmt.Errorf("line %d: invalid %s: %v", line, column, err)
+				}
+				list.Append(protoreflect.ValueOfMessage(ref.ProtoReflect()))
+			}
+		case field.IsList():
+			return fmt.Errorf("line %d: %s cannot be a column", line, column)
+		case field.Kind() == protoreflect.StringKind:
+			message.Set(field, protoreflect.ValueOfString(value))
+		case field.Kind() == protoreflect.DoubleKind:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("line %d: invalid %s %q, expected a number", line, column, value)
+			}
+			message.Set(field, protoreflect.ValueOfFloat64(number))
+		case field.Message().Name() == "Timestamp":
+			date, err := parseDate(column, value)
+			if err != nil {
+				return fmt.Errorf("line %d: invalid %s %q, expected 2006-01-02 or RFC 3339", line, column, value)
+			}
+			message.Set(field, protoreflect.ValueOfMessage(date.ProtoReflect()))
+		case field.Message().Name() == "ShowLength":
+			length, err := parseLength(value)
+			if err != nil {
+				return fmt.Errorf("line %d: invalid %s %q, expected a duration such as 1h30m", line, column, value)
+			}
+			message.Set(field, protoreflect.ValueOfMessage(length.ProtoReflect()))
+		default:
+			return fmt.Errorf("line %d: %s cannot be a column", line, column)
+		}
+	}
+	return nil
+}
+
+func parseCelebrityRef(value string) (*pb.CelebrityRef, error) {
+	parts := strings.SplitN(value, "|", 3)
+	ref := &pb.CelebrityRef{Name: strings.TrimSpace(parts[0])}
+	if ref.Name == "" {
+		return nil, errors.New("missing celebrity name")
+	}
+	if len(parts) > 1 {
+		dateOfBirth, err := parseDate("birth", strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, errors.New("invalid date of birth of " + ref.Name)
+		}
+		ref.DateOfBirth = dateOfBirth
+	}
+	if len(parts) > 2 {
+		ref.RoleName = strings.TrimSpace(parts[2])
+	}
+	return ref, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadCSVBundle(t *testing.T) {
+	csv := "" +
+		"show.title,show.releaseDate,show.genres,season.title,episode.title,episode.showLength,episode.starring\n" +
+		"Dark,2017-12-01,Mystery;Drama,Season 1,Secrets,52m,Louis Hofmann|1997-06-03|Jonas;Oliver Masucci\n" +
+		"Dark,2017-12-01,,Season 1,Lies,45m,\n" +
+		"Dark,2017-12-01,,Season 2,,,\n" +
+		"1899,,,,,,\n"
+	req, err := readCSVBundle(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Shows) != 2 || req.Shows[1].Title != "1899" || len(req.Shows[1].Seasons) != 0 {
+		t.Fatalf("the bundle holds the shows %v", req.Shows)
+	}
+	dark := req.Shows[0]
+	if dark.ReleaseDate.AsTime().Format("2006-01-02") != "2017-12-01" || strings.Join(dark.Genres, ",") != "Mystery,Drama" {
+		t.Errorf("the show of the rows is %v", dark)
+	}
+	if len(dark.Seasons) != 2 || len(dark.Seasons[0].Episodes) != 2 || len(dark.Seasons[1].Episodes) != 0 {
+		t.Fatalf("the show holds the seasons %v", dark.Seasons)
+	}
+	secrets := dark.Seasons[0].Episodes[0]
+	if secrets.ShowLength.Minutes != 52 || len(secrets.Starring) != 2 {
+		t.Fatalf("the first episode is %v", secrets)
+	}
+	if star := secrets.Starring[0]; star.Name != "Louis Hofmann" || star.DateOfBirth.AsTime().Year() != 1997 || star.RoleName != "Jonas" {
+		t.Errorf("the first star is %v", star)
+	}
+	if star := secrets.Starring[1]; star.Name != "Oliver Masucci" || star.DateOfBirth != nil {
+		t.Errorf("the second star is %v", star)
+	}
+
+	tests := []struct {
+		name string
+		csv  string
+		err  string
+	}{
+		{name: "empty", csv: "", err: "empty"},
+		{name: "unknown level", csv: "movie.title\nDark\n", err: "Unknown column movie.title"},
+		{name: "unknown field", csv: "show.title,show.budget\nDark,1\n", err: "line 2: unknown column show.budget"},
+		{name: "no show title", csv: "show.title,season.title\n,Season 1\n", err: "line 2: missing show.title"},
+		{name: "no season title", csv: "show.title,episode.title\nDark,Secrets\n", err: "line 2: missing season.title"},
+		{name: "invalid number", csv: "show.title,show.rating\nDark,high\n", err: "invalid show.rating"},
+		{name: "invalid date", csv: "show.title,show.releaseDate\nDark,01/12/2017\n", err: "invalid show.releaseDate"},
+		{name: "invalid celebrity", csv: "show.title,show.starring\nDark,|1997-06-03\n", err: "missing celebrity name"},
+		{name: "list of messages", csv: "show.title,show.seasons\nDark,Season 1\n", err: "show.seasons cannot be a column"},
+	}
+	for _, tt := range tests {
+		if _, err := readCSVBundle(strings.NewReader(tt.csv)); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: readCSVBundle returned %v, want an error containing %q", tt.name, err, tt.err)
+		}
+	}
+}
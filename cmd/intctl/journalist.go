@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var journalistCommands = map[string]command{
+	"list": {"list [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			journalists, err := c.journalists()
+			if err != nil {
+				return nil, err
+			}
+			return journalists.ListJournalists(ctx, &pb.ListJournalistsRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending})
+		}
+	}},
+	"get": {"get ID, or get --name NAME", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the journalist, instead of its ID")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			journalists, err := c.journalists()
+			if err != nil {
+				return nil, err
+			}
+			if *name != "" {
+				return journalists.GetJournalistByName(ctx, &pb.GetByNameRequest{Name: *name})
+			}
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			return journalists.GetJournalist(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --name NAME", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the journalist")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			journalists, err := c.journalists()
+			if err != nil {
+				return nil, err
+			}
+			return journalists.CreateJournalist(ctx, &pb.CreateJournalistRequest{Name: *name})
+		}
+	}},
+	"update": {"update ID --name NAME", func(flags *flag.FlagSet) runFunc {
+		name := flags.String("name", "", "name of the journalist")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			journalists, err := c.journalists()
+			if err != nil {
+				return nil, err
+			}
+			return journalists.UpdateJournalist(ctx, &pb.Journalist{Id: args[0], Name: *name})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			journalists, err := c.journalists()
+			if err != nil {
+				return nil, err
+			}
+			_, err = journalists.DeleteJournalist(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+}
@@ -0,0 +1,209 @@
+// Command intctl manages the catalog of the service through its gRPC API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// runFunc runs a command with its positional arguments and returns the
+// message to print, if any.
+type runFunc func(ctx context.Context, c *client, args []string) (proto.Message, error)
+
+type command struct {
+	usage string
+	// setup registers the flags of the command and returns the function
+	// running it with their values.
+	setup func(flags *flag.FlagSet) runFunc
+}
+
+var resources = map[string]map[string]command{
+	"article":    articleCommands,
+	"celebrity":  celebrityCommands,
+	"episode":    episodeCommands,
+	"genre":      genreCommands,
+	"import":     importCommands,
+	"journalist": journalistCommands,
+	"season":     seasonCommands,
+	"show":       showCommands,
+	"search":     searchCommands,
+	"watch":      watchCommands,
+	"webhook":    webhookCommands,
+	"trash":      trashCommands,
+	"revision":   revisionCommands,
+	"admin":      adminCommands,
+	"profile":    profileCommands,
+}
+
+type globalOptions struct {
+	configFile string
+	profile    string
+	server     string
+	token      string
+	tenant     string
+	output     string
+	timeout    time.Duration
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if st, ok := status.FromError(err); ok {
+			err = fmt.Errorf("%s: %s", st.Code(), st.Message())
+		}
+		fmt.Fprintln(os.Stderr, "intctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(out)
+		return nil
+	}
+	verbs, ok := resources[args[0]]
+	if !ok {
+		usage(os.Stderr)
+		return fmt.Errorf("unknown resource %q", args[0])
+	}
+
+	name, rest := commandName(args[1:])
+	cmd, ok := verbs[name]
+	if _, unnamed := verbs[""]; !ok && unnamed {
+		name, rest = "", args[1:]
+		cmd, ok = verbs[name]
+	}
+	if !ok {
+		resourceUsage(os.Stderr, args[0], verbs)
+		if name == "" {
+			return fmt.Errorf("missing command")
+		}
+		return fmt.Errorf("unknown command %q", args[0]+" "+name)
+	}
+
+	flags := flag.NewFlagSet(strings.TrimSpace("intctl "+args[0]+" "+name), flag.ContinueOnError)
+	flags.SetOutput(out)
+	options := globalOptions{}
+	flags.StringVar(&options.configFile, "config", "", "profiles file, defaults to $INTCTL_CONFIG or intctl/config.json in the user config directory")
+	flags.StringVar(&options.profile, "profile", "", "profile to use instead of the current one")
+	flags.StringVar(&options.server, "server", "", "address of the server, overriding the profile")
+	flags.StringVar(&options.token, "token", "", "bearer token, overriding $INTCTL_TOKEN and the profile")
+	flags.StringVar(&options.tenant, "tenant", "", "tenant whose catalog to work on, overriding the profile")
+	flags.StringVar(&options.output, "o", "table", "output format: table, json or yaml")
+	flags.DurationVar(&options.timeout, "timeout", 30*time.Second, "timeout of the command, 0 for none")
+	runCommand := cmd.setup(flags)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: intctl %s %s\n", args[0], cmd.usage)
+		flags.PrintDefaults()
+	}
+	positional, err := parseInterspersed(flags, rest)
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	printer, err := newPrinter(options.output, out)
+	if err != nil {
+		return err
+	}
+
+	c := newClient(options, out)
+	defer c.close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if options.timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, options.timeout)
+		defer cancelTimeout()
+	}
+	resp, err := runCommand(ctx, c, positional)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+	return printer.print(resp)
+}
+
+// commandName returns the command of the arguments following the resource,
+// which is two words long for posters, and the arguments left.
+func commandName(args []string) (string, []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return "", args
+	}
+	if args[0] == "posters" && len(args) > 1 {
+		return args[0] + " " + args[1], args[2:]
+	}
+	return args[0], args[1:]
+}
+
+// parseInterspersed parses flags placed before, between or after the
+// positional arguments, and returns the positional ones.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func usage(out io.Writer) {
+	fmt.Fprintln(out, "Usage: intctl RESOURCE COMMAND [ARGS] [FLAGS]")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "Resources:")
+	names := []string{}
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		verbs := verbNames(resources[name])
+		if len(verbs) == 1 && verbs[0] == "" {
+			verbs[0] = resources[name][""].usage
+		}
+		fmt.Fprintf(out, "  %-11s %s\n", name, strings.Join(verbs, ", "))
+	}
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "Run intctl RESOURCE COMMAND -h for the flags of a command.")
+}
+
+func resourceUsage(out io.Writer, resource string, verbs map[string]command) {
+	fmt.Fprintf(out, "Usage of intctl %s:\n", resource)
+	for _, name := range verbNames(verbs) {
+		fmt.Fprintf(out, "  intctl %s %s\n", resource, verbs[name].usage)
+	}
+}
+
+func verbNames(verbs map[string]command) []string {
+	names := []string{}
+	for name := range verbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expectArgs checks the number of positional arguments of a command.
+func expectArgs(args []string, names ...string) error {
+	if len(args) != len(names) {
+		return fmt.Errorf("expected %d arguments: %s", len(names), strings.Join(names, " "))
+	}
+	return nil
+}
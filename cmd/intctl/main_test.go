@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommandName(t *testing.T) {
+	tests := []struct {
+		args []string
+		name string
+		rest []string
+	}{
+		{args: []string{}, name: "", rest: []string{}},
+		{args: []string{"get", "s1"}, name: "get", rest: []string{"s1"}},
+		{args: []string{"--name", "Drama"}, name: "", rest: []string{"--name", "Drama"}},
+		{args: []string{"posters", "upload", "s1", "poster.jpg"}, name: "posters upload", rest: []string{"s1", "poster.jpg"}},
+		{args: []string{"posters"}, name: "posters", rest: []string{}},
+	}
+	for _, tt := range tests {
+		name, rest := commandName(tt.args)
+		if name != tt.name || !reflect.DeepEqual(rest, tt.rest) {
+			t.Errorf("commandName(%q) = %q, %q, want %q, %q", tt.args, name, rest, tt.name, tt.rest)
+		}
+	}
+}
+
+func TestParseInterspersed(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		positional []string
+		title      string
+		desc       bool
+	}{
+		{name: "no arguments", args: []string{}, positional: []string{}},
+		{name: "flags first", args: []string{"--title", "Dark", "--desc", "s1"}, positional: []string{"s1"}, title: "Dark", desc: true},
+		{name: "flags between", args: []string{"s1", "--title", "Dark", "se1"}, positional: []string{"s1", "se1"}, title: "Dark"},
+		{name: "flags last", args: []string{"s1", "se1", "--desc"}, positional: []string{"s1", "se1"}, desc: true},
+		{name: "terminator", args: []string{"s1", "--", "--desc"}, positional: []string{"s1", "--desc"}},
+	}
+	for _, tt := range tests {
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		title := flags.String("title", "", "")
+		desc := flags.Bool("desc", false, "")
+		positional, err := parseInterspersed(flags, tt.args)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(positional, tt.positional) || *title != tt.title || *desc != tt.desc {
+			t.Errorf("%s: parsed %q with --title %q --desc %v, want %q with %q %v", tt.name, positional, *title, *desc, tt.positional, tt.title, tt.desc)
+		}
+	}
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(&bytes.Buffer{})
+	if _, err := parseInterspersed(flags, []string{"s1", "--unknown"}); err == nil {
+		t.Error("an unknown flag after a positional argument was accepted")
+	}
+}
+
+// TestRun covers the commands failing before they connect to a server.
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		// err is part of the error returned, none if empty.
+		err string
+		// out is part of the output, if not empty.
+		out string
+	}{
+		{name: "no arguments", args: []string{}, out: "Usage: intctl RESOURCE COMMAND"},
+		{name: "help", args: []string{"help"}, out: "show "},
+		{name: "unknown resource", args: []string{"movie", "list"}, err: `unknown resource "movie"`},
+		{name: "unknown command", args: []string{"genre", "rename"}, err: `unknown command "genre rename"`},
+		{name: "missing command", args: []string{"genre"}, err: "missing command"},
+		{name: "command help", args: []string{"genre", "create", "-h"}, out: "Usage: intctl genre create --name NAME"},
+		{name: "unknown flag", args: []string{"genre", "create", "--title", "Drama"}, err: "flag provided but not defined: -title"},
+		{name: "unknown output", args: []string{"genre", "create", "--name", "Drama", "-o", "xml"}, err: "unknown output format xml"},
+		{name: "extra argument", args: []string{"genre", "create", "Drama"}, err: "expected 0 arguments"},
+		{name: "missing argument", args: []string{"genre", "delete", "--timeout", "1s"}, err: "expected 1 arguments: ID"},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		err := run(tt.args, out)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: run returned %v", tt.name, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: run returned %v, want an error containing %q", tt.name, err, tt.err)
+		}
+		if !strings.Contains(out.String(), tt.out) {
+			t.Errorf("%s: run printed %q, want it to contain %q", tt.name, out.String(), tt.out)
+		}
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	fields := mergeFields(map[string]string{"title": "title"}, crewFields)
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String("title", "", "")
+	flags.Bool("desc", false, "")
+	addCrewFlags(flags)
+	if err := flags.Parse([]string{"--director", "c1", "--title", "Dark", "--director", "c2", "--desc"}); err != nil {
+		t.Fatal(err)
+	}
+	mask, err := updateMask(flags, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Visit goes through the flags set in lexical order.
+	if want := []string{"directedBy", "title"}; !reflect.DeepEqual(mask.Paths, want) {
+		t.Errorf("the mask holds %q, want %q", mask.Paths, want)
+	}
+
+	flags = flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String("title", "", "")
+	if _, err := updateMask(flags, fields); err == nil {
+		t.Error("an update without any field flag was accepted")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+		err   bool
+	}{
+		{value: "2017-12-01", want: time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2017-12-01T20:30:00+01:00", want: time.Date(2017, 12, 1, 19, 30, 0, 0, time.UTC)},
+		{value: "01/12/2017", err: true},
+	}
+	for _, tt := range tests {
+		date, err := parseDate("release-date", tt.value)
+		if tt.err {
+			if err == nil || !strings.Contains(err.Error(), "--release-date") {
+				t.Errorf("parseDate(%q) returned %v, want an error naming the flag", tt.value, err)
+			}
+			continue
+		}
+		if err != nil || !date.AsTime().Equal(tt.want) {
+			t.Errorf("parseDate(%q) = %v, %v, want %v", tt.value, date, err, tt.want)
+		}
+	}
+	if date, err := parseDate("release-date", ""); date != nil || err != nil {
+		t.Errorf("parseDate of an empty value = %v, %v, want nothing", date, err)
+	}
+}
+
+func TestParseLength(t *testing.T) {
+	length, err := parseLength("1h30m")
+	if err != nil || length.Hours != 1 || length.Minutes != 30 {
+		t.Errorf("parseLength(1h30m) = %v, %v", length, err)
+	}
+	if length, err := parseLength("45m"); err != nil || length.Hours != 0 || length.Minutes != 45 {
+		t.Errorf("parseLength(45m) = %v, %v", length, err)
+	}
+	if length, err := parseLength(""); length != nil || err != nil {
+		t.Errorf("parseLength of an empty value = %v, %v, want nothing", length, err)
+	}
+	if _, err := parseLength("90"); err == nil {
+		t.Error("parseLength accepted a length without a unit")
+	}
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// columns are the fields printed in tables, by message name. Other messages
+// print every scalar field.
+var columns = map[protoreflect.Name][]protoreflect.Name{
+	"Article":          {"id", "title", "releaseDate", "journalist"},
+	"Celebrity":        {"id", "name", "occupation", "dateOfBirth", "placeOfBirth"},
+	"Episode":          {"id", "title", "seasonId", "showLength", "rating"},
+	"Genre":            {"id", "name", "description"},
+	"Journalist":       {"id", "name"},
+	"Season":           {"id", "title", "showId", "releaseDate", "rating", "episodes"},
+	"Show":             {"id", "title", "type", "releaseDate", "rating", "genres"},
+	"SearchHit":        {"type", "id", "title", "score"},
+	"ImportResult":     {"kind", "key", "id", "status", "error"},
+	"ConsistencyIssue": {"collection", "documentId", "field", "referenceId", "property", "expected", "found", "missing"},
+	"Webhook":          {"id", "url", "eventTypes", "secret"},
+	"DeadLetter":       {"id", "type", "entityId", "createdAt", "deliveries"},
+	"TrashItem":        {"id", "entityType", "name", "parentId", "deletedAt", "deletedBy"},
+	"Revision":         {"id", "number", "actor", "method", "createdAt"},
+}
+
+type printer interface {
+	print(m proto.Message) error
+}
+
+func newPrinter(format string, out io.Writer) (printer, error) {
+	switch format {
+	case "table":
+		return &tablePrinter{out}, nil
+	case "json":
+		return &jsonPrinter{out}, nil
+	case "yaml":
+		return &yamlPrinter{out}, nil
+	}
+	return nil, errors.New("unknown output format " + format + ", expected table, json or yaml")
+}
+
+type jsonPrinter struct {
+	out io.Writer
+}
+
+func (p *jsonPrinter) print(m proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.out, string(data))
+	return err
+}
+
+type yamlPrinter struct {
+	out io.Writer
+}
+
+// print converts the JSON form of m, so fields keep their JSON names and
+// their order.
+func (p *yamlPrinter) print(m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return err
+	}
+	blockStyle(node)
+	encoder := yaml.NewEncoder(p.out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func blockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// tablePrinter prints a row per item of the first list of a list response,
+// or a single row for other messages.
+type tablePrinter struct {
+	out io.Writer
+}
+
+func (p *tablePrinter) print(m proto.Message) error {
+	message := m.ProtoReflect()
+	rows := []protoreflect.Message{message}
+	var footer []string
+	if list := firstList(message); list != nil {
+		rows = []protoreflect.Message{}
+		for i := 0; i < message.Get(list).List().Len(); i++ {
+			rows = append(rows, message.Get(list).List().Get(i).Message())
+		}
+		footer = listFooter(message, list)
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(p.out, "No results")
+		for _, line := range footer {
+			fmt.Fprintln(p.out, line)
+		}
+		return nil
+	}
+
+	fields := tableFields(rows[0].Descriptor())
+	if len(fields) == 0 {
+		return nil
+	}
+	w := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	headers := []string{}
+	for _, field := range fields {
+		headers = append(headers, header(field.JSONName()))
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		values := []string{}
+		for _, field := range fields {
+			values = append(values, formatField(row, field))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, line := range footer {
+		fmt.Fprintln(p.out, line)
+	}
+	return nil
+}
+
+// firstList returns the repeated message field of a list response.
+func firstList(message protoreflect.Message) protoreflect.FieldDescriptor {
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.IsList() && field.Kind() == protoreflect.MessageKind {
+			return field
+		}
+	}
+	return nil
+}
+
+func listFooter(message protoreflect.Message, list protoreflect.FieldDescriptor) []string {
+	footer := []string{}
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field == list || field.IsList() || field.Kind() == protoreflect.MessageKind || !message.Has(field) {
+			continue
+		}
+		footer = append(footer, fmt.Sprintf("%s: %v", header(field.JSONName()), message.Get(field).Interface()))
+	}
+	return footer
+}
+
+func tableFields(descriptor protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := []protoreflect.FieldDescriptor{}
+	if names, ok := columns[descriptor.Name()]; ok {
+		for _, name := range names {
+			fields = append(fields, descriptor.Fields().ByName(name))
+		}
+		return fields
+	}
+	for i := 0; i < descriptor.Fields().Len(); i++ {
+		field := descriptor.Fields().Get(i)
+		if !field.IsList() && field.Kind() != protoreflect.MessageKind {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// header turns a JSON field name such as releaseDate into RELEASE DATE.
+func header(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+func formatField(message protoreflect.Message, field protoreflect.FieldDescriptor) string {
+	if !message.Has(field) && field.Kind() == protoreflect.MessageKind {
+		return ""
+	}
+	value := message.Get(field)
+	if field.IsList() {
+		items := []string{}
+		for i := 0; i < value.List().Len(); i++ {
+			if field.Kind() == protoreflect.MessageKind {
+				items = append(items, summary(value.List().Get(i).Message()))
+			} else {
+				items = append(items, fmt.Sprint(value.List().Get(i).Interface()))
+			}
+		}
+		return strings.Join(items, ", ")
+	}
+	if field.Kind() == protoreflect.MessageKind {
+		return summary(value.Message())
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// summary formats an embedded message in a table cell: a date, a length, the
+// names of a list of short documents, or the name or ID of a document.
+func summary(message protoreflect.Message) string {
+	switch m := message.Interface().(type) {
+	case *timestamppb.Timestamp:
+		t := m.AsTime()
+		if t.IsZero() || m.GetSeconds() == 0 && m.GetNanos() == 0 {
+			return ""
+		}
+		if t.Truncate(24 * time.Hour).Equal(t) {
+			return t.Format("2006-01-02")
+		}
+		return t.Format(time.RFC3339)
+	}
+	fields := message.Descriptor().Fields()
+	if message.Descriptor().Name() == "ShowLength" {
+		return fmt.Sprintf("%dh%02dm", message.Get(fields.ByName("hours")).Int(), message.Get(fields.ByName("minutes")).Int())
+	}
+	if message.Descriptor().Name() == "WebhookDelivery" {
+		return fmt.Sprintf("%s %s after %d attempts", message.Get(fields.ByName("url")).String(), message.Get(fields.ByName("status")).String(), message.Get(fields.ByName("attempts")).Int())
+	}
+	if list := firstList(message); list != nil && fields.Len() == 1 {
+		return formatField(message, list)
+	}
+	for _, name := range []protoreflect.Name{"name", "title", "id"} {
+		if field := fields.ByName(name); field != nil {
+			return message.Get(field).String()
+		}
+	}
+	return ""
+}
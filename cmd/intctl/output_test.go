@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	pb "int-service/_proto"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testShows() *pb.ShowListResponse {
+	return &pb.ShowListResponse{
+		Shows: []*pb.Show{
+			{Id: "s1", Title: "Dark", Type: "series", Rating: 8.7, ReleaseDate: timestamppb.New(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)),
+				Genres: &pb.ShortGenres{Genres: []*pb.ShortGenre{{Id: "g1", Name: "Mystery"}, {Id: "g2", Name: "Drama"}}}},
+			{Id: "s2", Title: "1899", Type: "series"},
+		},
+		NextPageToken: "Mg",
+		TotalSize:     3,
+	}
+}
+
+func TestHeader(t *testing.T) {
+	for name, want := range map[string]string{"id": "ID", "releaseDate": "RELEASE DATE", "nextPageToken": "NEXT PAGE TOKEN"} {
+		if got := header(name); got != want {
+			t.Errorf("header(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestTablePrinter(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := (&tablePrinter{out}).print(testShows()); err != nil {
+		t.Fatal(err)
+	}
+	want := "" +
+		"ID  TITLE  TYPE    RELEASE DATE  RATING  GENRES\n" +
+		"s1  Dark   series  2017-12-01    8.7     Mystery, Drama\n" +
+		"s2  1899   series                0       \n" +
+		"NEXT PAGE TOKEN: Mg\n" +
+		"TOTAL SIZE: 3\n"
+	if out.String() != want {
+		t.Errorf("the table of shows is\n%s\nwant\n%s", out, want)
+	}
+
+	out.Reset()
+	if err := (&tablePrinter{out}).print(&pb.ShowListResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "No results\n" {
+		t.Errorf("the table of no shows is %q", out)
+	}
+
+	out.Reset()
+	episode := &pb.Episode{Id: "e1", Title: "Secrets", SeasonId: "se1", ShowLength: &pb.ShowLength{Hours: 1, Minutes: 5}}
+	if err := (&tablePrinter{out}).print(episode); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(out.String(), "\n"); len(lines) != 3 || !strings.Contains(lines[1], "1h05m") {
+		t.Errorf("the table of an episode is %q, want a row with its length", out)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		date *timestamppb.Timestamp
+		want string
+	}{
+		{name: "date", date: timestamppb.New(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)), want: "2017-12-01"},
+		{name: "time", date: timestamppb.New(time.Date(2017, 12, 1, 20, 30, 0, 0, time.UTC)), want: "2017-12-01T20:30:00Z"},
+		{name: "unset", date: &timestamppb.Timestamp{}, want: ""},
+	}
+	for _, tt := range tests {
+		if got := summary(tt.date.ProtoReflect()); got != tt.want {
+			t.Errorf("%s: summary = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStructuredPrinters(t *testing.T) {
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{format: "json", want: []string{`"shows": [`, `"title": "Dark"`, `"releaseDate": "2017-12-01T00:00:00Z"`, `"nextPageToken": "Mg"`, `"totalSize": 3`}},
+		{format: "yaml", want: []string{"shows:\n  - id: s1\n    title: Dark", "releaseDate: \"2017-12-01T00:00:00Z\"", "nextPageToken: Mg", "totalSize: 3"}},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		p, err := newPrinter(tt.format, out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.print(testShows()); err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("the %s output\n%s\ndoes not contain %q", tt.format, out, want)
+			}
+		}
+	}
+	if _, err := newPrinter("xml", &bytes.Buffer{}); err == nil {
+		t.Error("newPrinter accepted the format xml")
+	}
+}
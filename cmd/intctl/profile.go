@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultServer = "localhost:2002"
+
+// profile holds the address of a server and the credentials to call it with.
+type profile struct {
+	Server string `json:"server"`
+	Token  string `json:"token,omitempty"`
+	// Tenant is sent with every call to pick the catalog of a tenant.
+	Tenant string `json:"tenant,omitempty"`
+	// TLS turns on TLS, which is implied by the TLS files.
+	TLS        bool   `json:"tls,omitempty"`
+	CAFile     string `json:"caFile,omitempty"`
+	CertFile   string `json:"certFile,omitempty"`
+	KeyFile    string `json:"keyFile,omitempty"`
+	ServerName string `json:"serverName,omitempty"`
+}
+
+type profiles struct {
+	Current  string              `json:"current"`
+	Profiles map[string]*profile `json:"profiles"`
+}
+
+func configPath(options globalOptions) (string, error) {
+	if options.configFile != "" {
+		return options.configFile, nil
+	}
+	if path := os.Getenv("INTCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "Error while finding the config directory")
+	}
+	return filepath.Join(dir, "intctl", "config.json"), nil
+}
+
+// loadProfiles reads the profiles file, which does not have to exist.
+func loadProfiles(options globalOptions) (*profiles, error) {
+	path, err := configPath(options)
+	if err != nil {
+		return nil, err
+	}
+	p := &profiles{Profiles: map[string]*profile{}}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the profiles file")
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding the profiles file "+path)
+	}
+	if p.Profiles == nil {
+		p.Profiles = map[string]*profile{}
+	}
+	return p, nil
+}
+
+func saveProfiles(options globalOptions, p *profiles) error {
+	path, err := configPath(options)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "Error while encoding the profiles")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrap(err, "Error while creating the config directory")
+	}
+	return errors.Wrap(os.WriteFile(path, append(data, '\n'), 0600), "Error while writing the profiles file")
+}
+
+// resolveProfile returns the selected profile with the overrides of the
+// command line and the environment applied.
+func resolveProfile(options globalOptions) (*profile, error) {
+	p, err := loadProfiles(options)
+	if err != nil {
+		return nil, err
+	}
+	name := options.profile
+	if name == "" {
+		name = p.Current
+	}
+	selected := &profile{Server: defaultServer}
+	if stored, ok := p.Profiles[name]; ok {
+		copied := *stored
+		selected = &copied
+	} else if options.profile != "" {
+		return nil, errors.New("Unknown profile " + options.profile)
+	}
+
+	if options.server != "" {
+		selected.Server = options.server
+	}
+	if token := os.Getenv("INTCTL_TOKEN"); token != "" {
+		selected.Token = token
+	}
+	if options.token != "" {
+		selected.Token = options.token
+	}
+	if options.tenant != "" {
+		selected.Tenant = options.tenant
+	}
+	return selected, nil
+}
+
+var profileCommands = map[string]command{
+	"set": {"set NAME [--server HOST:PORT] [--token TOKEN] [--tenant TENANT] [--tls] [--ca-file FILE] [--cert-file FILE] [--key-file FILE] [--server-name NAME]", func(flags *flag.FlagSet) runFunc {
+		values := profile{}
+		flags.BoolVar(&values.TLS, "tls", false, "connect over TLS")
+		flags.StringVar(&values.CAFile, "ca-file", "", "PEM CA certificates to verify the server with, instead of the system roots")
+		flags.StringVar(&values.CertFile, "cert-file", "", "PEM client certificate, for mutual TLS")
+		flags.StringVar(&values.KeyFile, "key-file", "", "PEM private key of the client certificate")
+		flags.StringVar(&values.ServerName, "server-name", "", "name expected in the server certificate, defaults to the host of the address")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "NAME"); err != nil {
+				return nil, err
+			}
+			p, err := loadProfiles(c.options)
+			if err != nil {
+				return nil, err
+			}
+			stored, ok := p.Profiles[args[0]]
+			if !ok {
+				stored = &profile{Server: defaultServer}
+				p.Profiles[args[0]] = stored
+			}
+			flags.Visit(func(f *flag.Flag) {
+				switch f.Name {
+				case "server":
+					stored.Server = c.options.server
+				case "token":
+					stored.Token = c.options.token
+				case "tenant":
+					stored.Tenant = c.options.tenant
+				case "tls":
+					stored.TLS = values.TLS
+				case "ca-file":
+					stored.CAFile = values.CAFile
+				case "cert-file":
+					stored.CertFile = values.CertFile
+				case "key-file":
+					stored.KeyFile = values.KeyFile
+				case "server-name":
+					stored.ServerName = values.ServerName
+				}
+			})
+			if p.Current == "" {
+				p.Current = args[0]
+			}
+			return nil, saveProfiles(c.options, p)
+		}
+	}},
+	"use": {"use NAME", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "NAME"); err != nil {
+				return nil, err
+			}
+			p, err := loadProfiles(c.options)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.Profiles[args[0]]; !ok {
+				return nil, errors.New("Unknown profile " + args[0])
+			}
+			p.Current = args[0]
+			return nil, saveProfiles(c.options, p)
+		}
+	}},
+	"delete": {"delete NAME", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "NAME"); err != nil {
+				return nil, err
+			}
+			p, err := loadProfiles(c.options)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.Profiles[args[0]]; !ok {
+				return nil, errors.New("Unknown profile " + args[0])
+			}
+			delete(p.Profiles, args[0])
+			if p.Current == args[0] {
+				p.Current = ""
+			}
+			return nil, saveProfiles(c.options, p)
+		}
+	}},
+	"list": {"list", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			p, err := loadProfiles(c.options)
+			if err != nil {
+				return nil, err
+			}
+			names := []string{}
+			for name := range p.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tTENANT\tTLS\tTOKEN")
+			for _, name := range names {
+				stored := p.Profiles[name]
+				current := ""
+				if name == p.Current {
+					current = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\n", current, name, stored.Server, stored.Tenant, stored.useTLS(), stored.Token != "")
+			}
+			return nil, w.Flush()
+		}
+	}},
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var revisionCommands = map[string]command{
+	"list": {"list KIND ID [--page-size N] [--page-token TOKEN]", func(flags *flag.FlagSet) runFunc {
+		pageSize := flags.Int("page-size", 0, "maximum number of revisions, 50 by default")
+		pageToken := flags.String("page-token", "", "token of the page to get, from the previous page")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "KIND", "ID"); err != nil {
+				return nil, err
+			}
+			revisions, err := c.revisions()
+			if err != nil {
+				return nil, err
+			}
+			return revisions.ListRevisions(ctx, &pb.ListRevisionsRequest{EntityType: args[0], EntityId: args[1], PageSize: int32(*pageSize), PageToken: *pageToken})
+		}
+	}},
+	"diff": {"diff FROM_REVISION TO_REVISION", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "FROM_REVISION", "TO_REVISION"); err != nil {
+				return nil, err
+			}
+			revisions, err := c.revisions()
+			if err != nil {
+				return nil, err
+			}
+			return revisions.GetRevisionDiff(ctx, &pb.GetRevisionDiffRequest{FromRevisionId: args[0], ToRevisionId: args[1]})
+		}
+	}},
+	"revert": {"revert REVISION", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "REVISION"); err != nil {
+				return nil, err
+			}
+			revisions, err := c.revisions()
+			if err != nil {
+				return nil, err
+			}
+			return revisions.RevertToRevision(ctx, &pb.RevertToRevisionRequest{RevisionId: args[0]})
+		}
+	}},
+}
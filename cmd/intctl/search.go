@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// searchCommands has a single unnamed command, so the query directly
+// follows the resource: intctl search "breaking bad".
+var searchCommands = map[string]command{
+	"": {"QUERY... [--type TYPE] [--page-size N] [--page-token TOKEN]", func(flags *flag.FlagSet) runFunc {
+		var types stringsFlag
+		flags.Var(&types, "type", "show, celebrity, episode or article, can be repeated")
+		pageSize := flags.Int("page-size", 0, "maximum number of hits")
+		pageToken := flags.String("page-token", "", "token of the page to get, from a previous call")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) == 0 {
+				return nil, expectArgs(args, "QUERY...")
+			}
+			search, err := c.search()
+			if err != nil {
+				return nil, err
+			}
+			return search.Search(ctx, &pb.SearchRequest{Query: strings.Join(args, " "), Types: types, PageSize: int32(*pageSize), PageToken: *pageToken})
+		}
+	}},
+}
+
+var adminCommands = map[string]command{
+	"consistency": {"consistency [--repair]", func(flags *flag.FlagSet) runFunc {
+		repair := flags.Bool("repair", false, "rewrite the stale and dangling embedded documents")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			admin, err := c.admin()
+			if err != nil {
+				return nil, err
+			}
+			return admin.CheckConsistency(ctx, &pb.CheckConsistencyRequest{Repair: *repair})
+		}
+	}},
+}
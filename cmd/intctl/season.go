@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// seasonFlags are the fields of a season set by the create and update
+// commands.
+type seasonFlags struct {
+	title       string
+	releaseDate string
+	rating      float64
+	resume      string
+	trailerURL  string
+	posters     stringsFlag
+	crew        *crewFlags
+}
+
+var seasonFields = mergeFields(map[string]string{
+	"title":        "title",
+	"release-date": "releaseDate",
+	"rating":       "rating",
+	"resume":       "resume",
+	"trailer":      "trailerUrl",
+	"poster":       "postersPath",
+}, crewFields)
+
+func addSeasonFlags(flags *flag.FlagSet) *seasonFlags {
+	s := &seasonFlags{}
+	flags.StringVar(&s.title, "title", "", "title of the season")
+	flags.StringVar(&s.releaseDate, "release-date", "", "release date, 2006-01-02")
+	flags.Float64Var(&s.rating, "rating", 0, "rating of the season")
+	flags.StringVar(&s.resume, "resume", "", "resume of the season")
+	flags.StringVar(&s.trailerURL, "trailer", "", "URL of the trailer")
+	flags.Var(&s.posters, "poster", "path of a poster, can be repeated")
+	s.crew = addCrewFlags(flags)
+	return s
+}
+
+func (s *seasonFlags) season(ctx context.Context, c *client) (*pb.Season, error) {
+	releaseDate, err := parseDate("release-date", s.releaseDate)
+	if err != nil {
+		return nil, err
+	}
+	directedBy, producedBy, writtenBy, err := s.crew.crews(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Season{
+		Title:       s.title,
+		TrailerUrl:  s.trailerURL,
+		PostersPath: s.posters,
+		Resume:      s.resume,
+		Rating:      s.rating,
+		ReleaseDate: releaseDate,
+		WrittenBy:   writtenBy,
+		ProducedBy:  producedBy,
+		DirectedBy:  directedBy,
+	}, nil
+}
+
+var seasonCommands = map[string]command{
+	"list": {"list [--show ID] [--released-after DATE] [--released-before DATE] [--min-rating N] [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		showID := flags.String("show", "", "only seasons of the show of this ID")
+		releasedAfter := flags.String("released-after", "", "only seasons released on or after this date")
+		releasedBefore := flags.String("released-before", "", "only seasons released before this date")
+		minRating := flags.Float64("min-rating", 0, "only seasons rated at least this")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			req := &pb.ListSeasonsRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending, ShowId: *showID, MinRating: *minRating}
+			var err error
+			if req.ReleasedAfter, err = parseDate("released-after", *releasedAfter); err != nil {
+				return nil, err
+			}
+			if req.ReleasedBefore, err = parseDate("released-before", *releasedBefore); err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			return seasons.ListSeasonsCollection(ctx, req)
+		}
+	}},
+	"get": {"get ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			return seasons.GetSeason(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --show ID --title TITLE [season flags]", func(flags *flag.FlagSet) runFunc {
+		showID := flags.String("show", "", "ID of the show of the season")
+		values := addSeasonFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			season, err := values.season(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			return seasons.CreateSeason(ctx, &pb.CreateSeasonRequest{
+				Title:       season.Title,
+				TrailerUrl:  season.TrailerUrl,
+				Resume:      season.Resume,
+				Rating:      season.Rating,
+				ReleaseDate: season.ReleaseDate,
+				WrittenBy:   season.WrittenBy,
+				ProducedBy:  season.ProducedBy,
+				DirectedBy:  season.DirectedBy,
+				PostersPath: season.PostersPath,
+				ShowId:      *showID,
+			})
+		}
+	}},
+	"update": {"update ID [season flags], writing only the fields of the given flags", func(flags *flag.FlagSet) runFunc {
+		values := addSeasonFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			mask, err := updateMask(flags, seasonFields)
+			if err != nil {
+				return nil, err
+			}
+			season, err := values.season(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			stored, err := seasons.GetSeason(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			season.Id = args[0]
+			season.ShowId = stored.ShowId
+			return seasons.PatchSeason(ctx, &pb.PatchSeasonRequest{Season: season, UpdateMask: mask})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			_, err = seasons.DeleteSeason(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"posters add": {"posters add ID PATH...", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) < 2 {
+				return nil, expectArgs(args, "ID", "PATH...")
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			return seasons.UploadSeasonPosters(ctx, &pb.UploadSeasonPostersRequest{SeasonId: args[0], PostersPath: args[1:]})
+		}
+	}},
+	"posters remove": {"posters remove ID IMAGE", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID", "IMAGE"); err != nil {
+				return nil, err
+			}
+			seasons, err := c.seasons()
+			if err != nil {
+				return nil, err
+			}
+			season, err := seasons.GetSeason(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			_, err = seasons.DeleteSeasonPoster(ctx, &pb.DeleteSeasonPosterRequest{SeriesId: season.ShowId, SeasonId: args[0], Image: args[1]})
+			return nil, err
+		}
+	}},
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// showFlags are the fields of a show set by the create and update commands.
+type showFlags struct {
+	title       string
+	showType    string
+	releaseDate string
+	endDate     string
+	rating      float64
+	length      string
+	trailerURL  string
+	description string
+	genres      stringsFlag
+	posters     stringsFlag
+	starring    stringsFlag
+	crew        *crewFlags
+}
+
+var showFields = mergeFields(map[string]string{
+	"title":        "title",
+	"type":         "type",
+	"release-date": "releaseDate",
+	"end-date":     "endDate",
+	"rating":       "rating",
+	"length":       "length",
+	"trailer":      "trailerUrl",
+	"description":  "description",
+	"genre":        "genres",
+	"poster":       "postersPath",
+	"star":         "starring",
+}, crewFields)
+
+func addShowFlags(flags *flag.FlagSet) *showFlags {
+	s := &showFlags{}
+	flags.StringVar(&s.title, "title", "", "title of the show")
+	flags.StringVar(&s.showType, "type", "", "type of the show, such as series or movie")
+	flags.StringVar(&s.releaseDate, "release-date", "", "release date, 2006-01-02")
+	flags.StringVar(&s.endDate, "end-date", "", "end date of a series, 2006-01-02")
+	flags.Float64Var(&s.rating, "rating", 0, "rating of the show")
+	flags.StringVar(&s.length, "length", "", "length of a movie, such as 2h15m")
+	flags.StringVar(&s.trailerURL, "trailer", "", "URL of the trailer")
+	flags.StringVar(&s.description, "description", "", "description of the show")
+	flags.Var(&s.genres, "genre", "name of a genre, can be repeated")
+	flags.Var(&s.posters, "poster", "path of a poster, can be repeated")
+	flags.Var(&s.starring, "star", "ID of a starring celebrity, optionally followed by :ROLE, can be repeated")
+	s.crew = addCrewFlags(flags)
+	return s
+}
+
+func (s *showFlags) show(ctx context.Context, c *client) (*pb.Show, error) {
+	releaseDate, err := parseDate("release-date", s.releaseDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDate("end-date", s.endDate)
+	if err != nil {
+		return nil, err
+	}
+	length, err := parseLength(s.length)
+	if err != nil {
+		return nil, err
+	}
+	genres := &pb.ShortGenres{Genres: []*pb.ShortGenre{}}
+	for _, name := range s.genres {
+		genre, err := c.genreByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		genres.Genres = append(genres.Genres, genre)
+	}
+	directedBy, producedBy, writtenBy, err := s.crew.crews(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	starring, err := c.starring(ctx, s.starring)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Show{
+		Title:       s.title,
+		Type:        s.showType,
+		PostersPath: s.posters,
+		ReleaseDate: releaseDate,
+		EndDate:     endDate,
+		Rating:      s.rating,
+		Length:      length,
+		TrailerUrl:  s.trailerURL,
+		Genres:      genres,
+		DirectedBy:  directedBy,
+		ProducedBy:  producedBy,
+		WrittenBy:   writtenBy,
+		Starring:    starring,
+		Description: s.description,
+	}, nil
+}
+
+var showCommands = map[string]command{
+	"list": {"list [--type TYPE] [--genre NAME] [--released-after DATE] [--released-before DATE] [--min-rating N] [paging flags]", func(flags *flag.FlagSet) runFunc {
+		list := addListFlags(flags)
+		showType := flags.String("type", "", "only shows of this type")
+		genre := flags.String("genre", "", "only shows of the genre of this name")
+		releasedAfter := flags.String("released-after", "", "only shows released on or after this date")
+		releasedBefore := flags.String("released-before", "", "only shows released before this date")
+		minRating := flags.Float64("min-rating", 0, "only shows rated at least this")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			req := &pb.ListShowsRequest{PageSize: int32(list.pageSize), PageToken: list.pageToken, SortBy: list.sortBy, Descending: list.descending, Type: *showType, MinRating: *minRating}
+			var err error
+			if req.ReleasedAfter, err = parseDate("released-after", *releasedAfter); err != nil {
+				return nil, err
+			}
+			if req.ReleasedBefore, err = parseDate("released-before", *releasedBefore); err != nil {
+				return nil, err
+			}
+			if *genre != "" {
+				found, err := c.genreByName(ctx, *genre)
+				if err != nil {
+					return nil, err
+				}
+				req.GenreId = found.Id
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			return shows.ListShows(ctx, req)
+		}
+	}},
+	"get": {"get ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			return shows.GetShow(ctx, &pb.GetByIDRequest{Id: args[0]})
+		}
+	}},
+	"create": {"create --title TITLE --type TYPE [--genre NAME]... [show flags]", func(flags *flag.FlagSet) runFunc {
+		values := addShowFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			show, err := values.show(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			return shows.CreateShow(ctx, &pb.CreateShowRequest{
+				Title:       show.Title,
+				Type:        show.Type,
+				PostersPath: show.PostersPath,
+				ReleaseDate: show.ReleaseDate,
+				EndDate:     show.EndDate,
+				Rating:      show.Rating,
+				Length:      show.Length,
+				TrailerUrl:  show.TrailerUrl,
+				Genres:      show.Genres,
+				DirectedBy:  show.DirectedBy,
+				ProducedBy:  show.ProducedBy,
+				WrittenBy:   show.WrittenBy,
+				Starring:    show.Starring,
+				Description: show.Description,
+			})
+		}
+	}},
+	"update": {"update ID [show flags], writing only the fields of the given flags", func(flags *flag.FlagSet) runFunc {
+		values := addShowFlags(flags)
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			mask, err := updateMask(flags, showFields)
+			if err != nil {
+				return nil, err
+			}
+			show, err := values.show(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			show.Id = args[0]
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			return shows.PatchShow(ctx, &pb.PatchShowRequest{Show: show, UpdateMask: mask})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			_, err = shows.DeleteShow(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"posters add": {"posters add ID PATH...", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if len(args) < 2 {
+				return nil, expectArgs(args, "ID", "PATH...")
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			show, err := shows.GetShow(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			if show.Type == "movie" {
+				return shows.UploadMoviePosters(ctx, &pb.UploadMoviePostersRequest{MovieId: args[0], PostersPath: args[1:]})
+			}
+			return shows.UploadSeriesPosters(ctx, &pb.UploadSeriesPostersRequest{SeriesId: args[0], PostersPath: args[1:]})
+		}
+	}},
+	"posters remove": {"posters remove ID IMAGE", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID", "IMAGE"); err != nil {
+				return nil, err
+			}
+			shows, err := c.shows()
+			if err != nil {
+				return nil, err
+			}
+			show, err := shows.GetShow(ctx, &pb.GetByIDRequest{Id: args[0]})
+			if err != nil {
+				return nil, err
+			}
+			if show.Type == "movie" {
+				_, err = shows.DeleteMoviePoster(ctx, &pb.DeleteMoviePosterRequest{MovieId: args[0], Image: args[1]})
+			} else {
+				_, err = shows.DeleteSeriesPoster(ctx, &pb.DeleteSeriesPosterRequest{SeriesId: args[0], Image: args[1]})
+			}
+			return nil, err
+		}
+	}},
+}
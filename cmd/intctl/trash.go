@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	pb "int-service/_proto"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// trashRPCs list and restore the deleted documents of a kind.
+type trashRPCs struct {
+	list    func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error)
+	restore func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error)
+}
+
+var trashKinds = map[string]trashRPCs{
+	"show": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedShows(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreShow(ctx, req)
+		},
+	},
+	"season": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedSeasons(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreSeason(ctx, req)
+		},
+	},
+	"episode": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedEpisodes(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreEpisode(ctx, req)
+		},
+	},
+	"celebrity": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedCelebrities(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreCelebrity(ctx, req)
+		},
+	},
+	"article": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedArticles(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreArticle(ctx, req)
+		},
+	},
+	"genre": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedGenres(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreGenre(ctx, req)
+		},
+	},
+	"journalist": {
+		list: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.ListTrashRequest) (proto.Message, error) {
+			return trash.ListTrashedJournalists(ctx, req)
+		},
+		restore: func(ctx context.Context, trash pb.TrashSvcClient, req *pb.GetByIDRequest) (proto.Message, error) {
+			return trash.RestoreJournalist(ctx, req)
+		},
+	},
+}
+
+func trashKind(kind string) error {
+	if _, ok := trashKinds[kind]; ok {
+		return nil
+	}
+	kinds := []string{}
+	for name := range trashKinds {
+		kinds = append(kinds, name)
+	}
+	sort.Strings(kinds)
+	return errors.New("unknown kind " + kind + ", expected one of " + strings.Join(kinds, ", "))
+}
+
+var trashCommands = map[string]command{
+	"list": {"list KIND [--page-size N] [--page-token TOKEN]", func(flags *flag.FlagSet) runFunc {
+		pageSize := flags.Int("page-size", 0, "maximum number of items, 50 by default")
+		pageToken := flags.String("page-token", "", "token of the page to get, from the previous page")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "KIND"); err != nil {
+				return nil, err
+			}
+			if err := trashKind(args[0]); err != nil {
+				return nil, err
+			}
+			trash, err := c.trash()
+			if err != nil {
+				return nil, err
+			}
+			return trashKinds[args[0]].list(ctx, trash, &pb.ListTrashRequest{PageSize: int32(*pageSize), PageToken: *pageToken})
+		}
+	}},
+	"restore": {"restore KIND ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "KIND", "ID"); err != nil {
+				return nil, err
+			}
+			if err := trashKind(args[0]); err != nil {
+				return nil, err
+			}
+			trash, err := c.trash()
+			if err != nil {
+				return nil, err
+			}
+			return trashKinds[args[0]].restore(ctx, trash, &pb.GetByIDRequest{Id: args[1]})
+		}
+	}},
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	pb "int-service/_proto"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// watchCommands has a single unnamed command printing the changes of the
+// catalog as they happen: intctl watch --type show.
+var watchCommands = map[string]command{
+	"": {"[--type TYPE] [--resume-token TOKEN]", func(flags *flag.FlagSet) runFunc {
+		var types stringsFlag
+		flags.Var(&types, "type", "show, season, episode, celebrity, article, genre or journalist, can be repeated")
+		resumeToken := flags.String("resume-token", "", "token of the last change seen, to print the ones that followed it")
+		// Watching lasts until interrupted unless a timeout is given.
+		flags.Set("timeout", "0")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			watch, err := c.watch()
+			if err != nil {
+				return nil, err
+			}
+			stream, err := watch.WatchChanges(ctx, &pb.WatchChangesRequest{EntityTypes: types, ResumeToken: *resumeToken})
+			if err != nil {
+				return nil, err
+			}
+			printEvent := eventPrinter(c.options.output, c.out)
+			for {
+				event, err := stream.Recv()
+				if err == io.EOF {
+					return nil, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				if err := printEvent(event); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}},
+}
+
+// eventPrinter prints an event per line in the table format, and every
+// event as a document of its own in the other formats.
+func eventPrinter(format string, out io.Writer) func(event *pb.ChangeEvent) error {
+	if format != "table" {
+		return func(event *pb.ChangeEvent) error {
+			p, err := newPrinter(format, out)
+			if err != nil {
+				return err
+			}
+			return p.print(event)
+		}
+	}
+	header := false
+	return func(event *pb.ChangeEvent) error {
+		if !header {
+			fmt.Fprintf(out, "%-20s  %-9s  %-10s  %-36s  %s\n", "TIME", "OPERATION", "TYPE", "ID", "RESUME TOKEN")
+			header = true
+		}
+		_, err := fmt.Fprintf(out, "%-20s  %-9s  %-10s  %-36s  %s\n", event.Time.AsTime().Format(time.RFC3339), event.Operation, event.EntityType, event.Id, event.ResumeToken)
+		return err
+	}
+}
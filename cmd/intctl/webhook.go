@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	pb "int-service/_proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var webhookCommands = map[string]command{
+	"register": {"register URL [--secret SECRET] [--event TYPE]...", func(flags *flag.FlagSet) runFunc {
+		secret := flags.String("secret", "", "key of the request signatures, generated when empty")
+		var events stringsFlag
+		flags.Var(&events, "event", "type of the events to receive, such as show.created, can be repeated, all of them by default")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "URL"); err != nil {
+				return nil, err
+			}
+			webhooks, err := c.webhooks()
+			if err != nil {
+				return nil, err
+			}
+			return webhooks.RegisterWebhook(ctx, &pb.RegisterWebhookRequest{Url: args[0], Secret: *secret, EventTypes: events})
+		}
+	}},
+	"list": {"list", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			webhooks, err := c.webhooks()
+			if err != nil {
+				return nil, err
+			}
+			return webhooks.ListWebhooks(ctx, &pb.ListWebhooksRequest{})
+		}
+	}},
+	"delete": {"delete ID", func(flags *flag.FlagSet) runFunc {
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args, "ID"); err != nil {
+				return nil, err
+			}
+			webhooks, err := c.webhooks()
+			if err != nil {
+				return nil, err
+			}
+			_, err = webhooks.DeleteWebhook(ctx, &pb.GetByIDRequest{Id: args[0]})
+			return nil, err
+		}
+	}},
+	"dead-letters": {"dead-letters [--page-size N] [--page-token TOKEN]", func(flags *flag.FlagSet) runFunc {
+		pageSize := flags.Int("page-size", 0, "maximum number of items, 50 by default")
+		pageToken := flags.String("page-token", "", "token of the page to get, from the previous page")
+		return func(ctx context.Context, c *client, args []string) (proto.Message, error) {
+			if err := expectArgs(args); err != nil {
+				return nil, err
+			}
+			webhooks, err := c.webhooks()
+			if err != nil {
+				return nil, err
+			}
+			return webhooks.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{PageSize: int32(*pageSize), PageToken: *pageToken})
+		}
+	}},
+}
@@ -54,146 +54,251 @@ type GenderDTO string
 type ShowsDTO []*ShowDTO
 
 type ShowDTO struct {
-	ID          string              `bson:"id"`
-	Title       string              `bson:"title"`
-	Type        string              `bson:"type"`
-	PostersPath []string            `bson:"postersPath"`
-	ReleaseDate time.Time           `bson:"releaseDate"`
-	EndDate     time.Time           `bson:"endDate"`
-	Rating      float64             `bson:"rating"`
-	Length      ShowLengthDTO       `bson:"length"`
-	TrailerURL  string              `bson:"trailerUrl"`
-	Genres      ShortGenresDTO      `bson:"genres"`
-	DirectedBy  FilmCrewsDTO        `bson:"directedBy"`
-	ProducedBy  FilmCrewsDTO        `bson:"producedBy"`
-	WrittenBy   FilmCrewsDTO        `bson:"writtenBy"`
-	Starring    ShortCelebritiesDTO `bson:"starring"`
-	Description string              `bson:"description"`
-	Seasons     ShortSeasonsDTO     `bson:"seasons"`
+	ID          string              `json:"id" xml:"id" bson:"id"`
+	Title       string              `json:"title" xml:"title" bson:"title"`
+	Type        string              `json:"type" xml:"type" bson:"type"`
+	PostersPath []string            `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	ReleaseDate time.Time           `json:"releaseDate" xml:"releaseDate" bson:"releaseDate"`
+	EndDate     time.Time           `json:"endDate" xml:"endDate" bson:"endDate"`
+	Rating      float64             `json:"rating" xml:"rating" bson:"rating"`
+	Length      ShowLengthDTO       `json:"length" xml:"length" bson:"length"`
+	TrailerURL  string              `json:"trailerUrl" xml:"trailerUrl" bson:"trailerUrl"`
+	Genres      ShortGenresDTO      `json:"genres" xml:"genres" bson:"genres"`
+	DirectedBy  FilmCrewsDTO        `json:"directedBy" xml:"directedBy" bson:"directedBy"`
+	ProducedBy  FilmCrewsDTO        `json:"producedBy" xml:"producedBy" bson:"producedBy"`
+	WrittenBy   FilmCrewsDTO        `json:"writtenBy" xml:"writtenBy" bson:"writtenBy"`
+	Starring    ShortCelebritiesDTO `json:"starring" xml:"starring" bson:"starring"`
+	Description string              `json:"description" xml:"description" bson:"description"`
+	Seasons     ShortSeasonsDTO     `json:"seasons" xml:"seasons" bson:"seasons"`
+	// DeletedAt marks a document deleted to the trash, which reads skip.
+	DeletedAt *time.Time `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ShortGenresDTO []*ShortGenreDTO
 
 type ShortGenreDTO struct {
-	ID   string `bson:"id"`
-	Name string `bson:"name"`
+	ID   string `json:"id" xml:"id" bson:"id"`
+	Name string `json:"name" xml:"name" bson:"name"`
 }
 
 type ShortSeasonsDTO []*ShortSeasonDTO
 
 type ShortSeasonDTO struct {
-	ID          string   `bson:"id"`
-	Title       string   `bson:"title"`
-	PostersPath []string `bson:"postersPath"`
-	Rating      float64  `bson:"rating"`
+	ID          string   `json:"id" xml:"id" bson:"id"`
+	Title       string   `json:"title" xml:"title" bson:"title"`
+	PostersPath []string `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	Rating      float64  `json:"rating" xml:"rating" bson:"rating"`
 }
 
 type SeasonsDTO []*SeasonDTO
 
 type SeasonDTO struct {
-	ID          string           `bson:"id"`
-	ShowID      string           `bson:"showId"`
-	Title       string           `bson:"title"`
-	TrailerURL  string           `bson:"trailerUrl"`
-	PostersPath []string         `bson:"postersPath"`
-	Resume      string 	         `bson:"resume"`
-	Rating      float64          `bson:"rating"`
-	ReleaseDate time.Time        `bson:"releaseDate"`
-	WrittenBy   FilmCrewsDTO     `bson:"writtenBy"`
-	ProducedBy  FilmCrewsDTO     `bson:"producedBy"`
-	DirectedBy  FilmCrewsDTO     `bson:"directedBy"`
-	Episodes    ShortEpisodesDTO `bson:"episodes"`
+	ID          string           `json:"id" xml:"id" bson:"id"`
+	ShowID      string           `json:"showId" xml:"showId" bson:"showId"`
+	Title       string           `json:"title" xml:"title" bson:"title"`
+	TrailerURL  string           `json:"trailerUrl" xml:"trailerUrl" bson:"trailerUrl"`
+	PostersPath []string         `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	Resume      string           `json:"resume" xml:"resume" bson:"resume"`
+	Rating      float64          `json:"rating" xml:"rating" bson:"rating"`
+	ReleaseDate time.Time        `json:"releaseDate" xml:"releaseDate" bson:"releaseDate"`
+	WrittenBy   FilmCrewsDTO     `json:"writtenBy" xml:"writtenBy" bson:"writtenBy"`
+	ProducedBy  FilmCrewsDTO     `json:"producedBy" xml:"producedBy" bson:"producedBy"`
+	DirectedBy  FilmCrewsDTO     `json:"directedBy" xml:"directedBy" bson:"directedBy"`
+	Episodes    ShortEpisodesDTO `json:"episodes" xml:"episodes" bson:"episodes"`
+	DeletedAt   *time.Time       `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ShortEpisodesDTO []*ShortEpisodeDTO
 
 type ShortEpisodeDTO struct {
-	ID          string   `bson:"id"`
-	Title       string   `bson:"title"`
-	PostersPath []string `bson:"postersPath"`
-	Rating      float64  `bson:"rating"`
-	Resume      string   `bson:"resume"`
+	ID          string   `json:"id" xml:"id" bson:"id"`
+	Title       string   `json:"title" xml:"title" bson:"title"`
+	PostersPath []string `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	Rating      float64  `json:"rating" xml:"rating" bson:"rating"`
+	Resume      string   `json:"resume" xml:"resume" bson:"resume"`
 }
 
 type EpisodesDTO []*EpisodeDTO
 
 type EpisodeDTO struct {
-	ID          string              `bson:"id"`
-	SeasonID    string              `bson:"seasonId"`
-	Title       string              `bson:"title"`
-	PostersPath []string            `bson:"postersPath"`
-	TrailerURL  string              `bson:"trailerUrl"`
-	Length      ShowLengthDTO       `bson:"length"`
-	Rating      float64             `bson:"rating"`
-	Resume      string              `bson:"resume"`
-	WrittenBy   FilmCrewsDTO        `bson:"writtenBy"`
-	ProducedBy  FilmCrewsDTO        `bson:"producedBy"`
-	DirectedBy  FilmCrewsDTO        `bson:"directedBy"`
-	Starring    ShortCelebritiesDTO `bson:"starring"`
+	ID          string              `json:"id" xml:"id" bson:"id"`
+	SeasonID    string              `json:"seasonId" xml:"seasonId" bson:"seasonId"`
+	Title       string              `json:"title" xml:"title" bson:"title"`
+	PostersPath []string            `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	TrailerURL  string              `json:"trailerUrl" xml:"trailerUrl" bson:"trailerUrl"`
+	Length      ShowLengthDTO       `json:"length" xml:"length" bson:"length"`
+	Rating      float64             `json:"rating" xml:"rating" bson:"rating"`
+	Resume      string              `json:"resume" xml:"resume" bson:"resume"`
+	WrittenBy   FilmCrewsDTO        `json:"writtenBy" xml:"writtenBy" bson:"writtenBy"`
+	ProducedBy  FilmCrewsDTO        `json:"producedBy" xml:"producedBy" bson:"producedBy"`
+	DirectedBy  FilmCrewsDTO        `json:"directedBy" xml:"directedBy" bson:"directedBy"`
+	Starring    ShortCelebritiesDTO `json:"starring" xml:"starring" bson:"starring"`
+	DeletedAt   *time.Time          `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ShortCelebritiesDTO []*ShortCelebrityDTO
 
 type ShortCelebrityDTO struct {
-	ID          string   `bson:"id"`
-	Name        string   `bson:"name"`
-	RoleName    string   `bson:"roleName"`
-	PostersPath []string `bson:"postersPath"`
+	ID          string   `json:"id" xml:"id" bson:"id"`
+	Name        string   `json:"name" xml:"name" bson:"name"`
+	RoleName    string   `json:"roleName" xml:"roleName" bson:"roleName"`
+	PostersPath []string `json:"postersPath" xml:"postersPath" bson:"postersPath"`
 }
 
 type FilmCrewsDTO []*FilmCrewDTO
 
 type FilmCrewDTO struct {
-	ID          string   `bson:"id"`
-	Name        string   `bson:"name"`
-	PostersPath []string `bson:"postersPath"`
+	ID          string   `json:"id" xml:"id" bson:"id"`
+	Name        string   `json:"name" xml:"name" bson:"name"`
+	PostersPath []string `json:"postersPath" xml:"postersPath" bson:"postersPath"`
 }
 
 type CelebritiesDTO []*CelebrityDTO
 
 type CelebrityDTO struct {
-	ID           string    `bson:"id"`
-	Name         string    `bson:"name"`
-	Occupation   []string  `bson:"occupation"`
-	PostersPath  []string  `bson:"postersPath"`
-	DateOfBirth  time.Time `bson:"dateOfBirth"`
-	DateOfDeath  time.Time `bson:"dateOfDeath"`
-	PlaceOfBirth string    `bson:"placeOfBirth"`
-	Gender       GenderDTO `bson:"gender"`
-	Bio          string    `bson:"bio"`
+	ID           string     `json:"id" xml:"id" bson:"id"`
+	Name         string     `json:"name" xml:"name" bson:"name"`
+	Occupation   []string   `json:"occupation" xml:"occupation" bson:"occupation"`
+	PostersPath  []string   `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	DateOfBirth  time.Time  `json:"dateOfBirth" xml:"dateOfBirth" bson:"dateOfBirth"`
+	DateOfDeath  time.Time  `json:"dateOfDeath" xml:"dateOfDeath" bson:"dateOfDeath"`
+	PlaceOfBirth string     `json:"placeOfBirth" xml:"placeOfBirth" bson:"placeOfBirth"`
+	Gender       GenderDTO  `json:"gender" xml:"gender" bson:"gender"`
+	Bio          string     `json:"bio" xml:"bio" bson:"bio"`
+	DeletedAt    *time.Time `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ArticlesDTO []*ArticleDTO
 
 type ArticleDTO struct {
-	ID          string             `bson:"id"`
-	Title       string             `bson:"title"`
-	ReleaseDate time.Time          `bson:"releaseDate"`
-	PostersPath []string           `bson:"postersPath"`
-	Description string             `bson:"description"`
-	Journalist  ShortJournalistDTO `bson:"journalist"`
+	ID          string             `json:"id" xml:"id" bson:"id"`
+	Title       string             `json:"title" xml:"title" bson:"title"`
+	ReleaseDate time.Time          `json:"releaseDate" xml:"releaseDate" bson:"releaseDate"`
+	PostersPath []string           `json:"postersPath" xml:"postersPath" bson:"postersPath"`
+	Description string             `json:"description" xml:"description" bson:"description"`
+	Journalist  ShortJournalistDTO `json:"journalist" xml:"journalist" bson:"journalist"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type JournalistsDTO []*JournalistDTO
 
 type JournalistDTO struct {
-	ID   string `bson:"id"`
-	Name string `bson:"name"`
+	ID        string     `json:"id" xml:"id" bson:"id"`
+	Name      string     `json:"name" xml:"name" bson:"name"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ShortJournalistDTO struct {
-	ID string `bson:"id"`
+	ID string `json:"id" xml:"id" bson:"id"`
 }
 
 type GenresDTO []*GenreDTO
 
 type GenreDTO struct {
-	ID          string `bson:"id"`
-	Name        string `bson:"name"`
-	Description string `bson:"description"`
+	ID          string     `json:"id" xml:"id" bson:"id"`
+	Name        string     `json:"name" xml:"name" bson:"name"`
+	Description string     `json:"description" xml:"description" bson:"description"`
+	DeletedAt   *time.Time `json:"deletedAt,omitempty" xml:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 type ShowLengthDTO struct {
-	Hours   int `bson:"hours"`
-	Minutes int `bson:"minutes"`
+	Hours   int `json:"hours" xml:"hours" bson:"hours"`
+	Minutes int `json:"minutes" xml:"minutes" bson:"minutes"`
+}
+
+type SearchHitsDTO []*SearchHitDTO
+
+// SearchHitDTO is a search match of any type, with the text of its main
+// searchable fields.
+type SearchHitDTO struct {
+	Type  string
+	ID    string
+	Title string
+	Text  string
+	Score float64
+}
+
+// ChangeEventDTO is a committed change of a document. Document holds the
+// document after the change, such as a *ShowDTO, and is nil for deletions.
+type ChangeEventDTO struct {
+	EntityType  string
+	Operation   string
+	ID          string
+	Document    interface{}
+	ResumeToken string
+	Time        time.Time
+}
+
+type OutboxEventsDTO []*OutboxEventDTO
+
+// OutboxEventDTO is a catalog event written with the change it reports, and
+// the state of its delivery to every webhook it was dispatched to. Pending
+// and NextAttemptAt summarize the deliveries so the due events can be found
+// with an index.
+type OutboxEventDTO struct {
+	ID            string                `json:"id" xml:"id" bson:"id"`
+	Type          string                `json:"type" xml:"type" bson:"type"`
+	EntityType    string                `json:"entityType" xml:"entityType" bson:"entityType"`
+	EntityID      string                `json:"entityId" xml:"entityId" bson:"entityId"`
+	Payload       string                `json:"payload" xml:"payload" bson:"payload"`
+	CreatedAt     time.Time             `json:"createdAt" xml:"createdAt" bson:"createdAt"`
+	Dispatched    bool                  `json:"dispatched" xml:"dispatched" bson:"dispatched"`
+	Pending       bool                  `json:"pending" xml:"pending" bson:"pending"`
+	Dead          bool                  `json:"dead" xml:"dead" bson:"dead"`
+	NextAttemptAt time.Time             `json:"nextAttemptAt" xml:"nextAttemptAt" bson:"nextAttemptAt"`
+	Deliveries    []*WebhookDeliveryDTO `json:"deliveries" xml:"deliveries" bson:"deliveries"`
+}
+
+type WebhookDeliveryDTO struct {
+	WebhookID     string    `json:"webhookId" xml:"webhookId" bson:"webhookId"`
+	URL           string    `json:"url" xml:"url" bson:"url"`
+	Status        string    `json:"status" xml:"status" bson:"status"`
+	Attempts      int       `json:"attempts" xml:"attempts" bson:"attempts"`
+	NextAttemptAt time.Time `json:"nextAttemptAt" xml:"nextAttemptAt" bson:"nextAttemptAt"`
+	LastError     string    `json:"lastError" xml:"lastError" bson:"lastError"`
+}
+
+type WebhooksDTO []*WebhookDTO
+
+// WebhookDTO is an endpoint receiving the events of EventTypes, or every
+// event when empty, signed with Secret.
+type WebhookDTO struct {
+	ID         string    `json:"id" xml:"id" bson:"id"`
+	URL        string    `json:"url" xml:"url" bson:"url"`
+	Secret     string    `json:"secret" xml:"secret" bson:"secret"`
+	EventTypes []string  `json:"eventTypes" xml:"eventTypes" bson:"eventTypes"`
+	CreatedAt  time.Time `json:"createdAt" xml:"createdAt" bson:"createdAt"`
+}
+
+type TrashItemsDTO []*TrashItemDTO
+
+// TrashItemDTO is a deleted document kept until it is restored or purged.
+// Payload holds the JSON of the documents the delete removed, the deleted one
+// and the ones it cascaded to, so a restore can write them back. ParentID is
+// the show of a season, the season of an episode or the journalist of an
+// article.
+type TrashItemDTO struct {
+	ID         string    `json:"id" xml:"id" bson:"id"`
+	EntityType string    `json:"entityType" xml:"entityType" bson:"entityType"`
+	Name       string    `json:"name" xml:"name" bson:"name"`
+	ParentID   string    `json:"parentId" xml:"parentId" bson:"parentId"`
+	DeletedAt  time.Time `json:"deletedAt" xml:"deletedAt" bson:"deletedAt"`
+	DeletedBy  string    `json:"deletedBy" xml:"deletedBy" bson:"deletedBy"`
+	Payload    string    `json:"payload" xml:"payload" bson:"payload"`
+}
+
+type RevisionsDTO []*RevisionDTO
+
+// RevisionDTO is the state of a document after one of its creates or
+// updates. Number counts the revisions of the document from 1, and Snapshot
+// holds the JSON of the whole document.
+type RevisionDTO struct {
+	ID         string    `json:"id" xml:"id" bson:"id"`
+	EntityType string    `json:"entityType" xml:"entityType" bson:"entityType"`
+	EntityID   string    `json:"entityId" xml:"entityId" bson:"entityId"`
+	Number     int64     `json:"number" xml:"number" bson:"number"`
+	Actor      string    `json:"actor" xml:"actor" bson:"actor"`
+	Method     string    `json:"method" xml:"method" bson:"method"`
+	CreatedAt  time.Time `json:"createdAt" xml:"createdAt" bson:"createdAt"`
+	Snapshot   string    `json:"snapshot" xml:"snapshot" bson:"snapshot"`
 }
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+// PageDTO selects a window of a list. A zero Limit returns every document
+// after Offset and an empty SortBy keeps the natural order of the store.
+type PageDTO struct {
+	Offset     int64
+	Limit      int64
+	SortBy     string
+	Descending bool
+}
+
+type ShowFilterDTO struct {
+	Type           string
+	GenreID        string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+	MinRating      float64
+}
+
+type SeasonFilterDTO struct {
+	ShowID         string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+	MinRating      float64
+}
+
+type EpisodeFilterDTO struct {
+	SeasonID  string
+	MinRating float64
+}
+
+type CelebrityFilterDTO struct {
+	Occupation string
+}
+
+type ArticleFilterDTO struct {
+	JournalistID   string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+}
+
+type TrashFilterDTO struct {
+	EntityType string
+	ParentID   string
+}
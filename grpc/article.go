@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "int-service/_proto"
 	"int-service/models"
 )
@@ -10,10 +9,10 @@ import (
 func (s *GrpcServerProject) CreateArticle(ctx context.Context, req *pb.CreateArticleRequest) (*pb.Article, error) {
 	releaseDate := req.ReleaseDate.AsTime()
 	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+		return nil, models.NewFieldError("releaseDate", err.Error())
 	}
 	journalist := models.Journalist{
-		Name: req.Journalist.Name,
+		Name: req.GetJournalist().GetName(),
 	}
 	resp, err := s.service.CreateArticle(ctx, req.Title, releaseDate, req.PostersPath, req.Description, journalist.Name)
 	if err != nil {
@@ -33,10 +32,10 @@ func (s *GrpcServerProject) GetArticle(ctx context.Context, req *pb.GetByIDReque
 func (s *GrpcServerProject) UpdateArticle(ctx context.Context, req *pb.Article) (*pb.Article, error) {
 	releaseDate := req.ReleaseDate.AsTime()
 	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+		return nil, models.NewFieldError("releaseDate", err.Error())
 	}
 	journalist := models.Journalist{
-		ID: req.Journalist.Id,
+		ID: req.GetJournalist().GetId(),
 	}
 	resp, err := s.service.UpdateArticle(ctx, req.Id, req.Title, releaseDate, req.PostersPath, req.Description, &journalist)
 	if err != nil {
@@ -46,13 +45,33 @@ func (s *GrpcServerProject) UpdateArticle(ctx context.Context, req *pb.Article)
 }
 
 func (s *GrpcServerProject) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (*pb.ArticleListResponse, error) {
-	resp, err := s.service.ListArticles(ctx,int(req.ElementCount))
+	releasedAfter, err := toOptionalTime("releasedAfter", req.ReleasedAfter)
+	if err != nil {
+		return nil, err
+	}
+	releasedBefore, err := toOptionalTime("releasedBefore", req.ReleasedBefore)
+	if err != nil {
+		return nil, err
+	}
+	filter := models.ArticleFilter{
+		JournalistID:   req.JournalistId,
+		ReleasedAfter:  releasedAfter,
+		ReleasedBefore: releasedBefore,
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = req.ElementCount
+	}
+	resp, err := s.service.ListArticles(ctx, filter, toListOptions(pageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	articles := &pb.ArticleListResponse{}
-	for _, article := range resp {
+	articles := &pb.ArticleListResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, article := range resp.Items {
 		articles.Articles = append(articles.Articles, article.ToGrpc().(*pb.Article))
 	}
 	return articles, nil
@@ -74,7 +93,7 @@ func (s *GrpcServerProject) ListArticlesByJournalist(ctx context.Context, req *p
 func (s *GrpcServerProject) UploadArticlePosters(ctx context.Context, req *pb.UploadArticlePostersRequest) (*pb.Article, error) {
 	resp, err := s.service.UploadArticlePosters(ctx, req.ArticleId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while updating article posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Article), nil
 }
@@ -82,7 +101,15 @@ func (s *GrpcServerProject) UploadArticlePosters(ctx context.Context, req *pb.Up
 func (s *GrpcServerProject) DeleteArticlePoster(ctx context.Context, req *pb.DeleteArticlePosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteArticlePoster(ctx, req.ArticleId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while updating deleted article poster")
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
+func (s *GrpcServerProject) DeleteArticle(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteArticle(ctx, req.Id)
+	if err != nil {
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
 }
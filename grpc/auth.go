@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"int-service/auth"
+	"int-service/models"
+	"int-service/service"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// AuthUnaryInterceptor authenticates the bearer token of the call and checks
+// the policy lets its roles call the method. The health and reflection
+// services of the grpc package stay public.
+func AuthUnaryInterceptor(authenticator *auth.Authenticator, policy auth.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, authenticator, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptor(authenticator *auth.Authenticator, policy auth.Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), authenticator, policy, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ss, ctx})
+	}
+}
+
+// authorize returns ctx with the principal of the call, and with its
+// subject in the request logger.
+func authorize(ctx context.Context, authenticator *auth.Authenticator, policy auth.Policy, method string) (context.Context, error) {
+	if strings.HasPrefix(method, "/grpc.") {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization := md.Get("authorization")
+	if len(authorization) == 0 {
+		return nil, errors.Wrap(models.ErrUnauthenticated, "Missing authorization header")
+	}
+	token, err := auth.BearerToken(authorization[0])
+	if err != nil {
+		return nil, err
+	}
+	principal, err := authenticator.Authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Allows(principal.Roles, method) {
+		return nil, errors.Wrap(models.ErrPermissionDenied, "Roles ["+strings.Join(principal.Roles, ", ")+"] cannot call "+method)
+	}
+
+	ctx = auth.ContextWithPrincipal(ctx, principal)
+	if entry := service.LoggerFromContext(ctx); entry != nil {
+		ctx = service.ContextWithLogger(ctx, entry.WithField("user", principal.Subject))
+	}
+	return ctx, nil
+}
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "int-service/_proto"
 	"int-service/models"
 	"time"
@@ -11,12 +10,13 @@ import (
 func (s *GrpcServerProject) CreateCelebrity(ctx context.Context, req *pb.CreateCelebrityRequest) (*pb.Celebrity, error) {
 	dateOfBirth := req.DateOfBirth.AsTime()
 	if err := req.DateOfBirth.CheckValid(); err != nil {
-		return nil, errors.New("error while converting dateOfBirth")
+		return nil, models.NewFieldError("dateOfBirth", err.Error())
 	}
 	var dateOfDeath time.Time
 	if req.DateOfDeath != nil {
+		dateOfDeath = req.DateOfDeath.AsTime()
 		if err := req.DateOfDeath.CheckValid(); err != nil {
-			return nil, errors.New("error while converting dateOfDeath")
+			return nil, models.NewFieldError("dateOfDeath", err.Error())
 		}
 	}
 
@@ -37,22 +37,31 @@ func (s *GrpcServerProject) GetCelebrity(ctx context.Context, req *pb.GetByIDReq
 }
 
 func (s *GrpcServerProject) UpdateCelebrity(ctx context.Context, req *pb.Celebrity) (*pb.Celebrity, error) {
-	dateOfBirth := req.DateOfBirth.AsTime()
-	if err := req.DateOfBirth.CheckValid(); err != nil {
-		return nil, errors.New("error while converting dateOfBirth")
+	return s.PatchCelebrity(ctx, &pb.PatchCelebrityRequest{Celebrity: req})
+}
+
+func (s *GrpcServerProject) PatchCelebrity(ctx context.Context, req *pb.PatchCelebrityRequest) (*pb.Celebrity, error) {
+	celebrity := req.GetCelebrity()
+	updateMask := toUpdateMask(req.UpdateMask, &pb.Celebrity{}, nil)
+	dateOfBirth := celebrity.GetDateOfBirth().AsTime()
+	if inUpdateMask(updateMask, "dateOfBirth") {
+		if err := celebrity.GetDateOfBirth().CheckValid(); err != nil {
+			return nil, models.NewFieldError("dateOfBirth", err.Error())
+		}
 	}
 	var dateOfDeath time.Time
-	if req.DateOfDeath != nil {
-		if err := req.DateOfDeath.CheckValid(); err != nil {
-			return nil, errors.New("error while converting dateOfDeath")
+	if celebrity.GetDateOfDeath() != nil {
+		dateOfDeath = celebrity.DateOfDeath.AsTime()
+		if err := celebrity.DateOfDeath.CheckValid(); err != nil {
+			return nil, models.NewFieldError("dateOfDeath", err.Error())
 		}
 	}
 
-	gender := models.Gender(req.Gender)
+	gender := models.Gender(celebrity.GetGender())
 
-	resp, err := s.service.UpdateCelebrity(ctx, req.Id, req.Name, req.Occupation, req.PostersPath, dateOfBirth, dateOfDeath, req.PlaceOfBirth, &gender, req.Bio)
+	resp, err := s.service.UpdateCelebrity(ctx, celebrity.GetId(), celebrity.GetName(), celebrity.GetOccupation(), celebrity.GetPostersPath(), dateOfBirth, dateOfDeath, celebrity.GetPlaceOfBirth(), &gender, celebrity.GetBio(), updateMask)
 	if err != nil {
-		return nil, errors.New("error while updating celebrity")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Celebrity), nil
 }
@@ -60,7 +69,7 @@ func (s *GrpcServerProject) UpdateCelebrity(ctx context.Context, req *pb.Celebri
 func (s *GrpcServerProject) UploadCelebrityPosters(ctx context.Context, req *pb.UploadCelebrityPostersRequest) (*pb.Celebrity, error) {
 	resp, err := s.service.UploadCelebrityPosters(ctx, req.CelebrityId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while uploading celebrity posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Celebrity), nil
 }
@@ -68,27 +77,41 @@ func (s *GrpcServerProject) UploadCelebrityPosters(ctx context.Context, req *pb.
 func (s *GrpcServerProject) DeleteCelebrityPoster(ctx context.Context, req *pb.DeleteCelebrityPosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteCelebrityPoster(ctx, req.CelebrityId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while deleting celebrity poster")
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
 }
 
-func (s *GrpcServerProject) ListCelebrities(ctx context.Context, req *pb.GetAllRequest) (*pb.CelebrityListResponse, error) {
-	resp, err := s.service.ListCelebrities(ctx)
+func (s *GrpcServerProject) ListCelebrities(ctx context.Context, req *pb.ListCelebritiesRequest) (*pb.CelebrityListResponse, error) {
+	filter := models.CelebrityFilter{
+		Occupation: req.Occupation,
+	}
+	resp, err := s.service.ListCelebrities(ctx, filter, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	celebs := &pb.CelebrityListResponse{}
-	for _, celeb := range resp {
+	celebs := &pb.CelebrityListResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, celeb := range resp.Items {
 		celebs.Celebrities = append(celebs.Celebrities, celeb.ToGrpc().(*pb.Celebrity))
 	}
 	return celebs, nil
 }
 
+func (s *GrpcServerProject) DeleteCelebrity(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteCelebrity(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
 func toFilmCrewsModel(filmCrewsPb *pb.FilmCrew) models.FilmCrews {
 	filmCrews := models.FilmCrews{}
-	for _, filmCrew := range filmCrewsPb.FilmCrew {
+	for _, filmCrew := range filmCrewsPb.GetFilmCrew() {
 		filmCrews = append(filmCrews, &models.FilmCrew{
 			ID:          filmCrew.Id,
 			Name:        filmCrew.Name,
@@ -100,7 +123,7 @@ func toFilmCrewsModel(filmCrewsPb *pb.FilmCrew) models.FilmCrews {
 
 func toShortCelebsModel(shortCelebsPb *pb.ShortCelebrities) models.ShortCelebrities {
 	shortCelebs := models.ShortCelebrities{}
-	for _, shortCeleb := range shortCelebsPb.ShortCelebs {
+	for _, shortCeleb := range shortCelebsPb.GetShortCelebs() {
 		shortCelebs = append(shortCelebs, &models.ShortCelebrity{
 			ID:          shortCeleb.Id,
 			Name:        shortCeleb.Name,
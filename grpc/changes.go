@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GrpcServerProject) WatchChanges(req *pb.WatchChangesRequest, stream pb.WatchSvc_WatchChangesServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopping:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := s.service.WatchChanges(ctx, req.EntityTypes, req.ResumeToken, func(event *models.ChangeEvent) error {
+		return stream.Send(event.ToGrpc().(*pb.ChangeEvent))
+	})
+	if ctx.Err() != nil && stream.Context().Err() == nil {
+		return status.Error(codes.Unavailable, "The server is shutting down, resume from the last token received")
+	}
+	return err
+}
+
+// StopStreams ends the running and future streams, before a graceful stop.
+func (s *GrpcServerProject) StopStreams() {
+	s.stopOnce.Do(func() {
+		close(s.stopping)
+	})
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/dto"
+	"int-service/repository"
+	"int-service/service"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestOlderClients calls the methods with the messages clients sent before
+// update masks and pages were added.
+func TestOlderClients(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	repo := repository.NewMemoryDB()
+	release := time.Date(2008, 1, 20, 0, 0, 0, 0, time.UTC)
+	for _, ID := range []string{"s1", "s2"} {
+		if _, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: ID, Title: "Show " + ID, Type: "series", Rating: 7, Description: "Old.", ReleaseDate: release}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryInterceptors(logger, nil, nil, nil)...))
+	pb.RegisterShowSvcServer(s, NewSvc(service.NewSvc(logger, repo), logger))
+	listen := bufconn.Listen(1 << 20)
+	go s.Serve(listen)
+	defer s.Stop()
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listen.DialContext(ctx)
+	}
+	conn, err := grpc.Dial("bufconn", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// UpdateShow takes the show alone and writes every field.
+	show := &pb.Show{Id: "s1", Title: "Breaking Bad", Type: "series", Rating: 9.5, ReleaseDate: timestamppb.New(release)}
+	updated := &pb.Show{}
+	if err := conn.Invoke(ctx, "/service.ShowSvc/UpdateShow", show, updated); err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetTitle() != "Breaking Bad" || updated.GetRating() != 9.5 || updated.GetDescription() != "" {
+		t.Errorf("UpdateShow of a whole show returned %v", updated)
+	}
+
+	// ListShows reads an empty GetAllRequest as a request for the first page.
+	shows := &pb.ShowListResponse{}
+	if err := conn.Invoke(ctx, "/service.ShowSvc/ListShows", &pb.GetAllRequest{}, shows); err != nil {
+		t.Fatal(err)
+	}
+	if len(shows.GetShows()) != 2 {
+		t.Errorf("ListShows of a GetAllRequest returned %d shows, want 2", len(shows.GetShows()))
+	}
+}
@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+)
+
+func (s *GrpcServerProject) CheckConsistency(ctx context.Context, req *pb.CheckConsistencyRequest) (*pb.ConsistencyReport, error) {
+	resp, err := s.service.CheckConsistency(ctx, req.Repair)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.ConsistencyReport), nil
+}
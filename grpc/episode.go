@@ -2,15 +2,14 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "int-service/_proto"
 	"int-service/models"
 )
 
 func (s *GrpcServerProject) CreateEpisode(ctx context.Context, req *pb.CreateEpisodeRequest) (*pb.Episode, error) {
 	length := &models.ShowLength{
-		Hours:   int(req.ShowLength.Hours),
-		Minutes: int(req.ShowLength.Minutes),
+		Hours:   int(req.GetShowLength().GetHours()),
+		Minutes: int(req.GetShowLength().GetMinutes()),
 	}
 
 	resp, err := s.service.CreateEpisode(ctx, req.SeasonId, req.Title, req.PostersPath, req.TrailerUrl, length, req.Rating, req.Resume, toFilmCrewsModel(req.WrittenBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.DirectedBy), toShortCelebsModel(req.Starring))
@@ -29,11 +28,17 @@ func (s *GrpcServerProject) GetEpisode(ctx context.Context, req *pb.GetByIDReque
 }
 
 func (s *GrpcServerProject) UpdateEpisode(ctx context.Context, req *pb.Episode) (*pb.Episode, error) {
+	return s.PatchEpisode(ctx, &pb.PatchEpisodeRequest{Episode: req})
+}
+
+func (s *GrpcServerProject) PatchEpisode(ctx context.Context, req *pb.PatchEpisodeRequest) (*pb.Episode, error) {
+	episode := req.GetEpisode()
+	updateMask := toUpdateMask(req.UpdateMask, &pb.Episode{}, map[string]string{"showLength": "length"})
 	lenght := &models.ShowLength{
-		Hours:   int(req.ShowLength.Hours),
-		Minutes: int(req.ShowLength.Minutes),
+		Hours:   int(episode.GetShowLength().GetHours()),
+		Minutes: int(episode.GetShowLength().GetMinutes()),
 	}
-	resp, err := s.service.UpdateEpisode(ctx, req.Id, req.SeasonId, req.Title, req.PostersPath, req.TrailerUrl, lenght, req.Rating, req.Resume, toFilmCrewsModel(req.WrittenBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.DirectedBy), toShortCelebsModel(req.Starring))
+	resp, err := s.service.UpdateEpisode(ctx, episode.GetId(), episode.GetSeasonId(), episode.GetTitle(), episode.GetPostersPath(), episode.GetTrailerUrl(), lenght, episode.GetRating(), episode.GetResume(), toFilmCrewsModel(episode.GetWrittenBy()), toFilmCrewsModel(episode.GetProducedBy()), toFilmCrewsModel(episode.GetDirectedBy()), toShortCelebsModel(episode.GetStarring()), updateMask)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +48,7 @@ func (s *GrpcServerProject) UpdateEpisode(ctx context.Context, req *pb.Episode)
 func (s *GrpcServerProject) UploadEpisodePosters(ctx context.Context, req *pb.UploadEpisodePostersRequest) (*pb.Episode, error) {
 	resp, err := s.service.UploadEpisodePosters(ctx, req.EpisodeId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while updating episode posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Episode), nil
 }
@@ -51,7 +56,7 @@ func (s *GrpcServerProject) UploadEpisodePosters(ctx context.Context, req *pb.Up
 func (s *GrpcServerProject) DeleteEpisodePoster(ctx context.Context, req *pb.DeleteEpisodePosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteEpisodePoster(ctx, req.SeriesId, req.SeasonId, req.EpisodeId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while deleting episode poster")
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -68,22 +73,37 @@ func (s *GrpcServerProject) ListSeasonEpisodes(ctx context.Context, req *pb.GetB
 	return episodes, nil
 }
 
-func (s *GrpcServerProject) ListCollectionEpisodes(ctx context.Context, req *pb.GetAllRequest) (*pb.ListEpisodeResponse, error) {
-	resp, err := s.service.ListCollectionEpisodes(ctx)
+func (s *GrpcServerProject) ListCollectionEpisodes(ctx context.Context, req *pb.ListEpisodesRequest) (*pb.ListEpisodeResponse, error) {
+	filter := models.EpisodeFilter{
+		SeasonID:  req.SeasonId,
+		MinRating: req.MinRating,
+	}
+	resp, err := s.service.ListCollectionEpisodes(ctx, filter, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	collection := &pb.ListEpisodeResponse{}
-	for _, episodes := range resp {
-		collection.Episodes = append(collection.Episodes, episodes.ToGrpc().(*pb.Episode))
+	collection := &pb.ListEpisodeResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, episode := range resp.Items {
+		collection.Episodes = append(collection.Episodes, episode.ToGrpc().(*pb.Episode))
 	}
 	return collection, nil
 }
 
+func (s *GrpcServerProject) DeleteEpisode(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteEpisode(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
 func toShortEpisodesModel(episodesPb *pb.ShortEpisodeList) models.ShortEpisodes {
 	episodes := models.ShortEpisodes{}
-	for _, episode := range episodesPb.ShortEpisodes {
+	for _, episode := range episodesPb.GetShortEpisodes() {
 		episodes = append(episodes, &models.ShortEpisode{
 			ID:          episode.Id,
 			Title:       episode.Title,
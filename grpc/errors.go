@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"int-service/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDomain = "int-service"
+
+// Reasons sent in the errdetails.ErrorInfo of every failed call, so clients
+// can branch on them without parsing messages.
+const (
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonResourceExhausted  = "RESOURCE_EXHAUSTED"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonInternal           = "INTERNAL"
+)
+
+func ErrorUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func ErrorStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	if err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
+
+// toStatusError translates the domain errors of the models package into a
+// gRPC status. Errors that already carry a status are passed through.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code, reason := codes.Internal, ReasonInternal
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		code, reason = codes.NotFound, ReasonNotFound
+	case errors.Is(err, models.ErrAlreadyExists):
+		code, reason = codes.AlreadyExists, ReasonAlreadyExists
+	case errors.Is(err, models.ErrInvalidArgument):
+		code, reason = codes.InvalidArgument, ReasonInvalidArgument
+	case errors.Is(err, models.ErrUnauthenticated):
+		code, reason = codes.Unauthenticated, ReasonUnauthenticated
+	case errors.Is(err, models.ErrPermissionDenied):
+		code, reason = codes.PermissionDenied, ReasonPermissionDenied
+	case errors.Is(err, models.ErrResourceExhausted):
+		code, reason = codes.ResourceExhausted, ReasonResourceExhausted
+	case errors.Is(err, models.ErrFailedPrecondition):
+		code, reason = codes.FailedPrecondition, ReasonFailedPrecondition
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	st := status.New(code, err.Error())
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+
+	var withDetails *status.Status
+	var detailsErr error
+	var fieldErr *models.FieldError
+	if errors.As(err, &fieldErr) {
+		withDetails, detailsErr = st.WithDetails(info, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       fieldErr.Field,
+				Description: fieldErr.Description,
+			}},
+		})
+	} else {
+		withDetails, detailsErr = st.WithDetails(info)
+	}
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
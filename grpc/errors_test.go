@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"int-service/models"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+		// field is the field named by the BadRequest details, if any.
+		field string
+	}{
+		{name: "not found", err: models.ErrNotFound, code: codes.NotFound, reason: ReasonNotFound},
+		{name: "already exists", err: models.ErrAlreadyExists, code: codes.AlreadyExists, reason: ReasonAlreadyExists},
+		{name: "invalid argument", err: models.ErrInvalidArgument, code: codes.InvalidArgument, reason: ReasonInvalidArgument},
+		{name: "unauthenticated", err: models.ErrUnauthenticated, code: codes.Unauthenticated, reason: ReasonUnauthenticated},
+		{name: "permission denied", err: models.ErrPermissionDenied, code: codes.PermissionDenied, reason: ReasonPermissionDenied},
+		{name: "resource exhausted", err: models.ErrResourceExhausted, code: codes.ResourceExhausted, reason: ReasonResourceExhausted},
+		{name: "failed precondition", err: models.ErrFailedPrecondition, code: codes.FailedPrecondition, reason: ReasonFailedPrecondition},
+		{name: "wrapped", err: pkgerrors.Wrap(pkgerrors.Wrap(models.ErrNotFound, "Error while finding show by id"), "Error while getting show by id"), code: codes.NotFound, reason: ReasonNotFound},
+		{name: "field", err: models.NewFieldError("releaseDate", "timestamp out of range"), code: codes.InvalidArgument, reason: ReasonInvalidArgument, field: "releaseDate"},
+		{name: "wrapped field", err: pkgerrors.Wrap(models.NewFieldError("updateMask", "unknown field"), "Error while updating show"), code: codes.InvalidArgument, reason: ReasonInvalidArgument, field: "updateMask"},
+		{name: "unknown", err: errors.New("connection reset"), code: codes.Internal, reason: ReasonInternal},
+		{name: "canceled", err: pkgerrors.Wrap(context.Canceled, "Error while listing shows"), code: codes.Canceled},
+		{name: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{name: "status", err: status.Error(codes.Unavailable, "shutting down"), code: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		st, ok := status.FromError(toStatusError(tt.err))
+		if !ok {
+			t.Errorf("%s: toStatusError returned no status", tt.name)
+			continue
+		}
+		if st.Code() != tt.code {
+			t.Errorf("%s: code %v, want %v", tt.name, st.Code(), tt.code)
+		}
+		if st.Message() != tt.err.Error() && tt.name != "status" {
+			t.Errorf("%s: message %q, want %q", tt.name, st.Message(), tt.err.Error())
+		}
+
+		var info *errdetails.ErrorInfo
+		var badRequest *errdetails.BadRequest
+		for _, detail := range st.Details() {
+			switch detail := detail.(type) {
+			case *errdetails.ErrorInfo:
+				info = detail
+			case *errdetails.BadRequest:
+				badRequest = detail
+			default:
+				t.Errorf("%s: unexpected details %v", tt.name, detail)
+			}
+		}
+		if tt.reason == "" {
+			if info != nil {
+				t.Errorf("%s: ErrorInfo %v, want none", tt.name, info)
+			}
+		} else if info.GetReason() != tt.reason || info.GetDomain() != errorDomain {
+			t.Errorf("%s: ErrorInfo %v, want reason %s in domain %s", tt.name, info, tt.reason, errorDomain)
+		}
+		if tt.field == "" {
+			if badRequest != nil {
+				t.Errorf("%s: BadRequest %v, want none", tt.name, badRequest)
+			}
+			continue
+		}
+		violations := badRequest.GetFieldViolations()
+		if len(violations) != 1 || violations[0].GetField() != tt.field || violations[0].GetDescription() == "" {
+			t.Errorf("%s: BadRequest %v, want a violation of %s", tt.name, badRequest, tt.field)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/address"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/wiremessage"
+)
+
+// fakeMongo is a Mongo deployment kept in memory, which runs the few commands
+// the catalog needs to create, get and list documents. Documents are kept per
+// database and collection, so tenants see only their own database.
+type fakeMongo struct {
+	mu        sync.Mutex
+	databases map[string]map[string][]bson.M
+	updates   chan description.Topology
+}
+
+var _ driver.Deployment = &fakeMongo{}
+var _ driver.Server = &fakeMongo{}
+var _ driver.Connector = &fakeMongo{}
+var _ driver.Disconnector = &fakeMongo{}
+var _ driver.Subscriber = &fakeMongo{}
+
+func newFakeMongo() *fakeMongo {
+	return &fakeMongo{databases: map[string]map[string][]bson.M{}}
+}
+
+var fakeMongoDescription = description.Server{
+	Addr:                  address.Address("fake:27017"),
+	CanonicalAddr:         address.Address("fake:27017"),
+	MaxDocumentSize:       16777216,
+	MaxMessageSize:        48000000,
+	MaxBatchCount:         100000,
+	SessionTimeoutMinutes: 30,
+	Kind:                  description.RSPrimary,
+	WireVersion:           &description.VersionRange{Max: topology.SupportedWireVersions.Max},
+}
+
+func (m *fakeMongo) SelectServer(context.Context, description.ServerSelector) (driver.Server, error) {
+	return m, nil
+}
+
+func (m *fakeMongo) Kind() description.TopologyKind {
+	return description.ReplicaSetWithPrimary
+}
+
+func (m *fakeMongo) Connection(context.Context) (driver.Connection, error) {
+	return &fakeMongoConnection{mongo: m}, nil
+}
+
+func (m *fakeMongo) MinRTT() time.Duration {
+	return 0
+}
+
+func (m *fakeMongo) Connect() error {
+	return nil
+}
+
+func (m *fakeMongo) Disconnect(context.Context) error {
+	return nil
+}
+
+func (m *fakeMongo) Subscribe() (*driver.Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.updates == nil {
+		m.updates = make(chan description.Topology, 1)
+		m.updates <- description.Topology{SessionTimeoutMinutes: 30}
+	}
+	return &driver.Subscription{Updates: m.updates}, nil
+}
+
+func (m *fakeMongo) Unsubscribe(*driver.Subscription) error {
+	return nil
+}
+
+// count returns the number of documents of a collection of a database.
+func (m *fakeMongo) count(database string, collection string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.databases[database][collection])
+}
+
+// run runs a command and returns its reply. Commands the fake does not know
+// fail, so a test cannot pass on a command that was silently ignored.
+func (m *fakeMongo) run(command bson.D, sequences map[string][]bson.M) bson.D {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values := command.Map()
+	name := command[0].Key
+	database, _ := values["$db"].(string)
+	collection, _ := command[0].Value.(string)
+	namespace := database + "." + collection
+
+	switch name {
+	case "insert":
+		documents := sequences["documents"]
+		if inline, ok := values["documents"].(bson.A); ok {
+			for _, document := range inline {
+				documents = append(documents, toM(document))
+			}
+		}
+		if m.databases[database] == nil {
+			m.databases[database] = map[string][]bson.M{}
+		}
+		m.databases[database][collection] = append(m.databases[database][collection], documents...)
+		return bson.D{{Key: "n", Value: int32(len(documents))}, {Key: "ok", Value: 1.0}}
+	case "find":
+		found, err := m.find(database, collection, toM(values["filter"]))
+		if err != nil {
+			return fakeMongoError(err)
+		}
+		if skip, ok := values["skip"].(int64); ok {
+			found = found[atMost(int(skip), len(found)):]
+		}
+		if limit, ok := values["limit"].(int64); ok && limit > 0 {
+			found = found[:atMost(int(limit), len(found))]
+		}
+		return fakeCursor(namespace, found)
+	case "aggregate":
+		found := m.databases[database][collection]
+		for _, stage := range values["pipeline"].(bson.A) {
+			stage := toM(stage)
+			switch {
+			case stage["$match"] != nil:
+				var err error
+				if found, err = filterDocuments(found, toM(stage["$match"])); err != nil {
+					return fakeMongoError(err)
+				}
+			case stage["$group"] != nil:
+				if len(found) == 0 {
+					break
+				}
+				found = []bson.M{{"_id": int32(1), "n": int32(len(found))}}
+			default:
+				return fakeMongoError(fmt.Errorf("unknown stage %v", stage))
+			}
+		}
+		return fakeCursor(namespace, found)
+	case "commitTransaction", "abortTransaction", "endSessions":
+		return bson.D{{Key: "ok", Value: 1.0}}
+	}
+	return fakeMongoError(fmt.Errorf("unknown command %s", name))
+}
+
+func (m *fakeMongo) find(database string, collection string, filter bson.M) ([]bson.M, error) {
+	return filterDocuments(m.databases[database][collection], filter)
+}
+
+// filterDocuments returns the documents matching filter, which can only test
+// fields for equality and existence.
+func filterDocuments(documents []bson.M, filter bson.M) ([]bson.M, error) {
+	found := []bson.M{}
+	for _, document := range documents {
+		matches := true
+		for key, want := range filter {
+			value, exists := lookup(document, key)
+			if operators, ok := want.(bson.D); ok {
+				want = operators.Map()
+			}
+			if operators, ok := want.(bson.M); ok {
+				for operator, operand := range operators {
+					if operator != "$exists" {
+						return nil, fmt.Errorf("unknown operator %s", operator)
+					}
+					matches = matches && exists == operand.(bool)
+				}
+				continue
+			}
+			matches = matches && exists && fmt.Sprint(value) == fmt.Sprint(want)
+		}
+		if matches {
+			found = append(found, document)
+		}
+	}
+	return found, nil
+}
+
+func atMost(n int, max int) int {
+	if n > max {
+		return max
+	}
+	return n
+}
+
+func lookup(document bson.M, path string) (interface{}, bool) {
+	var value interface{} = document
+	for _, key := range strings.Split(path, ".") {
+		if fields, ok := value.(bson.D); ok {
+			value = fields.Map()
+		}
+		fields, ok := value.(bson.M)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = fields[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func toM(value interface{}) bson.M {
+	data, err := bson.Marshal(value)
+	if err != nil {
+		return bson.M{}
+	}
+	document := bson.M{}
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return bson.M{}
+	}
+	return document
+}
+
+func fakeCursor(namespace string, documents []bson.M) bson.D {
+	batch := bson.A{}
+	for _, document := range documents {
+		batch = append(batch, document)
+	}
+	return bson.D{
+		{Key: "cursor", Value: bson.D{{Key: "id", Value: int64(0)}, {Key: "ns", Value: namespace}, {Key: "firstBatch", Value: batch}}},
+		{Key: "ok", Value: 1.0},
+	}
+}
+
+func fakeMongoError(err error) bson.D {
+	return bson.D{{Key: "ok", Value: 0.0}, {Key: "errmsg", Value: err.Error()}, {Key: "code", Value: int32(115)}}
+}
+
+// fakeMongoConnection answers each OP_MSG written to it with the reply of
+// the fake deployment.
+type fakeMongoConnection struct {
+	mongo *fakeMongo
+	reply bson.D
+}
+
+var _ driver.Connection = &fakeMongoConnection{}
+
+func (c *fakeMongoConnection) WriteWireMessage(_ context.Context, wm []byte) error {
+	_, _, _, opcode, rem, ok := wiremessage.ReadHeader(wm)
+	if !ok || opcode != wiremessage.OpMsg {
+		return fmt.Errorf("the fake Mongo only reads OP_MSG, got %v", opcode)
+	}
+	if _, rem, ok = wiremessage.ReadMsgFlags(rem); !ok {
+		return fmt.Errorf("malformed OP_MSG flags")
+	}
+	var command bson.D
+	sequences := map[string][]bson.M{}
+	for len(rem) > 0 {
+		var sectionType wiremessage.SectionType
+		sectionType, rem, ok = wiremessage.ReadMsgSectionType(rem)
+		if !ok {
+			return fmt.Errorf("malformed OP_MSG section")
+		}
+		switch sectionType {
+		case wiremessage.SingleDocument:
+			var document bsoncore.Document
+			if document, rem, ok = wiremessage.ReadMsgSectionSingleDocument(rem); !ok {
+				return fmt.Errorf("malformed OP_MSG document")
+			}
+			if err := bson.Unmarshal(document, &command); err != nil {
+				return err
+			}
+		case wiremessage.DocumentSequence:
+			var identifier string
+			var documents []bsoncore.Document
+			if identifier, documents, rem, ok = wiremessage.ReadMsgSectionDocumentSequence(rem); !ok {
+				return fmt.Errorf("malformed OP_MSG document sequence")
+			}
+			for _, document := range documents {
+				decoded := bson.M{}
+				if err := bson.Unmarshal(document, &decoded); err != nil {
+					return err
+				}
+				sequences[identifier] = append(sequences[identifier], decoded)
+			}
+		}
+	}
+	c.reply = c.mongo.run(command, sequences)
+	return nil
+}
+
+func (c *fakeMongoConnection) ReadWireMessage(_ context.Context, dst []byte) ([]byte, error) {
+	reply, err := bson.Marshal(c.reply)
+	if err != nil {
+		return dst, err
+	}
+	index, dst := wiremessage.AppendHeaderStart(dst, wiremessage.NextRequestID(), 0, wiremessage.OpMsg)
+	dst = wiremessage.AppendMsgFlags(dst, 0)
+	dst = wiremessage.AppendMsgSectionType(dst, wiremessage.SingleDocument)
+	dst = append(dst, reply...)
+	return bsoncore.UpdateLength(dst, index, int32(len(dst[index:]))), nil
+}
+
+func (c *fakeMongoConnection) Description() description.Server {
+	return fakeMongoDescription
+}
+
+func (*fakeMongoConnection) Close() error {
+	return nil
+}
+
+func (*fakeMongoConnection) ID() string {
+	return "fake"
+}
+
+func (*fakeMongoConnection) ServerConnectionID() *int32 {
+	ID := int32(1)
+	return &ID
+}
+
+func (*fakeMongoConnection) Address() address.Address {
+	return fakeMongoDescription.Addr
+}
+
+func (*fakeMongoConnection) Stale() bool {
+	return false
+}
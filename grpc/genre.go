@@ -38,22 +38,33 @@ func (s *GrpcServerProject) UpdateGenre(ctx context.Context, req *pb.Genre) (*pb
 	return resp.ToGrpc().(*pb.Genre), nil
 }
 
-func (s *GrpcServerProject) ListGenres(ctx context.Context, req *pb.GetAllRequest) (*pb.GenreListResponse, error) {
-	resp, err := s.service.ListGenres(ctx)
+func (s *GrpcServerProject) ListGenres(ctx context.Context, req *pb.ListGenresRequest) (*pb.GenreListResponse, error) {
+	resp, err := s.service.ListGenres(ctx, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	genres := &pb.GenreListResponse{}
-	for _, genre := range resp {
+	genres := &pb.GenreListResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, genre := range resp.Items {
 		genres.Genres = append(genres.Genres, genre.ToGrpc().(*pb.Genre))
 	}
 	return genres, nil
 }
 
+func (s *GrpcServerProject) DeleteGenre(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteGenre(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
 func toShortGenresModel(genresPb *pb.ShortGenres) models.ShortGenres {
 	genres := models.ShortGenres{}
-	for _, genre := range genresPb.Genres {
+	for _, genre := range genresPb.GetGenres() {
 		genres = append(genres, &models.ShortGenre{
 			ID:          genre.Id,
 			Name:        genre.Name,
@@ -4,6 +4,7 @@ import (
 	"context"
 	pb "int-service/_proto"
 	"int-service/service"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 )
@@ -17,6 +18,10 @@ type GrpcServer struct {
 type GrpcServerProject struct {
 	logger  *logrus.Logger
 	service service.ProjectServicer
+	// stopping is closed on shutdown to end the streams, which would
+	// otherwise hold the graceful stop until its timeout.
+	stopping chan struct{}
+	stopOnce sync.Once
 	pb.UnimplementedArticleSvcServer
 	pb.UnimplementedCelebritySvcServer
 	pb.UnimplementedEpisodeSvcServer
@@ -24,6 +29,13 @@ type GrpcServerProject struct {
 	pb.UnimplementedGenreSvcServer
 	pb.UnimplementedSeasonSvcServer
 	pb.UnimplementedJournalistSvcServer
+	pb.UnimplementedSearchSvcServer
+	pb.UnimplementedAdminSvcServer
+	pb.UnimplementedImportSvcServer
+	pb.UnimplementedWatchSvcServer
+	pb.UnimplementedWebhookSvcServer
+	pb.UnimplementedTrashSvcServer
+	pb.UnimplementedRevisionSvcServer
 }
 
 func New(service service.Servicer, logger *logrus.Logger) *GrpcServer {
@@ -35,8 +47,9 @@ func New(service service.Servicer, logger *logrus.Logger) *GrpcServer {
 
 func NewSvc(service service.ProjectServicer, logger *logrus.Logger) *GrpcServerProject {
 	return &GrpcServerProject{
-		logger:  logger,
-		service: service,
+		logger:   logger,
+		service:  service,
+		stopping: make(chan struct{}),
 	}
 }
 
@@ -0,0 +1,188 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	pb "int-service/_proto"
+	"int-service/models"
+)
+
+func (s *GrpcServerProject) BulkImport(ctx context.Context, req *pb.BulkImportRequest) (*pb.BulkImportResponse, error) {
+	bundle := &models.ImportBundle{}
+	for i, celebrity := range req.Celebrities {
+		field := fmt.Sprintf("celebrities[%d].", i)
+		dateOfBirth, err := toOptionalTime(field+"dateOfBirth", celebrity.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+		dateOfDeath, err := toOptionalTime(field+"dateOfDeath", celebrity.DateOfDeath)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Celebrities = append(bundle.Celebrities, &models.Celebrity{
+			Name:         celebrity.Name,
+			Occupation:   celebrity.Occupation,
+			PostersPath:  celebrity.PostersPath,
+			DateOfBirth:  dateOfBirth,
+			DateOfDeath:  dateOfDeath,
+			PlaceOfBirth: celebrity.PlaceOfBirth,
+			Gender:       models.Gender(celebrity.Gender),
+			Bio:          celebrity.Bio,
+		})
+	}
+	for i, show := range req.Shows {
+		importShow, err := toImportShowModel(fmt.Sprintf("shows[%d].", i), show)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Shows = append(bundle.Shows, importShow)
+	}
+
+	resp, err := s.service.BulkImport(ctx, bundle, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.BulkImportResponse), nil
+}
+
+func toImportShowModel(field string, show *pb.ImportShow) (*models.ImportShow, error) {
+	if err := show.ReleaseDate.CheckValid(); err != nil {
+		return nil, models.NewFieldError(field+"releaseDate", err.Error())
+	}
+	endDate, err := toOptionalTime(field+"endDate", show.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	directedBy, err := toCelebrityRefsModel(field+"directedBy", show.DirectedBy)
+	if err != nil {
+		return nil, err
+	}
+	producedBy, err := toCelebrityRefsModel(field+"producedBy", show.ProducedBy)
+	if err != nil {
+		return nil, err
+	}
+	writtenBy, err := toCelebrityRefsModel(field+"writtenBy", show.WrittenBy)
+	if err != nil {
+		return nil, err
+	}
+	starring, err := toCelebrityRefsModel(field+"starring", show.Starring)
+	if err != nil {
+		return nil, err
+	}
+	importShow := &models.ImportShow{
+		Title:       show.Title,
+		Type:        show.Type,
+		PostersPath: show.PostersPath,
+		ReleaseDate: show.ReleaseDate.AsTime(),
+		EndDate:     endDate,
+		Rating:      show.Rating,
+		Length:      toShowLengthModel(show.Length),
+		TrailerURL:  show.TrailerUrl,
+		Genres:      show.Genres,
+		DirectedBy:  directedBy,
+		ProducedBy:  producedBy,
+		WrittenBy:   writtenBy,
+		Starring:    starring,
+		Description: show.Description,
+	}
+	for i, season := range show.Seasons {
+		importSeason, err := toImportSeasonModel(fmt.Sprintf("%sseasons[%d].", field, i), season)
+		if err != nil {
+			return nil, err
+		}
+		importShow.Seasons = append(importShow.Seasons, importSeason)
+	}
+	return importShow, nil
+}
+
+func toImportSeasonModel(field string, season *pb.ImportSeason) (*models.ImportSeason, error) {
+	releaseDate, err := toOptionalTime(field+"releaseDate", season.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+	directedBy, err := toCelebrityRefsModel(field+"directedBy", season.DirectedBy)
+	if err != nil {
+		return nil, err
+	}
+	producedBy, err := toCelebrityRefsModel(field+"producedBy", season.ProducedBy)
+	if err != nil {
+		return nil, err
+	}
+	writtenBy, err := toCelebrityRefsModel(field+"writtenBy", season.WrittenBy)
+	if err != nil {
+		return nil, err
+	}
+	importSeason := &models.ImportSeason{
+		Title:       season.Title,
+		TrailerURL:  season.TrailerUrl,
+		PostersPath: season.PostersPath,
+		Resume:      season.Resume,
+		Rating:      season.Rating,
+		ReleaseDate: releaseDate,
+		WrittenBy:   writtenBy,
+		ProducedBy:  producedBy,
+		DirectedBy:  directedBy,
+	}
+	for i, episode := range season.Episodes {
+		importEpisode, err := toImportEpisodeModel(fmt.Sprintf("%sepisodes[%d].", field, i), episode)
+		if err != nil {
+			return nil, err
+		}
+		importSeason.Episodes = append(importSeason.Episodes, importEpisode)
+	}
+	return importSeason, nil
+}
+
+func toImportEpisodeModel(field string, episode *pb.ImportEpisode) (*models.ImportEpisode, error) {
+	directedBy, err := toCelebrityRefsModel(field+"directedBy", episode.DirectedBy)
+	if err != nil {
+		return nil, err
+	}
+	producedBy, err := toCelebrityRefsModel(field+"producedBy", episode.ProducedBy)
+	if err != nil {
+		return nil, err
+	}
+	writtenBy, err := toCelebrityRefsModel(field+"writtenBy", episode.WrittenBy)
+	if err != nil {
+		return nil, err
+	}
+	starring, err := toCelebrityRefsModel(field+"starring", episode.Starring)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ImportEpisode{
+		Title:       episode.Title,
+		PostersPath: episode.PostersPath,
+		TrailerURL:  episode.TrailerUrl,
+		Length:      toShowLengthModel(episode.ShowLength),
+		Rating:      episode.Rating,
+		Resume:      episode.Resume,
+		WrittenBy:   writtenBy,
+		ProducedBy:  producedBy,
+		DirectedBy:  directedBy,
+		Starring:    starring,
+	}, nil
+}
+
+func toCelebrityRefsModel(field string, refs []*pb.CelebrityRef) ([]*models.CelebrityRef, error) {
+	celebrityRefs := []*models.CelebrityRef{}
+	for i, ref := range refs {
+		dateOfBirth, err := toOptionalTime(fmt.Sprintf("%s[%d].dateOfBirth", field, i), ref.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+		celebrityRefs = append(celebrityRefs, &models.CelebrityRef{
+			Name:        ref.Name,
+			DateOfBirth: dateOfBirth,
+			RoleName:    ref.RoleName,
+		})
+	}
+	return celebrityRefs, nil
+}
+
+func toShowLengthModel(length *pb.ShowLength) models.ShowLength {
+	return models.ShowLength{
+		Hours:   int(length.GetHours()),
+		Minutes: int(length.GetMinutes()),
+	}
+}
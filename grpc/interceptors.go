@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"int-service/auth"
+	"int-service/service"
+	"runtime/debug"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key of the request ID, read from the
+// incoming metadata and echoed in the response header.
+const RequestIDHeader = "x-request-id"
+
+const maxRequestIDLength = 128
+
+var errPanic = errors.New("Internal error while handling the request")
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID of the request of ctx, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// UnaryInterceptors returns the interceptors every unary call goes through,
+// from the outermost one: request ID, logging, error mapping, authorization,
+// tenant routing and recovery. Authorization is skipped when authenticator is
+// nil.
+func UnaryInterceptors(logger *logrus.Logger, authenticator *auth.Authenticator, policy auth.Policy, tenants []string) []grpc.UnaryServerInterceptor {
+	interceptors := []grpc.UnaryServerInterceptor{
+		RequestIDUnaryInterceptor,
+		LoggingUnaryInterceptor(logger),
+		ErrorUnaryInterceptor,
+	}
+	if authenticator != nil {
+		interceptors = append(interceptors, AuthUnaryInterceptor(authenticator, policy))
+	}
+	return append(interceptors, TenantUnaryInterceptor(tenants), RecoveryUnaryInterceptor)
+}
+
+// StreamInterceptors is the stream counterpart of UnaryInterceptors.
+func StreamInterceptors(logger *logrus.Logger, authenticator *auth.Authenticator, policy auth.Policy, tenants []string) []grpc.StreamServerInterceptor {
+	interceptors := []grpc.StreamServerInterceptor{
+		RequestIDStreamInterceptor,
+		LoggingStreamInterceptor(logger),
+		ErrorStreamInterceptor,
+	}
+	if authenticator != nil {
+		interceptors = append(interceptors, AuthStreamInterceptor(authenticator, policy))
+	}
+	return append(interceptors, TenantStreamInterceptor(tenants), RecoveryStreamInterceptor)
+}
+
+func RequestIDUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := incomingRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+	return handler(context.WithValue(ctx, requestIDKey{}, requestID), req)
+}
+
+func RequestIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	requestID := incomingRequestID(ss.Context())
+	ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+	return handler(srv, &contextStream{ss, context.WithValue(ss.Context(), requestIDKey{}, requestID)})
+}
+
+// incomingRequestID returns the request ID sent by the client, when it is a
+// reasonable one, or a new one.
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, requestID := range md.Get(RequestIDHeader) {
+		if validRequestID(requestID) {
+			return requestID
+		}
+	}
+	return uuid.New().String()
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// LoggingUnaryInterceptor puts a logger with the request fields in the
+// context of the call, for the service to log with, and logs the outcome of
+// the call.
+func LoggingUnaryInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		entry := requestLogger(ctx, logger, info.FullMethod)
+		start := time.Now()
+		resp, err := handler(service.ContextWithLogger(ctx, entry), req)
+		logCall(entry, start, err)
+		return resp, err
+	}
+}
+
+func LoggingStreamInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		entry := requestLogger(ss.Context(), logger, info.FullMethod)
+		start := time.Now()
+		err := handler(srv, &contextStream{ss, service.ContextWithLogger(ss.Context(), entry)})
+		logCall(entry, start, err)
+		return err
+	}
+}
+
+func requestLogger(ctx context.Context, logger *logrus.Logger, method string) *logrus.Entry {
+	fields := logrus.Fields{
+		"grpc.method": method,
+		"request_id":  RequestIDFromContext(ctx),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer"] = p.Addr.String()
+	}
+	if name := ClientName(ctx); name != "" {
+		fields["client"] = name
+	}
+	return logger.WithFields(fields)
+}
+
+func logCall(entry *logrus.Entry, start time.Time, err error) {
+	code := status.Code(err)
+	entry = entry.WithFields(logrus.Fields{
+		"grpc.code":   code.String(),
+		"duration_ms": float64(time.Since(start).Microseconds()) / 1000,
+	})
+	switch code {
+	case codes.OK:
+		entry.Info("Call finished")
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		entry.WithError(err).Error("Call failed")
+	default:
+		entry.WithError(err).Warn("Call failed")
+	}
+}
+
+// RecoveryUnaryInterceptor turns a panic of the handler into an internal
+// error, so one bad call does not bring the server down.
+func RecoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logPanic(ctx, r)
+			resp, err = nil, errPanic
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func RecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logPanic(ss.Context(), r)
+			err = errPanic
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func logPanic(ctx context.Context, r interface{}) {
+	entry := service.LoggerFromContext(ctx)
+	if entry == nil {
+		entry = logrus.NewEntry(logrus.StandardLogger())
+	}
+	entry.WithFields(logrus.Fields{
+		"panic": r,
+		"stack": string(debug.Stack()),
+	}).Error("Recovered from a panic")
+}
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/service"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// panickingGenreSvc panics on ListGenres and records the request ID and
+// logger CreateGenre is called with.
+type panickingGenreSvc struct {
+	pb.UnimplementedGenreSvcServer
+	requestID string
+	logged    bool
+}
+
+func (s *panickingGenreSvc) ListGenres(ctx context.Context, req *pb.ListGenresRequest) (*pb.GenreListResponse, error) {
+	var genres []*pb.Genre
+	return &pb.GenreListResponse{Genres: []*pb.Genre{genres[0]}}, nil
+}
+
+func (s *panickingGenreSvc) CreateGenre(ctx context.Context, req *pb.CreateGenreRequest) (*pb.Genre, error) {
+	s.requestID = RequestIDFromContext(ctx)
+	s.logged = service.LoggerFromContext(ctx) != nil
+	return &pb.Genre{Name: req.Name}, nil
+}
+
+func startInterceptedServer(t *testing.T, logger *logrus.Logger, svc pb.GenreSvcServer) pb.GenreSvcClient {
+	t.Helper()
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryInterceptors(logger, nil, nil, nil)...))
+	pb.RegisterGenreSvcServer(s, svc)
+	listen := bufconn.Listen(1 << 20)
+	go s.Serve(listen)
+	t.Cleanup(s.Stop)
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listen.DialContext(ctx)
+	}
+	conn, err := grpc.Dial("bufconn", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewGenreSvcClient(conn)
+}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	client := startInterceptedServer(t, logger, &panickingGenreSvc{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var header metadata.MD
+	_, err := client.ListGenres(ctx, &pb.ListGenresRequest{}, grpc.Header(&header))
+	st := status.Convert(err)
+	if st.Code() != codes.Internal || strings.Contains(st.Message(), "index out of range") {
+		t.Errorf("a panicking call returned %v, want an internal error hiding the panic", err)
+	}
+	if len(st.Details()) != 1 || st.Details()[0].(*errdetails.ErrorInfo).GetReason() != ReasonInternal {
+		t.Errorf("a panicking call returned the details %v", st.Details())
+	}
+
+	var recovered *logrus.Entry
+	for _, entry := range hook.AllEntries() {
+		if entry.Message == "Recovered from a panic" {
+			recovered = entry
+		}
+	}
+	if recovered == nil {
+		t.Fatal("the panic was not logged")
+	}
+	if recovered.Level != logrus.ErrorLevel || recovered.Data["request_id"] != header.Get(RequestIDHeader)[0] || !strings.Contains(recovered.Data["stack"].(string), "ListGenres") {
+		t.Errorf("the panic was logged as %v with %v", recovered.Level, recovered.Data)
+	}
+
+	if _, err := client.CreateGenre(ctx, &pb.CreateGenreRequest{Name: "Drama"}); err != nil {
+		t.Errorf("the call after a panic returned %v", err)
+	}
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ctx := service.ContextWithLogger(context.Background(), logrus.NewEntry(logger))
+	err := RecoveryStreamInterceptor(nil, &contextStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+		panic("broken stream")
+	})
+	if err != errPanic {
+		t.Errorf("a panicking stream returned %v, want %v", err, errPanic)
+	}
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	svc := &panickingGenreSvc{}
+	client := startInterceptedServer(t, logger, svc)
+	tests := []struct {
+		name      string
+		requestID string
+		// kept is whether the request ID of the client is used, otherwise a
+		// new one is made.
+		kept bool
+	}{
+		{name: "sent", requestID: "abc-123", kept: true},
+		{name: "longest", requestID: strings.Repeat("a", maxRequestIDLength), kept: true},
+		{name: "none"},
+		{name: "too long", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "not ASCII", requestID: "été"},
+	}
+	for _, tt := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if tt.requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, tt.requestID)
+		}
+		var header metadata.MD
+		_, err := client.CreateGenre(ctx, &pb.CreateGenreRequest{Name: "Drama"}, grpc.Header(&header))
+		cancel()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		echoed := header.Get(RequestIDHeader)
+		if len(echoed) != 1 || echoed[0] != svc.requestID {
+			t.Errorf("%s: the response header holds the request IDs %q, the service saw %q", tt.name, echoed, svc.requestID)
+			continue
+		}
+		if tt.kept && svc.requestID != tt.requestID {
+			t.Errorf("%s: the request ID is %q, want %q", tt.name, svc.requestID, tt.requestID)
+		}
+		if _, err := uuid.Parse(svc.requestID); !tt.kept && err != nil {
+			t.Errorf("%s: the request ID is %q, want a new UUID", tt.name, svc.requestID)
+		}
+		if !svc.logged {
+			t.Errorf("%s: the service has no request logger", tt.name)
+		}
+	}
+	// Control characters cannot be sent in HTTP/2 headers, but could come
+	// from another transport.
+	if validRequestID("abc\x01") {
+		t.Error("a request ID with a control character is valid")
+	}
+}
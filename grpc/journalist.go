@@ -37,15 +37,26 @@ func (s *GrpcServerProject) UpdateJournalist(ctx context.Context, req *pb.Journa
 	return resp.ToGrpc().(*pb.Journalist), nil
 }
 
-func (s *GrpcServerProject) ListJournalists(ctx context.Context, req *pb.GetAllRequest) (*pb.JournalistListResponse, error) {
-	resp, err := s.service.ListJournalists(ctx)
+func (s *GrpcServerProject) ListJournalists(ctx context.Context, req *pb.ListJournalistsRequest) (*pb.JournalistListResponse, error) {
+	resp, err := s.service.ListJournalists(ctx, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	journalists := &pb.JournalistListResponse{}
-	for _, j := range resp {
-		journalists.Journalists = append(journalists.Journalists, j.ToGrpc().(*pb.Journalist))
+	journalists := &pb.JournalistListResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, journalist := range resp.Items {
+		journalists.Journalists = append(journalists.Journalists, journalist.ToGrpc().(*pb.Journalist))
 	}
 	return journalists, nil
 }
+
+func (s *GrpcServerProject) DeleteJournalist(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteJournalist(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"int-service/models"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toListOptions(pageSize int32, pageToken string, sortBy string, descending bool) models.ListOptions {
+	return models.ListOptions{
+		PageSize:   int(pageSize),
+		PageToken:  pageToken,
+		SortBy:     sortBy,
+		Descending: descending,
+	}
+}
+
+// toOptionalTime converts a timestamp filter, an unset one stays the zero time.
+func toOptionalTime(field string, timestamp *timestamppb.Timestamp) (time.Time, error) {
+	if timestamp == nil {
+		return time.Time{}, nil
+	}
+	if err := timestamp.CheckValid(); err != nil {
+		return time.Time{}, models.NewFieldError(field, err.Error())
+	}
+	return timestamp.AsTime(), nil
+}
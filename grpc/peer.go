@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"int-service/tlsconfig"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientNameHeader is the metadata key of the name of the client certificate
+// of a gateway call, which the gateway sets from its own TLS connection.
+const ClientNameHeader = "x-client-name"
+
+// GatewayAuthInfo is the AuthInfo of the local connection of the gateway.
+// Only its calls are trusted with ClientNameHeader.
+type GatewayAuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+func (GatewayAuthInfo) AuthType() string {
+	return "gateway"
+}
+
+// ServerCredentials exposes the connections of the listeners to the calls:
+// the TLS state of the connections of a TLS listener, whose handshake it
+// runs, and GatewayAuthInfo for the Unix socket of the gateway.
+func ServerCredentials() credentials.TransportCredentials {
+	return listenerCredentials{}
+}
+
+type listenerCredentials struct{}
+
+func (listenerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	switch c := conn.(type) {
+	case *tls.Conn:
+		if err := c.Handshake(); err != nil {
+			return nil, nil, err
+		}
+		info := credentials.TLSInfo{
+			State:          c.ConnectionState(),
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}
+		return c, info, nil
+	case *net.UnixConn:
+		return c, GatewayAuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity}}, nil
+	}
+	return conn, nil, nil
+}
+
+func (listenerCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, credentials.ErrConnDispatched
+}
+
+func (listenerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (c listenerCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (listenerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// ClientName returns the name of the verified client certificate of a call,
+// from its TLS connection or, for a gateway call, from the metadata the
+// gateway set. It is empty without mutual TLS.
+func ClientName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		if len(info.State.VerifiedChains) > 0 {
+			return tlsconfig.ClientName(info.State.PeerCertificates[0])
+		}
+	case GatewayAuthInfo:
+		md, _ := metadata.FromIncomingContext(ctx)
+		if names := md.Get(ClientNameHeader); len(names) == 1 {
+			return names[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+)
+
+func (s *GrpcServerProject) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.ListRevisionsResponse, error) {
+	resp, err := s.service.ListRevisions(ctx, req.EntityType, req.EntityId, toListOptions(req.PageSize, req.PageToken, "", false))
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := &pb.ListRevisionsResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, revision := range resp.Items {
+		revisions.Revisions = append(revisions.Revisions, revision.ToGrpc().(*pb.Revision))
+	}
+	return revisions, nil
+}
+
+func (s *GrpcServerProject) GetRevisionDiff(ctx context.Context, req *pb.GetRevisionDiffRequest) (*pb.RevisionDiff, error) {
+	resp, err := s.service.GetRevisionDiff(ctx, req.FromRevisionId, req.ToRevisionId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.RevisionDiff), nil
+}
+
+func (s *GrpcServerProject) RevertToRevision(ctx context.Context, req *pb.RevertToRevisionRequest) (*pb.Revision, error) {
+	resp, err := s.service.RevertToRevision(ctx, req.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Revision), nil
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+)
+
+func (s *GrpcServerProject) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	resp, err := s.service.Search(ctx, req.Query, req.Types, toListOptions(req.PageSize, req.PageToken, "", false))
+	if err != nil {
+		return nil, err
+	}
+
+	hits := &pb.SearchResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, hit := range resp.Items {
+		hits.Hits = append(hits.Hits, hit.ToGrpc().(*pb.SearchHit))
+	}
+	return hits, nil
+}
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "int-service/_proto"
 	"int-service/models"
 )
@@ -10,7 +9,7 @@ import (
 func (s *GrpcServerProject) CreateSeason(ctx context.Context, req *pb.CreateSeasonRequest) (*pb.Season, error) {
 	releaseDate := req.ReleaseDate.AsTime()
 	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+		return nil, models.NewFieldError("releaseDate", err.Error())
 	}
 	resp, err := s.service.CreateSeason(ctx, req.ShowId, req.Title, req.TrailerUrl, []string{}, releaseDate, req.Rating, req.Resume, toFilmCrewsModel(req.DirectedBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.WrittenBy), toShortEpisodesModel(req.Episodes))
 	if err != nil {
@@ -28,11 +27,19 @@ func (s *GrpcServerProject) GetSeason(ctx context.Context, req *pb.GetByIDReques
 }
 
 func (s *GrpcServerProject) UpdateSeason(ctx context.Context, req *pb.Season) (*pb.Season, error) {
-	releaseDate := req.ReleaseDate.AsTime()
-	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+	return s.PatchSeason(ctx, &pb.PatchSeasonRequest{Season: req})
+}
+
+func (s *GrpcServerProject) PatchSeason(ctx context.Context, req *pb.PatchSeasonRequest) (*pb.Season, error) {
+	season := req.GetSeason()
+	updateMask := toUpdateMask(req.UpdateMask, &pb.Season{}, nil)
+	releaseDate := season.GetReleaseDate().AsTime()
+	if inUpdateMask(updateMask, "releaseDate") {
+		if err := season.GetReleaseDate().CheckValid(); err != nil {
+			return nil, models.NewFieldError("releaseDate", err.Error())
+		}
 	}
-	resp, err := s.service.UpdateSeason(ctx, req.Id, req.ShowId, req.Title, req.TrailerUrl, req.PostersPath, releaseDate, req.Rating, req.Resume, toFilmCrewsModel(req.DirectedBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.WrittenBy), toShortEpisodesModel(req.Episodes))
+	resp, err := s.service.UpdateSeason(ctx, season.GetId(), season.GetShowId(), season.GetTitle(), season.GetTrailerUrl(), season.GetPostersPath(), releaseDate, season.GetRating(), season.GetResume(), toFilmCrewsModel(season.GetDirectedBy()), toFilmCrewsModel(season.GetProducedBy()), toFilmCrewsModel(season.GetWrittenBy()), toShortEpisodesModel(season.GetEpisodes()), updateMask)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +49,7 @@ func (s *GrpcServerProject) UpdateSeason(ctx context.Context, req *pb.Season) (*
 func (s *GrpcServerProject) UploadSeasonPosters(ctx context.Context, req *pb.UploadSeasonPostersRequest) (*pb.Season, error) {
 	resp, err := s.service.UploadSeasonPosters(ctx, req.SeasonId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while uploading season posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Season), nil
 }
@@ -50,7 +57,7 @@ func (s *GrpcServerProject) UploadSeasonPosters(ctx context.Context, req *pb.Upl
 func (s *GrpcServerProject) DeleteSeasonPoster(ctx context.Context, req *pb.DeleteSeasonPosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteSeasonPoster(ctx, req.SeriesId, req.SeasonId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while deleting season poster")
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -67,21 +74,47 @@ func (s *GrpcServerProject) ListShowSeasons(ctx context.Context, req *pb.GetByID
 	return showSeasons, nil
 }
 
-func (s *GrpcServerProject) ListSeasonsCollection(ctx context.Context, req *pb.GetAllRequest) (*pb.ListSeasonResponse, error) {
-	resp, err := s.service.ListSeasonsCollection(ctx)
+func (s *GrpcServerProject) ListSeasonsCollection(ctx context.Context, req *pb.ListSeasonsRequest) (*pb.ListSeasonResponse, error) {
+	releasedAfter, err := toOptionalTime("releasedAfter", req.ReleasedAfter)
 	if err != nil {
 		return nil, err
 	}
-	collection := &pb.ListSeasonResponse{}
-	for _, season := range resp {
+	releasedBefore, err := toOptionalTime("releasedBefore", req.ReleasedBefore)
+	if err != nil {
+		return nil, err
+	}
+	filter := models.SeasonFilter{
+		ShowID:         req.ShowId,
+		ReleasedAfter:  releasedAfter,
+		ReleasedBefore: releasedBefore,
+		MinRating:      req.MinRating,
+	}
+	resp, err := s.service.ListSeasonsCollection(ctx, filter, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &pb.ListSeasonResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, season := range resp.Items {
 		collection.Seasons = append(collection.Seasons, season.ToGrpc().(*pb.Season))
 	}
 	return collection, nil
 }
 
+func (s *GrpcServerProject) DeleteSeason(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteSeason(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
 func toShortSeasonsModel(seasonsPb *pb.ShortSeasons) models.ShortSeasons {
 	shortSeasons := models.ShortSeasons{}
-	for _, season := range seasonsPb.Seasons {
+	for _, season := range seasonsPb.GetSeasons() {
 		shortSeasons = append(shortSeasons, &models.ShortSeason{
 			ID:          season.Id,
 			Title:       season.Title,
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "int-service/_proto"
 	"int-service/models"
 	"time"
@@ -11,19 +10,19 @@ import (
 func (s *GrpcServerProject) CreateShow(ctx context.Context, req *pb.CreateShowRequest) (*pb.Show, error) {
 	releaseDate := req.ReleaseDate.AsTime()
 	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+		return nil, models.NewFieldError("releaseDate", err.Error())
 	}
 	var endDate time.Time
 	if req.EndDate != nil {
 		endDate = req.EndDate.AsTime()
 		if err := req.EndDate.CheckValid(); err != nil {
-			return nil, errors.New("error while converting endDate")
+			return nil, models.NewFieldError("endDate", err.Error())
 		}
 	}
 
 	length := &models.ShowLength{
-		Hours:   int(req.Length.Hours),
-		Minutes: int(req.Length.Minutes),
+		Hours:   int(req.GetLength().GetHours()),
+		Minutes: int(req.GetLength().GetMinutes()),
 	}
 
 	resp, err := s.service.CreateShow(ctx, req.Title, req.Type, req.PostersPath, releaseDate, endDate, req.Rating, length, req.TrailerUrl, toShortGenresModel(req.Genres), toFilmCrewsModel(req.DirectedBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.WrittenBy), toShortCelebsModel(req.Starring), req.Description, toShortSeasonsModel(req.Seasons))
@@ -42,36 +41,63 @@ func (s *GrpcServerProject) GetShow(ctx context.Context, req *pb.GetByIDRequest)
 }
 
 func (s *GrpcServerProject) UpdateShow(ctx context.Context, req *pb.Show) (*pb.Show, error) {
-	releaseDate := req.ReleaseDate.AsTime()
-	if err := req.ReleaseDate.CheckValid(); err != nil {
-		return nil, errors.New("error while converting releaseDate")
+	return s.PatchShow(ctx, &pb.PatchShowRequest{Show: req})
+}
+
+func (s *GrpcServerProject) PatchShow(ctx context.Context, req *pb.PatchShowRequest) (*pb.Show, error) {
+	show := req.GetShow()
+	updateMask := toUpdateMask(req.UpdateMask, &pb.Show{}, nil)
+	releaseDate := show.GetReleaseDate().AsTime()
+	if inUpdateMask(updateMask, "releaseDate") {
+		if err := show.GetReleaseDate().CheckValid(); err != nil {
+			return nil, models.NewFieldError("releaseDate", err.Error())
+		}
 	}
 	var endDate time.Time
-	if req.EndDate != nil {
-		if err := req.EndDate.CheckValid(); err != nil {
-			return nil, errors.New("error while converting endDate")
+	if show.GetEndDate() != nil {
+		endDate = show.EndDate.AsTime()
+		if err := show.EndDate.CheckValid(); err != nil {
+			return nil, models.NewFieldError("endDate", err.Error())
 		}
 	}
 
 	length := &models.ShowLength{
-		Hours:   int(req.Length.Hours),
-		Minutes: int(req.Length.Minutes),
+		Hours:   int(show.GetLength().GetHours()),
+		Minutes: int(show.GetLength().GetMinutes()),
 	}
-	resp, err := s.service.UpdateShow(ctx, req.Id, req.Title, req.Type, req.PostersPath, releaseDate, endDate, req.Rating, length, req.TrailerUrl, toShortGenresModel(req.Genres), toFilmCrewsModel(req.DirectedBy), toFilmCrewsModel(req.ProducedBy), toFilmCrewsModel(req.WrittenBy), toShortCelebsModel(req.Starring), req.Description, toShortSeasonsModel(req.Seasons))
+	resp, err := s.service.UpdateShow(ctx, show.GetId(), show.GetTitle(), show.GetType(), show.GetPostersPath(), releaseDate, endDate, show.GetRating(), length, show.GetTrailerUrl(), toShortGenresModel(show.GetGenres()), toFilmCrewsModel(show.GetDirectedBy()), toFilmCrewsModel(show.GetProducedBy()), toFilmCrewsModel(show.GetWrittenBy()), toShortCelebsModel(show.GetStarring()), show.GetDescription(), toShortSeasonsModel(show.GetSeasons()), updateMask)
 	if err != nil {
 		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Show), nil
 }
 
-func (s *GrpcServerProject) ListShows(ctx context.Context, req *pb.GetAllRequest) (*pb.ShowListResponse, error) {
-	resp, err := s.service.ListShows(ctx)
+func (s *GrpcServerProject) ListShows(ctx context.Context, req *pb.ListShowsRequest) (*pb.ShowListResponse, error) {
+	releasedAfter, err := toOptionalTime("releasedAfter", req.ReleasedAfter)
+	if err != nil {
+		return nil, err
+	}
+	releasedBefore, err := toOptionalTime("releasedBefore", req.ReleasedBefore)
+	if err != nil {
+		return nil, err
+	}
+	filter := models.ShowFilter{
+		Type:           req.Type,
+		GenreID:        req.GenreId,
+		ReleasedAfter:  releasedAfter,
+		ReleasedBefore: releasedBefore,
+		MinRating:      req.MinRating,
+	}
+	resp, err := s.service.ListShows(ctx, filter, toListOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending))
 	if err != nil {
 		return nil, err
 	}
 
-	shows := &pb.ShowListResponse{}
-	for _, show := range resp {
+	shows := &pb.ShowListResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, show := range resp.Items {
 		shows.Shows = append(shows.Shows, show.ToGrpc().(*pb.Show))
 	}
 	return shows, nil
@@ -80,7 +106,7 @@ func (s *GrpcServerProject) ListShows(ctx context.Context, req *pb.GetAllRequest
 func (s *GrpcServerProject) UploadSeriesPosters(ctx context.Context, req *pb.UploadSeriesPostersRequest) (*pb.Show, error) {
 	resp, err := s.service.UploadSeriesPosters(ctx, req.SeriesId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while updating series posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Show), nil
 }
@@ -88,7 +114,7 @@ func (s *GrpcServerProject) UploadSeriesPosters(ctx context.Context, req *pb.Upl
 func (s *GrpcServerProject) DeleteSeriesPoster(ctx context.Context, req *pb.DeleteSeriesPosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteSeriesPoster(ctx, req.SeriesId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while updating deleted series poster")
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -96,7 +122,7 @@ func (s *GrpcServerProject) DeleteSeriesPoster(ctx context.Context, req *pb.Dele
 func (s *GrpcServerProject) UploadMoviePosters(ctx context.Context, req *pb.UploadMoviePostersRequest) (*pb.Show, error) {
 	resp, err := s.service.UploadMoviePosters(ctx, req.MovieId, req.PostersPath)
 	if err != nil {
-		return nil, errors.New("error while updating movie posters")
+		return nil, err
 	}
 	return resp.ToGrpc().(*pb.Show), nil
 }
@@ -104,7 +130,15 @@ func (s *GrpcServerProject) UploadMoviePosters(ctx context.Context, req *pb.Uplo
 func (s *GrpcServerProject) DeleteMoviePoster(ctx context.Context, req *pb.DeleteMoviePosterRequest) (*pb.EmptyResponse, error) {
 	err := s.service.DeleteMoviePoster(ctx, req.MovieId, req.Image)
 	if err != nil {
-		return nil, errors.New("error while updating deleted movie poster")
+		return nil, err
 	}
 	return &pb.EmptyResponse{}, nil
-}
\ No newline at end of file
+}
+
+func (s *GrpcServerProject) DeleteShow(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteShow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
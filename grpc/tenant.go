@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"int-service/auth"
+	"int-service/models"
+	"int-service/service"
+	"int-service/tenant"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TenantUnaryInterceptor routes the call to the catalog of the tenant named
+// by the x-tenant-id metadata, or by the token of the caller. When tenants
+// are configured every call has to name one of them, otherwise calls naming
+// a tenant are rejected rather than served from the default catalog.
+func TenantUnaryInterceptor(tenants []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withTenant(ctx, tenants, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func TenantStreamInterceptor(tenants []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withTenant(ss.Context(), tenants, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ss, ctx})
+	}
+}
+
+// withTenant returns ctx with the tenant of the call, and with the tenant in
+// the request logger. A token bound to a tenant can only call that tenant.
+func withTenant(ctx context.Context, tenants []string, method string) (context.Context, error) {
+	if strings.HasPrefix(method, "/grpc.") {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	tenantID := ""
+	if values := md.Get(tenant.Header); len(values) > 0 {
+		tenantID = values[0]
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil && principal.TenantID != "" {
+		if tenantID == "" {
+			tenantID = principal.TenantID
+		}
+		if tenantID != principal.TenantID {
+			return nil, errors.Wrap(models.ErrPermissionDenied, "The token of tenant "+principal.TenantID+" cannot call tenant "+tenantID)
+		}
+	}
+
+	if len(tenants) == 0 {
+		if tenantID != "" {
+			return nil, errors.Wrap(models.ErrInvalidArgument, "The server does not host tenants, remove the "+tenant.Header+" metadata")
+		}
+		return ctx, nil
+	}
+	if tenantID == "" {
+		return nil, errors.Wrap(models.ErrInvalidArgument, "Missing "+tenant.Header+" metadata")
+	}
+	if !hostsTenant(tenants, tenantID) {
+		return nil, errors.Wrap(models.ErrPermissionDenied, "Unknown tenant "+tenantID)
+	}
+
+	ctx = tenant.ContextWithID(ctx, tenantID)
+	if entry := service.LoggerFromContext(ctx); entry != nil {
+		ctx = service.ContextWithLogger(ctx, entry.WithField("tenant", tenantID))
+	}
+	return ctx, nil
+}
+
+func hostsTenant(tenants []string, tenantID string) bool {
+	for _, hosted := range tenants {
+		if hosted == tenantID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/auth"
+	"int-service/repository"
+	"int-service/service"
+	"int-service/tenant"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testSecret = []byte("tenant-test-secret")
+
+// tenantGenreSvc records the tenant each call was routed to.
+type tenantGenreSvc struct {
+	pb.UnimplementedGenreSvcServer
+	mu      sync.Mutex
+	tenants []string
+}
+
+func (s *tenantGenreSvc) record(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tenants = append(s.tenants, tenant.FromContext(ctx))
+}
+
+func (s *tenantGenreSvc) routed() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.tenants...)
+}
+
+func (s *tenantGenreSvc) ListGenres(ctx context.Context, req *pb.ListGenresRequest) (*pb.GenreListResponse, error) {
+	s.record(ctx)
+	return &pb.GenreListResponse{}, nil
+}
+
+func (s *tenantGenreSvc) CreateGenre(ctx context.Context, req *pb.CreateGenreRequest) (*pb.Genre, error) {
+	s.record(ctx)
+	return &pb.Genre{Name: req.Name}, nil
+}
+
+// startTenantServer serves the services registered by register through the
+// interceptors of the server, with HMAC tokens and the given hosted tenants.
+func startTenantServer(t *testing.T, tenants []string, register func(s *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	authenticator := auth.NewHMACAuthenticator(testSecret, "", "")
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryInterceptors(logger, authenticator, auth.DefaultPolicy(), tenants)...))
+	register(s)
+
+	listen := bufconn.Listen(1 << 20)
+	go s.Serve(listen)
+	t.Cleanup(s.Stop)
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listen.DialContext(ctx)
+	}
+	conn, err := grpc.Dial("bufconn", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func testToken(t *testing.T, tenantID string) string {
+	t.Helper()
+	claims := auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "tester", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Roles:            []string{auth.RoleAdmin},
+		TenantID:         tenantID,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestTenantRouting(t *testing.T) {
+	hosted := []string{"acme", "globex"}
+	tests := []struct {
+		name        string
+		tenants     []string
+		header      string
+		tokenTenant string
+		code        codes.Code
+		routed      string
+	}{
+		{name: "named tenant", tenants: hosted, header: "acme", code: codes.OK, routed: "acme"},
+		{name: "other named tenant", tenants: hosted, header: "globex", code: codes.OK, routed: "globex"},
+		{name: "token tenant by default", tenants: hosted, tokenTenant: "globex", code: codes.OK, routed: "globex"},
+		{name: "token and metadata agree", tenants: hosted, header: "acme", tokenTenant: "acme", code: codes.OK, routed: "acme"},
+		{name: "missing tenant", tenants: hosted, code: codes.InvalidArgument},
+		{name: "unknown tenant", tenants: hosted, header: "initech", code: codes.PermissionDenied},
+		{name: "tenant of another case", tenants: hosted, header: "ACME", code: codes.PermissionDenied},
+		{name: "token of another tenant", tenants: hosted, header: "globex", tokenTenant: "acme", code: codes.PermissionDenied},
+		{name: "token of an unknown tenant", tenants: hosted, tokenTenant: "initech", code: codes.PermissionDenied},
+		{name: "tenant on a single catalog", header: "acme", code: codes.InvalidArgument},
+		{name: "single catalog", code: codes.OK, routed: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &tenantGenreSvc{}
+			client := pb.NewGenreSvcClient(startTenantServer(t, tt.tenants, func(s *grpc.Server) { pb.RegisterGenreSvcServer(s, svc) }))
+			md := metadata.Pairs("authorization", "Bearer "+testToken(t, tt.tokenTenant))
+			if tt.header != "" {
+				md.Set(tenant.Header, tt.header)
+			}
+			ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), 5*time.Second)
+			defer cancel()
+
+			_, readErr := client.ListGenres(ctx, &pb.ListGenresRequest{})
+			_, writeErr := client.CreateGenre(ctx, &pb.CreateGenreRequest{Name: "Drama"})
+			for _, err := range []error{readErr, writeErr} {
+				if code := status.Code(err); code != tt.code {
+					t.Fatalf("call answered %v (%v), want %v", code, err, tt.code)
+				}
+			}
+
+			routed := svc.routed()
+			if tt.code != codes.OK {
+				if len(routed) != 0 {
+					t.Fatalf("rejected calls reached the service, routed to %q", routed)
+				}
+				return
+			}
+			if len(routed) != 2 || routed[0] != tt.routed || routed[1] != tt.routed {
+				t.Fatalf("calls routed to %q, want %q", routed, tt.routed)
+			}
+		})
+	}
+}
+
+// TestTenantIsolation creates a show under one tenant through the services
+// and checks another tenant can neither get nor list it.
+func TestTenantIsolation(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	mongoDB := newFakeMongo()
+	clientOptions := options.Client()
+	clientOptions.Deployment = mongoDB
+	client, err := mongo.Connect(context.Background(), clientOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := repository.NewMongoDB(client, repository.DefaultMongoNames())
+	conn := startTenantServer(t, []string{"acme", "globex"}, func(s *grpc.Server) {
+		pb.RegisterShowSvcServer(s, NewSvc(service.NewSvc(logger, repo), logger))
+	})
+	shows := pb.NewShowSvcClient(conn)
+	call := func(tenantID string) (context.Context, context.CancelFunc) {
+		md := metadata.Pairs("authorization", "Bearer "+testToken(t, tenantID))
+		return context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), 5*time.Second)
+	}
+
+	ctx, cancel := call("acme")
+	defer cancel()
+	created, err := shows.CreateShow(ctx, &pb.CreateShowRequest{Title: "Dark", Type: "series", Rating: 8.7, ReleaseDate: timestamppb.New(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := shows.GetShow(ctx, &pb.GetByIDRequest{Id: created.GetId()}); err != nil || got.GetTitle() != "Dark" {
+		t.Fatalf("GetShow under the tenant of the show returned %v, %v", got, err)
+	}
+
+	ctx, cancel = call("globex")
+	defer cancel()
+	if _, err := shows.GetShow(ctx, &pb.GetByIDRequest{Id: created.GetId()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetShow under another tenant returned %v, want NotFound", err)
+	}
+	list, err := shows.ListShows(ctx, &pb.ListShowsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.GetShows()) != 0 || list.GetTotalSize() != 0 {
+		t.Errorf("ListShows under another tenant returned %v, want an empty page", list)
+	}
+	// A show of the same title and date is not a duplicate in another tenant.
+	if _, err := shows.CreateShow(ctx, &pb.CreateShowRequest{Title: "Dark", Type: "series", Rating: 8.7, ReleaseDate: timestamppb.New(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC))}); err != nil {
+		t.Errorf("CreateShow of the same show under another tenant returned %v", err)
+	}
+	for _, database := range []string{"Project_acme", "Project_globex"} {
+		if n := mongoDB.count(database, "Shows"); n != 1 {
+			t.Errorf("%s holds %d shows, want 1", database, n)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+	"int-service/repository"
+)
+
+func (s *GrpcServerProject) listTrash(ctx context.Context, entityType string, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	resp, err := s.service.ListTrash(ctx, entityType, toListOptions(req.PageSize, req.PageToken, "", false))
+	if err != nil {
+		return nil, err
+	}
+
+	trash := &pb.ListTrashResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, item := range resp.Items {
+		trash.Items = append(trash.Items, item.ToGrpc().(*pb.TrashItem))
+	}
+	return trash, nil
+}
+
+func (s *GrpcServerProject) ListTrashedShows(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.ShowEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreShow(ctx context.Context, req *pb.GetByIDRequest) (*pb.Show, error) {
+	resp, err := s.service.RestoreShow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Show), nil
+}
+
+func (s *GrpcServerProject) ListTrashedSeasons(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.SeasonEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreSeason(ctx context.Context, req *pb.GetByIDRequest) (*pb.Season, error) {
+	resp, err := s.service.RestoreSeason(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Season), nil
+}
+
+func (s *GrpcServerProject) ListTrashedEpisodes(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.EpisodeEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreEpisode(ctx context.Context, req *pb.GetByIDRequest) (*pb.Episode, error) {
+	resp, err := s.service.RestoreEpisode(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Episode), nil
+}
+
+func (s *GrpcServerProject) ListTrashedCelebrities(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.CelebrityEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreCelebrity(ctx context.Context, req *pb.GetByIDRequest) (*pb.Celebrity, error) {
+	resp, err := s.service.RestoreCelebrity(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Celebrity), nil
+}
+
+func (s *GrpcServerProject) ListTrashedArticles(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.ArticleEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreArticle(ctx context.Context, req *pb.GetByIDRequest) (*pb.Article, error) {
+	resp, err := s.service.RestoreArticle(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Article), nil
+}
+
+func (s *GrpcServerProject) ListTrashedGenres(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.GenreEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreGenre(ctx context.Context, req *pb.GetByIDRequest) (*pb.Genre, error) {
+	resp, err := s.service.RestoreGenre(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Genre), nil
+}
+
+func (s *GrpcServerProject) ListTrashedJournalists(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.listTrash(ctx, repository.JournalistEntity, req)
+}
+
+func (s *GrpcServerProject) RestoreJournalist(ctx context.Context, req *pb.GetByIDRequest) (*pb.Journalist, error) {
+	resp, err := s.service.RestoreJournalist(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Journalist), nil
+}
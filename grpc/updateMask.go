@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// toUpdateMask returns the paths of mask, renaming the proto fields of
+// message whose stored name differs. The REST gateway builds nested paths
+// from the keys of a PATCH body: a path into a message wrapping a single
+// list, such as starring.shortCelebs, names its whole field, and the other
+// nested paths are left for the service to write into the sub-document.
+func toUpdateMask(mask *fieldmaskpb.FieldMask, message proto.Message, renamed map[string]string) []string {
+	fields := message.ProtoReflect().Descriptor().Fields()
+	paths := []string{}
+	for _, path := range mask.GetPaths() {
+		field, subPath, nested := strings.Cut(path, ".")
+		if nested {
+			if descriptor := fields.ByName(protoreflect.Name(field)); descriptor != nil && descriptor.Message() != nil {
+				wrapped := descriptor.Message().Fields()
+				if wrapped.Len() == 1 && wrapped.Get(0).IsList() && string(wrapped.Get(0).Name()) == subPath {
+					nested = false
+				}
+			}
+		}
+		if name, ok := renamed[field]; ok {
+			field = name
+		}
+		if nested {
+			field += "." + subPath
+		}
+		paths = append(paths, field)
+	}
+	return paths
+}
+
+// inUpdateMask reports whether an update with the given mask writes field.
+func inUpdateMask(mask []string, field string) bool {
+	if len(mask) == 0 {
+		return true
+	}
+	for _, path := range mask {
+		if path == field {
+			return true
+		}
+	}
+	return false
+}
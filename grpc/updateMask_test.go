@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "int-service/_proto"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"int-service/service"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestToUpdateMask(t *testing.T) {
+	renamed := map[string]string{"showLength": "length"}
+	tests := []struct {
+		message proto.Message
+		paths   []string
+		want    []string
+	}{
+		{&pb.Show{}, nil, []string{}},
+		{&pb.Show{}, []string{"title", "rating"}, []string{"title", "rating"}},
+		{&pb.Show{}, []string{"length.minutes"}, []string{"length.minutes"}},
+		{&pb.Show{}, []string{"starring.shortCelebs", "genres.genres", "directedBy.filmCrew"}, []string{"starring", "genres", "directedBy"}},
+		{&pb.Season{}, []string{"episodes.shortEpisodes"}, []string{"episodes"}},
+		{&pb.Episode{}, []string{"showLength", "showLength.hours"}, []string{"length", "length.hours"}},
+		{&pb.Episode{}, []string{"title.size"}, []string{"title.size"}},
+	}
+	for _, tt := range tests {
+		if got := toUpdateMask(&fieldmaskpb.FieldMask{Paths: tt.paths}, tt.message, renamed); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("toUpdateMask(%q) = %q, want %q", tt.paths, got, tt.want)
+		}
+	}
+}
+
+// TestUpdateNestedPaths checks a path into the length of a show or episode
+// only writes that field of the length.
+func TestUpdateNestedPaths(t *testing.T) {
+	ctx := context.Background()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	repo := repository.NewMemoryDB()
+	svc := NewSvc(service.NewSvc(logger, repo), logger)
+	length := dto.ShowLengthDTO{Hours: 1, Minutes: 30}
+	if _, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "m1", Title: "Heat", Type: "movie", Length: length}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", SeasonID: "se1", Title: "Secrets", Length: length}); err != nil {
+		t.Fatal(err)
+	}
+
+	show, err := svc.PatchShow(ctx, &pb.PatchShowRequest{
+		Show:       &pb.Show{Id: "m1", Length: &pb.ShowLength{Minutes: 50}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"length.minutes"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if show.GetLength().GetHours() != 1 || show.GetLength().GetMinutes() != 50 || show.GetTitle() != "Heat" {
+		t.Errorf("the show after an update of length.minutes is %v", show)
+	}
+
+	episode, err := svc.PatchEpisode(ctx, &pb.PatchEpisodeRequest{
+		Episode:    &pb.Episode{Id: "e1", ShowLength: &pb.ShowLength{Hours: 2}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"showLength.hours"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if episode.GetShowLength().GetHours() != 2 || episode.GetShowLength().GetMinutes() != 30 {
+		t.Errorf("the episode length after an update of showLength.hours is %v", episode.GetShowLength())
+	}
+	stored, err := repo.GetEpisode(ctx, "e1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Length != (dto.ShowLengthDTO{Hours: 2, Minutes: 30}) {
+		t.Errorf("the stored episode length is %+v, want 2h30", stored.Length)
+	}
+
+	for _, paths := range [][]string{{"showLength.seconds"}, {"title.size"}, {"starring.shortCelebs.name"}} {
+		_, err := svc.PatchEpisode(ctx, &pb.PatchEpisodeRequest{
+			Episode:    &pb.Episode{Id: "e1", Title: "Lies"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		var fieldErr *models.FieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Field != "updateMask" {
+			t.Errorf("PatchEpisode with the mask %q returned %v, want an updateMask field error", paths, err)
+		}
+	}
+	if stored, err := repo.GetEpisode(ctx, "e1"); err != nil || stored.Title != "Secrets" {
+		t.Errorf("a rejected update changed the episode to %+v, %v", stored, err)
+	}
+}
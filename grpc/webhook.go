@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	pb "int-service/_proto"
+)
+
+func (s *GrpcServerProject) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.Webhook, error) {
+	resp, err := s.service.RegisterWebhook(ctx, req.Url, req.Secret, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToGrpc().(*pb.Webhook), nil
+}
+
+func (s *GrpcServerProject) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	resp, err := s.service.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := &pb.ListWebhooksResponse{}
+	for _, webhook := range resp {
+		webhooks.Webhooks = append(webhooks.Webhooks, webhook.ToGrpc().(*pb.Webhook))
+	}
+	return webhooks, nil
+}
+
+func (s *GrpcServerProject) DeleteWebhook(ctx context.Context, req *pb.GetByIDRequest) (*pb.EmptyResponse, error) {
+	err := s.service.DeleteWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
+func (s *GrpcServerProject) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	resp, err := s.service.ListDeadLetters(ctx, toListOptions(req.PageSize, req.PageToken, "", false))
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := &pb.ListDeadLettersResponse{
+		NextPageToken: resp.NextPageToken,
+		TotalSize:     int32(resp.TotalSize),
+	}
+	for _, deadLetter := range resp.Items {
+		deadLetters.DeadLetters = append(deadLetters.DeadLetters, deadLetter.ToGrpc().(*pb.DeadLetter))
+	}
+	return deadLetters, nil
+}
@@ -1,16 +1,68 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"int-service/app"
 	"os"
+)
 
-	"github.com/sirupsen/logrus"
+const (
+	consistencyCommand = "consistency"
+	exportCommand      = "export"
+	restoreCommand     = "restore"
+	migrateCommand     = "migrate"
 )
 
 func main() {
-	logger := logrus.New()
-	logger.Out = os.Stdout
-	logger.SetFormatter(&logrus.JSONFormatter{})
+	cfg, args, err := app.LoadConfig(os.Args[0], os.Args[1:])
+	if err == flag.ErrHelp {
+		fmt.Fprintf(os.Stderr, "Run without a command to serve the gRPC API, or with one of the commands: %s [-repair], %s FILE, %s FILE, %s [-to VERSION]\n", consistencyCommand, exportCommand, restoreCommand, migrateCommand)
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	logger := app.NewLogger(cfg)
+
+	if len(args) == 0 {
+		if err := app.Initialize(cfg, logger); err != nil {
+			logger.WithError(err).Fatal("Error while serving")
+		}
+		return
+	}
+	switch args[0] {
+	case consistencyCommand:
+		consistency := flag.NewFlagSet(consistencyCommand, flag.ExitOnError)
+		repair := consistency.Bool("repair", false, "rewrite the stale embedded documents from their sources")
+		consistency.Parse(args[1:])
+
+		if err := app.CheckConsistency(cfg, *repair, os.Stdout, logger); err != nil {
+			logger.WithError(err).Fatal("Error while checking consistency")
+		}
+	case exportCommand, restoreCommand:
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, "Usage: "+args[0]+" FILE")
+			os.Exit(2)
+		}
+		run := app.Export
+		if args[0] == restoreCommand {
+			run = app.Restore
+		}
+		if err := run(cfg, args[1], os.Stdout, logger); err != nil {
+			logger.WithError(err).Fatal("Error while running " + args[0])
+		}
+	case migrateCommand:
+		migrate := flag.NewFlagSet(migrateCommand, flag.ExitOnError)
+		to := migrate.Int("to", -1, "version to migrate the Mongo database to, reverting the later migrations, the latest one when negative")
+		migrate.Parse(args[1:])
 
-	app.Initialize("2002", logger)
+		if err := app.Migrate(cfg, *to, os.Stdout, logger); err != nil {
+			logger.WithError(err).Fatal("Error while migrating")
+		}
+	default:
+		fmt.Fprintln(os.Stderr, "Unknown command: "+args[0])
+		os.Exit(2)
+	}
 }
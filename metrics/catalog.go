@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/repository"
+	"int-service/tenant"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const catalogCountTimeout = 5 * time.Second
+
+// catalogCollector counts the documents of every collection of the catalog
+// when the metrics are scraped.
+type catalogCollector struct {
+	repo      repository.ProjectRepository
+	tenants   []string
+	documents *prometheus.Desc
+}
+
+// RegisterCatalog adds gauges with the number of shows, seasons, episodes,
+// celebrities, articles, genres and journalists of the catalog of each
+// tenant of repo, "" being the default catalog.
+func (m *Metrics) RegisterCatalog(repo repository.ProjectRepository, tenants []string) {
+	m.registerer.MustRegister(&catalogCollector{
+		repo:    repo,
+		tenants: tenants,
+		documents: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "catalog", "documents"),
+			"Number of documents of the catalog, by tenant and collection.",
+			[]string{"tenant", "collection"}, nil,
+		),
+	})
+}
+
+func (c *catalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.documents
+}
+
+func (c *catalogCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), catalogCountTimeout)
+	defer cancel()
+
+	for _, tenantID := range c.tenants {
+		c.collectTenant(tenant.ContextWithID(ctx, tenantID), tenantID, ch)
+	}
+}
+
+func (c *catalogCollector) collectTenant(ctx context.Context, tenantID string, ch chan<- prometheus.Metric) {
+	// A page of one document is enough, only the total matters.
+	page := dto.PageDTO{Limit: 1}
+	counts := map[string]func() (int64, error){
+		"shows": func() (int64, error) {
+			_, total, err := c.repo.ListShows(ctx, dto.ShowFilterDTO{}, page)
+			return total, err
+		},
+		"seasons": func() (int64, error) {
+			_, total, err := c.repo.ListSeasonsCollection(ctx, dto.SeasonFilterDTO{}, page)
+			return total, err
+		},
+		"episodes": func() (int64, error) {
+			_, total, err := c.repo.ListCollectionEpisodes(ctx, dto.EpisodeFilterDTO{}, page)
+			return total, err
+		},
+		"celebrities": func() (int64, error) {
+			_, total, err := c.repo.ListCelebrities(ctx, dto.CelebrityFilterDTO{}, page)
+			return total, err
+		},
+		"articles": func() (int64, error) {
+			_, total, err := c.repo.ListArticles(ctx, dto.ArticleFilterDTO{}, page)
+			return total, err
+		},
+		"genres": func() (int64, error) {
+			_, total, err := c.repo.ListGenres(ctx, page)
+			return total, err
+		},
+		"journalists": func() (int64, error) {
+			_, total, err := c.repo.ListJournalists(ctx, page)
+			return total, err
+		},
+	}
+	for collection, count := range counts {
+		total, err := count()
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(c.documents, err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.documents, prometheus.GaugeValue, float64(total), tenantID, collection)
+	}
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "int_service"
+
+// Metrics holds the collectors of the service. They are registered with the
+// registerer given to New, so tests can use a registry of their own.
+type Metrics struct {
+	rpcRequests        *prometheus.CounterVec
+	rpcDuration        *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	repositoryErrors   *prometheus.CounterVec
+	mongoConnections   *prometheus.GaugeVec
+	mongoInUse         *prometheus.GaugeVec
+	mongoCheckoutFails *prometheus.CounterVec
+	mongoPoolClears    *prometheus.CounterVec
+	registerer         prometheus.Registerer
+}
+
+func New(registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Number of gRPC calls handled, by method and status code.",
+		}, []string{"service", "method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of the gRPC calls, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_call_duration_seconds",
+			Help:      "Duration of the repository calls, by repository method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		repositoryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "repository_errors_total",
+			Help:      "Number of repository calls that returned an error, by repository method.",
+		}, []string{"method"}),
+		mongoConnections: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "mongo_pool_connections",
+			Help:      "Number of open connections of the Mongo pool, by server.",
+		}, []string{"address"}),
+		mongoInUse: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "mongo_pool_connections_in_use",
+			Help:      "Number of connections of the Mongo pool checked out by an operation, by server.",
+		}, []string{"address"}),
+		mongoCheckoutFails: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mongo_pool_checkout_failures_total",
+			Help:      "Number of failed checkouts from the Mongo pool, by server and reason.",
+		}, []string{"address", "reason"}),
+		mongoPoolClears: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mongo_pool_cleared_total",
+			Help:      "Number of times the Mongo pool of a server was cleared after an error.",
+		}, []string{"address"}),
+		registerer: registerer,
+	}
+	registerer.MustRegister(
+		m.rpcRequests,
+		m.rpcDuration,
+		m.repositoryDuration,
+		m.repositoryErrors,
+		m.mongoConnections,
+		m.mongoInUse,
+		m.mongoCheckoutFails,
+		m.mongoPoolClears,
+	)
+	return m
+}
+
+func (m *Metrics) observeRepository(method string, start time.Time, err *error) {
+	m.repositoryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if *err != nil {
+		m.repositoryErrors.WithLabelValues(method).Inc()
+	}
+}
@@ -0,0 +1,190 @@
+package metrics
+
+import (
+	"context"
+	pb "int-service/_proto"
+	transport_grpc "int-service/grpc"
+	"int-service/repository"
+	"int-service/service"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	promdto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startServer serves the genres of an empty memory catalog through the
+// metrics interceptor and repository, recording into registry.
+func startServer(t *testing.T, registry *prometheus.Registry) pb.GenreSvcClient {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	m := New(registry)
+	repo := m.Repository(repository.NewMemoryDB())
+	m.RegisterCatalog(repo, []string{""})
+
+	interceptors := append([]grpc.UnaryServerInterceptor{m.UnaryServerInterceptor}, transport_grpc.UnaryInterceptors(logger, nil, nil, nil)...)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	pb.RegisterGenreSvcServer(s, transport_grpc.NewSvc(service.NewSvc(logger, repo), logger))
+	listen := bufconn.Listen(1 << 20)
+	go s.Serve(listen)
+	t.Cleanup(s.Stop)
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listen.DialContext(ctx)
+	}
+	conn, err := grpc.Dial("bufconn", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewGenreSvcClient(conn)
+}
+
+// sample returns the metric of family name with the given labels, or nil.
+func sample(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) *promdto.Metric {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, labels) {
+				return metric
+			}
+		}
+	}
+	return nil
+}
+
+func hasLabels(metric *promdto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range metric.GetLabel() {
+		if value, ok := labels[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
+
+func counterValue(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) float64 {
+	t.Helper()
+	metric := sample(t, registry, name, labels)
+	if metric == nil {
+		return 0
+	}
+	return metric.GetCounter().GetValue()
+}
+
+func histogramCount(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) uint64 {
+	t.Helper()
+	metric := sample(t, registry, name, labels)
+	if metric == nil {
+		return 0
+	}
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestMetricsOfCalls(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	client := startServer(t, registry)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	genre, err := client.CreateGenre(ctx, &pb.CreateGenreRequest{Name: "Drama"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetGenre(ctx, &pb.GetByIDRequest{Id: genre.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetGenre(ctx, &pb.GetByIDRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetGenre of a missing genre answered %v", err)
+	}
+
+	requests := []struct {
+		method string
+		code   string
+		want   float64
+	}{
+		{"CreateGenre", "OK", 1},
+		{"GetGenre", "OK", 1},
+		{"GetGenre", "NotFound", 1},
+		{"ListGenres", "OK", 0},
+	}
+	for _, tt := range requests {
+		labels := map[string]string{"service": "service.GenreSvc", "method": tt.method, "code": tt.code}
+		if got := counterValue(t, registry, "int_service_grpc_requests_total", labels); got != tt.want {
+			t.Errorf("grpc_requests_total%v = %v, want %v", labels, got, tt.want)
+		}
+	}
+	durations := map[string]uint64{"CreateGenre": 1, "GetGenre": 2}
+	for method, want := range durations {
+		labels := map[string]string{"service": "service.GenreSvc", "method": method}
+		if got := histogramCount(t, registry, "int_service_grpc_request_duration_seconds", labels); got != want {
+			t.Errorf("grpc_request_duration_seconds%v has %d samples, want %d", labels, got, want)
+		}
+	}
+
+	// CreateGenre checks the name is free, then writes the genre and its
+	// revision in a transaction.
+	repositoryCalls := []struct {
+		method string
+		calls  uint64
+		errors float64
+	}{
+		{"GetGenreByName", 1, 1},
+		{"WithTransaction", 1, 0},
+		{"CreateGenre", 1, 0},
+		{"AddRevision", 1, 0},
+		{"GetGenre", 2, 1},
+		{"DeleteGenre", 0, 0},
+	}
+	for _, tt := range repositoryCalls {
+		labels := map[string]string{"method": tt.method}
+		if got := histogramCount(t, registry, "int_service_repository_call_duration_seconds", labels); got != tt.calls {
+			t.Errorf("repository_call_duration_seconds%v has %d samples, want %d", labels, got, tt.calls)
+		}
+		if got := counterValue(t, registry, "int_service_repository_errors_total", labels); got != tt.errors {
+			t.Errorf("repository_errors_total%v = %v, want %v", labels, got, tt.errors)
+		}
+	}
+
+	documents := sample(t, registry, "int_service_catalog_documents", map[string]string{"tenant": "", "collection": "genres"})
+	if documents == nil || documents.GetGauge().GetValue() != 1 {
+		t.Errorf("catalog_documents of genres is %v, want 1", documents)
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		service    string
+		method     string
+	}{
+		{"/service.GenreSvc/GetGenre", "service.GenreSvc", "GetGenre"},
+		{"/grpc.health.v1.Health/Check", "grpc.health.v1.Health", "Check"},
+		{"Check", "unknown", "Check"},
+	}
+	for _, tt := range tests {
+		service, method := splitMethod(tt.fullMethod)
+		if service != tt.service || method != tt.method {
+			t.Errorf("splitMethod(%q) = %q, %q, want %q, %q", tt.fullMethod, service, method, tt.service, tt.method)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// PoolMonitor returns a Mongo pool monitor feeding the pool metrics, to be
+// set on the client options.
+func (m *Metrics) PoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: m.observePool,
+	}
+}
+
+func (m *Metrics) observePool(e *event.PoolEvent) {
+	switch e.Type {
+	case event.ConnectionCreated:
+		m.mongoConnections.WithLabelValues(e.Address).Inc()
+	case event.ConnectionClosed:
+		m.mongoConnections.WithLabelValues(e.Address).Dec()
+	case event.GetSucceeded:
+		m.mongoInUse.WithLabelValues(e.Address).Inc()
+	case event.ConnectionReturned:
+		m.mongoInUse.WithLabelValues(e.Address).Dec()
+	case event.GetFailed:
+		m.mongoCheckoutFails.WithLabelValues(e.Address, e.Reason).Inc()
+	case event.PoolCleared:
+		m.mongoPoolClears.WithLabelValues(e.Address).Inc()
+	}
+}
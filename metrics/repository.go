@@ -0,0 +1,510 @@
+package metrics
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/repository"
+	"time"
+)
+
+// repositoryMetrics times every call of the repository it wraps.
+type repositoryMetrics struct {
+	next    repository.ProjectRepository
+	metrics *Metrics
+}
+
+// Repository wraps repo so the duration and the errors of each of its methods
+// are recorded.
+func (m *Metrics) Repository(repo repository.ProjectRepository) repository.ProjectRepository {
+	return &repositoryMetrics{next: repo, metrics: m}
+}
+
+//------SHOWS------
+
+func (r *repositoryMetrics) CreateShow(ctx context.Context, newShow *dto.ShowDTO) (_ *dto.ShowDTO, err error) {
+	defer r.metrics.observeRepository("CreateShow", time.Now(), &err)
+	return r.next.CreateShow(ctx, newShow)
+}
+
+func (r *repositoryMetrics) AddShortSeason(ctx context.Context, showID string, season *dto.ShortSeasonDTO) (_ *dto.ShortSeasonDTO, err error) {
+	defer r.metrics.observeRepository("AddShortSeason", time.Now(), &err)
+	return r.next.AddShortSeason(ctx, showID, season)
+}
+
+func (r *repositoryMetrics) GetShow(ctx context.Context, ID string) (_ *dto.ShowDTO, err error) {
+	defer r.metrics.observeRepository("GetShow", time.Now(), &err)
+	return r.next.GetShow(ctx, ID)
+}
+
+func (r *repositoryMetrics) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (_ *dto.ShowDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShow", time.Now(), &err)
+	return r.next.UpdateShow(ctx, updatedShow, fields)
+}
+
+func (r *repositoryMetrics) UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (_ *dto.ShortSeasonDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShortSeason", time.Now(), &err)
+	return r.next.UpdateShortSeason(ctx, updatedSeason)
+}
+
+func (r *repositoryMetrics) UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (_ *dto.ShortCelebrityDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShortCelebritiesInShow", time.Now(), &err)
+	return r.next.UpdateShortCelebritiesInShow(ctx, updatedCelebrity, celebrityType)
+}
+
+func (r *repositoryMetrics) ListShows(ctx context.Context, filter dto.ShowFilterDTO, page dto.PageDTO) (_ dto.ShowsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListShows", time.Now(), &err)
+	return r.next.ListShows(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (_ *dto.ShowDTO, err error) {
+	defer r.metrics.observeRepository("UploadSeriesPosters", time.Now(), &err)
+	return r.next.UploadSeriesPosters(ctx, ID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteSeriesPoster(ctx context.Context, ID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteSeriesPoster", time.Now(), &err)
+	return r.next.DeleteSeriesPoster(ctx, ID, image)
+}
+
+func (r *repositoryMetrics) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (_ *dto.ShowDTO, err error) {
+	defer r.metrics.observeRepository("UploadMoviePosters", time.Now(), &err)
+	return r.next.UploadMoviePosters(ctx, ID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteMoviePoster(ctx context.Context, ID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteMoviePoster", time.Now(), &err)
+	return r.next.DeleteMoviePoster(ctx, ID, image)
+}
+
+func (r *repositoryMetrics) DeleteShortCelebritiesPostersInShow(ctx context.Context, ID string, image string, celebrityType string) (err error) {
+	defer r.metrics.observeRepository("DeleteShortCelebritiesPostersInShow", time.Now(), &err)
+	return r.next.DeleteShortCelebritiesPostersInShow(ctx, ID, image, celebrityType)
+}
+
+func (r *repositoryMetrics) DeleteShortSeasonPostersInShow(ctx context.Context, seriesID string, seasonID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteShortSeasonPostersInShow", time.Now(), &err)
+	return r.next.DeleteShortSeasonPostersInShow(ctx, seriesID, seasonID, image)
+}
+
+func (r *repositoryMetrics) DeleteShow(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteShow", time.Now(), &err)
+	return r.next.DeleteShow(ctx, ID)
+}
+
+func (r *repositoryMetrics) RemoveShortSeason(ctx context.Context, seasonID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortSeason", time.Now(), &err)
+	return r.next.RemoveShortSeason(ctx, seasonID)
+}
+
+func (r *repositoryMetrics) RemoveShortCelebrityInShows(ctx context.Context, celebrityID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortCelebrityInShows", time.Now(), &err)
+	return r.next.RemoveShortCelebrityInShows(ctx, celebrityID)
+}
+
+func (r *repositoryMetrics) RemoveShortGenre(ctx context.Context, genreID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortGenre", time.Now(), &err)
+	return r.next.RemoveShortGenre(ctx, genreID)
+}
+
+//------SEASONS------
+
+func (r *repositoryMetrics) CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (_ *dto.SeasonDTO, err error) {
+	defer r.metrics.observeRepository("CreateSeason", time.Now(), &err)
+	return r.next.CreateSeason(ctx, newSeason)
+}
+
+func (r *repositoryMetrics) AddShortEpisode(ctx context.Context, seasonID string, newEpisode *dto.ShortEpisodeDTO) (_ *dto.ShortEpisodeDTO, err error) {
+	defer r.metrics.observeRepository("AddShortEpisode", time.Now(), &err)
+	return r.next.AddShortEpisode(ctx, seasonID, newEpisode)
+}
+
+func (r *repositoryMetrics) GetSeason(ctx context.Context, seasonID string) (_ *dto.SeasonDTO, err error) {
+	defer r.metrics.observeRepository("GetSeason", time.Now(), &err)
+	return r.next.GetSeason(ctx, seasonID)
+}
+
+func (r *repositoryMetrics) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (_ *dto.SeasonDTO, err error) {
+	defer r.metrics.observeRepository("UpdateSeason", time.Now(), &err)
+	return r.next.UpdateSeason(ctx, updatedSeason, fields)
+}
+
+func (r *repositoryMetrics) UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (_ *dto.ShortEpisodeDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShortEpisode", time.Now(), &err)
+	return r.next.UpdateShortEpisode(ctx, updatedEpisode)
+}
+
+func (r *repositoryMetrics) UpdateShortCelebritiesInSeasons(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (_ *dto.ShortCelebrityDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShortCelebritiesInSeasons", time.Now(), &err)
+	return r.next.UpdateShortCelebritiesInSeasons(ctx, updatedCelebrity, celebrityType)
+}
+
+func (r *repositoryMetrics) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (_ *dto.SeasonDTO, err error) {
+	defer r.metrics.observeRepository("UploadSeasonPosters", time.Now(), &err)
+	return r.next.UploadSeasonPosters(ctx, seasonID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteSeasonPoster", time.Now(), &err)
+	return r.next.DeleteSeasonPoster(ctx, seriesID, seasonID, image)
+}
+
+func (r *repositoryMetrics) DeleteShortCelebritiesPostersInSeason(ctx context.Context, celebrityID string, image string, celebrityType string) (err error) {
+	defer r.metrics.observeRepository("DeleteShortCelebritiesPostersInSeason", time.Now(), &err)
+	return r.next.DeleteShortCelebritiesPostersInSeason(ctx, celebrityID, image, celebrityType)
+}
+
+func (r *repositoryMetrics) ListShowSeasons(ctx context.Context, ID string) (_ dto.SeasonsDTO, err error) {
+	defer r.metrics.observeRepository("ListShowSeasons", time.Now(), &err)
+	return r.next.ListShowSeasons(ctx, ID)
+}
+
+func (r *repositoryMetrics) ListSeasonsCollection(ctx context.Context, filter dto.SeasonFilterDTO, page dto.PageDTO) (_ dto.SeasonsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListSeasonsCollection", time.Now(), &err)
+	return r.next.ListSeasonsCollection(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) DeleteSeason(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteSeason", time.Now(), &err)
+	return r.next.DeleteSeason(ctx, ID)
+}
+
+func (r *repositoryMetrics) RemoveShortEpisode(ctx context.Context, episodeID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortEpisode", time.Now(), &err)
+	return r.next.RemoveShortEpisode(ctx, episodeID)
+}
+
+func (r *repositoryMetrics) RemoveShortCelebrityInSeasons(ctx context.Context, celebrityID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortCelebrityInSeasons", time.Now(), &err)
+	return r.next.RemoveShortCelebrityInSeasons(ctx, celebrityID)
+}
+
+//------CELEBRITIES------
+
+func (r *repositoryMetrics) CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (_ *dto.CelebrityDTO, err error) {
+	defer r.metrics.observeRepository("CreateCelebrity", time.Now(), &err)
+	return r.next.CreateCelebrity(ctx, newCelebrity)
+}
+
+func (r *repositoryMetrics) GetCelebrity(ctx context.Context, ID string) (_ *dto.CelebrityDTO, err error) {
+	defer r.metrics.observeRepository("GetCelebrity", time.Now(), &err)
+	return r.next.GetCelebrity(ctx, ID)
+}
+
+func (r *repositoryMetrics) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (_ *dto.CelebrityDTO, err error) {
+	defer r.metrics.observeRepository("UpdateCelebrity", time.Now(), &err)
+	return r.next.UpdateCelebrity(ctx, updatedCelebrity, fields)
+}
+
+func (r *repositoryMetrics) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (_ *dto.CelebrityDTO, err error) {
+	defer r.metrics.observeRepository("UploadCelebrityPosters", time.Now(), &err)
+	return r.next.UploadCelebrityPosters(ctx, ID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteCelebrityPoster(ctx context.Context, ID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteCelebrityPoster", time.Now(), &err)
+	return r.next.DeleteCelebrityPoster(ctx, ID, image)
+}
+
+func (r *repositoryMetrics) ListCelebrities(ctx context.Context, filter dto.CelebrityFilterDTO, page dto.PageDTO) (_ dto.CelebritiesDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListCelebrities", time.Now(), &err)
+	return r.next.ListCelebrities(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) DeleteCelebrity(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteCelebrity", time.Now(), &err)
+	return r.next.DeleteCelebrity(ctx, ID)
+}
+
+//------EPISODES------
+
+func (r *repositoryMetrics) CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (_ *dto.EpisodeDTO, err error) {
+	defer r.metrics.observeRepository("CreateEpisode", time.Now(), &err)
+	return r.next.CreateEpisode(ctx, newEpisode)
+}
+
+func (r *repositoryMetrics) GetEpisode(ctx context.Context, ID string) (_ *dto.EpisodeDTO, err error) {
+	defer r.metrics.observeRepository("GetEpisode", time.Now(), &err)
+	return r.next.GetEpisode(ctx, ID)
+}
+
+func (r *repositoryMetrics) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (_ *dto.EpisodeDTO, err error) {
+	defer r.metrics.observeRepository("UpdateEpisode", time.Now(), &err)
+	return r.next.UpdateEpisode(ctx, updatedEpisode, fields)
+}
+
+func (r *repositoryMetrics) UpdateShortCelebritiesInEpisode(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (_ *dto.ShortCelebrityDTO, err error) {
+	defer r.metrics.observeRepository("UpdateShortCelebritiesInEpisode", time.Now(), &err)
+	return r.next.UpdateShortCelebritiesInEpisode(ctx, updatedCelebrity, celebrityType)
+}
+
+func (r *repositoryMetrics) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (_ *dto.EpisodeDTO, err error) {
+	defer r.metrics.observeRepository("UploadEpisodePosters", time.Now(), &err)
+	return r.next.UploadEpisodePosters(ctx, episodeID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteEpisodePoster", time.Now(), &err)
+	return r.next.DeleteEpisodePoster(ctx, seriesID, seasonID, episodeID, image)
+}
+
+func (r *repositoryMetrics) DeleteShortCelebritiesPostersInEpisode(ctx context.Context, celebrityID string, image string, celebrityType string) (err error) {
+	defer r.metrics.observeRepository("DeleteShortCelebritiesPostersInEpisode", time.Now(), &err)
+	return r.next.DeleteShortCelebritiesPostersInEpisode(ctx, celebrityID, image, celebrityType)
+}
+
+func (r *repositoryMetrics) ListSeasonEpisodes(ctx context.Context, seasonID string) (_ dto.EpisodesDTO, err error) {
+	defer r.metrics.observeRepository("ListSeasonEpisodes", time.Now(), &err)
+	return r.next.ListSeasonEpisodes(ctx, seasonID)
+}
+
+func (r *repositoryMetrics) ListCollectionEpisodes(ctx context.Context, filter dto.EpisodeFilterDTO, page dto.PageDTO) (_ dto.EpisodesDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListCollectionEpisodes", time.Now(), &err)
+	return r.next.ListCollectionEpisodes(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) DeleteEpisode(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteEpisode", time.Now(), &err)
+	return r.next.DeleteEpisode(ctx, ID)
+}
+
+func (r *repositoryMetrics) RemoveShortCelebrityInEpisodes(ctx context.Context, celebrityID string) (err error) {
+	defer r.metrics.observeRepository("RemoveShortCelebrityInEpisodes", time.Now(), &err)
+	return r.next.RemoveShortCelebrityInEpisodes(ctx, celebrityID)
+}
+
+//------ARTICLES------
+
+func (r *repositoryMetrics) CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (_ *dto.ArticleDTO, err error) {
+	defer r.metrics.observeRepository("CreateArticle", time.Now(), &err)
+	return r.next.CreateArticle(ctx, newArticle)
+}
+
+func (r *repositoryMetrics) GetArticle(ctx context.Context, ID string) (_ *dto.ArticleDTO, err error) {
+	defer r.metrics.observeRepository("GetArticle", time.Now(), &err)
+	return r.next.GetArticle(ctx, ID)
+}
+
+func (r *repositoryMetrics) UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (_ *dto.ArticleDTO, err error) {
+	defer r.metrics.observeRepository("UpdateArticle", time.Now(), &err)
+	return r.next.UpdateArticle(ctx, updatedArticle)
+}
+
+func (r *repositoryMetrics) ListArticles(ctx context.Context, filter dto.ArticleFilterDTO, page dto.PageDTO) (_ dto.ArticlesDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListArticles", time.Now(), &err)
+	return r.next.ListArticles(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) ListArticlesByJournalist(ctx context.Context, ID string) (_ dto.ArticlesDTO, err error) {
+	defer r.metrics.observeRepository("ListArticlesByJournalist", time.Now(), &err)
+	return r.next.ListArticlesByJournalist(ctx, ID)
+}
+
+func (r *repositoryMetrics) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (_ *dto.ArticleDTO, err error) {
+	defer r.metrics.observeRepository("UploadArticlePosters", time.Now(), &err)
+	return r.next.UploadArticlePosters(ctx, ID, postersPath)
+}
+
+func (r *repositoryMetrics) DeleteArticlePoster(ctx context.Context, ID string, image string) (err error) {
+	defer r.metrics.observeRepository("DeleteArticlePoster", time.Now(), &err)
+	return r.next.DeleteArticlePoster(ctx, ID, image)
+}
+
+func (r *repositoryMetrics) DeleteArticle(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteArticle", time.Now(), &err)
+	return r.next.DeleteArticle(ctx, ID)
+}
+
+//------GENRES------
+
+func (r *repositoryMetrics) CreateGenre(ctx context.Context, newGenre *dto.GenreDTO) (_ *dto.GenreDTO, err error) {
+	defer r.metrics.observeRepository("CreateGenre", time.Now(), &err)
+	return r.next.CreateGenre(ctx, newGenre)
+}
+
+func (r *repositoryMetrics) GetGenre(ctx context.Context, ID string) (_ *dto.GenreDTO, err error) {
+	defer r.metrics.observeRepository("GetGenre", time.Now(), &err)
+	return r.next.GetGenre(ctx, ID)
+}
+
+func (r *repositoryMetrics) GetGenreByName(ctx context.Context, name string) (_ *dto.GenreDTO, err error) {
+	defer r.metrics.observeRepository("GetGenreByName", time.Now(), &err)
+	return r.next.GetGenreByName(ctx, name)
+}
+
+func (r *repositoryMetrics) UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (_ *dto.GenreDTO, err error) {
+	defer r.metrics.observeRepository("UpdateGenre", time.Now(), &err)
+	return r.next.UpdateGenre(ctx, updatedGenre)
+}
+
+func (r *repositoryMetrics) ListGenres(ctx context.Context, page dto.PageDTO) (_ dto.GenresDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListGenres", time.Now(), &err)
+	return r.next.ListGenres(ctx, page)
+}
+
+func (r *repositoryMetrics) DeleteGenre(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteGenre", time.Now(), &err)
+	return r.next.DeleteGenre(ctx, ID)
+}
+
+//------JOURNALISTS------
+
+func (r *repositoryMetrics) CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (_ *dto.JournalistDTO, err error) {
+	defer r.metrics.observeRepository("CreateJournalist", time.Now(), &err)
+	return r.next.CreateJournalist(ctx, newJournalist)
+}
+
+func (r *repositoryMetrics) GetJournalist(ctx context.Context, ID string) (_ *dto.JournalistDTO, err error) {
+	defer r.metrics.observeRepository("GetJournalist", time.Now(), &err)
+	return r.next.GetJournalist(ctx, ID)
+}
+
+func (r *repositoryMetrics) UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (_ *dto.JournalistDTO, err error) {
+	defer r.metrics.observeRepository("UpdateJournalist", time.Now(), &err)
+	return r.next.UpdateJournalist(ctx, updatedJournalist)
+}
+
+func (r *repositoryMetrics) ListJournalists(ctx context.Context, page dto.PageDTO) (_ dto.JournalistsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListJournalists", time.Now(), &err)
+	return r.next.ListJournalists(ctx, page)
+}
+
+func (r *repositoryMetrics) GetJournalistByName(ctx context.Context, name string) (_ *dto.JournalistDTO, err error) {
+	defer r.metrics.observeRepository("GetJournalistByName", time.Now(), &err)
+	return r.next.GetJournalistByName(ctx, name)
+}
+
+func (r *repositoryMetrics) DeleteJournalist(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteJournalist", time.Now(), &err)
+	return r.next.DeleteJournalist(ctx, ID)
+}
+
+//------SEARCH------
+
+func (r *repositoryMetrics) Search(ctx context.Context, query string, types []string, page dto.PageDTO) (_ dto.SearchHitsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("Search", time.Now(), &err)
+	return r.next.Search(ctx, query, types, page)
+}
+
+//------CHANGES------
+
+// WatchChanges is not timed, it lasts as long as its stream.
+func (r *repositoryMetrics) WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error {
+	return r.next.WatchChanges(ctx, entityTypes, resumeToken, fn)
+}
+
+//------OUTBOX------
+
+func (r *repositoryMetrics) AddOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) (err error) {
+	defer r.metrics.observeRepository("AddOutboxEvent", time.Now(), &err)
+	return r.next.AddOutboxEvent(ctx, event)
+}
+
+func (r *repositoryMetrics) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) (_ dto.OutboxEventsDTO, err error) {
+	defer r.metrics.observeRepository("ListDueOutboxEvents", time.Now(), &err)
+	return r.next.ListDueOutboxEvents(ctx, now, limit)
+}
+
+func (r *repositoryMetrics) UpdateOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) (err error) {
+	defer r.metrics.observeRepository("UpdateOutboxEvent", time.Now(), &err)
+	return r.next.UpdateOutboxEvent(ctx, event)
+}
+
+func (r *repositoryMetrics) DeleteOutboxEvent(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteOutboxEvent", time.Now(), &err)
+	return r.next.DeleteOutboxEvent(ctx, ID)
+}
+
+func (r *repositoryMetrics) ListDeadLetters(ctx context.Context, page dto.PageDTO) (_ dto.OutboxEventsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListDeadLetters", time.Now(), &err)
+	return r.next.ListDeadLetters(ctx, page)
+}
+
+func (r *repositoryMetrics) PurgeDeadLetters(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer r.metrics.observeRepository("PurgeDeadLetters", time.Now(), &err)
+	return r.next.PurgeDeadLetters(ctx, before)
+}
+
+//------WEBHOOKS------
+
+func (r *repositoryMetrics) CreateWebhook(ctx context.Context, newWebhook *dto.WebhookDTO) (_ *dto.WebhookDTO, err error) {
+	defer r.metrics.observeRepository("CreateWebhook", time.Now(), &err)
+	return r.next.CreateWebhook(ctx, newWebhook)
+}
+
+func (r *repositoryMetrics) ListWebhooks(ctx context.Context) (_ dto.WebhooksDTO, err error) {
+	defer r.metrics.observeRepository("ListWebhooks", time.Now(), &err)
+	return r.next.ListWebhooks(ctx)
+}
+
+func (r *repositoryMetrics) DeleteWebhook(ctx context.Context, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteWebhook", time.Now(), &err)
+	return r.next.DeleteWebhook(ctx, ID)
+}
+
+//------TRASH------
+
+func (r *repositoryMetrics) AddTrashItem(ctx context.Context, item *dto.TrashItemDTO) (err error) {
+	defer r.metrics.observeRepository("AddTrashItem", time.Now(), &err)
+	return r.next.AddTrashItem(ctx, item)
+}
+
+func (r *repositoryMetrics) GetTrashItem(ctx context.Context, entityType string, ID string) (_ *dto.TrashItemDTO, err error) {
+	defer r.metrics.observeRepository("GetTrashItem", time.Now(), &err)
+	return r.next.GetTrashItem(ctx, entityType, ID)
+}
+
+func (r *repositoryMetrics) ListTrashItems(ctx context.Context, filter dto.TrashFilterDTO, page dto.PageDTO) (_ dto.TrashItemsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListTrashItems", time.Now(), &err)
+	return r.next.ListTrashItems(ctx, filter, page)
+}
+
+func (r *repositoryMetrics) DeleteTrashItem(ctx context.Context, entityType string, ID string) (err error) {
+	defer r.metrics.observeRepository("DeleteTrashItem", time.Now(), &err)
+	return r.next.DeleteTrashItem(ctx, entityType, ID)
+}
+
+func (r *repositoryMetrics) RestoreDocument(ctx context.Context, entityType string, ID string) (err error) {
+	defer r.metrics.observeRepository("RestoreDocument", time.Now(), &err)
+	return r.next.RestoreDocument(ctx, entityType, ID)
+}
+
+func (r *repositoryMetrics) PurgeTrashItems(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer r.metrics.observeRepository("PurgeTrashItems", time.Now(), &err)
+	return r.next.PurgeTrashItems(ctx, before)
+}
+
+//------REVISIONS------
+
+func (r *repositoryMetrics) AddRevision(ctx context.Context, revision *dto.RevisionDTO) (err error) {
+	defer r.metrics.observeRepository("AddRevision", time.Now(), &err)
+	return r.next.AddRevision(ctx, revision)
+}
+
+func (r *repositoryMetrics) GetRevision(ctx context.Context, ID string) (_ *dto.RevisionDTO, err error) {
+	defer r.metrics.observeRepository("GetRevision", time.Now(), &err)
+	return r.next.GetRevision(ctx, ID)
+}
+
+func (r *repositoryMetrics) ListRevisions(ctx context.Context, entityType string, entityID string, page dto.PageDTO) (_ dto.RevisionsDTO, _ int64, err error) {
+	defer r.metrics.observeRepository("ListRevisions", time.Now(), &err)
+	return r.next.ListRevisions(ctx, entityType, entityID, page)
+}
+
+func (r *repositoryMetrics) PruneRevisions(ctx context.Context, keep int) (_ int64, err error) {
+	defer r.metrics.observeRepository("PruneRevisions", time.Now(), &err)
+	return r.next.PruneRevisions(ctx, keep)
+}
+
+//------TRANSACTIONS------
+
+func (r *repositoryMetrics) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer r.metrics.observeRepository("WithTransaction", time.Now(), &err)
+	return r.next.WithTransaction(ctx, fn)
+}
+
+//------HEALTH------
+
+func (r *repositoryMetrics) Ping(ctx context.Context) (err error) {
+	defer r.metrics.observeRepository("Ping", time.Now(), &err)
+	return r.next.Ping(ctx)
+}
@@ -0,0 +1,40 @@
+package models
+
+import "errors"
+
+// Domain errors returned by the service and repository layers. Wrap them
+// with errors.Wrap so the transport layer can still find them with errors.Is.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrAlreadyExists    = errors.New("already exists")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrResourceExhausted reports a caller that used more than its share,
+	// such as a change stream reader too slow to keep up with the changes.
+	ErrResourceExhausted = errors.New("resource exhausted")
+	// ErrFailedPrecondition reports a call the catalog is not in a state to
+	// serve, such as restoring a season of a show that is in the trash.
+	ErrFailedPrecondition = errors.New("failed precondition")
+)
+
+// FieldError reports an invalid request field. It matches ErrInvalidArgument.
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func NewFieldError(field string, description string) error {
+	return &FieldError{
+		Field:       field,
+		Description: description,
+	}
+}
+
+func (e *FieldError) Error() string {
+	return "invalid " + e.Field + ": " + e.Description
+}
+
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
@@ -167,3 +167,188 @@ type Journalist struct {
 type ShortJournalist struct {
 	ID string
 }
+
+type SearchHit struct {
+	Type    string
+	ID      string
+	Title   string
+	Snippet string
+	Score   float64
+}
+
+// ChangeEvent is a committed change of a document of the catalog. Document
+// is nil for deletions.
+type ChangeEvent struct {
+	EntityType  string
+	Operation   string
+	ID          string
+	Document    ResponseModeler
+	ResumeToken string
+	Time        time.Time
+}
+
+// Webhook is an endpoint receiving catalog events. Secret is only set when
+// the webhook is registered.
+type Webhook struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	WebhookID string
+	URL       string
+	Status    string
+	Attempts  int
+	LastError string
+}
+
+// DeadLetter is an event given up for at least one of its webhooks.
+type DeadLetter struct {
+	ID         string
+	Type       string
+	EntityType string
+	EntityID   string
+	Payload    string
+	CreatedAt  time.Time
+	Deliveries []*WebhookDelivery
+}
+
+// TrashItem is a deleted document, restorable until it is purged.
+type TrashItem struct {
+	ID         string
+	EntityType string
+	Name       string
+	ParentID   string
+	DeletedAt  time.Time
+	DeletedBy  string
+}
+
+// Revision is the state of a document after one of its creates or updates,
+// with Snapshot holding the JSON of the whole document.
+type Revision struct {
+	ID         string
+	EntityType string
+	EntityID   string
+	Number     int64
+	Actor      string
+	Method     string
+	CreatedAt  time.Time
+	Snapshot   string
+}
+
+// RevisionDiff lists the fields that differ between two revisions of a
+// document.
+type RevisionDiff struct {
+	From    *Revision
+	To      *Revision
+	Changes []*FieldChange
+}
+
+// FieldChange is a top-level field of a document with the JSON of its value
+// in two revisions, empty where the field is absent.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// ConsistencyIssue is an embedded copy of an entity that differs from its
+// source, or whose source does not exist anymore.
+type ConsistencyIssue struct {
+	Collection  string
+	DocumentID  string
+	Field       string
+	ReferenceID string
+	Property    string
+	Expected    string
+	Found       string
+	Missing     bool
+}
+
+type ConsistencyReport struct {
+	Checked  int
+	Issues   []*ConsistencyIssue
+	Repaired int
+}
+
+const (
+	ImportCreated  = "created"
+	ImportExisting = "existing"
+	ImportFailed   = "failed"
+)
+
+// ImportBundle is a batch of celebrities and of shows with their seasons and
+// episodes, referencing celebrities and genres by natural key instead of ID.
+type ImportBundle struct {
+	Celebrities Celebrities
+	Shows       []*ImportShow
+}
+
+// CelebrityRef references a celebrity by name and date of birth, which is
+// zero when unknown.
+type CelebrityRef struct {
+	Name        string
+	DateOfBirth time.Time
+	RoleName    string
+}
+
+type ImportShow struct {
+	Title       string
+	Type        string
+	PostersPath []string
+	ReleaseDate time.Time
+	EndDate     time.Time
+	Rating      float64
+	Length      ShowLength
+	TrailerURL  string
+	Genres      []string
+	DirectedBy  []*CelebrityRef
+	ProducedBy  []*CelebrityRef
+	WrittenBy   []*CelebrityRef
+	Starring    []*CelebrityRef
+	Description string
+	Seasons     []*ImportSeason
+}
+
+type ImportSeason struct {
+	Title       string
+	TrailerURL  string
+	PostersPath []string
+	Resume      string
+	Rating      float64
+	ReleaseDate time.Time
+	WrittenBy   []*CelebrityRef
+	ProducedBy  []*CelebrityRef
+	DirectedBy  []*CelebrityRef
+	Episodes    []*ImportEpisode
+}
+
+type ImportEpisode struct {
+	Title       string
+	PostersPath []string
+	TrailerURL  string
+	Length      ShowLength
+	Rating      float64
+	Resume      string
+	WrittenBy   []*CelebrityRef
+	ProducedBy  []*CelebrityRef
+	DirectedBy  []*CelebrityRef
+	Starring    []*CelebrityRef
+}
+
+// ImportResult is the outcome of importing one record of a bundle.
+type ImportResult struct {
+	Kind   string
+	Key    string
+	ID     string
+	Status string
+	Error  string
+}
+
+type ImportReport struct {
+	DryRun  bool
+	Results []*ImportResult
+}
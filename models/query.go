@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type ListOptions struct {
+	PageSize   int
+	PageToken  string
+	SortBy     string
+	Descending bool
+}
+
+type ListResult struct {
+	Items         []ResponseModeler
+	NextPageToken string
+	TotalSize     int64
+}
+
+type ShowFilter struct {
+	Type           string
+	GenreID        string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+	MinRating      float64
+}
+
+type SeasonFilter struct {
+	ShowID         string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+	MinRating      float64
+}
+
+type EpisodeFilter struct {
+	SeasonID  string
+	MinRating float64
+}
+
+type CelebrityFilter struct {
+	Occupation string
+}
+
+type ArticleFilter struct {
+	JournalistID   string
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+}
@@ -214,3 +214,158 @@ func (e *ShortEpisode) ToGrpc() interface{} {
 		Resume:      e.Resume,
 	}
 }
+
+func (h *SearchHit) ToGrpc() interface{} {
+	return &pb.SearchHit{
+		Type:    h.Type,
+		Id:      h.ID,
+		Title:   h.Title,
+		Snippet: h.Snippet,
+		Score:   h.Score,
+	}
+}
+
+func (e *ChangeEvent) ToGrpc() interface{} {
+	event := &pb.ChangeEvent{
+		EntityType:  e.EntityType,
+		Operation:   e.Operation,
+		Id:          e.ID,
+		ResumeToken: e.ResumeToken,
+		Time:        timestamppb.New(e.Time),
+	}
+	if e.Document == nil {
+		return event
+	}
+	switch document := e.Document.ToGrpc().(type) {
+	case *pb.Show:
+		event.Document = &pb.ChangeEvent_Show{Show: document}
+	case *pb.Season:
+		event.Document = &pb.ChangeEvent_Season{Season: document}
+	case *pb.Episode:
+		event.Document = &pb.ChangeEvent_Episode{Episode: document}
+	case *pb.Celebrity:
+		event.Document = &pb.ChangeEvent_Celebrity{Celebrity: document}
+	case *pb.Article:
+		event.Document = &pb.ChangeEvent_Article{Article: document}
+	case *pb.Genre:
+		event.Document = &pb.ChangeEvent_Genre{Genre: document}
+	case *pb.Journalist:
+		event.Document = &pb.ChangeEvent_Journalist{Journalist: document}
+	}
+	return event
+}
+
+func (w *Webhook) ToGrpc() interface{} {
+	return &pb.Webhook{
+		Id:         w.ID,
+		Url:        w.URL,
+		Secret:     w.Secret,
+		EventTypes: w.EventTypes,
+		CreatedAt:  timestamppb.New(w.CreatedAt),
+	}
+}
+
+func (d *DeadLetter) ToGrpc() interface{} {
+	deadLetter := &pb.DeadLetter{
+		Id:         d.ID,
+		Type:       d.Type,
+		EntityType: d.EntityType,
+		EntityId:   d.EntityID,
+		Payload:    d.Payload,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+	for _, delivery := range d.Deliveries {
+		deadLetter.Deliveries = append(deadLetter.Deliveries, &pb.WebhookDelivery{
+			WebhookId: delivery.WebhookID,
+			Url:       delivery.URL,
+			Status:    delivery.Status,
+			Attempts:  int32(delivery.Attempts),
+			LastError: delivery.LastError,
+		})
+	}
+	return deadLetter
+}
+
+func (t *TrashItem) ToGrpc() interface{} {
+	return &pb.TrashItem{
+		Id:         t.ID,
+		EntityType: t.EntityType,
+		Name:       t.Name,
+		ParentId:   t.ParentID,
+		DeletedAt:  timestamppb.New(t.DeletedAt),
+		DeletedBy:  t.DeletedBy,
+	}
+}
+
+func (r *Revision) ToGrpc() interface{} {
+	return &pb.Revision{
+		Id:         r.ID,
+		EntityType: r.EntityType,
+		EntityId:   r.EntityID,
+		Number:     int32(r.Number),
+		Actor:      r.Actor,
+		Method:     r.Method,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		Snapshot:   r.Snapshot,
+	}
+}
+
+func (d *RevisionDiff) ToGrpc() interface{} {
+	diff := &pb.RevisionDiff{
+		From:    d.From.ToGrpc().(*pb.Revision),
+		To:      d.To.ToGrpc().(*pb.Revision),
+		Changes: []*pb.FieldChange{},
+	}
+	for _, change := range d.Changes {
+		diff.Changes = append(diff.Changes, &pb.FieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+	return diff
+}
+
+func (r *ConsistencyReport) ToGrpc() interface{} {
+	report := &pb.ConsistencyReport{
+		Checked:  int32(r.Checked),
+		Repaired: int32(r.Repaired),
+	}
+	for _, issue := range r.Issues {
+		report.Issues = append(report.Issues, &pb.ConsistencyIssue{
+			Collection:  issue.Collection,
+			DocumentId:  issue.DocumentID,
+			Field:       issue.Field,
+			ReferenceId: issue.ReferenceID,
+			Property:    issue.Property,
+			Expected:    issue.Expected,
+			Found:       issue.Found,
+			Missing:     issue.Missing,
+		})
+	}
+	return report
+}
+
+func (r *ImportReport) ToGrpc() interface{} {
+	response := &pb.BulkImportResponse{
+		DryRun: r.DryRun,
+	}
+	for _, result := range r.Results {
+		response.Results = append(response.Results, &pb.ImportResult{
+			Kind:   result.Kind,
+			Key:    result.Key,
+			Id:     result.ID,
+			Status: result.Status,
+			Error:  result.Error,
+		})
+		switch result.Status {
+		case ImportCreated:
+			response.Created++
+		case ImportExisting:
+			response.Existing++
+		case ImportFailed:
+			response.Failed++
+		}
+	}
+	return response
+}
@@ -13,37 +13,38 @@ type ArticleRepository interface {
 	CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (*dto.ArticleDTO, error)
 	GetArticle(ctx context.Context, ID string) (*dto.ArticleDTO, error)
 	UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (*dto.ArticleDTO, error)
-	ListArticles(ctx context.Context, elementCount int) (dto.ArticlesDTO, error)
+	ListArticles(ctx context.Context, filter dto.ArticleFilterDTO, page dto.PageDTO) (dto.ArticlesDTO, int64, error)
 	ListArticlesByJournalist(ctx context.Context, ID string) (dto.ArticlesDTO, error)
 	UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (*dto.ArticleDTO, error)
 	DeleteArticlePoster(ctx context.Context, ID string, image string) error
+	DeleteArticle(ctx context.Context, ID string) error
 }
 
 func (m *MongoDatabase) CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
+	collection := m.collection(ctx, "Articles")
 	newArticle.PostersPath = []string{}
 	_, err := collection.InsertOne(ctx, newArticle)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting the new article in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new article in the Mongo database")
 	}
 	return newArticle, nil
 }
 
 func (m *MongoDatabase) GetArticle(ctx context.Context, ID string) (*dto.ArticleDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Articles")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	article := dto.ArticleDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&article)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding article by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding article by id from the Mongo database")
 	}
 	return &article, nil
 }
 
 func (m *MongoDatabase) UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
-	filter := bson.D{bson.E{Key: "id", Value: updatedArticle.ID}}
+	collection := m.collection(ctx, "Articles")
+	filter := bson.D{bson.E{Key: "id", Value: updatedArticle.ID}, notDeleted}
 	update := bson.D{
 		bson.E{Key: "$set", Value: bson.D{
 			bson.E{Key: "title", Value: updatedArticle.Title},
@@ -61,40 +62,43 @@ func (m *MongoDatabase) UpdateArticle(ctx context.Context, updatedArticle *dto.A
 	return updatedArticle, nil
 }
 
-func (m *MongoDatabase) ListArticles(ctx context.Context, elementCount int) (dto.ArticlesDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
+func (m *MongoDatabase) ListArticles(ctx context.Context, filter dto.ArticleFilterDTO, page dto.PageDTO) (dto.ArticlesDTO, int64, error) {
+	collection := m.collection(ctx, "Articles")
 	articles := dto.ArticlesDTO{}
-	opts := options.FindOptions{}
+	query := bson.D{notDeleted}
+	if filter.JournalistID != "" {
+		query = append(query, bson.E{Key: "journalist.id", Value: filter.JournalistID})
+	}
+	query = appendDateRange(query, "releaseDate", filter.ReleasedAfter, filter.ReleasedBefore)
 
-	if elementCount == 0 {
-		opts = *options.Find().SetSort(bson.D{{Key:"releaseDate",Value: -1}})
-	} else {
-		opts = *options.Find().SetSort(bson.D{{Key:"releaseDate",Value: -1}}).SetLimit(int64(elementCount))
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting articles in the Mongo database")
 	}
-	
-	cursor, err := collection.Find(ctx, bson.D{{}}, &opts)
+	cursor, err := collection.Find(ctx, query, findOptions(page))
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all articles from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while finding all articles from the Mongo database")
 	}
+
 	for cursor.Next(ctx) {
 		article := dto.ArticleDTO{}
 		err = cursor.Decode(&article)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding article")
+			return nil, 0, errors.Wrap(err, "Error while decoding article")
 		}
 		articles = append(articles, &article)
 
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
-	return articles, nil
+	return articles, total, nil
 }
 
 func (m *MongoDatabase) ListArticlesByJournalist(ctx context.Context, journalistID string) (dto.ArticlesDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
-	filter := bson.D{bson.E{Key: "journalist.id", Value: journalistID}}
+	collection := m.collection(ctx, "Articles")
+	filter := bson.D{bson.E{Key: "journalist.id", Value: journalistID}, notDeleted}
 	articles := dto.ArticlesDTO{}
 
 	cursor, err := collection.Find(ctx, filter)
@@ -119,8 +123,8 @@ func (m *MongoDatabase) ListArticlesByJournalist(ctx context.Context, journalist
 }
 
 func (m *MongoDatabase) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (*dto.ArticleDTO, error) {
-	collection := m.client.Database("Project").Collection("Articles")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Articles")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -134,15 +138,15 @@ func (m *MongoDatabase) UploadArticlePosters(ctx context.Context, ID string, pos
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedArticle)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating article in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating article in the Mongo database")
 	}
 
 	return &updatedArticle, nil
 }
 
 func (m *MongoDatabase) DeleteArticlePoster(ctx context.Context, ID string, image string) error {
-	collection := m.client.Database("Project").Collection("Articles")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Articles")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	posterPath := "/articles/" + ID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -153,3 +157,10 @@ func (m *MongoDatabase) DeleteArticlePoster(ctx context.Context, ID string, imag
 
 	return nil
 }
+
+func (m *MongoDatabase) DeleteArticle(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Articles", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting article from the Mongo database")
+	}
+	return nil
+}
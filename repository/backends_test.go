@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+// testBackends returns an empty repository of every backend that runs
+// without a server, by name.
+func testBackends(t *testing.T) map[string]ProjectRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "catalog.db")+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := MigrateSQL(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	return map[string]ProjectRepository{
+		"memory": NewMemoryDB(),
+		"json":   NewProjectFileDB(NewJSON(), t.TempDir()),
+		"xml":    NewProjectFileDB(NewXML(), t.TempDir()),
+		"sqlite": NewSQLDB(db),
+	}
+}
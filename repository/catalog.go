@@ -0,0 +1,473 @@
+package repository
+
+import (
+	"int-service/dto"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type catalog struct {
+	Shows       dto.ShowsDTO
+	Seasons     dto.SeasonsDTO
+	Episodes    dto.EpisodesDTO
+	Celebrities dto.CelebritiesDTO
+	Articles    dto.ArticlesDTO
+	Genres      dto.GenresDTO
+	Journalists dto.JournalistsDTO
+	Outbox      dto.OutboxEventsDTO
+	Webhooks    dto.WebhooksDTO
+	Trash       dto.TrashItemsDTO
+	Revisions   dto.RevisionsDTO
+
+	// changes are the documents written since the last commit, in the order
+	// of their first write, and recorded their keys.
+	changes  []documentChange
+	recorded map[string]bool
+	// written are the files of the collections written since the last save,
+	// the only ones the file store writes back.
+	written map[string]bool
+}
+
+// documentChange is the first write of a document since the last commit,
+// and whether the document was out of the trash before it.
+type documentChange struct {
+	entityType string
+	ID         string
+	existed    bool
+}
+
+// record notes a write of the document ID of entityType, for the change feed
+// to report it when the write commits. Every write path of the documents
+// records its writes, so a commit costs the documents it wrote rather than
+// the whole catalog.
+func (c *catalog) record(entityType string, ID string, operation string) {
+	key := entityType + "/" + ID
+	if c.recorded[key] {
+		return
+	}
+	if c.recorded == nil {
+		c.recorded = map[string]bool{}
+	}
+	c.recorded[key] = true
+	c.touch(entityFileNames[entityType])
+	existed := operation == OperationDeleted
+	if operation == OperationUpdated {
+		existed = c.findDocument(entityType, ID) != nil
+	}
+	c.changes = append(c.changes, documentChange{entityType: entityType, ID: ID, existed: existed})
+}
+
+// clone copies src into dst through a bson round trip, so stored documents
+// look exactly like the ones decoded from Mongo (UTC millisecond timestamps,
+// nil slices instead of empty ones, no shared backing arrays).
+func clone(src interface{}, dst interface{}) error {
+	data, err := bson.Marshal(src)
+	if err != nil {
+		return errors.Wrap(err, "Error while encoding document")
+	}
+	return bson.Unmarshal(data, dst)
+}
+
+// applyFields copies the given fields of updated, by their bson names, over a
+// copy of stored and decodes the result into dst.
+func applyFields(stored interface{}, updated interface{}, fields []string, dst interface{}) error {
+	storedValues := bson.M{}
+	if err := clone(stored, &storedValues); err != nil {
+		return err
+	}
+	updatedValues := bson.M{}
+	if err := clone(updated, &updatedValues); err != nil {
+		return err
+	}
+	for _, field := range fields {
+		storedValues[field] = updatedValues[field]
+	}
+	return clone(storedValues, dst)
+}
+
+// deletedNow is the deletedAt mark of a document deleted now, at the
+// millisecond precision of the stored times.
+func deletedNow() *time.Time {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return &now
+}
+
+// The find functions return the documents that are not in the trash.
+
+func (c *catalog) findShow(ID string) *dto.ShowDTO {
+	for _, show := range c.Shows {
+		if show.ID == ID && show.DeletedAt == nil {
+			return show
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findSeason(ID string) *dto.SeasonDTO {
+	for _, season := range c.Seasons {
+		if season.ID == ID && season.DeletedAt == nil {
+			return season
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findEpisode(ID string) *dto.EpisodeDTO {
+	for _, episode := range c.Episodes {
+		if episode.ID == ID && episode.DeletedAt == nil {
+			return episode
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findCelebrity(ID string) *dto.CelebrityDTO {
+	for _, celebrity := range c.Celebrities {
+		if celebrity.ID == ID && celebrity.DeletedAt == nil {
+			return celebrity
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findArticle(ID string) *dto.ArticleDTO {
+	for _, article := range c.Articles {
+		if article.ID == ID && article.DeletedAt == nil {
+			return article
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findGenre(ID string) *dto.GenreDTO {
+	for _, genre := range c.Genres {
+		if genre.ID == ID && genre.DeletedAt == nil {
+			return genre
+		}
+	}
+	return nil
+}
+
+func (c *catalog) findJournalist(ID string) *dto.JournalistDTO {
+	for _, journalist := range c.Journalists {
+		if journalist.ID == ID && journalist.DeletedAt == nil {
+			return journalist
+		}
+	}
+	return nil
+}
+
+// entityFileNames maps the entity types to the files of their collections.
+var entityFileNames = map[string]string{
+	ShowEntity:       showsFileName,
+	SeasonEntity:     seasonsFileName,
+	EpisodeEntity:    episodesFileName,
+	CelebrityEntity:  celebritiesFileName,
+	ArticleEntity:    articlesFileName,
+	GenreEntity:      genresFileName,
+	JournalistEntity: journalistsFileName,
+}
+
+// touch notes a write of the collection of the file fileName.
+func (c *catalog) touch(fileName string) {
+	if c.written == nil {
+		c.written = map[string]bool{}
+	}
+	c.written[fileName] = true
+}
+
+// findDocument returns the document ID of entityType, or nil when there is
+// none or it is in the trash.
+func (c *catalog) findDocument(entityType string, ID string) interface{} {
+	switch entityType {
+	case ShowEntity:
+		if show := c.findShow(ID); show != nil {
+			return show
+		}
+	case SeasonEntity:
+		if season := c.findSeason(ID); season != nil {
+			return season
+		}
+	case EpisodeEntity:
+		if episode := c.findEpisode(ID); episode != nil {
+			return episode
+		}
+	case CelebrityEntity:
+		if celebrity := c.findCelebrity(ID); celebrity != nil {
+			return celebrity
+		}
+	case ArticleEntity:
+		if article := c.findArticle(ID); article != nil {
+			return article
+		}
+	case GenreEntity:
+		if genre := c.findGenre(ID); genre != nil {
+			return genre
+		}
+	case JournalistEntity:
+		if journalist := c.findJournalist(ID); journalist != nil {
+			return journalist
+		}
+	}
+	return nil
+}
+
+// deletedMark returns the deletedAt field of the document ID of entityType,
+// whether it is in the trash or not, and nil when there is no such document.
+func (c *catalog) deletedMark(entityType string, ID string) **time.Time {
+	switch entityType {
+	case ShowEntity:
+		for _, show := range c.Shows {
+			if show.ID == ID {
+				return &show.DeletedAt
+			}
+		}
+	case SeasonEntity:
+		for _, season := range c.Seasons {
+			if season.ID == ID {
+				return &season.DeletedAt
+			}
+		}
+	case EpisodeEntity:
+		for _, episode := range c.Episodes {
+			if episode.ID == ID {
+				return &episode.DeletedAt
+			}
+		}
+	case CelebrityEntity:
+		for _, celebrity := range c.Celebrities {
+			if celebrity.ID == ID {
+				return &celebrity.DeletedAt
+			}
+		}
+	case ArticleEntity:
+		for _, article := range c.Articles {
+			if article.ID == ID {
+				return &article.DeletedAt
+			}
+		}
+	case GenreEntity:
+		for _, genre := range c.Genres {
+			if genre.ID == ID {
+				return &genre.DeletedAt
+			}
+		}
+	case JournalistEntity:
+		for _, journalist := range c.Journalists {
+			if journalist.ID == ID {
+				return &journalist.DeletedAt
+			}
+		}
+	}
+	return nil
+}
+
+// purgeDeleted removes the documents put in the trash before the given time.
+func (c *catalog) purgeDeleted(before time.Time) {
+	purged := func(deletedAt *time.Time) bool {
+		return deletedAt != nil && deletedAt.Before(before)
+	}
+	shows := dto.ShowsDTO{}
+	for _, show := range c.Shows {
+		if !purged(show.DeletedAt) {
+			shows = append(shows, show)
+		}
+	}
+	if len(shows) != len(c.Shows) {
+		c.touch(showsFileName)
+	}
+	c.Shows = shows
+	seasons := dto.SeasonsDTO{}
+	for _, season := range c.Seasons {
+		if !purged(season.DeletedAt) {
+			seasons = append(seasons, season)
+		}
+	}
+	if len(seasons) != len(c.Seasons) {
+		c.touch(seasonsFileName)
+	}
+	c.Seasons = seasons
+	episodes := dto.EpisodesDTO{}
+	for _, episode := range c.Episodes {
+		if !purged(episode.DeletedAt) {
+			episodes = append(episodes, episode)
+		}
+	}
+	if len(episodes) != len(c.Episodes) {
+		c.touch(episodesFileName)
+	}
+	c.Episodes = episodes
+	celebrities := dto.CelebritiesDTO{}
+	for _, celebrity := range c.Celebrities {
+		if !purged(celebrity.DeletedAt) {
+			celebrities = append(celebrities, celebrity)
+		}
+	}
+	if len(celebrities) != len(c.Celebrities) {
+		c.touch(celebritiesFileName)
+	}
+	c.Celebrities = celebrities
+	articles := dto.ArticlesDTO{}
+	for _, article := range c.Articles {
+		if !purged(article.DeletedAt) {
+			articles = append(articles, article)
+		}
+	}
+	if len(articles) != len(c.Articles) {
+		c.touch(articlesFileName)
+	}
+	c.Articles = articles
+	genres := dto.GenresDTO{}
+	for _, genre := range c.Genres {
+		if !purged(genre.DeletedAt) {
+			genres = append(genres, genre)
+		}
+	}
+	if len(genres) != len(c.Genres) {
+		c.touch(genresFileName)
+	}
+	c.Genres = genres
+	journalists := dto.JournalistsDTO{}
+	for _, journalist := range c.Journalists {
+		if !purged(journalist.DeletedAt) {
+			journalists = append(journalists, journalist)
+		}
+	}
+	if len(journalists) != len(c.Journalists) {
+		c.touch(journalistsFileName)
+	}
+	c.Journalists = journalists
+}
+
+func copyStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	return append([]string{}, values...)
+}
+
+func pullString(values []string, value string) []string {
+	if values == nil {
+		return nil
+	}
+	result := []string{}
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func showCredits(show *dto.ShowDTO, celebrityType string) interface{} {
+	switch celebrityType {
+	case "starring":
+		return show.Starring
+	case "writtenBy":
+		return show.WrittenBy
+	case "directedBy":
+		return show.DirectedBy
+	case "producedBy":
+		return show.ProducedBy
+	}
+	return nil
+}
+
+func seasonCredits(season *dto.SeasonDTO, celebrityType string) interface{} {
+	switch celebrityType {
+	case "writtenBy":
+		return season.WrittenBy
+	case "directedBy":
+		return season.DirectedBy
+	case "producedBy":
+		return season.ProducedBy
+	}
+	return nil
+}
+
+func episodeCredits(episode *dto.EpisodeDTO, celebrityType string) interface{} {
+	switch celebrityType {
+	case "starring":
+		return episode.Starring
+	case "writtenBy":
+		return episode.WrittenBy
+	case "directedBy":
+		return episode.DirectedBy
+	case "producedBy":
+		return episode.ProducedBy
+	}
+	return nil
+}
+
+// updateCredit mirrors the positional update used by the Mongo backend:
+// only the first credit referencing the celebrity is renamed and gets the
+// new posters appended. It reports whether there was such a credit.
+func updateCredit(credits interface{}, updatedCelebrity *dto.ShortCelebrityDTO) bool {
+	switch list := credits.(type) {
+	case dto.ShortCelebritiesDTO:
+		for _, credit := range list {
+			if credit != nil && credit.ID == updatedCelebrity.ID {
+				credit.Name = updatedCelebrity.Name
+				credit.PostersPath = append(credit.PostersPath, updatedCelebrity.PostersPath...)
+				return true
+			}
+		}
+	case dto.FilmCrewsDTO:
+		for _, credit := range list {
+			if credit != nil && credit.ID == updatedCelebrity.ID {
+				credit.Name = updatedCelebrity.Name
+				credit.PostersPath = append(credit.PostersPath, updatedCelebrity.PostersPath...)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func pullCreditPoster(credits interface{}, celebrityID string, posterPath string) bool {
+	switch list := credits.(type) {
+	case dto.ShortCelebritiesDTO:
+		for _, credit := range list {
+			if credit != nil && credit.ID == celebrityID {
+				credit.PostersPath = pullString(credit.PostersPath, posterPath)
+				return true
+			}
+		}
+	case dto.FilmCrewsDTO:
+		for _, credit := range list {
+			if credit != nil && credit.ID == celebrityID {
+				credit.PostersPath = pullString(credit.PostersPath, posterPath)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func removeShortCelebrity(credits dto.ShortCelebritiesDTO, celebrityID string) dto.ShortCelebritiesDTO {
+	if credits == nil {
+		return nil
+	}
+	result := dto.ShortCelebritiesDTO{}
+	for _, credit := range credits {
+		if credit == nil || credit.ID != celebrityID {
+			result = append(result, credit)
+		}
+	}
+	return result
+}
+
+func removeFilmCrew(credits dto.FilmCrewsDTO, celebrityID string) dto.FilmCrewsDTO {
+	if credits == nil {
+		return nil
+	}
+	result := dto.FilmCrewsDTO{}
+	for _, credit := range credits {
+		if credit == nil || credit.ID != celebrityID {
+			result = append(result, credit)
+		}
+	}
+	return result
+}
@@ -0,0 +1,1825 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CatalogDatabase is a ProjectRepository that works on a whole catalog at a
+// time. It follows the semantics of MongoDatabase, including the positional
+// updates of the embedded short documents, and leaves loading and saving the
+// catalog to its store, which keeps it either in memory or in files.
+type CatalogDatabase struct {
+	store   catalogStore
+	search  catalogSearch
+	changes *changeFeed
+}
+
+type catalogStore interface {
+	view(fn func(c *catalog) error) error
+	update(fn func(c *catalog) error) error
+	ping() error
+}
+
+type memoryStore struct {
+	mu   sync.RWMutex
+	data catalog
+}
+
+func NewMemoryDB() ProjectRepository {
+	return &CatalogDatabase{
+		store:   &memoryStore{},
+		changes: newChangeFeed(),
+	}
+}
+
+func (s *memoryStore) view(fn func(c *catalog) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(&s.data)
+}
+
+func (s *memoryStore) update(fn func(c *catalog) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(&s.data)
+}
+
+func (s *memoryStore) ping() error {
+	return nil
+}
+
+func (m *CatalogDatabase) view(ctx context.Context, fn func(c *catalog) error) error {
+	if transaction := m.transaction(ctx); transaction != nil {
+		return fn(transaction)
+	}
+	return m.store.view(fn)
+}
+
+func (m *CatalogDatabase) update(ctx context.Context, fn func(c *catalog) error) error {
+	if transaction := m.transaction(ctx); transaction != nil {
+		return fn(transaction)
+	}
+	defer m.search.invalidate()
+	return m.store.update(func(c *catalog) error {
+		if err := fn(c); err != nil {
+			return err
+		}
+		return m.changes.publish(c)
+	})
+}
+
+//------SHOWS------
+
+func (m *CatalogDatabase) CreateShow(ctx context.Context, newShow *dto.ShowDTO) (*dto.ShowDTO, error) {
+	newShow.PostersPath = []string{}
+	err := m.update(ctx, func(c *catalog) error {
+		show := dto.ShowDTO{}
+		if err := clone(newShow, &show); err != nil {
+			return err
+		}
+		c.Shows = append(c.Shows, &show)
+		c.record(ShowEntity, show.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new show in the catalog database")
+	}
+	return newShow, nil
+}
+
+func (m *CatalogDatabase) AddShortSeason(ctx context.Context, showID string, newSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		show := c.findShow(showID)
+		if show == nil {
+			return nil
+		}
+		season := dto.ShortSeasonDTO{}
+		if err := clone(newSeason, &season); err != nil {
+			return err
+		}
+		show.Seasons = append(show.Seasons, &season)
+		c.record(ShowEntity, showID, OperationUpdated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while adding short season in the catalog database")
+	}
+	return newSeason, nil
+}
+
+func (m *CatalogDatabase) GetShow(ctx context.Context, ID string) (*dto.ShowDTO, error) {
+	show := dto.ShowDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findShow(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &show)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding show by id from the catalog database")
+	}
+	return &show, nil
+}
+
+func (m *CatalogDatabase) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (*dto.ShowDTO, error) {
+	show := dto.ShowDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Shows {
+			if stored.ID != updatedShow.ID || stored.DeletedAt != nil {
+				continue
+			}
+			updated := dto.ShowDTO{}
+			if err := applyFields(stored, updatedShow, fields, &updated); err != nil {
+				return err
+			}
+			c.record(ShowEntity, stored.ID, OperationUpdated)
+			c.Shows[i] = &updated
+			return clone(&updated, &show)
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating show in the catalog database")
+	}
+	return &show, nil
+}
+
+func (m *CatalogDatabase) UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			for _, season := range show.Seasons {
+				if season == nil || season.ID != updatedSeason.ID {
+					continue
+				}
+				c.record(ShowEntity, show.ID, OperationUpdated)
+				season.Title = updatedSeason.Title
+				season.Rating = updatedSeason.Rating
+				season.PostersPath = copyStrings(updatedSeason.PostersPath)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating season in the catalog database")
+	}
+	return updatedSeason, nil
+}
+
+func (m *CatalogDatabase) UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			if updateCredit(showCredits(show, celebrityType), updatedCelebrity) {
+				c.record(ShowEntity, show.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating celebrity in the catalog database")
+	}
+	return updatedCelebrity, nil
+}
+
+func (m *CatalogDatabase) ListShows(ctx context.Context, filter dto.ShowFilterDTO, page dto.PageDTO) (dto.ShowsDTO, int64, error) {
+	shows := dto.ShowsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.ShowsDTO{}
+		for _, stored := range c.Shows {
+			if stored.DeletedAt == nil && matchShow(stored, filter) {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, showSortValue(matched[i], page.SortBy), showSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			show := dto.ShowDTO{}
+			if err := clone(stored, &show); err != nil {
+				return err
+			}
+			shows = append(shows, &show)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all shows from the catalog database")
+	}
+	return shows, int64(total), nil
+}
+
+func (m *CatalogDatabase) pushShowPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	updatedShow := dto.ShowDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		show := c.findShow(ID)
+		if show == nil {
+			return models.ErrNotFound
+		}
+		c.record(ShowEntity, ID, OperationUpdated)
+		show.PostersPath = append(show.PostersPath, postersPath...)
+		return clone(show, &updatedShow)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updatedShow, nil
+}
+
+func (m *CatalogDatabase) pullShowPoster(ctx context.Context, ID string, posterPath string) error {
+	return m.update(ctx, func(c *catalog) error {
+		if show := c.findShow(ID); show != nil {
+			c.record(ShowEntity, ID, OperationUpdated)
+			show.PostersPath = pullString(show.PostersPath, posterPath)
+		}
+		return nil
+	})
+}
+
+func (m *CatalogDatabase) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	updatedSeries, err := m.pushShowPosters(ctx, ID, postersPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating series in the catalog database")
+	}
+	return updatedSeries, nil
+}
+
+func (m *CatalogDatabase) DeleteSeriesPoster(ctx context.Context, ID string, image string) error {
+	err := m.pullShowPoster(ctx, ID, "/series/"+ID+"/"+image)
+	if err != nil {
+		return errors.Wrap(err, "Error while updating deleted series poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	updatedMovie, err := m.pushShowPosters(ctx, ID, postersPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating movie in the catalog database")
+	}
+	return updatedMovie, nil
+}
+
+func (m *CatalogDatabase) DeleteMoviePoster(ctx context.Context, ID string, image string) error {
+	err := m.pullShowPoster(ctx, ID, "/movie/"+ID+"/"+image)
+	if err != nil {
+		return errors.Wrap(err, "Error while updating deleted movie poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteShortCelebritiesPostersInShow(ctx context.Context, celebrityID string, image string, celebrityType string) error {
+	posterPath := "/celebrities/" + celebrityID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			if pullCreditPoster(showCredits(show, celebrityType), celebrityID, posterPath) {
+				c.record(ShowEntity, show.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity poster in short celebrity in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteShortSeasonPostersInShow(ctx context.Context, seriesID string, seasonID string, image string) error {
+	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			for _, season := range show.Seasons {
+				if season != nil && season.ID == seasonID {
+					c.record(ShowEntity, show.ID, OperationUpdated)
+					season.PostersPath = pullString(season.PostersPath, posterPath)
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting season poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteShow(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		show := c.findShow(ID)
+		if show == nil {
+			return models.ErrNotFound
+		}
+		show.DeletedAt = deletedNow()
+		c.record(ShowEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting show from the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortSeason(ctx context.Context, seasonID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			if show.Seasons == nil {
+				continue
+			}
+			seasons := dto.ShortSeasonsDTO{}
+			for _, season := range show.Seasons {
+				if season == nil || season.ID != seasonID {
+					seasons = append(seasons, season)
+				}
+			}
+			if len(seasons) != len(show.Seasons) {
+				c.record(ShowEntity, show.ID, OperationUpdated)
+			}
+			show.Seasons = seasons
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short season from shows in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortCelebrityInShows(ctx context.Context, celebrityID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			credits := len(show.Starring) + len(show.DirectedBy) + len(show.WrittenBy) + len(show.ProducedBy)
+			show.Starring = removeShortCelebrity(show.Starring, celebrityID)
+			show.DirectedBy = removeFilmCrew(show.DirectedBy, celebrityID)
+			show.WrittenBy = removeFilmCrew(show.WrittenBy, celebrityID)
+			show.ProducedBy = removeFilmCrew(show.ProducedBy, celebrityID)
+			if credits != len(show.Starring)+len(show.DirectedBy)+len(show.WrittenBy)+len(show.ProducedBy) {
+				c.record(ShowEntity, show.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from shows in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortGenre(ctx context.Context, genreID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, show := range c.Shows {
+			if show.Genres == nil {
+				continue
+			}
+			genres := dto.ShortGenresDTO{}
+			for _, genre := range show.Genres {
+				if genre == nil || genre.ID != genreID {
+					genres = append(genres, genre)
+				}
+			}
+			if len(genres) != len(show.Genres) {
+				c.record(ShowEntity, show.ID, OperationUpdated)
+			}
+			show.Genres = genres
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short genre from shows in the catalog database")
+	}
+	return nil
+}
+
+//------SEASONS------
+
+func (m *CatalogDatabase) CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (*dto.SeasonDTO, error) {
+	newSeason.PostersPath = []string{}
+	err := m.update(ctx, func(c *catalog) error {
+		season := dto.SeasonDTO{}
+		if err := clone(newSeason, &season); err != nil {
+			return err
+		}
+		c.Seasons = append(c.Seasons, &season)
+		c.record(SeasonEntity, season.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting new season in the catalog database")
+	}
+	return newSeason, nil
+}
+
+func (m *CatalogDatabase) AddShortEpisode(ctx context.Context, seasonID string, newEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		season := c.findSeason(seasonID)
+		if season == nil {
+			return nil
+		}
+		episode := dto.ShortEpisodeDTO{}
+		if err := clone(newEpisode, &episode); err != nil {
+			return err
+		}
+		season.Episodes = append(season.Episodes, &episode)
+		c.record(SeasonEntity, seasonID, OperationUpdated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while adding short episode in the catalog database")
+	}
+	return &dto.ShortEpisodeDTO{
+		ID:          newEpisode.ID,
+		Title:       newEpisode.Title,
+		PostersPath: newEpisode.PostersPath,
+		Rating:      newEpisode.Rating,
+		Resume:      newEpisode.Resume,
+	}, nil
+}
+
+func (m *CatalogDatabase) GetSeason(ctx context.Context, ID string) (*dto.SeasonDTO, error) {
+	season := dto.SeasonDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findSeason(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &season)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding season by id from the catalog database")
+	}
+	return &season, nil
+}
+
+func (m *CatalogDatabase) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (*dto.SeasonDTO, error) {
+	season := dto.SeasonDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Seasons {
+			if stored.ID != updatedSeason.ID || stored.DeletedAt != nil {
+				continue
+			}
+			updated := dto.SeasonDTO{}
+			if err := applyFields(stored, updatedSeason, fields, &updated); err != nil {
+				return err
+			}
+			c.record(SeasonEntity, stored.ID, OperationUpdated)
+			c.Seasons[i] = &updated
+			return clone(&updated, &season)
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating season in the catalog database")
+	}
+	return &season, nil
+}
+
+func (m *CatalogDatabase) UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, season := range c.Seasons {
+			updated := false
+			for _, episode := range season.Episodes {
+				if episode == nil || episode.ID != updatedEpisode.ID {
+					continue
+				}
+				c.record(SeasonEntity, season.ID, OperationUpdated)
+				episode.Title = updatedEpisode.Title
+				episode.PostersPath = copyStrings(updatedEpisode.PostersPath)
+				episode.Rating = updatedEpisode.Rating
+				episode.Resume = updatedEpisode.Resume
+				updated = true
+			}
+			if updated {
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating short episode in the catalog database")
+	}
+	return updatedEpisode, nil
+}
+
+func (m *CatalogDatabase) UpdateShortCelebritiesInSeasons(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, season := range c.Seasons {
+			if updateCredit(seasonCredits(season, celebrityType), updatedCelebrity) {
+				c.record(SeasonEntity, season.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating short celebrity in the catalog database")
+	}
+	return updatedCelebrity, nil
+}
+
+func (m *CatalogDatabase) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (*dto.SeasonDTO, error) {
+	updatedSeason := dto.SeasonDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		season := c.findSeason(seasonID)
+		if season == nil {
+			return models.ErrNotFound
+		}
+		c.record(SeasonEntity, seasonID, OperationUpdated)
+		season.PostersPath = append(season.PostersPath, postersPath...)
+		return clone(season, &updatedSeason)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while uploading season posters in the catalog database")
+	}
+	return &updatedSeason, nil
+}
+
+func (m *CatalogDatabase) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error {
+	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		if season := c.findSeason(seasonID); season != nil {
+			c.record(SeasonEntity, seasonID, OperationUpdated)
+			season.PostersPath = pullString(season.PostersPath, posterPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting season poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteShortCelebritiesPostersInSeason(ctx context.Context, celebrityID string, image string, celebrityType string) error {
+	posterPath := "/celebrities/" + celebrityID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		for _, season := range c.Seasons {
+			if pullCreditPoster(seasonCredits(season, celebrityType), celebrityID, posterPath) {
+				c.record(SeasonEntity, season.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity poster in short celebrity in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) ListShowSeasons(ctx context.Context, showID string) (dto.SeasonsDTO, error) {
+	seasons := dto.SeasonsDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Seasons {
+			if stored.ShowID != showID || stored.DeletedAt != nil {
+				continue
+			}
+			season := dto.SeasonDTO{}
+			if err := clone(stored, &season); err != nil {
+				return err
+			}
+			seasons = append(seasons, &season)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all seasons from the catalog database")
+	}
+	return seasons, nil
+}
+
+func (m *CatalogDatabase) ListSeasonsCollection(ctx context.Context, filter dto.SeasonFilterDTO, page dto.PageDTO) (dto.SeasonsDTO, int64, error) {
+	seasons := dto.SeasonsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.SeasonsDTO{}
+		for _, stored := range c.Seasons {
+			if stored.DeletedAt == nil && matchSeason(stored, filter) {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, seasonSortValue(matched[i], page.SortBy), seasonSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			season := dto.SeasonDTO{}
+			if err := clone(stored, &season); err != nil {
+				return err
+			}
+			seasons = append(seasons, &season)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all show seasons from the catalog database")
+	}
+	return seasons, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteSeason(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		season := c.findSeason(ID)
+		if season == nil {
+			return models.ErrNotFound
+		}
+		season.DeletedAt = deletedNow()
+		c.record(SeasonEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting season from the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortEpisode(ctx context.Context, episodeID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, season := range c.Seasons {
+			if season.Episodes == nil {
+				continue
+			}
+			episodes := dto.ShortEpisodesDTO{}
+			for _, episode := range season.Episodes {
+				if episode == nil || episode.ID != episodeID {
+					episodes = append(episodes, episode)
+				}
+			}
+			if len(episodes) != len(season.Episodes) {
+				c.record(SeasonEntity, season.ID, OperationUpdated)
+			}
+			season.Episodes = episodes
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short episode from seasons in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortCelebrityInSeasons(ctx context.Context, celebrityID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, season := range c.Seasons {
+			credits := len(season.DirectedBy) + len(season.WrittenBy) + len(season.ProducedBy)
+			season.DirectedBy = removeFilmCrew(season.DirectedBy, celebrityID)
+			season.WrittenBy = removeFilmCrew(season.WrittenBy, celebrityID)
+			season.ProducedBy = removeFilmCrew(season.ProducedBy, celebrityID)
+			if credits != len(season.DirectedBy)+len(season.WrittenBy)+len(season.ProducedBy) {
+				c.record(SeasonEntity, season.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from seasons in the catalog database")
+	}
+	return nil
+}
+
+//------EPISODES------
+
+func (m *CatalogDatabase) CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error) {
+	newEpisode.PostersPath = []string{}
+	err := m.update(ctx, func(c *catalog) error {
+		episode := dto.EpisodeDTO{}
+		if err := clone(newEpisode, &episode); err != nil {
+			return err
+		}
+		c.Episodes = append(c.Episodes, &episode)
+		c.record(EpisodeEntity, episode.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while new episode in the catalog database")
+	}
+	return newEpisode, nil
+}
+
+func (m *CatalogDatabase) GetEpisode(ctx context.Context, ID string) (*dto.EpisodeDTO, error) {
+	episode := dto.EpisodeDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findEpisode(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &episode)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding episode by id from the catalog database")
+	}
+	return &episode, nil
+}
+
+func (m *CatalogDatabase) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (*dto.EpisodeDTO, error) {
+	episode := dto.EpisodeDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Episodes {
+			if stored.ID != updatedEpisode.ID || stored.DeletedAt != nil {
+				continue
+			}
+			updated := dto.EpisodeDTO{}
+			if err := applyFields(stored, updatedEpisode, fields, &updated); err != nil {
+				return err
+			}
+			c.record(EpisodeEntity, stored.ID, OperationUpdated)
+			c.Episodes[i] = &updated
+			return clone(&updated, &episode)
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating episode in the catalog database")
+	}
+	return &episode, nil
+}
+
+func (m *CatalogDatabase) UpdateShortCelebritiesInEpisode(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, episode := range c.Episodes {
+			if updateCredit(episodeCredits(episode, celebrityType), updatedCelebrity) {
+				c.record(EpisodeEntity, episode.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating short celebrity in the catalog database")
+	}
+	return updatedCelebrity, nil
+}
+
+func (m *CatalogDatabase) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (*dto.EpisodeDTO, error) {
+	updatedEpisode := dto.EpisodeDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		episode := c.findEpisode(episodeID)
+		if episode == nil {
+			return models.ErrNotFound
+		}
+		c.record(EpisodeEntity, episodeID, OperationUpdated)
+		episode.PostersPath = append(episode.PostersPath, postersPath...)
+		return clone(episode, &updatedEpisode)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating episode posters in the catalog database")
+	}
+	return &updatedEpisode, nil
+}
+
+func (m *CatalogDatabase) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error {
+	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + episodeID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		if episode := c.findEpisode(episodeID); episode != nil {
+			c.record(EpisodeEntity, episodeID, OperationUpdated)
+			episode.PostersPath = pullString(episode.PostersPath, posterPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while updating deleted episode poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteShortCelebritiesPostersInEpisode(ctx context.Context, celebrityID string, image string, celebrityType string) error {
+	posterPath := "/celebrities/" + celebrityID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		for _, episode := range c.Episodes {
+			if pullCreditPoster(episodeCredits(episode, celebrityType), celebrityID, posterPath) {
+				c.record(EpisodeEntity, episode.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity poster in short celebrity in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) ListSeasonEpisodes(ctx context.Context, seasonID string) (dto.EpisodesDTO, error) {
+	episodes := dto.EpisodesDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Episodes {
+			if stored.SeasonID != seasonID || stored.DeletedAt != nil {
+				continue
+			}
+			episode := dto.EpisodeDTO{}
+			if err := clone(stored, &episode); err != nil {
+				return err
+			}
+			episodes = append(episodes, &episode)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all episodes from the catalog database")
+	}
+	return episodes, nil
+}
+
+func (m *CatalogDatabase) ListCollectionEpisodes(ctx context.Context, filter dto.EpisodeFilterDTO, page dto.PageDTO) (dto.EpisodesDTO, int64, error) {
+	episodes := dto.EpisodesDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.EpisodesDTO{}
+		for _, stored := range c.Episodes {
+			if stored.DeletedAt == nil && matchEpisode(stored, filter) {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, episodeSortValue(matched[i], page.SortBy), episodeSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			episode := dto.EpisodeDTO{}
+			if err := clone(stored, &episode); err != nil {
+				return err
+			}
+			episodes = append(episodes, &episode)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all episodes from the catalog database")
+	}
+	return episodes, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteEpisode(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		episode := c.findEpisode(ID)
+		if episode == nil {
+			return models.ErrNotFound
+		}
+		episode.DeletedAt = deletedNow()
+		c.record(EpisodeEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting episode from the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RemoveShortCelebrityInEpisodes(ctx context.Context, celebrityID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, episode := range c.Episodes {
+			credits := len(episode.Starring) + len(episode.DirectedBy) + len(episode.WrittenBy) + len(episode.ProducedBy)
+			episode.Starring = removeShortCelebrity(episode.Starring, celebrityID)
+			episode.DirectedBy = removeFilmCrew(episode.DirectedBy, celebrityID)
+			episode.WrittenBy = removeFilmCrew(episode.WrittenBy, celebrityID)
+			episode.ProducedBy = removeFilmCrew(episode.ProducedBy, celebrityID)
+			if credits != len(episode.Starring)+len(episode.DirectedBy)+len(episode.WrittenBy)+len(episode.ProducedBy) {
+				c.record(EpisodeEntity, episode.ID, OperationUpdated)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from episodes in the catalog database")
+	}
+	return nil
+}
+
+//------CELEBRITIES------
+
+func (m *CatalogDatabase) CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error) {
+	newCelebrity.PostersPath = []string{}
+	err := m.update(ctx, func(c *catalog) error {
+		celebrity := dto.CelebrityDTO{}
+		if err := clone(newCelebrity, &celebrity); err != nil {
+			return err
+		}
+		c.Celebrities = append(c.Celebrities, &celebrity)
+		c.record(CelebrityEntity, celebrity.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new celebrity in the catalog database")
+	}
+	return newCelebrity, nil
+}
+
+func (m *CatalogDatabase) GetCelebrity(ctx context.Context, ID string) (*dto.CelebrityDTO, error) {
+	celebrity := dto.CelebrityDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findCelebrity(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &celebrity)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding celebrity by id from the catalog database")
+	}
+	return &celebrity, nil
+}
+
+func (m *CatalogDatabase) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (*dto.CelebrityDTO, error) {
+	celebrity := dto.CelebrityDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Celebrities {
+			if stored.ID != updatedCelebrity.ID || stored.DeletedAt != nil {
+				continue
+			}
+			updated := dto.CelebrityDTO{}
+			if err := applyFields(stored, updatedCelebrity, fields, &updated); err != nil {
+				return err
+			}
+			c.record(CelebrityEntity, stored.ID, OperationUpdated)
+			c.Celebrities[i] = &updated
+			return clone(&updated, &celebrity)
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating celebrity in the catalog database")
+	}
+	return &celebrity, nil
+}
+
+func (m *CatalogDatabase) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (*dto.CelebrityDTO, error) {
+	updatedCelebrity := dto.CelebrityDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		celebrity := c.findCelebrity(ID)
+		if celebrity == nil {
+			return models.ErrNotFound
+		}
+		c.record(CelebrityEntity, ID, OperationUpdated)
+		celebrity.PostersPath = append(celebrity.PostersPath, postersPath...)
+		return clone(celebrity, &updatedCelebrity)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating celebrity posters in the catalog database")
+	}
+	return &updatedCelebrity, nil
+}
+
+func (m *CatalogDatabase) DeleteCelebrityPoster(ctx context.Context, ID string, image string) error {
+	posterPath := "/celebrities/" + ID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		if celebrity := c.findCelebrity(ID); celebrity != nil {
+			c.record(CelebrityEntity, ID, OperationUpdated)
+			celebrity.PostersPath = pullString(celebrity.PostersPath, posterPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while updating deleted celebrity poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) ListCelebrities(ctx context.Context, filter dto.CelebrityFilterDTO, page dto.PageDTO) (dto.CelebritiesDTO, int64, error) {
+	celebrities := dto.CelebritiesDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.CelebritiesDTO{}
+		for _, stored := range c.Celebrities {
+			if stored.DeletedAt == nil && matchCelebrity(stored, filter) {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, celebritySortValue(matched[i], page.SortBy), celebritySortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			celebrity := dto.CelebrityDTO{}
+			if err := clone(stored, &celebrity); err != nil {
+				return err
+			}
+			celebrities = append(celebrities, &celebrity)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all celebrities from the catalog database")
+	}
+	return celebrities, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteCelebrity(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		celebrity := c.findCelebrity(ID)
+		if celebrity == nil {
+			return models.ErrNotFound
+		}
+		celebrity.DeletedAt = deletedNow()
+		c.record(CelebrityEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity from the catalog database")
+	}
+	return nil
+}
+
+//------ARTICLES------
+
+func (m *CatalogDatabase) CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
+	newArticle.PostersPath = []string{}
+	err := m.update(ctx, func(c *catalog) error {
+		article := dto.ArticleDTO{}
+		if err := clone(newArticle, &article); err != nil {
+			return err
+		}
+		c.Articles = append(c.Articles, &article)
+		c.record(ArticleEntity, article.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new article in the catalog database")
+	}
+	return newArticle, nil
+}
+
+func (m *CatalogDatabase) GetArticle(ctx context.Context, ID string) (*dto.ArticleDTO, error) {
+	article := dto.ArticleDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findArticle(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &article)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding article by id from the catalog database")
+	}
+	return &article, nil
+}
+
+func (m *CatalogDatabase) UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Articles {
+			if stored.ID != updatedArticle.ID || stored.DeletedAt != nil {
+				continue
+			}
+			article := dto.ArticleDTO{}
+			if err := clone(updatedArticle, &article); err != nil {
+				return err
+			}
+			c.record(ArticleEntity, stored.ID, OperationUpdated)
+			c.Articles[i] = &article
+			return nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating article in the catalog database")
+	}
+	return updatedArticle, nil
+}
+
+func (m *CatalogDatabase) ListArticles(ctx context.Context, filter dto.ArticleFilterDTO, page dto.PageDTO) (dto.ArticlesDTO, int64, error) {
+	articles := dto.ArticlesDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.ArticlesDTO{}
+		for _, stored := range c.Articles {
+			if stored.DeletedAt == nil && matchArticle(stored, filter) {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, articleSortValue(matched[i], page.SortBy), articleSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			article := dto.ArticleDTO{}
+			if err := clone(stored, &article); err != nil {
+				return err
+			}
+			articles = append(articles, &article)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all articles from the catalog database")
+	}
+	return articles, int64(total), nil
+}
+
+func (m *CatalogDatabase) ListArticlesByJournalist(ctx context.Context, journalistID string) (dto.ArticlesDTO, error) {
+	articles := dto.ArticlesDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Articles {
+			if stored.Journalist.ID != journalistID || stored.DeletedAt != nil {
+				continue
+			}
+			article := dto.ArticleDTO{}
+			if err := clone(stored, &article); err != nil {
+				return err
+			}
+			articles = append(articles, &article)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all articles by journalist id from the catalog database")
+	}
+	return articles, nil
+}
+
+func (m *CatalogDatabase) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (*dto.ArticleDTO, error) {
+	updatedArticle := dto.ArticleDTO{}
+	err := m.update(ctx, func(c *catalog) error {
+		article := c.findArticle(ID)
+		if article == nil {
+			return models.ErrNotFound
+		}
+		c.record(ArticleEntity, ID, OperationUpdated)
+		article.PostersPath = append(article.PostersPath, postersPath...)
+		return clone(article, &updatedArticle)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating article in the catalog database")
+	}
+	return &updatedArticle, nil
+}
+
+func (m *CatalogDatabase) DeleteArticlePoster(ctx context.Context, ID string, image string) error {
+	posterPath := "/articles/" + ID + "/" + image
+	err := m.update(ctx, func(c *catalog) error {
+		if article := c.findArticle(ID); article != nil {
+			c.record(ArticleEntity, ID, OperationUpdated)
+			article.PostersPath = pullString(article.PostersPath, posterPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while updating deleted article poster in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteArticle(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		article := c.findArticle(ID)
+		if article == nil {
+			return models.ErrNotFound
+		}
+		article.DeletedAt = deletedNow()
+		c.record(ArticleEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting article from the catalog database")
+	}
+	return nil
+}
+
+//------GENRES------
+
+func (m *CatalogDatabase) CreateGenre(ctx context.Context, newGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		genre := dto.GenreDTO{}
+		if err := clone(newGenre, &genre); err != nil {
+			return err
+		}
+		c.Genres = append(c.Genres, &genre)
+		c.record(GenreEntity, genre.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new genre in the catalog database")
+	}
+	return newGenre, nil
+}
+
+func (m *CatalogDatabase) GetGenreByName(ctx context.Context, name string) (*dto.GenreDTO, error) {
+	genre := dto.GenreDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Genres {
+			if stored.Name == name && stored.DeletedAt == nil {
+				return clone(stored, &genre)
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding genre by name from the catalog database")
+	}
+	return &genre, nil
+}
+
+func (m *CatalogDatabase) GetGenre(ctx context.Context, ID string) (*dto.GenreDTO, error) {
+	genre := dto.GenreDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findGenre(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &genre)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding genre by id from the catalog database")
+	}
+	return &genre, nil
+}
+
+func (m *CatalogDatabase) UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		if genre := c.findGenre(updatedGenre.ID); genre != nil {
+			c.record(GenreEntity, genre.ID, OperationUpdated)
+			genre.Name = updatedGenre.Name
+			genre.Description = updatedGenre.Description
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating genre in the catalog database")
+	}
+	return updatedGenre, nil
+}
+
+func (m *CatalogDatabase) ListGenres(ctx context.Context, page dto.PageDTO) (dto.GenresDTO, int64, error) {
+	genres := dto.GenresDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.GenresDTO{}
+		for _, stored := range c.Genres {
+			if stored.DeletedAt == nil {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, genreSortValue(matched[i], page.SortBy), genreSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			genre := dto.GenreDTO{}
+			if err := clone(stored, &genre); err != nil {
+				return err
+			}
+			genres = append(genres, &genre)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all genres from the catalog database")
+	}
+	return genres, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteGenre(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		genre := c.findGenre(ID)
+		if genre == nil {
+			return models.ErrNotFound
+		}
+		genre.DeletedAt = deletedNow()
+		c.record(GenreEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting genre from the catalog database")
+	}
+	return nil
+}
+
+//------JOURNALISTS------
+
+func (m *CatalogDatabase) CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		journalist := dto.JournalistDTO{}
+		if err := clone(newJournalist, &journalist); err != nil {
+			return err
+		}
+		c.Journalists = append(c.Journalists, &journalist)
+		c.record(JournalistEntity, journalist.ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new journalist in the catalog database")
+	}
+	return newJournalist, nil
+}
+
+func (m *CatalogDatabase) GetJournalistByName(ctx context.Context, name string) (*dto.JournalistDTO, error) {
+	journalist := dto.JournalistDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Journalists {
+			if stored.Name == name && stored.DeletedAt == nil {
+				return clone(stored, &journalist)
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding journalist by name from the catalog database")
+	}
+	return &journalist, nil
+}
+
+func (m *CatalogDatabase) GetJournalist(ctx context.Context, ID string) (*dto.JournalistDTO, error) {
+	journalist := dto.JournalistDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		stored := c.findJournalist(ID)
+		if stored == nil {
+			return models.ErrNotFound
+		}
+		return clone(stored, &journalist)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding journalist by id from the catalog database")
+	}
+	return &journalist, nil
+}
+
+func (m *CatalogDatabase) UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		if journalist := c.findJournalist(updatedJournalist.ID); journalist != nil {
+			c.record(JournalistEntity, journalist.ID, OperationUpdated)
+			journalist.Name = updatedJournalist.Name
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating journalist in the catalog database")
+	}
+	return updatedJournalist, nil
+}
+
+func (m *CatalogDatabase) ListJournalists(ctx context.Context, page dto.PageDTO) (dto.JournalistsDTO, int64, error) {
+	journalists := dto.JournalistsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.JournalistsDTO{}
+		for _, stored := range c.Journalists {
+			if stored.DeletedAt == nil {
+				matched = append(matched, stored)
+			}
+		}
+		if page.SortBy != "" {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return sortLess(page, journalistSortValue(matched[i], page.SortBy), journalistSortValue(matched[j], page.SortBy), matched[i].ID, matched[j].ID)
+			})
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			journalist := dto.JournalistDTO{}
+			if err := clone(stored, &journalist); err != nil {
+				return err
+			}
+			journalists = append(journalists, &journalist)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all journalists from the catalog database")
+	}
+	return journalists, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteJournalist(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		journalist := c.findJournalist(ID)
+		if journalist == nil {
+			return models.ErrNotFound
+		}
+		journalist.DeletedAt = deletedNow()
+		c.record(JournalistEntity, ID, OperationDeleted)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting journalist from the catalog database")
+	}
+	return nil
+}
+
+//------OUTBOX------
+
+func (m *CatalogDatabase) AddOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	err := m.update(ctx, func(c *catalog) error {
+		stored := dto.OutboxEventDTO{}
+		if err := clone(event, &stored); err != nil {
+			return err
+		}
+		c.Outbox = append(c.Outbox, &stored)
+		c.touch(outboxFileName)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while inserting the outbox event in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) (dto.OutboxEventsDTO, error) {
+	events := dto.OutboxEventsDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		// Events are appended as they are written, so they already are
+		// in the order of their creation.
+		for _, stored := range c.Outbox {
+			if len(events) == limit {
+				break
+			}
+			if stored.Dispatched && !(stored.Pending && !stored.NextAttemptAt.After(now)) {
+				continue
+			}
+			event := dto.OutboxEventDTO{}
+			if err := clone(stored, &event); err != nil {
+				return err
+			}
+			events = append(events, &event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding due outbox events in the catalog database")
+	}
+	return events, nil
+}
+
+func (m *CatalogDatabase) UpdateOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Outbox {
+			if stored.ID != event.ID {
+				continue
+			}
+			updated := dto.OutboxEventDTO{}
+			if err := clone(event, &updated); err != nil {
+				return err
+			}
+			c.Outbox[i] = &updated
+			c.touch(outboxFileName)
+			return nil
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while updating the outbox event in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) DeleteOutboxEvent(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for i, event := range c.Outbox {
+			if event.ID == ID {
+				c.Outbox = append(c.Outbox[:i], c.Outbox[i+1:]...)
+				c.touch(outboxFileName)
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting the outbox event from the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) ListDeadLetters(ctx context.Context, page dto.PageDTO) (dto.OutboxEventsDTO, int64, error) {
+	events := dto.OutboxEventsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.OutboxEventsDTO{}
+		for i := len(c.Outbox) - 1; i >= 0; i-- {
+			if c.Outbox[i].Dead {
+				matched = append(matched, c.Outbox[i])
+			}
+		}
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			event := dto.OutboxEventDTO{}
+			if err := clone(stored, &event); err != nil {
+				return err
+			}
+			events = append(events, &event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding dead letters in the catalog database")
+	}
+	return events, int64(total), nil
+}
+
+func (m *CatalogDatabase) PurgeDeadLetters(ctx context.Context, before time.Time) (int64, error) {
+	purged := 0
+	err := m.update(ctx, func(c *catalog) error {
+		kept := dto.OutboxEventsDTO{}
+		for _, stored := range c.Outbox {
+			if stored.Dead && !stored.Pending && stored.CreatedAt.Before(before) {
+				purged++
+				continue
+			}
+			kept = append(kept, stored)
+		}
+		if purged > 0 {
+			c.touch(outboxFileName)
+		}
+		c.Outbox = kept
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the dead letters of the catalog database")
+	}
+	return int64(purged), nil
+}
+
+//------WEBHOOKS------
+
+func (m *CatalogDatabase) CreateWebhook(ctx context.Context, newWebhook *dto.WebhookDTO) (*dto.WebhookDTO, error) {
+	err := m.update(ctx, func(c *catalog) error {
+		webhook := dto.WebhookDTO{}
+		if err := clone(newWebhook, &webhook); err != nil {
+			return err
+		}
+		c.Webhooks = append(c.Webhooks, &webhook)
+		c.touch(webhooksFileName)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while inserting the new webhook in the catalog database")
+	}
+	return newWebhook, nil
+}
+
+func (m *CatalogDatabase) ListWebhooks(ctx context.Context) (dto.WebhooksDTO, error) {
+	webhooks := dto.WebhooksDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Webhooks {
+			webhook := dto.WebhookDTO{}
+			if err := clone(stored, &webhook); err != nil {
+				return err
+			}
+			webhooks = append(webhooks, &webhook)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding webhooks in the catalog database")
+	}
+	return webhooks, nil
+}
+
+func (m *CatalogDatabase) DeleteWebhook(ctx context.Context, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for i, webhook := range c.Webhooks {
+			if webhook.ID == ID {
+				c.Webhooks = append(c.Webhooks[:i], c.Webhooks[i+1:]...)
+				c.touch(webhooksFileName)
+				return nil
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting webhook from the catalog database")
+	}
+	return nil
+}
+
+//------TRASH------
+
+func (m *CatalogDatabase) AddTrashItem(ctx context.Context, item *dto.TrashItemDTO) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, stored := range c.Trash {
+			if stored.EntityType == item.EntityType && stored.ID == item.ID {
+				return models.ErrAlreadyExists
+			}
+		}
+		stored := dto.TrashItemDTO{}
+		if err := clone(item, &stored); err != nil {
+			return err
+		}
+		c.Trash = append(c.Trash, &stored)
+		c.touch(trashFileName)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while inserting the trash item in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) RestoreDocument(ctx context.Context, entityType string, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		mark := c.deletedMark(entityType, ID)
+		if mark == nil || *mark == nil {
+			return models.ErrNotFound
+		}
+		*mark = nil
+		c.record(entityType, ID, OperationCreated)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while restoring the "+entityType+" in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) GetTrashItem(ctx context.Context, entityType string, ID string) (*dto.TrashItemDTO, error) {
+	item := dto.TrashItemDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Trash {
+			if stored.EntityType == entityType && stored.ID == ID {
+				return clone(stored, &item)
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting the trash item from the catalog database")
+	}
+	return &item, nil
+}
+
+func (m *CatalogDatabase) ListTrashItems(ctx context.Context, filter dto.TrashFilterDTO, page dto.PageDTO) (dto.TrashItemsDTO, int64, error) {
+	items := dto.TrashItemsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.TrashItemsDTO{}
+		for _, stored := range c.Trash {
+			if (filter.EntityType == "" || stored.EntityType == filter.EntityType) && (filter.ParentID == "" || stored.ParentID == filter.ParentID) {
+				matched = append(matched, stored)
+			}
+		}
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].DeletedAt.After(matched[j].DeletedAt)
+		})
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			item := dto.TrashItemDTO{}
+			if err := clone(stored, &item); err != nil {
+				return err
+			}
+			items = append(items, &item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding trash items in the catalog database")
+	}
+	return items, int64(total), nil
+}
+
+func (m *CatalogDatabase) DeleteTrashItem(ctx context.Context, entityType string, ID string) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for i, stored := range c.Trash {
+			if stored.EntityType == entityType && stored.ID == ID {
+				c.Trash = append(c.Trash[:i], c.Trash[i+1:]...)
+				c.touch(trashFileName)
+				return nil
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting the trash item from the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) PurgeTrashItems(ctx context.Context, before time.Time) (int64, error) {
+	purged := 0
+	err := m.update(ctx, func(c *catalog) error {
+		kept := dto.TrashItemsDTO{}
+		for _, stored := range c.Trash {
+			if stored.DeletedAt.Before(before) {
+				purged++
+				continue
+			}
+			kept = append(kept, stored)
+		}
+		if purged > 0 {
+			c.touch(trashFileName)
+		}
+		c.Trash = kept
+		c.purgeDeleted(before)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the trash of the catalog database")
+	}
+	return int64(purged), nil
+}
+
+//------REVISIONS------
+
+func (m *CatalogDatabase) AddRevision(ctx context.Context, revision *dto.RevisionDTO) error {
+	err := m.update(ctx, func(c *catalog) error {
+		for _, stored := range c.Revisions {
+			if stored.ID == revision.ID || (stored.EntityType == revision.EntityType && stored.EntityID == revision.EntityID && stored.Number == revision.Number) {
+				return models.ErrAlreadyExists
+			}
+		}
+		stored := dto.RevisionDTO{}
+		if err := clone(revision, &stored); err != nil {
+			return err
+		}
+		c.Revisions = append(c.Revisions, &stored)
+		c.touch(revisionsFileName)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error while inserting the revision in the catalog database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) GetRevision(ctx context.Context, ID string) (*dto.RevisionDTO, error) {
+	revision := dto.RevisionDTO{}
+	err := m.view(ctx, func(c *catalog) error {
+		for _, stored := range c.Revisions {
+			if stored.ID == ID {
+				return clone(stored, &revision)
+			}
+		}
+		return models.ErrNotFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting the revision from the catalog database")
+	}
+	return &revision, nil
+}
+
+func (m *CatalogDatabase) ListRevisions(ctx context.Context, entityType string, entityID string, page dto.PageDTO) (dto.RevisionsDTO, int64, error) {
+	revisions := dto.RevisionsDTO{}
+	total := 0
+	err := m.view(ctx, func(c *catalog) error {
+		matched := dto.RevisionsDTO{}
+		for _, stored := range c.Revisions {
+			if stored.EntityType == entityType && stored.EntityID == entityID {
+				matched = append(matched, stored)
+			}
+		}
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].Number > matched[j].Number
+		})
+		total = len(matched)
+		start, end := pageBounds(total, page)
+		for _, stored := range matched[start:end] {
+			revision := dto.RevisionDTO{}
+			if err := clone(stored, &revision); err != nil {
+				return err
+			}
+			revisions = append(revisions, &revision)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding revisions in the catalog database")
+	}
+	return revisions, int64(total), nil
+}
+
+func (m *CatalogDatabase) PruneRevisions(ctx context.Context, keep int) (int64, error) {
+	pruned := 0
+	err := m.update(ctx, func(c *catalog) error {
+		latest := map[string]int64{}
+		for _, stored := range c.Revisions {
+			if key := stored.EntityType + "/" + stored.EntityID; stored.Number > latest[key] {
+				latest[key] = stored.Number
+			}
+		}
+		kept := dto.RevisionsDTO{}
+		for _, stored := range c.Revisions {
+			if stored.Number <= latest[stored.EntityType+"/"+stored.EntityID]-int64(keep) {
+				pruned++
+				continue
+			}
+			kept = append(kept, stored)
+		}
+		if pruned > 0 {
+			c.touch(revisionsFileName)
+		}
+		c.Revisions = kept
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while pruning the revisions of the catalog database")
+	}
+	return int64(pruned), nil
+}
@@ -0,0 +1,434 @@
+package repository
+
+import (
+	"encoding/xml"
+	"int-service/dto"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	showsFileName       = "shows"
+	seasonsFileName     = "seasons"
+	episodesFileName    = "episodes"
+	celebritiesFileName = "celebrities"
+	articlesFileName    = "articles"
+	genresFileName      = "genres"
+	journalistsFileName = "journalists"
+	outboxFileName      = "outbox"
+	webhooksFileName    = "webhooks"
+	trashFileName       = "trash"
+	revisionsFileName   = "revisions"
+)
+
+var catalogFileNames = []string{
+	showsFileName,
+	seasonsFileName,
+	episodesFileName,
+	celebritiesFileName,
+	articlesFileName,
+	genresFileName,
+	journalistsFileName,
+	outboxFileName,
+	webhooksFileName,
+	trashFileName,
+	revisionsFileName,
+}
+
+type showsFile struct {
+	XMLName xml.Name     `json:"-" xml:"Shows"`
+	Shows   dto.ShowsDTO `json:"shows" xml:"ShowDTO"`
+}
+
+type seasonsFile struct {
+	XMLName xml.Name       `json:"-" xml:"Seasons"`
+	Seasons dto.SeasonsDTO `json:"seasons" xml:"SeasonDTO"`
+}
+
+type episodesFile struct {
+	XMLName  xml.Name        `json:"-" xml:"Episodes"`
+	Episodes dto.EpisodesDTO `json:"episodes" xml:"EpisodeDTO"`
+}
+
+type celebritiesFile struct {
+	XMLName     xml.Name           `json:"-" xml:"Celebrities"`
+	Celebrities dto.CelebritiesDTO `json:"celebrities" xml:"CelebrityDTO"`
+}
+
+type articlesFile struct {
+	XMLName  xml.Name        `json:"-" xml:"Articles"`
+	Articles dto.ArticlesDTO `json:"articles" xml:"ArticleDTO"`
+}
+
+type genresFile struct {
+	XMLName xml.Name      `json:"-" xml:"Genres"`
+	Genres  dto.GenresDTO `json:"genres" xml:"GenreDTO"`
+}
+
+type journalistsFile struct {
+	XMLName     xml.Name           `json:"-" xml:"Journalists"`
+	Journalists dto.JournalistsDTO `json:"journalists" xml:"JournalistDTO"`
+}
+
+type outboxFile struct {
+	XMLName xml.Name            `json:"-" xml:"Outbox"`
+	Events  dto.OutboxEventsDTO `json:"events" xml:"OutboxEventDTO"`
+}
+
+type webhooksFile struct {
+	XMLName  xml.Name        `json:"-" xml:"Webhooks"`
+	Webhooks dto.WebhooksDTO `json:"webhooks" xml:"WebhookDTO"`
+}
+
+type trashFile struct {
+	XMLName xml.Name          `json:"-" xml:"Trash"`
+	Items   dto.TrashItemsDTO `json:"items" xml:"TrashItemDTO"`
+}
+
+type revisionsFile struct {
+	XMLName   xml.Name         `json:"-" xml:"Revisions"`
+	Revisions dto.RevisionsDTO `json:"revisions" xml:"RevisionDTO"`
+}
+
+// commitDirName is the journal of the file store: the new versions of the
+// files a write changes are staged in it, and committedFileName marks them
+// complete. A commit then moves them over the data files, so after a crash
+// either every file of a write is replaced or none is.
+const (
+	commitDirName     = ".commit"
+	committedFileName = "COMMITTED"
+)
+
+// fileStore keeps every catalog collection in its own file inside dir, in the
+// format of the given DataManipulator. It caches the catalog of the files,
+// and each call reads again only the files changed since, applies the change
+// and writes back the files of the collections it wrote while holding the
+// process wide file lock.
+type fileStore struct {
+	dir       string
+	ReadWrite DataManipulator
+
+	recoverOnce sync.Once
+	recoverErr  error
+
+	// mu guards the cache against the concurrent views. cached is the
+	// catalog last read or saved, and files the state of the files it
+	// holds, nil for the missing ones.
+	mu     sync.Mutex
+	cached *catalog
+	files  map[string]os.FileInfo
+}
+
+func NewProjectFileDB(format DataManipulator, dir string) ProjectRepository {
+	return &CatalogDatabase{
+		store: &fileStore{
+			dir:       dir,
+			ReadWrite: format,
+		},
+		changes: newChangeFeed(),
+	}
+}
+
+func (f *fileStore) view(fn func(c *catalog) error) error {
+	if err := f.recoverCommit(); err != nil {
+		return err
+	}
+	fileLock.RLock()
+	defer fileLock.RUnlock()
+
+	c, err := f.load()
+	if err != nil {
+		return err
+	}
+	return fn(c)
+}
+
+func (f *fileStore) update(fn func(c *catalog) error) error {
+	if err := f.recoverCommit(); err != nil {
+		return err
+	}
+	fileLock.Lock()
+	defer fileLock.Unlock()
+
+	c, err := f.load()
+	if err != nil {
+		return err
+	}
+	err = fn(c)
+	if err == nil {
+		err = f.save(c)
+	}
+	if err != nil {
+		// fn works on the cached catalog, which it may have changed
+		// before failing.
+		f.mu.Lock()
+		f.cached, f.files = nil, nil
+		f.mu.Unlock()
+	}
+	return err
+}
+
+// ping only checks that the data directory can be reached, as reading the
+// whole catalog on every health check would cost as much as a request.
+func (f *fileStore) ping() error {
+	if err := f.recoverCommit(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(f.dir, 0755); err != nil {
+		return errors.Wrap(err, "Error while creating the data directory")
+	}
+	return nil
+}
+
+func (f *fileStore) path(fileName string) string {
+	return filepath.Join(f.dir, fileName)
+}
+
+func (f *fileStore) read(fileName string, fileData interface{}) error {
+	err := f.ReadWrite.ReadData(f.path(fileName), fileData)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "Error while reading the "+fileName+" file")
+	}
+	return nil
+}
+
+// load returns the cached catalog, after reading again the files changed
+// since it was read or saved.
+func (f *fileStore) load() (*catalog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, err := f.stat()
+	if err != nil {
+		return nil, err
+	}
+	changed := []string{}
+	for _, fileName := range catalogFileNames {
+		if f.cached == nil || !sameFile(files[fileName], f.files[fileName]) {
+			changed = append(changed, fileName)
+		}
+	}
+	if len(changed) == 0 {
+		return f.cached, nil
+	}
+
+	// Other views may still read the cached catalog, so the files are read
+	// into a copy of it.
+	c := &catalog{}
+	if f.cached != nil {
+		*c = *f.cached
+	}
+	for _, fileName := range changed {
+		if err := f.readFile(c, fileName); err != nil {
+			return nil, err
+		}
+	}
+	f.cached, f.files = c, files
+	return c, nil
+}
+
+// stat returns the state of the files of dir by their name without the
+// extension of the format.
+func (f *fileStore) stat() (map[string]os.FileInfo, error) {
+	entries, err := ioutil.ReadDir(f.dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(err, "Error while reading the data directory")
+	}
+	files := map[string]os.FileInfo{}
+	for _, entry := range entries {
+		files[strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))] = entry
+	}
+	return files, nil
+}
+
+// sameFile reports whether a file is unchanged since its previous state.
+// The files are replaced on every write, and so are their inodes.
+func sameFile(info os.FileInfo, previous os.FileInfo) bool {
+	if info == nil || previous == nil {
+		return info == nil && previous == nil
+	}
+	return os.SameFile(info, previous) && info.Size() == previous.Size() && info.ModTime().Equal(previous.ModTime())
+}
+
+// readFile reads the file fileName into its collection of c.
+func (f *fileStore) readFile(c *catalog, fileName string) error {
+	switch fileName {
+	case showsFileName:
+		file := showsFile{}
+		err := f.read(fileName, &file)
+		c.Shows = file.Shows
+		return err
+	case seasonsFileName:
+		file := seasonsFile{}
+		err := f.read(fileName, &file)
+		c.Seasons = file.Seasons
+		return err
+	case episodesFileName:
+		file := episodesFile{}
+		err := f.read(fileName, &file)
+		c.Episodes = file.Episodes
+		return err
+	case celebritiesFileName:
+		file := celebritiesFile{}
+		err := f.read(fileName, &file)
+		c.Celebrities = file.Celebrities
+		return err
+	case articlesFileName:
+		file := articlesFile{}
+		err := f.read(fileName, &file)
+		c.Articles = file.Articles
+		return err
+	case genresFileName:
+		file := genresFile{}
+		err := f.read(fileName, &file)
+		c.Genres = file.Genres
+		return err
+	case journalistsFileName:
+		file := journalistsFile{}
+		err := f.read(fileName, &file)
+		c.Journalists = file.Journalists
+		return err
+	case outboxFileName:
+		file := outboxFile{}
+		err := f.read(fileName, &file)
+		c.Outbox = file.Events
+		return err
+	case webhooksFileName:
+		file := webhooksFile{}
+		err := f.read(fileName, &file)
+		c.Webhooks = file.Webhooks
+		return err
+	case trashFileName:
+		file := trashFile{}
+		err := f.read(fileName, &file)
+		c.Trash = file.Items
+		return err
+	case revisionsFileName:
+		file := revisionsFile{}
+		err := f.read(fileName, &file)
+		c.Revisions = file.Revisions
+		return err
+	}
+	return errors.New("Unknown catalog file " + fileName)
+}
+
+func catalogFiles(c *catalog) map[string]interface{} {
+	return map[string]interface{}{
+		showsFileName:       &showsFile{Shows: c.Shows},
+		seasonsFileName:     &seasonsFile{Seasons: c.Seasons},
+		episodesFileName:    &episodesFile{Episodes: c.Episodes},
+		celebritiesFileName: &celebritiesFile{Celebrities: c.Celebrities},
+		articlesFileName:    &articlesFile{Articles: c.Articles},
+		genresFileName:      &genresFile{Genres: c.Genres},
+		journalistsFileName: &journalistsFile{Journalists: c.Journalists},
+		outboxFileName:      &outboxFile{Events: c.Outbox},
+		webhooksFileName:    &webhooksFile{Webhooks: c.Webhooks},
+		trashFileName:       &trashFile{Items: c.Trash},
+		revisionsFileName:   &revisionsFile{Revisions: c.Revisions},
+	}
+}
+
+// save writes the files of the collections written since the last save.
+// They are staged in the journal first, then committed together.
+func (f *fileStore) save(c *catalog) error {
+	changed := []string{}
+	for _, fileName := range catalogFileNames {
+		if c.written[fileName] {
+			changed = append(changed, fileName)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	files := catalogFiles(c)
+
+	commitDir := f.path(commitDirName)
+	if err := os.RemoveAll(commitDir); err != nil {
+		return errors.Wrap(err, "Error while clearing the commit journal")
+	}
+	if err := os.MkdirAll(commitDir, 0755); err != nil {
+		return errors.Wrap(err, "Error while creating the commit journal")
+	}
+	for _, fileName := range changed {
+		if err := f.ReadWrite.WriteFile(filepath.Join(commitDir, fileName), files[fileName]); err != nil {
+			return errors.Wrap(err, "Error while writing the "+fileName+" file")
+		}
+	}
+	if err := syncDir(commitDir); err != nil {
+		return errors.Wrap(err, "Error while writing the commit journal")
+	}
+	if err := writeFileAtomic(filepath.Join(commitDir, committedFileName), nil, 0644); err != nil {
+		return errors.Wrap(err, "Error while committing the files")
+	}
+	if err := f.commit(); err != nil {
+		return err
+	}
+
+	c.written = nil
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	saved, err := f.stat()
+	if err != nil {
+		return err
+	}
+	for _, fileName := range changed {
+		f.files[fileName] = saved[fileName]
+	}
+	return nil
+}
+
+// commit moves the staged files of a committed journal over the data files
+// and removes the journal. Moving a file twice is harmless, so a commit
+// interrupted by a crash is finished by running it again.
+func (f *fileStore) commit() error {
+	commitDir := f.path(commitDirName)
+	entries, err := ioutil.ReadDir(commitDir)
+	if err != nil {
+		return errors.Wrap(err, "Error while reading the commit journal")
+	}
+	for _, entry := range entries {
+		if entry.Name() == committedFileName || strings.Contains(entry.Name(), ".tmp-") {
+			continue
+		}
+		if err := os.Rename(filepath.Join(commitDir, entry.Name()), f.path(entry.Name())); err != nil {
+			return errors.Wrap(err, "Error while committing the "+entry.Name()+" file")
+		}
+	}
+	if err := syncDir(f.dir); err != nil {
+		return errors.Wrap(err, "Error while committing the files")
+	}
+	if err := os.RemoveAll(commitDir); err != nil {
+		return errors.Wrap(err, "Error while removing the commit journal")
+	}
+	return nil
+}
+
+// recoverCommit finishes the commit a crash interrupted, or drops the files
+// staged by a write that never committed, before the files are first read.
+func (f *fileStore) recoverCommit() error {
+	f.recoverOnce.Do(func() {
+		fileLock.Lock()
+		defer fileLock.Unlock()
+
+		_, err := os.Stat(filepath.Join(f.path(commitDirName), committedFileName))
+		switch {
+		case err == nil:
+			f.recoverErr = f.commit()
+		case errors.Is(err, os.ErrNotExist):
+			if err := os.RemoveAll(f.path(commitDirName)); err != nil {
+				f.recoverErr = errors.Wrap(err, "Error while removing the commit journal")
+			}
+		default:
+			f.recoverErr = errors.Wrap(err, "Error while reading the commit journal")
+		}
+	})
+	return f.recoverErr
+}
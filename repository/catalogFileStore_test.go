@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestFileStoreSave checks a write only replaces the files of the collections
+// it wrote.
+func TestFileStoreSave(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := NewProjectFileDB(NewJSON(), dir)
+	if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "genres.json" {
+		t.Fatalf("the data directory holds %v, want the genres file only", entries)
+	}
+	genres, err := os.Stat(filepath.Join(dir, "genres.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark"}); err != nil {
+		t.Fatal(err)
+	}
+	shows, err := os.Stat(filepath.Join(dir, "shows.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A cascade matching no document writes nothing.
+	if err := repo.RemoveShortGenre(ctx, "g2"); err != nil {
+		t.Fatal(err)
+	}
+	for name, before := range map[string]os.FileInfo{"genres.json": genres, "shows.json": shows} {
+		after, err := os.Stat(filepath.Join(dir, name))
+		if err != nil || !os.SameFile(before, after) {
+			t.Errorf("%s was written again, %v", name, err)
+		}
+	}
+}
+
+// TestFileStoreReload checks the cached catalog follows the files written by
+// another store, and is dropped by a failed write.
+func TestFileStoreReload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := NewProjectFileDB(NewJSON(), dir)
+	other := NewProjectFileDB(NewJSON(), dir)
+	if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"}); err != nil {
+		t.Fatal(err)
+	}
+	if genre, err := other.GetGenre(ctx, "g1"); err != nil || genre.Name != "Mystery" {
+		t.Fatalf("the other store reads %+v, %v", genre, err)
+	}
+	if _, err := repo.UpdateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Thriller"}); err != nil {
+		t.Fatal(err)
+	}
+	if genre, err := other.GetGenre(ctx, "g1"); err != nil || genre.Name != "Thriller" {
+		t.Errorf("the other store reads %+v, %v after the update", genre, err)
+	}
+	if err := os.Remove(filepath.Join(dir, "genres.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.GetGenre(ctx, "g1"); err == nil {
+		t.Error("the other store reads the genre of a removed file")
+	}
+
+	store := repo.(*CatalogDatabase).store
+	failure := errors.New("failure midway")
+	err := store.update(func(c *catalog) error {
+		c.Genres = append(c.Genres, &dto.GenreDTO{ID: "g2", Name: "Drama"})
+		c.touch(genresFileName)
+		return failure
+	})
+	if err != failure {
+		t.Fatalf("the failed update returned %v", err)
+	}
+	if _, err := repo.GetGenre(ctx, "g2"); err == nil {
+		t.Error("the failed update is kept in the cache")
+	}
+}
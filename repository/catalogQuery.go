@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"int-service/dto"
+	"strings"
+	"time"
+)
+
+// compareValues orders two values returned by the sort value helpers below.
+// Values of different or unknown types compare as equal.
+func compareValues(a interface{}, b interface{}) int {
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y)
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			switch {
+			case x.Before(y):
+				return -1
+			case x.After(y):
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// sortLess mirrors the sort built by findOptions, including the id tie breaker.
+func sortLess(page dto.PageDTO, a interface{}, b interface{}, aID string, bID string) bool {
+	result := compareValues(a, b)
+	if result == 0 {
+		result = strings.Compare(aID, bID)
+	}
+	if page.Descending {
+		return result > 0
+	}
+	return result < 0
+}
+
+// pageBounds returns the bounds of the page inside a list of total documents.
+func pageBounds(total int, page dto.PageDTO) (int, int) {
+	start := int(page.Offset)
+	if start > total {
+		start = total
+	}
+	end := total
+	if page.Limit > 0 && int64(end-start) > page.Limit {
+		end = start + int(page.Limit)
+	}
+	return start, end
+}
+
+func inDateRange(date time.Time, after time.Time, before time.Time) bool {
+	if !after.IsZero() && date.Before(after) {
+		return false
+	}
+	if !before.IsZero() && !date.Before(before) {
+		return false
+	}
+	return true
+}
+
+func matchShow(show *dto.ShowDTO, filter dto.ShowFilterDTO) bool {
+	if filter.Type != "" && show.Type != filter.Type {
+		return false
+	}
+	if filter.GenreID != "" {
+		found := false
+		for _, genre := range show.Genres {
+			if genre != nil && genre.ID == filter.GenreID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return inDateRange(show.ReleaseDate, filter.ReleasedAfter, filter.ReleasedBefore) && show.Rating >= filter.MinRating
+}
+
+func matchSeason(season *dto.SeasonDTO, filter dto.SeasonFilterDTO) bool {
+	if filter.ShowID != "" && season.ShowID != filter.ShowID {
+		return false
+	}
+	return inDateRange(season.ReleaseDate, filter.ReleasedAfter, filter.ReleasedBefore) && season.Rating >= filter.MinRating
+}
+
+func matchEpisode(episode *dto.EpisodeDTO, filter dto.EpisodeFilterDTO) bool {
+	if filter.SeasonID != "" && episode.SeasonID != filter.SeasonID {
+		return false
+	}
+	return episode.Rating >= filter.MinRating
+}
+
+func matchCelebrity(celebrity *dto.CelebrityDTO, filter dto.CelebrityFilterDTO) bool {
+	if filter.Occupation == "" {
+		return true
+	}
+	for _, occupation := range celebrity.Occupation {
+		if occupation == filter.Occupation {
+			return true
+		}
+	}
+	return false
+}
+
+func matchArticle(article *dto.ArticleDTO, filter dto.ArticleFilterDTO) bool {
+	if filter.JournalistID != "" && article.Journalist.ID != filter.JournalistID {
+		return false
+	}
+	return inDateRange(article.ReleaseDate, filter.ReleasedAfter, filter.ReleasedBefore)
+}
+
+func showSortValue(show *dto.ShowDTO, field string) interface{} {
+	switch field {
+	case "title":
+		return show.Title
+	case "releaseDate":
+		return show.ReleaseDate
+	case "rating":
+		return show.Rating
+	}
+	return nil
+}
+
+func seasonSortValue(season *dto.SeasonDTO, field string) interface{} {
+	switch field {
+	case "title":
+		return season.Title
+	case "releaseDate":
+		return season.ReleaseDate
+	case "rating":
+		return season.Rating
+	}
+	return nil
+}
+
+func episodeSortValue(episode *dto.EpisodeDTO, field string) interface{} {
+	switch field {
+	case "title":
+		return episode.Title
+	case "rating":
+		return episode.Rating
+	}
+	return nil
+}
+
+func celebritySortValue(celebrity *dto.CelebrityDTO, field string) interface{} {
+	switch field {
+	case "name":
+		return celebrity.Name
+	case "dateOfBirth":
+		return celebrity.DateOfBirth
+	}
+	return nil
+}
+
+func articleSortValue(article *dto.ArticleDTO, field string) interface{} {
+	switch field {
+	case "title":
+		return article.Title
+	case "releaseDate":
+		return article.ReleaseDate
+	}
+	return nil
+}
+
+func genreSortValue(genre *dto.GenreDTO, field string) interface{} {
+	if field == "name" {
+		return genre.Name
+	}
+	return nil
+}
+
+func journalistSortValue(journalist *dto.JournalistDTO, field string) interface{} {
+	if field == "name" {
+		return journalist.Name
+	}
+	return nil
+}
@@ -12,60 +12,61 @@ import (
 type CelebrityRepository interface {
 	CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error)
 	GetCelebrity(ctx context.Context, ID string) (*dto.CelebrityDTO, error)
-	UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error)
+	UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (*dto.CelebrityDTO, error)
 	UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (*dto.CelebrityDTO, error)
 	DeleteCelebrityPoster(ctx context.Context, ID string, image string) error
-	ListCelebrities(ctx context.Context) (dto.CelebritiesDTO, error)
+	ListCelebrities(ctx context.Context, filter dto.CelebrityFilterDTO, page dto.PageDTO) (dto.CelebritiesDTO, int64, error)
+	DeleteCelebrity(ctx context.Context, ID string) error
 }
 
+// CelebrityFields are the fields of a celebrity an update can write.
+var CelebrityFields = []string{"name", "occupation", "postersPath", "dateOfBirth", "dateOfDeath", "placeOfBirth", "gender", "bio"}
+
 func (m *MongoDatabase) CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Celebrities")
+	collection := m.collection(ctx, "Celebrities")
 	newCelebrity.PostersPath = []string{}
 	_, err := collection.InsertOne(ctx, newCelebrity)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting the new celebrity in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new celebrity in the Mongo database")
 	}
 	return newCelebrity, nil
 }
 
 func (m *MongoDatabase) GetCelebrity(ctx context.Context, ID string) (*dto.CelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Celebrities")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Celebrities")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	celebrity := dto.CelebrityDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&celebrity)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding celebrity by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding celebrity by id from the Mongo database")
 	}
 	return &celebrity, nil
 }
 
-func (m *MongoDatabase) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Celebrities")
-	filter := bson.D{bson.E{Key: "id", Value: updatedCelebrity.ID}}
-	update := bson.D{
-		bson.E{Key: "$set", Value: bson.D{
-			bson.E{Key: "name", Value: updatedCelebrity.Name},
-			bson.E{Key: "occupation", Value: updatedCelebrity.Occupation},
-			bson.E{Key: "postersPath", Value: updatedCelebrity.PostersPath},
-			bson.E{Key: "dateOfBirth", Value: updatedCelebrity.DateOfBirth},
-			bson.E{Key: "dateOfDeath", Value: updatedCelebrity.DateOfDeath},
-			bson.E{Key: "placeOfBirth", Value: updatedCelebrity.PlaceOfBirth},
-			bson.E{Key: "gender", Value: updatedCelebrity.Gender},
-			bson.E{Key: "bio", Value: updatedCelebrity.Bio},
-		}},
+func (m *MongoDatabase) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (*dto.CelebrityDTO, error) {
+	collection := m.collection(ctx, "Celebrities")
+	filter := bson.D{bson.E{Key: "id", Value: updatedCelebrity.ID}, notDeleted}
+	update, err := setFields(updatedCelebrity, fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating celebrity in the Mongo database")
 	}
 
-	_, err := collection.UpdateOne(ctx, filter, update)
+	after := options.After
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+	}
+	celebrity := dto.CelebrityDTO{}
+	err = collection.FindOneAndUpdate(ctx, filter, update, &opt).Decode(&celebrity)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating celebrity in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating celebrity in the Mongo database")
 	}
-	return updatedCelebrity, nil
+	return &celebrity, nil
 }
 
 func (m *MongoDatabase) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (*dto.CelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Celebrities")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Celebrities")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -79,15 +80,15 @@ func (m *MongoDatabase) UploadCelebrityPosters(ctx context.Context, ID string, p
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedCelebrity)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating celebrity posters in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating celebrity posters in the Mongo database")
 	}
 
 	return &updatedCelebrity, nil
 }
 
 func (m *MongoDatabase) DeleteCelebrityPoster(ctx context.Context, ID string, image string) error {
-	collection := m.client.Database("Project").Collection("Celebrities")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Celebrities")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	posterPath := "/celebrities/" + ID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -99,27 +100,42 @@ func (m *MongoDatabase) DeleteCelebrityPoster(ctx context.Context, ID string, im
 	return nil
 }
 
-func (m *MongoDatabase) ListCelebrities(ctx context.Context) (dto.CelebritiesDTO, error) {
-	collection := m.client.Database("Project").Collection("Celebrities")
+func (m *MongoDatabase) ListCelebrities(ctx context.Context, filter dto.CelebrityFilterDTO, page dto.PageDTO) (dto.CelebritiesDTO, int64, error) {
+	collection := m.collection(ctx, "Celebrities")
 	celebrities := dto.CelebritiesDTO{}
+	query := bson.D{notDeleted}
+	if filter.Occupation != "" {
+		query = append(query, bson.E{Key: "occupation", Value: filter.Occupation})
+	}
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting celebrities in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all celebrities from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while finding all celebrities from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		celebrity := dto.CelebrityDTO{}
 		err = cursor.Decode(&celebrity)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding celebrity")
+			return nil, 0, errors.Wrap(err, "Error while decoding celebrity")
 		}
 		celebrities = append(celebrities, &celebrity)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return celebrities, nil
+	return celebrities, total, nil
+}
+
+func (m *MongoDatabase) DeleteCelebrity(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Celebrities", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity from the Mongo database")
+	}
+	return nil
 }
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// changeHistorySize is the number of events a catalog keeps for the streams
+// that resume or fall behind.
+const changeHistorySize = 4096
+
+// changeFeed turns the documents written by each commit of a
+// CatalogDatabase into change events. It keeps the last changeHistorySize
+// events and wakes the streams waiting for new ones. Each stream reads the
+// history at its own pace, and fails once the events it has not read yet are
+// dropped from it.
+type changeFeed struct {
+	mu sync.Mutex
+	// epoch tells the resume tokens of this process from the ones of
+	// earlier runs, whose sequence numbers mean nothing anymore.
+	epoch     string
+	sequence  uint64
+	history   []*dto.ChangeEventDTO
+	published chan struct{}
+}
+
+func newChangeFeed() *changeFeed {
+	return &changeFeed{
+		epoch:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		published: make(chan struct{}),
+	}
+}
+
+// publish records the documents written in c since its last commit, once
+// per commit and in the order of their first write. A document out of the
+// trash only before or after its writes is reported as deleted or created,
+// so putting a document in the trash shows as its deletion and restoring it
+// as its creation.
+func (f *changeFeed) publish(c *catalog) error {
+	changes := c.changes
+	c.changes, c.recorded = nil, nil
+
+	now := time.Now().UTC()
+	events := []*dto.ChangeEventDTO{}
+	for _, change := range changes {
+		document := c.findDocument(change.entityType, change.ID)
+		event := &dto.ChangeEventDTO{EntityType: change.entityType, ID: change.ID, Time: now}
+		switch {
+		case document == nil && !change.existed:
+			continue
+		case document == nil:
+			event.Operation = OperationDeleted
+		case change.existed:
+			event.Operation = OperationUpdated
+		default:
+			event.Operation = OperationCreated
+		}
+		if document != nil {
+			event.Document = newEntityDocument(change.entityType)
+			if err := clone(document, event.Document); err != nil {
+				return errors.Wrap(err, "Error while copying a changed document")
+			}
+		}
+		events = append(events, event)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, event := range events {
+		f.sequence++
+		event.ResumeToken = f.epoch + "-" + strconv.FormatUint(f.sequence, 10)
+	}
+	f.history = append(f.history, events...)
+	if len(f.history) > changeHistorySize {
+		f.history = append([]*dto.ChangeEventDTO(nil), f.history[len(f.history)-changeHistorySize:]...)
+	}
+	close(f.published)
+	f.published = make(chan struct{})
+	return nil
+}
+
+// start returns the sequence number of the first event of a stream.
+func (f *changeFeed) start(resumeToken string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if resumeToken == "" {
+		return f.sequence + 1, nil
+	}
+	epoch, number, _ := strings.Cut(resumeToken, "-")
+	sequence, err := strconv.ParseUint(number, 10, 64)
+	if err != nil || epoch != f.epoch || sequence > f.sequence {
+		return 0, models.NewFieldError("resumeToken", "is not a token of this server, which may have restarted")
+	}
+	if sequence < f.oldest()-1 {
+		return 0, models.NewFieldError("resumeToken", "is older than the change history")
+	}
+	return sequence + 1, nil
+}
+
+// since returns the events from the sequence number next on, and a channel
+// closed on the next publish for when there are none.
+func (f *changeFeed) since(next uint64) ([]*dto.ChangeEventDTO, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if next < f.oldest() {
+		return nil, nil, errors.Wrap(models.ErrResourceExhausted, "The change stream fell behind the change history")
+	}
+	return f.history[next-f.oldest():], f.published, nil
+}
+
+// oldest is the sequence number of the first event of the history.
+func (f *changeFeed) oldest() uint64 {
+	return f.sequence - uint64(len(f.history)) + 1
+}
+
+func (f *changeFeed) watch(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error {
+	next, err := f.start(resumeToken)
+	if err != nil {
+		return err
+	}
+	for {
+		events, published, err := f.since(next)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-published:
+			}
+			continue
+		}
+		for _, event := range events {
+			next++
+			if !watchesEntity(entityTypes, event.EntityType) {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func newEntityDocument(entityType string) interface{} {
+	if source, ok := changeSourceOf(entityType); ok {
+		return source.newDocument()
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error {
+	return m.changes.watch(ctx, entityTypes, resumeToken, fn)
+}
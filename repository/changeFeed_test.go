@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// publishGenres publishes the creation of count genres in one commit.
+func publishGenres(t *testing.T, feed *changeFeed, c *catalog, count int) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		ID := "g" + strconv.Itoa(len(c.Genres)+1)
+		c.Genres = append(c.Genres, &dto.GenreDTO{ID: ID, Name: ID})
+		c.record(GenreEntity, ID, OperationCreated)
+	}
+	if err := feed.publish(c); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChangeFeedStart(t *testing.T) {
+	feed := newChangeFeed()
+	c := &catalog{}
+	for i := 0; i < 3; i++ {
+		publishGenres(t, feed, c, 1)
+	}
+	tests := []struct {
+		name  string
+		token string
+		next  uint64
+		// err is part of the error returned, none if empty.
+		err string
+	}{
+		{name: "no token", token: "", next: 4},
+		{name: "last event", token: feed.epoch + "-3", next: 4},
+		{name: "first event", token: feed.epoch + "-1", next: 2},
+		{name: "earlier run", token: "0-1", err: "is not a token of this server"},
+		{name: "future event", token: feed.epoch + "-4", err: "is not a token of this server"},
+		{name: "malformed", token: "token", err: "is not a token of this server"},
+	}
+	for _, tt := range tests {
+		next, err := feed.start(tt.token)
+		if tt.err != "" {
+			if !errors.Is(err, models.ErrInvalidArgument) || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: start returned %v, want an invalid argument containing %q", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || next != tt.next {
+			t.Errorf("%s: start = %d, %v, want %d", tt.name, next, err, tt.next)
+		}
+	}
+
+	events, _, err := feed.since(2)
+	if err != nil || len(events) != 2 || events[0].ID != "g2" || events[1].ResumeToken != feed.epoch+"-3" {
+		t.Errorf("since(2) = %v, %v, want the events of g2 and g3", events, err)
+	}
+}
+
+func TestChangeFeedOverflow(t *testing.T) {
+	feed := newChangeFeed()
+	c := &catalog{}
+	publishGenres(t, feed, c, 1)
+	first := feed.epoch + "-1"
+	publishGenres(t, feed, c, changeHistorySize)
+
+	if len(feed.history) != changeHistorySize || feed.oldest() != 2 {
+		t.Fatalf("the history holds %d events from %d, want %d from 2", len(feed.history), feed.oldest(), changeHistorySize)
+	}
+	if next, err := feed.start(first); err != nil || next != 2 {
+		t.Errorf("start after the dropped event = %d, %v, want the oldest event kept", next, err)
+	}
+	publishGenres(t, feed, c, 1)
+	if _, err := feed.start(first); err == nil || !strings.Contains(err.Error(), "is older than the change history") {
+		t.Errorf("start from before the history returned %v", err)
+	}
+	if _, _, err := feed.since(2); !errors.Is(err, models.ErrResourceExhausted) {
+		t.Errorf("since a dropped event returned %v, want %v", err, models.ErrResourceExhausted)
+	}
+}
+
+// TestWatchFallsBehind checks a stream slower than the writes fails once the
+// events it has not read are dropped from the history.
+func TestWatchFallsBehind(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	repo := NewMemoryDB().(*CatalogDatabase)
+	if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g0", Name: "g0"}); err != nil {
+		t.Fatal(err)
+	}
+	received := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- repo.WatchChanges(ctx, nil, repo.changes.epoch+"-1", func(event *dto.ChangeEventDTO) error {
+			if event.ID == "g1" {
+				close(received)
+				<-release
+			}
+			return nil
+		})
+	}()
+
+	if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "g1"}); err != nil {
+		t.Fatal(err)
+	}
+	<-received
+	err := repo.WithTransaction(ctx, func(ctx context.Context) error {
+		// One more than the history holds drops g2, the next event of the
+		// stream.
+		for i := 2; i < changeHistorySize+3; i++ {
+			ID := "g" + strconv.Itoa(i)
+			if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: ID, Name: ID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	if err := <-done; !errors.Is(err, models.ErrResourceExhausted) {
+		t.Errorf("the stream behind the history returned %v, want %v", err, models.ErrResourceExhausted)
+	}
+}
+
+// TestChangeEvents checks the events of each kind of write of the catalog
+// backends, reported once per commit.
+func TestChangeEvents(t *testing.T) {
+	backends := map[string]*CatalogDatabase{
+		"memory": NewMemoryDB().(*CatalogDatabase),
+		"json":   NewProjectFileDB(NewJSON(), t.TempDir()).(*CatalogDatabase),
+	}
+	for name, repo := range backends {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			writes := []func(ctx context.Context) error{
+				func(ctx context.Context) error {
+					_, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"})
+					return err
+				},
+				func(ctx context.Context) error {
+					_, err := repo.UpdateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Thriller"})
+					return err
+				},
+				func(ctx context.Context) error {
+					_, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark", Genres: dto.ShortGenresDTO{{ID: "g1", Name: "Thriller"}}})
+					return err
+				},
+				// The cascade only changes the show holding the genre.
+				func(ctx context.Context) error {
+					if _, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: "s2", Title: "1899"}); err != nil {
+						return err
+					}
+					if err := repo.DeleteGenre(ctx, "g1"); err != nil {
+						return err
+					}
+					return repo.RemoveShortGenre(ctx, "g1")
+				},
+				func(ctx context.Context) error { return repo.RestoreDocument(ctx, GenreEntity, "g1") },
+				// A document created and deleted in one commit has no event.
+				func(ctx context.Context) error {
+					if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g2", Name: "Drama"}); err != nil {
+						return err
+					}
+					return repo.DeleteGenre(ctx, "g2")
+				},
+				func(ctx context.Context) error { return repo.DeleteShow(ctx, "s2") },
+			}
+			want := []string{
+				"genre g1 created",
+				"genre g1 updated",
+				"show s1 created",
+				"show s2 created", "genre g1 deleted", "show s1 updated",
+				"genre g1 created",
+				"show s2 deleted",
+			}
+
+			events := make(chan *dto.ChangeEventDTO, len(want)+1)
+			watchCtx, stop := context.WithCancel(ctx)
+			done := make(chan error)
+			go func() {
+				// The token of no event of a new feed streams all of them.
+				done <- repo.WatchChanges(watchCtx, nil, repo.changes.epoch+"-0", func(event *dto.ChangeEventDTO) error {
+					events <- event
+					return nil
+				})
+			}()
+			for _, write := range writes {
+				if err := repo.WithTransaction(ctx, write); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := []string{}
+			for len(got) < len(want) {
+				select {
+				case event := <-events:
+					got = append(got, event.EntityType+" "+event.ID+" "+event.Operation)
+					if event.Operation == OperationDeleted && event.Document != nil {
+						t.Errorf("the deletion of %s %s holds a document", event.EntityType, event.ID)
+					}
+					if event.Operation == OperationUpdated && event.EntityType == GenreEntity && event.Document.(*dto.GenreDTO).Name != "Thriller" {
+						t.Errorf("the update of the genre holds %+v", event.Document)
+					}
+				case <-ctx.Done():
+					t.Fatalf("the stream reported %q, want %q", got, want)
+				}
+			}
+			stop()
+			if err := <-done; !errors.Is(err, context.Canceled) {
+				t.Errorf("the canceled stream returned %v", err)
+			}
+			if !reflect.DeepEqual(got, want) || len(events) != 0 {
+				t.Errorf("the stream reported %q and %d more, want %q", got, len(events), want)
+			}
+		})
+	}
+}
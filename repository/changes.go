@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ShowEntity       = "show"
+	SeasonEntity     = "season"
+	EpisodeEntity    = "episode"
+	CelebrityEntity  = "celebrity"
+	ArticleEntity    = "article"
+	GenreEntity      = "genre"
+	JournalistEntity = "journalist"
+)
+
+const (
+	OperationCreated = "created"
+	OperationUpdated = "updated"
+	OperationDeleted = "deleted"
+)
+
+// changeStreamHistoryLost is the Mongo error code of a resume token older
+// than the oplog.
+const changeStreamHistoryLost = 286
+
+type ChangeWatcher interface {
+	// WatchChanges calls fn with every change of the documents of entityTypes,
+	// or of every type when empty, until ctx is done or fn fails. It starts
+	// after the change of resumeToken, or with the next change when empty.
+	// The next change is only read once fn returns, so a slow caller holds
+	// back its own stream only.
+	WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error
+}
+
+// changeSource maps an entity type to its Mongo collection.
+type changeSource struct {
+	Type        string
+	Collection  string
+	newDocument func() interface{}
+}
+
+var changeSources = []changeSource{
+	{Type: ShowEntity, Collection: "Shows", newDocument: func() interface{} { return &dto.ShowDTO{} }},
+	{Type: SeasonEntity, Collection: "Seasons", newDocument: func() interface{} { return &dto.SeasonDTO{} }},
+	{Type: EpisodeEntity, Collection: "Episodes", newDocument: func() interface{} { return &dto.EpisodeDTO{} }},
+	{Type: CelebrityEntity, Collection: "Celebrities", newDocument: func() interface{} { return &dto.CelebrityDTO{} }},
+	{Type: ArticleEntity, Collection: "Articles", newDocument: func() interface{} { return &dto.ArticleDTO{} }},
+	{Type: GenreEntity, Collection: "Genres", newDocument: func() interface{} { return &dto.GenreDTO{} }},
+	{Type: JournalistEntity, Collection: "Journalists", newDocument: func() interface{} { return &dto.JournalistDTO{} }},
+}
+
+func changeSourceOf(entityType string) (changeSource, bool) {
+	for _, source := range changeSources {
+		if source.Type == entityType {
+			return source, true
+		}
+	}
+	return changeSource{}, false
+}
+
+// EntityTypes are the types of documents WatchChanges reports.
+func EntityTypes() []string {
+	types := []string{}
+	for _, source := range changeSources {
+		types = append(types, source.Type)
+	}
+	return types
+}
+
+func watchesEntity(entityTypes []string, entityType string) bool {
+	if len(entityTypes) == 0 {
+		return true
+	}
+	for _, watched := range entityTypes {
+		if watched == entityType {
+			return true
+		}
+	}
+	return false
+}
+
+type mongoChangeEvent struct {
+	OperationType string              `bson:"operationType"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	Namespace     struct {
+		Collection string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey              bson.Raw `bson:"documentKey"`
+	FullDocument             bson.Raw `bson:"fullDocument"`
+	FullDocumentBeforeChange bson.Raw `bson:"fullDocumentBeforeChange"`
+	UpdateDescription        struct {
+		UpdatedFields bson.Raw `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// trashOperation returns the operation of an update putting a document in
+// the trash or restoring it, which the catalog reports as its deletion or
+// creation, and an empty string for the other updates.
+func (c *mongoChangeEvent) trashOperation() string {
+	if c.OperationType != "update" {
+		return ""
+	}
+	if _, err := c.UpdateDescription.UpdatedFields.LookupErr("deletedAt"); err == nil {
+		return OperationDeleted
+	}
+	for _, field := range c.UpdateDescription.RemovedFields {
+		if field == "deletedAt" {
+			return OperationCreated
+		}
+	}
+	return ""
+}
+
+// documentID returns the id of the document of a change, read from the
+// document before the change, which migration 7 has Mongo keep, or after it.
+// Without either, such as for a document deleted before the migration, it
+// falls back to the Mongo _id, so the event is still reported.
+func (c *mongoChangeEvent) documentID() string {
+	for _, document := range []bson.Raw{c.FullDocumentBeforeChange, c.FullDocument} {
+		if ID, ok := document.Lookup("id").StringValueOK(); ok {
+			return ID
+		}
+	}
+	key := c.DocumentKey.Lookup("_id")
+	if objectID, ok := key.ObjectIDOK(); ok {
+		return objectID.Hex()
+	}
+	if ID, ok := key.StringValueOK(); ok {
+		return ID
+	}
+	return key.String()
+}
+
+// purged reports whether a change is the purge of a document that was in
+// the trash, whose deletion was already reported when it was put there.
+func (c *mongoChangeEvent) purged() bool {
+	if c.OperationType != "delete" || c.FullDocumentBeforeChange == nil {
+		return false
+	}
+	_, err := c.FullDocumentBeforeChange.LookupErr("deletedAt")
+	return err == nil
+}
+
+// WatchChanges reads a change stream of the database, which needs a replica
+// set or a sharded cluster. The ids of deleted documents come from the
+// pre-images of the collections, turned on by migration 7.
+func (m *MongoDatabase) WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error {
+	sources := map[string]changeSource{}
+	collections := bson.A{}
+	for _, source := range changeSources {
+		if watchesEntity(entityTypes, source.Type) {
+			name := m.names.Collection(source.Collection)
+			sources[name] = source
+			collections = append(collections, name)
+		}
+	}
+	pipeline := mongo.Pipeline{bson.D{bson.E{Key: "$match", Value: bson.D{
+		bson.E{Key: "ns.coll", Value: bson.D{bson.E{Key: "$in", Value: collections}}},
+		bson.E{Key: "operationType", Value: bson.D{bson.E{Key: "$in", Value: bson.A{"insert", "update", "replace", "delete"}}}},
+	}}}}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup).
+		SetCustomPipeline(bson.M{"fullDocumentBeforeChange": "whenAvailable"})
+	if resumeToken != "" {
+		opts.SetResumeAfter(bson.D{bson.E{Key: "_data", Value: resumeToken}})
+	}
+
+	stream, err := m.catalog(ctx).Watch(ctx, pipeline, opts)
+	if err != nil {
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == changeStreamHistoryLost {
+			return models.NewFieldError("resumeToken", "is older than the change history")
+		}
+		return errors.Wrap(err, "Error while opening the Mongo change stream")
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		change := mongoChangeEvent{}
+		if err := stream.Decode(&change); err != nil {
+			return errors.Wrap(err, "Error while decoding a Mongo change event")
+		}
+		if change.purged() {
+			continue
+		}
+		source := sources[change.Namespace.Collection]
+		event := &dto.ChangeEventDTO{
+			EntityType:  source.Type,
+			ID:          change.documentID(),
+			ResumeToken: stream.ResumeToken().Lookup("_data").StringValue(),
+			Time:        time.Unix(int64(change.ClusterTime.T), 0).UTC(),
+		}
+		switch change.OperationType {
+		case "delete":
+			event.Operation = OperationDeleted
+		case "insert":
+			event.Operation = OperationCreated
+		default:
+			event.Operation = OperationUpdated
+		}
+		if operation := change.trashOperation(); operation != "" {
+			event.Operation = operation
+		}
+		if event.Operation != OperationDeleted {
+			// The document of an update is looked up afterwards and is
+			// missing or in the trash when a deletion followed, which has
+			// its own event.
+			if change.FullDocument == nil {
+				continue
+			}
+			if _, err := change.FullDocument.LookupErr("deletedAt"); err == nil {
+				continue
+			}
+			event.Document = source.newDocument()
+			if err := bson.Unmarshal(change.FullDocument, event.Document); err != nil {
+				return errors.Wrap(err, "Error while decoding a changed document")
+			}
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == changeStreamHistoryLost {
+			return errors.Wrap(models.ErrResourceExhausted, "The change stream fell behind the change history")
+		}
+		return errors.Wrap(err, "Error while reading the Mongo change stream")
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,806 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"int-service/dto"
+	"int-service/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// The conformance tests hold every backend of testBackends to the behaviour
+// of the Mongo backend. Writes come in the order the service makes them:
+// posters are uploaded after the document is created, and the short copies
+// are written after the document, since the SQL backend assembles the copies
+// on read instead of storing them.
+
+var releaseDate = time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkPosters(t *testing.T, what string, got []string, want ...string) {
+	t.Helper()
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s posters are %q, want %q", what, got, want)
+	}
+}
+
+func checkNotFound(t *testing.T, what string, err error) {
+	t.Helper()
+	if !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("%s returned %v, want a not found error", what, err)
+	}
+}
+
+func createCelebrity(t *testing.T, repo ProjectRepository, ID string, name string, occupation string) *dto.CelebrityDTO {
+	t.Helper()
+	celebrity := &dto.CelebrityDTO{
+		ID:          ID,
+		Name:        name,
+		Occupation:  []string{occupation},
+		DateOfBirth: releaseDate,
+		Gender:      "male",
+		Bio:         "Bio of " + name,
+	}
+	_, err := repo.CreateCelebrity(context.Background(), celebrity)
+	check(t, err)
+	celebrity, err = repo.UploadCelebrityPosters(context.Background(), ID, []string{"/celebrities/" + ID + "/a.jpg"})
+	check(t, err)
+	return celebrity
+}
+
+func createShow(t *testing.T, repo ProjectRepository, show *dto.ShowDTO) {
+	t.Helper()
+	if show.Type == "" {
+		show.Type = "series"
+	}
+	show.ReleaseDate = releaseDate
+	_, err := repo.CreateShow(context.Background(), show)
+	check(t, err)
+}
+
+func createSeason(t *testing.T, repo ProjectRepository, season *dto.SeasonDTO) {
+	t.Helper()
+	ctx := context.Background()
+	season.ReleaseDate = releaseDate
+	posters := season.PostersPath
+	_, err := repo.CreateSeason(ctx, season)
+	check(t, err)
+	if len(posters) > 0 {
+		season, err = repo.UploadSeasonPosters(ctx, season.ID, posters)
+		check(t, err)
+	}
+	_, err = repo.AddShortSeason(ctx, season.ShowID, &dto.ShortSeasonDTO{ID: season.ID, Title: season.Title, PostersPath: season.PostersPath, Rating: season.Rating})
+	check(t, err)
+}
+
+func createEpisode(t *testing.T, repo ProjectRepository, episode *dto.EpisodeDTO) {
+	t.Helper()
+	ctx := context.Background()
+	posters := episode.PostersPath
+	_, err := repo.CreateEpisode(ctx, episode)
+	check(t, err)
+	if len(posters) > 0 {
+		episode, err = repo.UploadEpisodePosters(ctx, episode.ID, posters)
+		check(t, err)
+	}
+	_, err = repo.AddShortEpisode(ctx, episode.SeasonID, &dto.ShortEpisodeDTO{ID: episode.ID, Title: episode.Title, PostersPath: episode.PostersPath, Rating: episode.Rating, Resume: episode.Resume})
+	check(t, err)
+}
+
+func TestConformanceShows(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Drama"})
+			check(t, err)
+			louis := createCelebrity(t, repo, "c1", "Louis Hofmann", "Actor")
+			createShow(t, repo, &dto.ShowDTO{
+				ID:          "s1",
+				Title:       "Dark",
+				Rating:      8.7,
+				Description: "A German mystery.",
+				Genres:      dto.ShortGenresDTO{{ID: "g1", Name: "Drama"}},
+				Starring:    dto.ShortCelebritiesDTO{{ID: louis.ID, Name: louis.Name, RoleName: "Jonas", PostersPath: louis.PostersPath}},
+			})
+			createShow(t, repo, &dto.ShowDTO{ID: "s2", Title: "Heat", Type: "movie", Rating: 8.3})
+
+			show, err := repo.GetShow(ctx, "s1")
+			check(t, err)
+			if show.Title != "Dark" || show.Type != "series" || show.Rating != 8.7 || show.Description != "A German mystery." || !show.ReleaseDate.Equal(releaseDate) {
+				t.Errorf("GetShow returned %+v", show)
+			}
+			if len(show.Genres) != 1 || *show.Genres[0] != (dto.ShortGenreDTO{ID: "g1", Name: "Drama"}) {
+				t.Errorf("show genres are %+v", show.Genres)
+			}
+			if len(show.Starring) != 1 || show.Starring[0].ID != "c1" || show.Starring[0].Name != "Louis Hofmann" || show.Starring[0].RoleName != "Jonas" {
+				t.Fatalf("show starring is %+v", show.Starring)
+			}
+			checkPosters(t, "starring", show.Starring[0].PostersPath, "/celebrities/c1/a.jpg")
+			_, err = repo.GetShow(ctx, "missing")
+			checkNotFound(t, "GetShow of a missing show", err)
+
+			// UpdateShow only writes the given fields.
+			updated, err := repo.UpdateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark (2017)", Rating: 1, Description: "Changed."}, []string{"title"})
+			check(t, err)
+			if updated.Title != "Dark (2017)" || updated.Rating != 8.7 || updated.Description != "A German mystery." {
+				t.Errorf("UpdateShow of the title returned %+v", updated)
+			}
+			show, err = repo.GetShow(ctx, "s1")
+			check(t, err)
+			if show.Title != "Dark (2017)" || show.Rating != 8.7 || len(show.Starring) != 1 {
+				t.Errorf("the show after an update of its title is %+v", show)
+			}
+
+			for _, tt := range []struct {
+				filter dto.ShowFilterDTO
+				want   []string
+			}{
+				{dto.ShowFilterDTO{}, []string{"s1", "s2"}},
+				{dto.ShowFilterDTO{Type: "movie"}, []string{"s2"}},
+				{dto.ShowFilterDTO{GenreID: "g1"}, []string{"s1"}},
+				{dto.ShowFilterDTO{MinRating: 8.5}, []string{"s1"}},
+			} {
+				shows, total, err := repo.ListShows(ctx, tt.filter, dto.PageDTO{SortBy: "title"})
+				check(t, err)
+				if got := showIDs(shows); !reflect.DeepEqual(got, tt.want) || total != int64(len(tt.want)) {
+					t.Errorf("ListShows(%+v) returned %q of %d, want %q", tt.filter, got, total, tt.want)
+				}
+			}
+
+			check(t, repo.DeleteShow(ctx, "s1"))
+			_, err = repo.GetShow(ctx, "s1")
+			checkNotFound(t, "GetShow of a deleted show", err)
+			shows, total, err := repo.ListShows(ctx, dto.ShowFilterDTO{}, dto.PageDTO{})
+			check(t, err)
+			if got := showIDs(shows); !reflect.DeepEqual(got, []string{"s2"}) || total != 1 {
+				t.Errorf("ListShows after a delete returned %q of %d", got, total)
+			}
+		})
+	}
+}
+
+func showIDs(shows dto.ShowsDTO) []string {
+	IDs := []string{}
+	for _, show := range shows {
+		IDs = append(IDs, show.ID)
+	}
+	return IDs
+}
+
+func TestConformanceShortSeasons(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			createShow(t, repo, &dto.ShowDTO{ID: "s1", Title: "Dark"})
+			createSeason(t, repo, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1", Rating: 8, PostersPath: []string{"/series/s1/se1/a.jpg"}})
+			createSeason(t, repo, &dto.SeasonDTO{ID: "se2", ShowID: "s1", Title: "Season 2", Rating: 7})
+
+			shortSeason := func(ID string) *dto.ShortSeasonDTO {
+				t.Helper()
+				show, err := repo.GetShow(ctx, "s1")
+				check(t, err)
+				for _, season := range show.Seasons {
+					if season.ID == ID {
+						return season
+					}
+				}
+				return nil
+			}
+			if season := shortSeason("se1"); season == nil || season.Title != "Season 1" || season.Rating != 8 {
+				t.Fatalf("the short season is %+v", season)
+			}
+			checkPosters(t, "short season", shortSeason("se1").PostersPath, "/series/s1/se1/a.jpg")
+			seasons, err := repo.ListShowSeasons(ctx, "s1")
+			check(t, err)
+			if len(seasons) != 2 {
+				t.Errorf("ListShowSeasons returned %d seasons, want 2", len(seasons))
+			}
+
+			updated, err := repo.UpdateSeason(ctx, &dto.SeasonDTO{ID: "se1", Title: "Season One", Rating: 9, Resume: "Ignored."}, []string{"title", "rating"})
+			check(t, err)
+			_, err = repo.UpdateShortSeason(ctx, &dto.ShortSeasonDTO{ID: updated.ID, Title: updated.Title, PostersPath: updated.PostersPath, Rating: updated.Rating})
+			check(t, err)
+			if season := shortSeason("se1"); season.Title != "Season One" || season.Rating != 9 {
+				t.Errorf("the short season after an update is %+v", season)
+			}
+			if season := shortSeason("se2"); season.Title != "Season 2" {
+				t.Errorf("the update of a season changed another one to %+v", season)
+			}
+
+			season, err := repo.UploadSeasonPosters(ctx, "se1", []string{"/series/s1/se1/b.jpg"})
+			check(t, err)
+			checkPosters(t, "uploaded season", season.PostersPath, "/series/s1/se1/a.jpg", "/series/s1/se1/b.jpg")
+			_, err = repo.UpdateShortSeason(ctx, &dto.ShortSeasonDTO{ID: season.ID, Title: season.Title, PostersPath: season.PostersPath, Rating: season.Rating})
+			check(t, err)
+			checkPosters(t, "short season after an upload", shortSeason("se1").PostersPath, "/series/s1/se1/a.jpg", "/series/s1/se1/b.jpg")
+
+			check(t, repo.DeleteSeasonPoster(ctx, "s1", "se1", "a.jpg"))
+			check(t, repo.DeleteShortSeasonPostersInShow(ctx, "s1", "se1", "a.jpg"))
+			season, err = repo.GetSeason(ctx, "se1")
+			check(t, err)
+			checkPosters(t, "season after a delete", season.PostersPath, "/series/s1/se1/b.jpg")
+			checkPosters(t, "short season after a delete", shortSeason("se1").PostersPath, "/series/s1/se1/b.jpg")
+
+			check(t, repo.DeleteSeason(ctx, "se1"))
+			check(t, repo.RemoveShortSeason(ctx, "se1"))
+			if season := shortSeason("se1"); season != nil {
+				t.Errorf("the deleted season is still in the show as %+v", season)
+			}
+			if shortSeason("se2") == nil {
+				t.Error("the other season left the show")
+			}
+			_, err = repo.GetSeason(ctx, "se1")
+			checkNotFound(t, "GetSeason of a deleted season", err)
+		})
+	}
+}
+
+func TestConformanceShortEpisodes(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			createShow(t, repo, &dto.ShowDTO{ID: "s1", Title: "Dark"})
+			createSeason(t, repo, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1"})
+			createEpisode(t, repo, &dto.EpisodeDTO{ID: "e1", SeasonID: "se1", Title: "Secrets", Rating: 8, Resume: "Mikkel goes missing.", PostersPath: []string{"/series/s1/se1/e1/a.jpg"}})
+			createEpisode(t, repo, &dto.EpisodeDTO{ID: "e2", SeasonID: "se1", Title: "Lies", Rating: 7})
+
+			shortEpisode := func(ID string) *dto.ShortEpisodeDTO {
+				t.Helper()
+				season, err := repo.GetSeason(ctx, "se1")
+				check(t, err)
+				for _, episode := range season.Episodes {
+					if episode.ID == ID {
+						return episode
+					}
+				}
+				return nil
+			}
+			if episode := shortEpisode("e1"); episode == nil || episode.Title != "Secrets" || episode.Rating != 8 || episode.Resume != "Mikkel goes missing." {
+				t.Fatalf("the short episode is %+v", episode)
+			}
+			checkPosters(t, "short episode", shortEpisode("e1").PostersPath, "/series/s1/se1/e1/a.jpg")
+			episodes, err := repo.ListSeasonEpisodes(ctx, "se1")
+			check(t, err)
+			if len(episodes) != 2 {
+				t.Errorf("ListSeasonEpisodes returned %d episodes, want 2", len(episodes))
+			}
+
+			updated, err := repo.UpdateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", Title: "Secrets (pilot)", Rating: 9}, []string{"title", "rating"})
+			check(t, err)
+			if updated.Resume != "Mikkel goes missing." {
+				t.Errorf("UpdateEpisode of the title and rating changed the resume to %q", updated.Resume)
+			}
+			_, err = repo.UpdateShortEpisode(ctx, &dto.ShortEpisodeDTO{ID: updated.ID, Title: updated.Title, PostersPath: updated.PostersPath, Rating: updated.Rating, Resume: updated.Resume})
+			check(t, err)
+			if episode := shortEpisode("e1"); episode.Title != "Secrets (pilot)" || episode.Rating != 9 {
+				t.Errorf("the short episode after an update is %+v", episode)
+			}
+
+			episode, err := repo.UploadEpisodePosters(ctx, "e1", []string{"/series/s1/se1/e1/b.jpg"})
+			check(t, err)
+			checkPosters(t, "uploaded episode", episode.PostersPath, "/series/s1/se1/e1/a.jpg", "/series/s1/se1/e1/b.jpg")
+			check(t, repo.DeleteEpisodePoster(ctx, "s1", "se1", "e1", "a.jpg"))
+			episode, err = repo.GetEpisode(ctx, "e1")
+			check(t, err)
+			checkPosters(t, "episode after a delete", episode.PostersPath, "/series/s1/se1/e1/b.jpg")
+
+			check(t, repo.DeleteEpisode(ctx, "e1"))
+			check(t, repo.RemoveShortEpisode(ctx, "e1"))
+			if episode := shortEpisode("e1"); episode != nil {
+				t.Errorf("the deleted episode is still in the season as %+v", episode)
+			}
+			if shortEpisode("e2") == nil {
+				t.Error("the other episode left the season")
+			}
+		})
+	}
+}
+
+func TestConformanceShortCelebrities(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			louis := createCelebrity(t, repo, "c1", "Louis Hofmann", "Actor")
+			baran := createCelebrity(t, repo, "c2", "Baran bo Odar", "Director")
+			createShow(t, repo, &dto.ShowDTO{
+				ID:         "s1",
+				Title:      "Dark",
+				Starring:   dto.ShortCelebritiesDTO{{ID: louis.ID, Name: louis.Name, RoleName: "Jonas", PostersPath: louis.PostersPath}},
+				DirectedBy: dto.FilmCrewsDTO{{ID: baran.ID, Name: baran.Name, PostersPath: baran.PostersPath}},
+			})
+			createSeason(t, repo, &dto.SeasonDTO{
+				ID:         "se1",
+				ShowID:     "s1",
+				Title:      "Season 1",
+				DirectedBy: dto.FilmCrewsDTO{{ID: baran.ID, Name: baran.Name, PostersPath: baran.PostersPath}},
+			})
+			createEpisode(t, repo, &dto.EpisodeDTO{
+				ID:       "e1",
+				SeasonID: "se1",
+				Title:    "Secrets",
+				Starring: dto.ShortCelebritiesDTO{{ID: louis.ID, Name: louis.Name, RoleName: "Jonas", PostersPath: louis.PostersPath}},
+			})
+
+			starring := func() *dto.ShortCelebrityDTO {
+				t.Helper()
+				show, err := repo.GetShow(ctx, "s1")
+				check(t, err)
+				if len(show.Starring) != 1 {
+					return nil
+				}
+				return show.Starring[0]
+			}
+			updateShortCelebrity := func(short *dto.ShortCelebrityDTO, celebrityType string) {
+				t.Helper()
+				_, err := repo.UpdateShortCelebritiesInShow(ctx, short, celebrityType)
+				check(t, err)
+				_, err = repo.UpdateShortCelebritiesInSeasons(ctx, short, celebrityType)
+				check(t, err)
+				_, err = repo.UpdateShortCelebritiesInEpisode(ctx, short, celebrityType)
+				check(t, err)
+			}
+
+			// A new name replaces the name of the short copies, which keep
+			// their role and posters.
+			updated, err := repo.UpdateCelebrity(ctx, &dto.CelebrityDTO{ID: "c1", Name: "Louis H."}, []string{"name"})
+			check(t, err)
+			updateShortCelebrity(&dto.ShortCelebrityDTO{ID: updated.ID, Name: updated.Name}, "starring")
+			if short := starring(); short == nil || short.Name != "Louis H." || short.RoleName != "Jonas" {
+				t.Fatalf("the starring after a rename is %+v", short)
+			}
+			checkPosters(t, "starring after a rename", starring().PostersPath, "/celebrities/c1/a.jpg")
+			episode, err := repo.GetEpisode(ctx, "e1")
+			check(t, err)
+			if len(episode.Starring) != 1 || episode.Starring[0].Name != "Louis H." {
+				t.Errorf("the episode starring after a rename is %+v", episode.Starring)
+			}
+
+			// Uploaded posters are added to the ones of the short copies.
+			_, err = repo.UploadCelebrityPosters(ctx, "c1", []string{"/celebrities/c1/b.jpg"})
+			check(t, err)
+			updateShortCelebrity(&dto.ShortCelebrityDTO{ID: "c1", Name: "Louis H.", PostersPath: []string{"/celebrities/c1/b.jpg"}}, "starring")
+			checkPosters(t, "starring after an upload", starring().PostersPath, "/celebrities/c1/a.jpg", "/celebrities/c1/b.jpg")
+
+			check(t, repo.DeleteCelebrityPoster(ctx, "c1", "a.jpg"))
+			check(t, repo.DeleteShortCelebritiesPostersInShow(ctx, "c1", "a.jpg", "starring"))
+			check(t, repo.DeleteShortCelebritiesPostersInSeason(ctx, "c1", "a.jpg", "starring"))
+			check(t, repo.DeleteShortCelebritiesPostersInEpisode(ctx, "c1", "a.jpg", "starring"))
+			checkPosters(t, "starring after a delete", starring().PostersPath, "/celebrities/c1/b.jpg")
+			episode, err = repo.GetEpisode(ctx, "e1")
+			check(t, err)
+			checkPosters(t, "episode starring after a delete", episode.Starring[0].PostersPath, "/celebrities/c1/b.jpg")
+
+			// The film crews follow their celebrity the same way.
+			updated, err = repo.UpdateCelebrity(ctx, &dto.CelebrityDTO{ID: "c2", Name: "Baran"}, []string{"name"})
+			check(t, err)
+			updateShortCelebrity(&dto.ShortCelebrityDTO{ID: updated.ID, Name: updated.Name}, "directedBy")
+			show, err := repo.GetShow(ctx, "s1")
+			check(t, err)
+			season, err := repo.GetSeason(ctx, "se1")
+			check(t, err)
+			if len(show.DirectedBy) != 1 || show.DirectedBy[0].Name != "Baran" || len(season.DirectedBy) != 1 || season.DirectedBy[0].Name != "Baran" {
+				t.Errorf("the directors after a rename are %+v and %+v", show.DirectedBy, season.DirectedBy)
+			}
+
+			check(t, repo.DeleteCelebrity(ctx, "c1"))
+			check(t, repo.RemoveShortCelebrityInShows(ctx, "c1"))
+			check(t, repo.RemoveShortCelebrityInSeasons(ctx, "c1"))
+			check(t, repo.RemoveShortCelebrityInEpisodes(ctx, "c1"))
+			if short := starring(); short != nil {
+				t.Errorf("the deleted celebrity still stars in the show as %+v", short)
+			}
+			episode, err = repo.GetEpisode(ctx, "e1")
+			check(t, err)
+			if len(episode.Starring) != 0 {
+				t.Errorf("the deleted celebrity still stars in the episode as %+v", episode.Starring)
+			}
+			show, err = repo.GetShow(ctx, "s1")
+			check(t, err)
+			if len(show.DirectedBy) != 1 {
+				t.Errorf("the removal of an actor changed the directors to %+v", show.DirectedBy)
+			}
+		})
+	}
+}
+
+func TestConformanceRemoveShortGenre(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, genre := range []*dto.GenreDTO{{ID: "g1", Name: "Drama"}, {ID: "g2", Name: "Mystery"}} {
+				_, err := repo.CreateGenre(ctx, genre)
+				check(t, err)
+			}
+			createShow(t, repo, &dto.ShowDTO{ID: "s1", Title: "Dark", Genres: dto.ShortGenresDTO{{ID: "g1", Name: "Drama"}, {ID: "g2", Name: "Mystery"}}})
+
+			check(t, repo.DeleteGenre(ctx, "g1"))
+			check(t, repo.RemoveShortGenre(ctx, "g1"))
+			show, err := repo.GetShow(ctx, "s1")
+			check(t, err)
+			if len(show.Genres) != 1 || show.Genres[0].ID != "g2" {
+				t.Errorf("the show genres after a removal are %+v", show.Genres)
+			}
+		})
+	}
+}
+
+// TestConformancePosters checks uploads append to the posters, and deletes
+// pull every occurrence of the poster, and nothing when it is missing.
+func TestConformancePosters(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			createShow(t, repo, &dto.ShowDTO{ID: "s1", Title: "Dark"})
+			createShow(t, repo, &dto.ShowDTO{ID: "m1", Title: "Heat", Type: "movie"})
+			createCelebrity(t, repo, "c1", "Louis Hofmann", "Actor")
+			_, err := repo.CreateArticle(ctx, &dto.ArticleDTO{ID: "a1", Title: "Dark ends", ReleaseDate: releaseDate})
+			check(t, err)
+
+			tests := []struct {
+				name    string
+				prefix  string
+				upload  func(paths []string) ([]string, error)
+				delete  func(image string) error
+				posters func() ([]string, error)
+			}{
+				{
+					name:   "series",
+					prefix: "/series/s1/",
+					upload: func(paths []string) ([]string, error) {
+						show, err := repo.UploadSeriesPosters(ctx, "s1", paths)
+						return postersOf(show, err)
+					},
+					delete:  func(image string) error { return repo.DeleteSeriesPoster(ctx, "s1", image) },
+					posters: func() ([]string, error) { return postersOf(repo.GetShow(ctx, "s1")) },
+				},
+				{
+					name:   "movie",
+					prefix: "/movie/m1/",
+					upload: func(paths []string) ([]string, error) {
+						show, err := repo.UploadMoviePosters(ctx, "m1", paths)
+						return postersOf(show, err)
+					},
+					delete:  func(image string) error { return repo.DeleteMoviePoster(ctx, "m1", image) },
+					posters: func() ([]string, error) { return postersOf(repo.GetShow(ctx, "m1")) },
+				},
+				{
+					name:   "celebrity",
+					prefix: "/celebrities/c1/",
+					upload: func(paths []string) ([]string, error) {
+						celebrity, err := repo.UploadCelebrityPosters(ctx, "c1", paths)
+						return postersOf(celebrity, err)
+					},
+					delete:  func(image string) error { return repo.DeleteCelebrityPoster(ctx, "c1", image) },
+					posters: func() ([]string, error) { return postersOf(repo.GetCelebrity(ctx, "c1")) },
+				},
+				{
+					name:   "article",
+					prefix: "/articles/a1/",
+					upload: func(paths []string) ([]string, error) {
+						article, err := repo.UploadArticlePosters(ctx, "a1", paths)
+						return postersOf(article, err)
+					},
+					delete:  func(image string) error { return repo.DeleteArticlePoster(ctx, "a1", image) },
+					posters: func() ([]string, error) { return postersOf(repo.GetArticle(ctx, "a1")) },
+				},
+			}
+			for _, tt := range tests {
+				before, err := tt.posters()
+				check(t, err)
+				first, second := tt.prefix+"first.jpg", tt.prefix+"second.jpg"
+
+				uploaded, err := tt.upload([]string{first, second})
+				check(t, err)
+				checkPosters(t, tt.name+" uploaded", uploaded, append(append([]string{}, before...), first, second)...)
+				uploaded, err = tt.upload([]string{first})
+				check(t, err)
+				checkPosters(t, tt.name+" uploaded twice", uploaded, append(append([]string{}, before...), first, second, first)...)
+
+				check(t, tt.delete("first.jpg"))
+				posters, err := tt.posters()
+				check(t, err)
+				checkPosters(t, tt.name+" after a delete", posters, append(append([]string{}, before...), second)...)
+
+				check(t, tt.delete("missing.jpg"))
+				posters, err = tt.posters()
+				check(t, err)
+				checkPosters(t, tt.name+" after the delete of a missing poster", posters, append(append([]string{}, before...), second)...)
+			}
+
+			_, err = repo.UploadSeriesPosters(ctx, "missing", []string{"/series/missing/a.jpg"})
+			checkNotFound(t, "UploadSeriesPosters of a missing show", err)
+			_, err = repo.UploadCelebrityPosters(ctx, "missing", []string{"/celebrities/missing/a.jpg"})
+			checkNotFound(t, "UploadCelebrityPosters of a missing celebrity", err)
+		})
+	}
+}
+
+// postersOf returns the posters of a show, celebrity or article.
+func postersOf(document interface{}, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch document := document.(type) {
+	case *dto.ShowDTO:
+		return document.PostersPath, nil
+	case *dto.CelebrityDTO:
+		return document.PostersPath, nil
+	case *dto.ArticleDTO:
+		return document.PostersPath, nil
+	}
+	return nil, errors.New("no posters")
+}
+
+func articleIDs(articles dto.ArticlesDTO) []string {
+	IDs := []string{}
+	for _, article := range articles {
+		IDs = append(IDs, article.ID)
+	}
+	return IDs
+}
+
+func TestConformanceListArticles(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, article := range []*dto.ArticleDTO{
+				{ID: "a1", Title: "Bravo", ReleaseDate: date(2020, 1, 1), Journalist: dto.ShortJournalistDTO{ID: "j1"}},
+				{ID: "a2", Title: "Alpha", ReleaseDate: date(2021, 6, 1), Journalist: dto.ShortJournalistDTO{ID: "j2"}},
+				{ID: "a3", Title: "Charlie", ReleaseDate: date(2021, 6, 1), Journalist: dto.ShortJournalistDTO{ID: "j1"}},
+				{ID: "a4", Title: "Delta", ReleaseDate: date(2022, 3, 1), Journalist: dto.ShortJournalistDTO{ID: "j1"}},
+			} {
+				_, err := repo.CreateArticle(ctx, article)
+				check(t, err)
+			}
+			check(t, repo.DeleteArticle(ctx, "a4"))
+
+			newestFirst := dto.PageDTO{SortBy: "releaseDate", Descending: true}
+			tests := []struct {
+				name   string
+				filter dto.ArticleFilterDTO
+				page   dto.PageDTO
+				want   []string
+				total  int64
+			}{
+				{name: "newest first, ties by id", page: newestFirst, want: []string{"a3", "a2", "a1"}, total: 3},
+				{name: "oldest first", page: dto.PageDTO{SortBy: "releaseDate"}, want: []string{"a1", "a2", "a3"}, total: 3},
+				{name: "by title", page: dto.PageDTO{SortBy: "title"}, want: []string{"a2", "a1", "a3"}, total: 3},
+				{name: "by journalist", filter: dto.ArticleFilterDTO{JournalistID: "j1"}, page: newestFirst, want: []string{"a3", "a1"}, total: 2},
+				{
+					name:   "released in a range",
+					filter: dto.ArticleFilterDTO{ReleasedAfter: date(2020, 1, 1), ReleasedBefore: date(2021, 6, 1)},
+					page:   newestFirst,
+					want:   []string{"a1"},
+					total:  1,
+				},
+				{name: "a page", page: dto.PageDTO{SortBy: "releaseDate", Descending: true, Offset: 1, Limit: 1}, want: []string{"a2"}, total: 3},
+				{name: "past the end", page: dto.PageDTO{SortBy: "releaseDate", Descending: true, Offset: 5, Limit: 1}, want: []string{}, total: 3},
+			}
+			for _, tt := range tests {
+				articles, total, err := repo.ListArticles(ctx, tt.filter, tt.page)
+				check(t, err)
+				if got := articleIDs(articles); !reflect.DeepEqual(got, tt.want) || total != tt.total {
+					t.Errorf("%s: ListArticles returned %q of %d, want %q of %d", tt.name, got, total, tt.want, tt.total)
+				}
+			}
+
+			articles, err := repo.ListArticlesByJournalist(ctx, "j1")
+			check(t, err)
+			if got := articleIDs(articles); !reflect.DeepEqual(got, []string{"a1", "a3"}) {
+				t.Errorf("ListArticlesByJournalist returned %q, want the articles of j1 in the order they were written", got)
+			}
+		})
+	}
+}
+
+func TestConformanceGenres(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, genre := range []*dto.GenreDTO{{ID: "g1", Name: "Drama", Description: "Serious."}, {ID: "g2", Name: "Comedy"}} {
+				_, err := repo.CreateGenre(ctx, genre)
+				check(t, err)
+			}
+			genre, err := repo.GetGenreByName(ctx, "Drama")
+			check(t, err)
+			if genre.ID != "g1" || genre.Description != "Serious." {
+				t.Errorf("GetGenreByName returned %+v", genre)
+			}
+			_, err = repo.GetGenreByName(ctx, "Western")
+			checkNotFound(t, "GetGenreByName of a missing genre", err)
+
+			_, err = repo.UpdateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Thriller", Description: "Tense."})
+			check(t, err)
+			genre, err = repo.GetGenre(ctx, "g1")
+			check(t, err)
+			if genre.Name != "Thriller" || genre.Description != "Tense." {
+				t.Errorf("the genre after an update is %+v", genre)
+			}
+			_, err = repo.GetGenreByName(ctx, "Drama")
+			checkNotFound(t, "GetGenreByName of the old name", err)
+
+			genres, total, err := repo.ListGenres(ctx, dto.PageDTO{SortBy: "name"})
+			check(t, err)
+			if len(genres) != 2 || genres[0].ID != "g2" || genres[1].ID != "g1" || total != 2 {
+				t.Errorf("ListGenres by name returned %+v of %d", genres, total)
+			}
+
+			check(t, repo.DeleteGenre(ctx, "g1"))
+			_, err = repo.GetGenre(ctx, "g1")
+			checkNotFound(t, "GetGenre of a deleted genre", err)
+			_, err = repo.GetGenreByName(ctx, "Thriller")
+			checkNotFound(t, "GetGenreByName of a deleted genre", err)
+			genres, total, err = repo.ListGenres(ctx, dto.PageDTO{})
+			check(t, err)
+			if len(genres) != 1 || genres[0].ID != "g2" || total != 1 {
+				t.Errorf("ListGenres after a delete returned %+v of %d", genres, total)
+			}
+		})
+	}
+}
+
+func TestConformanceJournalists(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, journalist := range []*dto.JournalistDTO{{ID: "j1", Name: "Zoe"}, {ID: "j2", Name: "Adam"}} {
+				_, err := repo.CreateJournalist(ctx, journalist)
+				check(t, err)
+			}
+			journalist, err := repo.GetJournalistByName(ctx, "Zoe")
+			check(t, err)
+			if journalist.ID != "j1" {
+				t.Errorf("GetJournalistByName returned %+v", journalist)
+			}
+			_, err = repo.GetJournalistByName(ctx, "Nobody")
+			checkNotFound(t, "GetJournalistByName of a missing journalist", err)
+
+			_, err = repo.UpdateJournalist(ctx, &dto.JournalistDTO{ID: "j1", Name: "Beth"})
+			check(t, err)
+			journalist, err = repo.GetJournalist(ctx, "j1")
+			check(t, err)
+			if journalist.Name != "Beth" {
+				t.Errorf("the journalist after an update is %+v", journalist)
+			}
+
+			journalists, total, err := repo.ListJournalists(ctx, dto.PageDTO{SortBy: "name", Descending: true})
+			check(t, err)
+			if len(journalists) != 2 || journalists[0].ID != "j1" || journalists[1].ID != "j2" || total != 2 {
+				t.Errorf("ListJournalists by name returned %+v of %d", journalists, total)
+			}
+
+			check(t, repo.DeleteJournalist(ctx, "j1"))
+			_, err = repo.GetJournalist(ctx, "j1")
+			checkNotFound(t, "GetJournalist of a deleted journalist", err)
+			journalists, total, err = repo.ListJournalists(ctx, dto.PageDTO{})
+			check(t, err)
+			if len(journalists) != 1 || journalists[0].ID != "j2" || total != 1 {
+				t.Errorf("ListJournalists after a delete returned %+v of %d", journalists, total)
+			}
+		})
+	}
+}
+
+func TestConformancePurgeDeadLetters(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			events := []*dto.OutboxEventDTO{
+				{ID: "old", CreatedAt: releaseDate, Dispatched: true, Dead: true},
+				{ID: "retried", CreatedAt: releaseDate, Dispatched: true, Pending: true, Dead: true},
+				{ID: "recent", CreatedAt: releaseDate.Add(48 * time.Hour), Dispatched: true, Dead: true},
+				{ID: "due", CreatedAt: releaseDate},
+			}
+			for _, event := range events {
+				event.Type, event.EntityType, event.EntityID = "genre.created", GenreEntity, "g1"
+				check(t, repo.AddOutboxEvent(ctx, event))
+			}
+			purged, err := repo.PurgeDeadLetters(ctx, releaseDate.Add(24*time.Hour))
+			check(t, err)
+			if purged != 1 {
+				t.Errorf("PurgeDeadLetters purged %d events, want 1", purged)
+			}
+			dead, total, err := repo.ListDeadLetters(ctx, dto.PageDTO{})
+			check(t, err)
+			if len(dead) != 2 || dead[0].ID != "recent" || dead[1].ID != "retried" || total != 2 {
+				t.Errorf("ListDeadLetters after the purge returned %+v of %d", dead, total)
+			}
+			due, err := repo.ListDueOutboxEvents(ctx, releaseDate.Add(time.Hour), 10)
+			check(t, err)
+			if len(due) != 2 || due[0].ID != "retried" && due[1].ID != "retried" {
+				t.Errorf("ListDueOutboxEvents after the purge returned %+v", due)
+			}
+		})
+	}
+}
+
+func TestConformancePruneRevisions(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for document, count := range map[string]int{"g1": 5, "g2": 2} {
+				for number := 1; number <= count; number++ {
+					check(t, repo.AddRevision(ctx, &dto.RevisionDTO{
+						ID: fmt.Sprintf("%s-%d", document, number), EntityType: GenreEntity, EntityID: document,
+						Number: int64(number), CreatedAt: releaseDate, Snapshot: "{}",
+					}))
+				}
+			}
+			pruned, err := repo.PruneRevisions(ctx, 3)
+			check(t, err)
+			if pruned != 2 {
+				t.Errorf("PruneRevisions pruned %d revisions, want 2", pruned)
+			}
+			for document, want := range map[string][]int64{"g1": {5, 4, 3}, "g2": {2, 1}} {
+				revisions, total, err := repo.ListRevisions(ctx, GenreEntity, document, dto.PageDTO{})
+				check(t, err)
+				numbers := []int64{}
+				for _, revision := range revisions {
+					numbers = append(numbers, revision.Number)
+				}
+				if !reflect.DeepEqual(numbers, want) || total != int64(len(want)) {
+					t.Errorf("the revisions of %s after the pruning are %v of %d, want %v", document, numbers, total, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConformancePurgeTrash(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, genre := range []*dto.GenreDTO{{ID: "g1", Name: "Mystery"}, {ID: "g2", Name: "Drama"}} {
+				_, err := repo.CreateGenre(ctx, genre)
+				check(t, err)
+			}
+			// Some backends store times to the millisecond.
+			deletedAt := time.Now().UTC().Truncate(time.Millisecond)
+			check(t, repo.DeleteGenre(ctx, "g1"))
+			check(t, repo.AddTrashItem(ctx, &dto.TrashItemDTO{ID: "g1", EntityType: GenreEntity, Name: "Mystery", DeletedAt: deletedAt, Payload: "{}"}))
+			_, err := repo.GetGenre(ctx, "g1")
+			checkNotFound(t, "GetGenre of a deleted genre", err)
+			genres, total, err := repo.ListGenres(ctx, dto.PageDTO{})
+			check(t, err)
+			if len(genres) != 1 || genres[0].ID != "g2" || total != 1 {
+				t.Errorf("ListGenres with a deleted genre returned %+v of %d", genres, total)
+			}
+
+			purged, err := repo.PurgeTrashItems(ctx, deletedAt)
+			check(t, err)
+			if purged != 0 {
+				t.Errorf("PurgeTrashItems before the delete purged %d items", purged)
+			}
+			_, err = repo.GetTrashItem(ctx, GenreEntity, "g1")
+			check(t, err)
+
+			purged, err = repo.PurgeTrashItems(ctx, time.Now().Add(time.Hour))
+			check(t, err)
+			if purged != 1 {
+				t.Errorf("PurgeTrashItems purged %d items, want 1", purged)
+			}
+			_, err = repo.GetTrashItem(ctx, GenreEntity, "g1")
+			checkNotFound(t, "GetTrashItem of a purged item", err)
+			checkNotFound(t, "RestoreDocument of a purged genre", repo.RestoreDocument(ctx, GenreEntity, "g1"))
+			_, err = repo.GetGenre(ctx, "g2")
+			check(t, err)
+			_, err = repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"})
+			check(t, err)
+		})
+	}
+}
@@ -12,65 +12,64 @@ import (
 type EpisodeRepository interface {
 	CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error)
 	GetEpisode(ctx context.Context, ID string) (*dto.EpisodeDTO, error)
-	UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error)
+	UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (*dto.EpisodeDTO, error)
 	UpdateShortCelebritiesInEpisode(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error)
 	UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (*dto.EpisodeDTO, error)
 	DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error
 	DeleteShortCelebritiesPostersInEpisode(ctx context.Context, celebrityID string, image string, celebrityType string) error
 	ListSeasonEpisodes(ctx context.Context, seasonID string) (dto.EpisodesDTO, error)
-	ListCollectionEpisodes(ctx context.Context) (dto.EpisodesDTO, error)
+	ListCollectionEpisodes(ctx context.Context, filter dto.EpisodeFilterDTO, page dto.PageDTO) (dto.EpisodesDTO, int64, error)
+	DeleteEpisode(ctx context.Context, ID string) error
+	RemoveShortCelebrityInEpisodes(ctx context.Context, celebrityID string) error
 }
 
+// EpisodeFields are the fields of a episode an update can write.
+var EpisodeFields = []string{"title", "trailerUrl", "postersPath", "length", "rating", "resume", "writtenBy", "producedBy", "directedBy", "starring"}
+
 func (m *MongoDatabase) CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
+	collection := m.collection(ctx, "Episodes")
 	newEpisode.PostersPath = []string{}
 	_, err := collection.InsertOne(ctx, newEpisode)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while new episode in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while new episode in the Mongo database")
 	}
 	return newEpisode, nil
 }
 
 func (m *MongoDatabase) GetEpisode(ctx context.Context, ID string) (*dto.EpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Episodes")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	episode := dto.EpisodeDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&episode)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding episode by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding episode by id from the Mongo database")
 	}
 	return &episode, nil
 }
 
-func (m *MongoDatabase) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
-	filter := bson.D{bson.E{Key: "id", Value: updatedEpisode.ID}}
-	update := bson.D{
-		bson.E{Key: "$set", Value: bson.D{
-			bson.E{Key: "title", Value: updatedEpisode.Title},
-			bson.E{Key: "trailerUrl", Value: updatedEpisode.TrailerURL},
-			bson.E{Key: "postersPath", Value: updatedEpisode.PostersPath},
-			bson.E{Key: "length", Value: updatedEpisode.Length},
-			bson.E{Key: "rating", Value: updatedEpisode.Rating},
-			bson.E{Key: "resume", Value: updatedEpisode.Resume},
-			bson.E{Key: "writtenBy", Value: updatedEpisode.WrittenBy},
-			bson.E{Key: "producedBy", Value: updatedEpisode.ProducedBy},
-			bson.E{Key: "directedBy", Value: updatedEpisode.DirectedBy},
-			bson.E{Key: "starring", Value: updatedEpisode.Starring},
-		}},
-	}
-
-	_, err := collection.UpdateOne(ctx, filter, update)
+func (m *MongoDatabase) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (*dto.EpisodeDTO, error) {
+	collection := m.collection(ctx, "Episodes")
+	filter := bson.D{bson.E{Key: "id", Value: updatedEpisode.ID}, notDeleted}
+	update, err := setFields(updatedEpisode, fields)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error while updating episode in the Mongo database")
 	}
 
-	return updatedEpisode, nil
+	after := options.After
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+	}
+	episode := dto.EpisodeDTO{}
+	err = collection.FindOneAndUpdate(ctx, filter, update, &opt).Decode(&episode)
+	if err != nil {
+		return nil, errors.Wrap(mongoError(err), "Error while updating episode in the Mongo database")
+	}
+	return &episode, nil
 }
 
 func (m *MongoDatabase) UpdateShortCelebritiesInEpisode(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
+	collection := m.collection(ctx, "Episodes")
 	_, err := collection.UpdateMany(
 		ctx,
 		bson.D{bson.E{Key: celebrityType + ".id", Value: updatedCelebrity.ID}},
@@ -89,8 +88,8 @@ func (m *MongoDatabase) UpdateShortCelebritiesInEpisode(ctx context.Context, upd
 }
 
 func (m *MongoDatabase) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (*dto.EpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
-	filter := bson.D{bson.E{Key: "id", Value: episodeID}}
+	collection := m.collection(ctx, "Episodes")
+	filter := bson.D{bson.E{Key: "id", Value: episodeID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -104,15 +103,15 @@ func (m *MongoDatabase) UploadEpisodePosters(ctx context.Context, episodeID stri
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedEpisode)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating episode posters in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating episode posters in the Mongo database")
 	}
 
 	return &updatedEpisode, nil
 }
 
 func (m *MongoDatabase) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error {
-	collection := m.client.Database("Project").Collection("Episodes")
-	filter := bson.D{bson.E{Key: "id", Value: episodeID}}
+	collection := m.collection(ctx, "Episodes")
+	filter := bson.D{bson.E{Key: "id", Value: episodeID}, notDeleted}
 	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + episodeID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -125,7 +124,7 @@ func (m *MongoDatabase) DeleteEpisodePoster(ctx context.Context, seriesID string
 }
 
 func (m *MongoDatabase) DeleteShortCelebritiesPostersInEpisode(ctx context.Context, celebrityID string, image string, celebrityType string) error {
-	collection := m.client.Database("Project").Collection("Episodes")
+	collection := m.collection(ctx, "Episodes")
 	filter := bson.D{bson.E{Key: celebrityType + ".id", Value: celebrityID}}
 	posterPath := "/celebrities/" + celebrityID + "/" + image
 	update := bson.M{"$pull": bson.M{celebrityType + ".$.postersPath": posterPath}}
@@ -139,8 +138,8 @@ func (m *MongoDatabase) DeleteShortCelebritiesPostersInEpisode(ctx context.Conte
 }
 
 func (m *MongoDatabase) ListSeasonEpisodes(ctx context.Context, seasonID string) (dto.EpisodesDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
-	filter := bson.D{bson.E{Key: "seasonId", Value: seasonID}}
+	collection := m.collection(ctx, "Episodes")
+	filter := bson.D{bson.E{Key: "seasonId", Value: seasonID}, notDeleted}
 	episodes := dto.EpisodesDTO{}
 
 	cursor, err := collection.Find(ctx, filter)
@@ -163,27 +162,48 @@ func (m *MongoDatabase) ListSeasonEpisodes(ctx context.Context, seasonID string)
 	return episodes, nil
 }
 
-func (m *MongoDatabase) ListCollectionEpisodes(ctx context.Context) (dto.EpisodesDTO, error) {
-	collection := m.client.Database("Project").Collection("Episodes")
+func (m *MongoDatabase) ListCollectionEpisodes(ctx context.Context, filter dto.EpisodeFilterDTO, page dto.PageDTO) (dto.EpisodesDTO, int64, error) {
+	collection := m.collection(ctx, "Episodes")
 	episodes := dto.EpisodesDTO{}
+	query := bson.D{notDeleted}
+	if filter.SeasonID != "" {
+		query = append(query, bson.E{Key: "seasonId", Value: filter.SeasonID})
+	}
+	query = appendMinRating(query, filter.MinRating)
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all episodes from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while counting episodes in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all episodes from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		episode := dto.EpisodeDTO{}
 		err = cursor.Decode(&episode)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding episode")
+			return nil, 0, errors.Wrap(err, "Error while decoding episode")
 		}
 		episodes = append(episodes, &episode)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return episodes, nil
+	return episodes, total, nil
+}
+
+func (m *MongoDatabase) DeleteEpisode(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Episodes", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting episode from the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RemoveShortCelebrityInEpisodes(ctx context.Context, celebrityID string) error {
+	collection := m.collection(ctx, "Episodes")
+	return pullShortCelebrity(ctx, collection, celebrityID, []string{"starring", "directedBy", "writtenBy", "producedBy"})
 }
@@ -20,6 +20,9 @@ func NewFileDatabase(format DataManipulator) Repository {
 }
 
 func (f *FileDatabase) CreateClothing(ctx context.Context, clothing *dto.ClothingDTO) (*dto.ClothingDTO, error) {
+	fileLock.Lock()
+	defer fileLock.Unlock()
+
 	clothes := dto.ClothesDTO{}
 	err := f.ReadWrite.ReadData(fileName, &clothes)
 
@@ -36,6 +39,9 @@ func (f *FileDatabase) CreateClothing(ctx context.Context, clothing *dto.Clothin
 }
 
 func (f *FileDatabase) DeleteClothing(ctx context.Context, ID string) error {
+	fileLock.Lock()
+	defer fileLock.Unlock()
+
 	clothes := dto.ClothesDTO{}
 	err := f.ReadWrite.ReadData(fileName, &clothes)
 	if err != nil {
@@ -58,6 +64,9 @@ func (f *FileDatabase) DeleteClothing(ctx context.Context, ID string) error {
 }
 
 func (f *FileDatabase) GetAll(ctx context.Context) (*dto.ClothesDTO, error) {
+	fileLock.RLock()
+	defer fileLock.RUnlock()
+
 	clothes := dto.ClothesDTO{}
 	err := f.ReadWrite.ReadData(fileName, &clothes)
 	if err != nil {
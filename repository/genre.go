@@ -13,45 +13,46 @@ type GenreRepository interface {
 	GetGenre(ctx context.Context, ID string) (*dto.GenreDTO, error)
 	GetGenreByName(ctx context.Context, name string)(*dto.GenreDTO,error)
 	UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (*dto.GenreDTO, error)
-	ListGenres(ctx context.Context) (dto.GenresDTO, error)
+	ListGenres(ctx context.Context, page dto.PageDTO) (dto.GenresDTO, int64, error)
+	DeleteGenre(ctx context.Context, ID string) error
 }
 
 func (m *MongoDatabase) CreateGenre(ctx context.Context, newGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
-	collection := m.client.Database("Project").Collection("Genres")
+	collection := m.collection(ctx, "Genres")
 	_, err := collection.InsertOne(ctx, newGenre)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting the new genre in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new genre in the Mongo database")
 	}
 	return newGenre, nil
 }
 
 func (m *MongoDatabase) GetGenreByName(ctx context.Context, name string) (*dto.GenreDTO, error) {
-	collection := m.client.Database("Project").Collection("Genres")
-	filter := bson.D{bson.E{Key: "name", Value: name}}
+	collection := m.collection(ctx, "Genres")
+	filter := bson.D{bson.E{Key: "name", Value: name}, notDeleted}
 	genre := dto.GenreDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&genre)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding genre by name from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding genre by name from the Mongo database")
 	}
 	return &genre, nil
 }
 
 func (m *MongoDatabase) GetGenre(ctx context.Context, ID string) (*dto.GenreDTO, error) {
-	collection := m.client.Database("Project").Collection("Genres")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Genres")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	genre := dto.GenreDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&genre)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding genre by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding genre by id from the Mongo database")
 	}
 	return &genre, nil
 }
 
 func (m *MongoDatabase) UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
-	collection := m.client.Database("Project").Collection("Genres")
-	filter := bson.D{bson.E{Key: "id", Value: updatedGenre.ID}}
+	collection := m.collection(ctx, "Genres")
+	filter := bson.D{bson.E{Key: "id", Value: updatedGenre.ID}, notDeleted}
 	update := bson.D{
 		bson.E{Key: "$set", Value: bson.D{
 			bson.E{Key: "name", Value: updatedGenre.Name},
@@ -66,27 +67,39 @@ func (m *MongoDatabase) UpdateGenre(ctx context.Context, updatedGenre *dto.Genre
 	return updatedGenre, nil
 }
 
-func (m *MongoDatabase) ListGenres(ctx context.Context) (dto.GenresDTO, error) {
-	collection := m.client.Database("Project").Collection("Genres")
+func (m *MongoDatabase) ListGenres(ctx context.Context, page dto.PageDTO) (dto.GenresDTO, int64, error) {
+	collection := m.collection(ctx, "Genres")
 	genres := dto.GenresDTO{}
+	query := bson.D{notDeleted}
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all genres from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while counting genres in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all genres from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		genre := dto.GenreDTO{}
 		err = cursor.Decode(&genre)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding genre")
+			return nil, 0, errors.Wrap(err, "Error while decoding genre")
 		}
 		genres = append(genres, &genre)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return genres, nil
+	return genres, total, nil
+}
+
+func (m *MongoDatabase) DeleteGenre(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Genres", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting genre from the Mongo database")
+	}
+	return nil
 }
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type HealthChecker interface {
+	// Ping reports whether the storage behind the repository can be reached.
+	Ping(ctx context.Context) error
+}
+
+func (m *MongoDatabase) Ping(ctx context.Context) error {
+	if err := m.client.Ping(ctx, readpref.Primary()); err != nil {
+		return errors.Wrap(err, "Error while pinging the Mongo database")
+	}
+	return nil
+}
+
+func (m *CatalogDatabase) Ping(ctx context.Context) error {
+	if err := m.store.ping(); err != nil {
+		return errors.Wrap(err, "Error while reading the catalog database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) Ping(ctx context.Context) error {
+	if err := m.db.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "Error while pinging the SQL database")
+	}
+	return nil
+}
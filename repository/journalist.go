@@ -12,46 +12,47 @@ type JournalistRepository interface {
 	CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error)
 	GetJournalist(ctx context.Context, ID string) (*dto.JournalistDTO, error)
 	UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error)
-	ListJournalists(ctx context.Context) (dto.JournalistsDTO, error)
+	ListJournalists(ctx context.Context, page dto.PageDTO) (dto.JournalistsDTO, int64, error)
 	GetJournalistByName(ctx context.Context, name string) (*dto.JournalistDTO, error)
+	DeleteJournalist(ctx context.Context, ID string) error
 }
 
 func (m *MongoDatabase) CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
-	collection := m.client.Database("Project").Collection("Journalists")
+	collection := m.collection(ctx, "Journalists")
 	_, err := collection.InsertOne(ctx, newJournalist)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting the new journalist in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new journalist in the Mongo database")
 	}
 	return newJournalist, nil
 }
 
 func (m *MongoDatabase) GetJournalistByName(ctx context.Context, name string) (*dto.JournalistDTO, error) {
-	collection := m.client.Database("Project").Collection("Journalists")
-	filter := bson.D{bson.E{Key: "name", Value: name}}
+	collection := m.collection(ctx, "Journalists")
+	filter := bson.D{bson.E{Key: "name", Value: name}, notDeleted}
 	journalist := dto.JournalistDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&journalist)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding journalist by name from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding journalist by name from the Mongo database")
 	}
 	return &journalist, nil
 }
 
 func (m *MongoDatabase) GetJournalist(ctx context.Context, ID string) (*dto.JournalistDTO, error) {
-	collection := m.client.Database("Project").Collection("Journalists")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Journalists")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	journalist := dto.JournalistDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&journalist)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding journalist by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding journalist by id from the Mongo database")
 	}
 	return &journalist, nil
 }
 
 func (m *MongoDatabase) UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
-	collection := m.client.Database("Project").Collection("Journalists")
-	filter := bson.D{bson.E{Key: "id", Value: updatedJournalist.ID}}
+	collection := m.collection(ctx, "Journalists")
+	filter := bson.D{bson.E{Key: "id", Value: updatedJournalist.ID}, notDeleted}
 	update := bson.D{
 		bson.E{Key: "$set", Value: bson.D{
 			bson.E{Key: "name", Value: updatedJournalist.Name},
@@ -65,27 +66,39 @@ func (m *MongoDatabase) UpdateJournalist(ctx context.Context, updatedJournalist
 	return updatedJournalist, nil
 }
 
-func (m *MongoDatabase) ListJournalists(ctx context.Context) (dto.JournalistsDTO, error) {
-	collection := m.client.Database("Project").Collection("Journalists")
+func (m *MongoDatabase) ListJournalists(ctx context.Context, page dto.PageDTO) (dto.JournalistsDTO, int64, error) {
+	collection := m.collection(ctx, "Journalists")
 	journalists := dto.JournalistsDTO{}
+	query := bson.D{notDeleted}
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all journalists from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while counting journalists in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all journalists from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		journalist := dto.JournalistDTO{}
 		err = cursor.Decode(&journalist)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding journalist")
+			return nil, 0, errors.Wrap(err, "Error while decoding journalist")
 		}
 		journalists = append(journalists, &journalist)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return journalists, nil
+	return journalists, total, nil
+}
+
+func (m *MongoDatabase) DeleteJournalist(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Journalists", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting journalist from the Mongo database")
+	}
+	return nil
 }
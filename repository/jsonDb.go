@@ -30,5 +30,5 @@ func (j *JSONType) WriteFile(fileName string, fileData interface{}) error {
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(fileName+j.extension, data, 0644)
+	return writeFileAtomic(fileName+j.extension, data, 0644)
 }
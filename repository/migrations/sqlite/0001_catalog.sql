@@ -0,0 +1,417 @@
+-- The catalog documents. Lists are kept in child tables ordered by position,
+-- and the short documents embedded in the Mongo documents, such as the
+-- seasons of a show or the names of its celebrities, are joined on read.
+-- References between documents are not foreign keys, as in Mongo: deleting
+-- a document removes its references through the service.
+
+CREATE TABLE shows (
+	id             TEXT PRIMARY KEY,
+	title          TEXT NOT NULL,
+	type           TEXT NOT NULL,
+	release_date   TEXT NOT NULL,
+	end_date       TEXT NOT NULL,
+	rating         REAL NOT NULL,
+	length_hours   INTEGER NOT NULL,
+	length_minutes INTEGER NOT NULL,
+	trailer_url    TEXT NOT NULL,
+	description    TEXT NOT NULL
+);
+CREATE INDEX shows_type ON shows (type);
+CREATE INDEX shows_release_date ON shows (release_date, id);
+
+CREATE TABLE show_posters (
+	show_id  TEXT NOT NULL REFERENCES shows (id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	path     TEXT NOT NULL,
+	PRIMARY KEY (show_id, position)
+);
+
+CREATE TABLE show_genres (
+	show_id  TEXT NOT NULL REFERENCES shows (id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	genre_id TEXT NOT NULL,
+	PRIMARY KEY (show_id, position)
+);
+CREATE INDEX show_genres_genre ON show_genres (genre_id);
+
+-- kind is the list of the credit: directedBy, producedBy, writtenBy or
+-- starring.
+CREATE TABLE show_credits (
+	show_id      TEXT NOT NULL REFERENCES shows (id) ON DELETE CASCADE,
+	kind         TEXT NOT NULL,
+	position     INTEGER NOT NULL,
+	celebrity_id TEXT NOT NULL,
+	role_name    TEXT NOT NULL,
+	PRIMARY KEY (show_id, kind, position)
+);
+CREATE INDEX show_credits_celebrity ON show_credits (celebrity_id);
+
+CREATE TABLE seasons (
+	id           TEXT PRIMARY KEY,
+	show_id      TEXT NOT NULL,
+	title        TEXT NOT NULL,
+	trailer_url  TEXT NOT NULL,
+	resume       TEXT NOT NULL,
+	rating       REAL NOT NULL,
+	release_date TEXT NOT NULL
+);
+CREATE INDEX seasons_show ON seasons (show_id);
+
+CREATE TABLE season_posters (
+	season_id TEXT NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+	position  INTEGER NOT NULL,
+	path      TEXT NOT NULL,
+	PRIMARY KEY (season_id, position)
+);
+
+CREATE TABLE season_credits (
+	season_id    TEXT NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+	kind         TEXT NOT NULL,
+	position     INTEGER NOT NULL,
+	celebrity_id TEXT NOT NULL,
+	role_name    TEXT NOT NULL,
+	PRIMARY KEY (season_id, kind, position)
+);
+CREATE INDEX season_credits_celebrity ON season_credits (celebrity_id);
+
+CREATE TABLE episodes (
+	id             TEXT PRIMARY KEY,
+	season_id      TEXT NOT NULL,
+	title          TEXT NOT NULL,
+	trailer_url    TEXT NOT NULL,
+	length_hours   INTEGER NOT NULL,
+	length_minutes INTEGER NOT NULL,
+	rating         REAL NOT NULL,
+	resume         TEXT NOT NULL
+);
+CREATE INDEX episodes_season ON episodes (season_id);
+
+CREATE TABLE episode_posters (
+	episode_id TEXT NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+	position   INTEGER NOT NULL,
+	path       TEXT NOT NULL,
+	PRIMARY KEY (episode_id, position)
+);
+
+CREATE TABLE episode_credits (
+	episode_id   TEXT NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+	kind         TEXT NOT NULL,
+	position     INTEGER NOT NULL,
+	celebrity_id TEXT NOT NULL,
+	role_name    TEXT NOT NULL,
+	PRIMARY KEY (episode_id, kind, position)
+);
+CREATE INDEX episode_credits_celebrity ON episode_credits (celebrity_id);
+
+CREATE TABLE celebrities (
+	id             TEXT PRIMARY KEY,
+	name           TEXT NOT NULL,
+	date_of_birth  TEXT NOT NULL,
+	date_of_death  TEXT NOT NULL,
+	place_of_birth TEXT NOT NULL,
+	gender         TEXT NOT NULL,
+	bio            TEXT NOT NULL
+);
+CREATE INDEX celebrities_name ON celebrities (name);
+
+CREATE TABLE celebrity_occupations (
+	celebrity_id TEXT NOT NULL REFERENCES celebrities (id) ON DELETE CASCADE,
+	position     INTEGER NOT NULL,
+	occupation   TEXT NOT NULL,
+	PRIMARY KEY (celebrity_id, position)
+);
+CREATE INDEX celebrity_occupations_occupation ON celebrity_occupations (occupation);
+
+CREATE TABLE celebrity_posters (
+	celebrity_id TEXT NOT NULL REFERENCES celebrities (id) ON DELETE CASCADE,
+	position     INTEGER NOT NULL,
+	path         TEXT NOT NULL,
+	PRIMARY KEY (celebrity_id, position)
+);
+
+CREATE TABLE articles (
+	id            TEXT PRIMARY KEY,
+	title         TEXT NOT NULL,
+	release_date  TEXT NOT NULL,
+	description   TEXT NOT NULL,
+	journalist_id TEXT NOT NULL
+);
+CREATE INDEX articles_journalist ON articles (journalist_id);
+CREATE INDEX articles_release_date ON articles (release_date, id);
+
+CREATE TABLE article_posters (
+	article_id TEXT NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+	position   INTEGER NOT NULL,
+	path       TEXT NOT NULL,
+	PRIMARY KEY (article_id, position)
+);
+
+CREATE TABLE genres (
+	id          TEXT PRIMARY KEY,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL
+);
+CREATE INDEX genres_name ON genres (name);
+
+CREATE TABLE journalists (
+	id   TEXT PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE INDEX journalists_name ON journalists (name);
+
+-- The outbox and the webhooks are read and written whole, so their lists are
+-- kept as JSON.
+CREATE TABLE outbox_events (
+	id              TEXT PRIMARY KEY,
+	type            TEXT NOT NULL,
+	entity_type     TEXT NOT NULL,
+	entity_id       TEXT NOT NULL,
+	payload         TEXT NOT NULL,
+	created_at      TEXT NOT NULL,
+	dispatched      INTEGER NOT NULL,
+	pending         INTEGER NOT NULL,
+	dead            INTEGER NOT NULL,
+	next_attempt_at TEXT NOT NULL,
+	deliveries      TEXT NOT NULL
+);
+CREATE INDEX outbox_events_due ON outbox_events (dispatched, pending, next_attempt_at);
+CREATE INDEX outbox_events_dead ON outbox_events (dead, created_at);
+
+CREATE TABLE webhooks (
+	id          TEXT PRIMARY KEY,
+	url         TEXT NOT NULL,
+	secret      TEXT NOT NULL,
+	event_types TEXT NOT NULL,
+	created_at  TEXT NOT NULL
+);
+
+-- search is the full text index of the searchable documents, kept up to date
+-- by the triggers below.
+CREATE VIRTUAL TABLE search USING fts5 (type UNINDEXED, id UNINDEXED, title, text);
+
+CREATE TRIGGER shows_search_insert AFTER INSERT ON shows BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('show', NEW.id, NEW.title, NEW.description);
+END;
+CREATE TRIGGER shows_search_update AFTER UPDATE OF title, description ON shows BEGIN
+	UPDATE search SET title = NEW.title, text = NEW.description WHERE type = 'show' AND id = NEW.id;
+END;
+CREATE TRIGGER shows_search_delete AFTER DELETE ON shows BEGIN
+	DELETE FROM search WHERE type = 'show' AND id = OLD.id;
+END;
+
+CREATE TRIGGER celebrities_search_insert AFTER INSERT ON celebrities BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('celebrity', NEW.id, NEW.name, NEW.bio);
+END;
+CREATE TRIGGER celebrities_search_update AFTER UPDATE OF name, bio ON celebrities BEGIN
+	UPDATE search SET title = NEW.name, text = NEW.bio WHERE type = 'celebrity' AND id = NEW.id;
+END;
+CREATE TRIGGER celebrities_search_delete AFTER DELETE ON celebrities BEGIN
+	DELETE FROM search WHERE type = 'celebrity' AND id = OLD.id;
+END;
+
+CREATE TRIGGER episodes_search_insert AFTER INSERT ON episodes BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('episode', NEW.id, NEW.title, NEW.resume);
+END;
+CREATE TRIGGER episodes_search_update AFTER UPDATE OF title, resume ON episodes BEGIN
+	UPDATE search SET title = NEW.title, text = NEW.resume WHERE type = 'episode' AND id = NEW.id;
+END;
+CREATE TRIGGER episodes_search_delete AFTER DELETE ON episodes BEGIN
+	DELETE FROM search WHERE type = 'episode' AND id = OLD.id;
+END;
+
+CREATE TRIGGER articles_search_insert AFTER INSERT ON articles BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('article', NEW.id, NEW.title, NEW.description);
+END;
+CREATE TRIGGER articles_search_update AFTER UPDATE OF title, description ON articles BEGIN
+	UPDATE search SET title = NEW.title, text = NEW.description WHERE type = 'article' AND id = NEW.id;
+END;
+CREATE TRIGGER articles_search_delete AFTER DELETE ON articles BEGIN
+	DELETE FROM search WHERE type = 'article' AND id = OLD.id;
+END;
+
+-- changes logs every change of a document, including the changes of the rows
+-- joined into it, for WatchChanges. It keeps the last 10000 of them.
+CREATE TABLE changes (
+	seq         INTEGER PRIMARY KEY AUTOINCREMENT,
+	entity_type TEXT NOT NULL,
+	entity_id   TEXT NOT NULL,
+	operation   TEXT NOT NULL,
+	changed_at  TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+CREATE TRIGGER changes_trim AFTER INSERT ON changes BEGIN
+	DELETE FROM changes WHERE seq <= NEW.seq - 10000;
+END;
+
+-- Shows.
+
+CREATE TRIGGER shows_created AFTER INSERT ON shows BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', NEW.id, 'created');
+END;
+CREATE TRIGGER shows_updated AFTER UPDATE ON shows BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', NEW.id, 'updated');
+END;
+CREATE TRIGGER shows_deleted AFTER DELETE ON shows BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', OLD.id, 'deleted');
+END;
+
+CREATE TRIGGER show_posters_inserted AFTER INSERT ON show_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER show_posters_deleted AFTER DELETE ON show_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = OLD.show_id;
+END;
+CREATE TRIGGER show_genres_inserted AFTER INSERT ON show_genres BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER show_genres_deleted AFTER DELETE ON show_genres BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = OLD.show_id;
+END;
+CREATE TRIGGER show_credits_inserted AFTER INSERT ON show_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER show_credits_deleted AFTER DELETE ON show_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = OLD.show_id;
+END;
+
+-- Seasons, which also change the seasons of their show.
+
+CREATE TRIGGER seasons_created AFTER INSERT ON seasons BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', NEW.id, 'created');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER seasons_updated AFTER UPDATE ON seasons BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', NEW.id, 'updated');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER seasons_deleted AFTER DELETE ON seasons BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', OLD.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = OLD.show_id;
+END;
+
+CREATE TRIGGER season_posters_inserted AFTER INSERT ON season_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', shows.id, 'updated' FROM seasons JOIN shows ON shows.id = seasons.show_id WHERE seasons.id = NEW.season_id;
+END;
+CREATE TRIGGER season_posters_deleted AFTER DELETE ON season_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = OLD.season_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', shows.id, 'updated' FROM seasons JOIN shows ON shows.id = seasons.show_id WHERE seasons.id = OLD.season_id;
+END;
+CREATE TRIGGER season_credits_inserted AFTER INSERT ON season_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER season_credits_deleted AFTER DELETE ON season_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = OLD.season_id;
+END;
+
+-- Episodes, which also change the episodes of their season.
+
+CREATE TRIGGER episodes_created AFTER INSERT ON episodes BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', NEW.id, 'created');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER episodes_updated AFTER UPDATE ON episodes BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', NEW.id, 'updated');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER episodes_deleted AFTER DELETE ON episodes BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', OLD.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = OLD.season_id;
+END;
+
+CREATE TRIGGER episode_posters_inserted AFTER INSERT ON episode_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'episode', id, 'updated' FROM episodes WHERE id = NEW.episode_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', seasons.id, 'updated' FROM episodes JOIN seasons ON seasons.id = episodes.season_id WHERE episodes.id = NEW.episode_id;
+END;
+CREATE TRIGGER episode_posters_deleted AFTER DELETE ON episode_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'episode', id, 'updated' FROM episodes WHERE id = OLD.episode_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', seasons.id, 'updated' FROM episodes JOIN seasons ON seasons.id = episodes.season_id WHERE episodes.id = OLD.episode_id;
+END;
+CREATE TRIGGER episode_credits_inserted AFTER INSERT ON episode_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'episode', id, 'updated' FROM episodes WHERE id = NEW.episode_id;
+END;
+CREATE TRIGGER episode_credits_deleted AFTER DELETE ON episode_credits BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'episode', id, 'updated' FROM episodes WHERE id = OLD.episode_id;
+END;
+
+-- Celebrities, whose name and posters also change the documents crediting
+-- them.
+
+CREATE TRIGGER celebrities_created AFTER INSERT ON celebrities BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', NEW.id, 'created');
+END;
+CREATE TRIGGER celebrities_updated AFTER UPDATE ON celebrities BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', NEW.id, 'updated');
+END;
+CREATE TRIGGER celebrities_renamed AFTER UPDATE OF name ON celebrities WHEN OLD.name IS NOT NEW.name BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'show', show_id, 'updated' FROM show_credits WHERE celebrity_id = NEW.id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'season', season_id, 'updated' FROM season_credits WHERE celebrity_id = NEW.id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'episode', episode_id, 'updated' FROM episode_credits WHERE celebrity_id = NEW.id;
+END;
+CREATE TRIGGER celebrities_deleted AFTER DELETE ON celebrities BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', OLD.id, 'deleted');
+END;
+
+CREATE TRIGGER celebrity_occupations_inserted AFTER INSERT ON celebrity_occupations BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'celebrity', id, 'updated' FROM celebrities WHERE id = NEW.celebrity_id;
+END;
+CREATE TRIGGER celebrity_occupations_deleted AFTER DELETE ON celebrity_occupations BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'celebrity', id, 'updated' FROM celebrities WHERE id = OLD.celebrity_id;
+END;
+CREATE TRIGGER celebrity_posters_inserted AFTER INSERT ON celebrity_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'celebrity', id, 'updated' FROM celebrities WHERE id = NEW.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'show', show_id, 'updated' FROM show_credits WHERE celebrity_id = NEW.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'season', season_id, 'updated' FROM season_credits WHERE celebrity_id = NEW.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'episode', episode_id, 'updated' FROM episode_credits WHERE celebrity_id = NEW.celebrity_id;
+END;
+CREATE TRIGGER celebrity_posters_deleted AFTER DELETE ON celebrity_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'celebrity', id, 'updated' FROM celebrities WHERE id = OLD.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'show', show_id, 'updated' FROM show_credits WHERE celebrity_id = OLD.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'season', season_id, 'updated' FROM season_credits WHERE celebrity_id = OLD.celebrity_id;
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'episode', episode_id, 'updated' FROM episode_credits WHERE celebrity_id = OLD.celebrity_id;
+END;
+
+-- Articles.
+
+CREATE TRIGGER articles_created AFTER INSERT ON articles BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', NEW.id, 'created');
+END;
+CREATE TRIGGER articles_updated AFTER UPDATE ON articles BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', NEW.id, 'updated');
+END;
+CREATE TRIGGER articles_deleted AFTER DELETE ON articles BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', OLD.id, 'deleted');
+END;
+CREATE TRIGGER article_posters_inserted AFTER INSERT ON article_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'article', id, 'updated' FROM articles WHERE id = NEW.article_id;
+END;
+CREATE TRIGGER article_posters_deleted AFTER DELETE ON article_posters BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'article', id, 'updated' FROM articles WHERE id = OLD.article_id;
+END;
+
+-- Genres, whose name also changes the shows of the genre.
+
+CREATE TRIGGER genres_created AFTER INSERT ON genres BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', NEW.id, 'created');
+END;
+CREATE TRIGGER genres_updated AFTER UPDATE ON genres BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', NEW.id, 'updated');
+END;
+CREATE TRIGGER genres_renamed AFTER UPDATE OF name ON genres WHEN OLD.name IS NOT NEW.name BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT DISTINCT 'show', show_id, 'updated' FROM show_genres WHERE genre_id = NEW.id;
+END;
+CREATE TRIGGER genres_deleted AFTER DELETE ON genres BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', OLD.id, 'deleted');
+END;
+
+-- Journalists.
+
+CREATE TRIGGER journalists_created AFTER INSERT ON journalists BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', NEW.id, 'created');
+END;
+CREATE TRIGGER journalists_updated AFTER UPDATE ON journalists BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', NEW.id, 'updated');
+END;
+CREATE TRIGGER journalists_deleted AFTER DELETE ON journalists BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', OLD.id, 'deleted');
+END;
@@ -0,0 +1,14 @@
+-- trash keeps the deleted documents until they are restored or purged, with
+-- the documents of the delete as JSON.
+CREATE TABLE trash (
+	entity_type TEXT NOT NULL,
+	id          TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	parent_id   TEXT NOT NULL,
+	deleted_at  TEXT NOT NULL,
+	deleted_by  TEXT NOT NULL,
+	payload     TEXT NOT NULL,
+	PRIMARY KEY (entity_type, id)
+);
+CREATE INDEX trash_deleted ON trash (entity_type, deleted_at);
+CREATE INDEX trash_purge ON trash (deleted_at);
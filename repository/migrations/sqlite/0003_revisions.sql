@@ -0,0 +1,13 @@
+-- revisions keep the history of the catalog documents, with the whole
+-- document after each create and update as JSON.
+CREATE TABLE revisions (
+	id          TEXT PRIMARY KEY,
+	entity_type TEXT NOT NULL,
+	entity_id   TEXT NOT NULL,
+	number      INTEGER NOT NULL,
+	actor       TEXT NOT NULL,
+	method      TEXT NOT NULL,
+	created_at  TEXT NOT NULL,
+	snapshot    TEXT NOT NULL,
+	UNIQUE (entity_type, entity_id, number)
+);
@@ -0,0 +1,160 @@
+-- deleted_at marks a document deleted to the trash. The reads skip marked
+-- rows, restoring clears the mark and purging the trash deletes the rows.
+ALTER TABLE shows ADD COLUMN deleted_at TEXT;
+ALTER TABLE seasons ADD COLUMN deleted_at TEXT;
+ALTER TABLE episodes ADD COLUMN deleted_at TEXT;
+ALTER TABLE celebrities ADD COLUMN deleted_at TEXT;
+ALTER TABLE articles ADD COLUMN deleted_at TEXT;
+ALTER TABLE genres ADD COLUMN deleted_at TEXT;
+ALTER TABLE journalists ADD COLUMN deleted_at TEXT;
+
+CREATE INDEX shows_deleted ON shows (deleted_at);
+CREATE INDEX seasons_deleted ON seasons (deleted_at);
+CREATE INDEX episodes_deleted ON episodes (deleted_at);
+CREATE INDEX celebrities_deleted ON celebrities (deleted_at);
+CREATE INDEX articles_deleted ON articles (deleted_at);
+CREATE INDEX genres_deleted ON genres (deleted_at);
+CREATE INDEX journalists_deleted ON journalists (deleted_at);
+
+-- Marking a document logs its deletion and clearing the mark its creation,
+-- instead of an update. Purging a marked row is not logged again.
+
+DROP TRIGGER shows_updated;
+DROP TRIGGER shows_deleted;
+CREATE TRIGGER shows_updated AFTER UPDATE ON shows WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', NEW.id, 'updated');
+END;
+CREATE TRIGGER shows_trashed AFTER UPDATE OF deleted_at ON shows WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', NEW.id, 'deleted');
+END;
+CREATE TRIGGER shows_restored AFTER UPDATE OF deleted_at ON shows WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', NEW.id, 'created');
+END;
+CREATE TRIGGER shows_deleted AFTER DELETE ON shows WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('show', OLD.id, 'deleted');
+END;
+
+DROP TRIGGER seasons_updated;
+DROP TRIGGER seasons_deleted;
+CREATE TRIGGER seasons_updated AFTER UPDATE ON seasons WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', NEW.id, 'updated');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER seasons_trashed AFTER UPDATE OF deleted_at ON seasons WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', NEW.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER seasons_restored AFTER UPDATE OF deleted_at ON seasons WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', NEW.id, 'created');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = NEW.show_id;
+END;
+CREATE TRIGGER seasons_deleted AFTER DELETE ON seasons WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('season', OLD.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'show', id, 'updated' FROM shows WHERE id = OLD.show_id;
+END;
+
+DROP TRIGGER episodes_updated;
+DROP TRIGGER episodes_deleted;
+CREATE TRIGGER episodes_updated AFTER UPDATE ON episodes WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', NEW.id, 'updated');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER episodes_trashed AFTER UPDATE OF deleted_at ON episodes WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', NEW.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER episodes_restored AFTER UPDATE OF deleted_at ON episodes WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', NEW.id, 'created');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = NEW.season_id;
+END;
+CREATE TRIGGER episodes_deleted AFTER DELETE ON episodes WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('episode', OLD.id, 'deleted');
+	INSERT INTO changes (entity_type, entity_id, operation) SELECT 'season', id, 'updated' FROM seasons WHERE id = OLD.season_id;
+END;
+
+DROP TRIGGER celebrities_updated;
+DROP TRIGGER celebrities_deleted;
+CREATE TRIGGER celebrities_updated AFTER UPDATE ON celebrities WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', NEW.id, 'updated');
+END;
+CREATE TRIGGER celebrities_trashed AFTER UPDATE OF deleted_at ON celebrities WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', NEW.id, 'deleted');
+END;
+CREATE TRIGGER celebrities_restored AFTER UPDATE OF deleted_at ON celebrities WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', NEW.id, 'created');
+END;
+CREATE TRIGGER celebrities_deleted AFTER DELETE ON celebrities WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('celebrity', OLD.id, 'deleted');
+END;
+
+DROP TRIGGER articles_updated;
+DROP TRIGGER articles_deleted;
+CREATE TRIGGER articles_updated AFTER UPDATE ON articles WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', NEW.id, 'updated');
+END;
+CREATE TRIGGER articles_trashed AFTER UPDATE OF deleted_at ON articles WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', NEW.id, 'deleted');
+END;
+CREATE TRIGGER articles_restored AFTER UPDATE OF deleted_at ON articles WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', NEW.id, 'created');
+END;
+CREATE TRIGGER articles_deleted AFTER DELETE ON articles WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('article', OLD.id, 'deleted');
+END;
+
+DROP TRIGGER genres_updated;
+DROP TRIGGER genres_deleted;
+CREATE TRIGGER genres_updated AFTER UPDATE ON genres WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', NEW.id, 'updated');
+END;
+CREATE TRIGGER genres_trashed AFTER UPDATE OF deleted_at ON genres WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', NEW.id, 'deleted');
+END;
+CREATE TRIGGER genres_restored AFTER UPDATE OF deleted_at ON genres WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', NEW.id, 'created');
+END;
+CREATE TRIGGER genres_deleted AFTER DELETE ON genres WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('genre', OLD.id, 'deleted');
+END;
+
+DROP TRIGGER journalists_updated;
+DROP TRIGGER journalists_deleted;
+CREATE TRIGGER journalists_updated AFTER UPDATE ON journalists WHEN OLD.deleted_at IS NEW.deleted_at BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', NEW.id, 'updated');
+END;
+CREATE TRIGGER journalists_trashed AFTER UPDATE OF deleted_at ON journalists WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', NEW.id, 'deleted');
+END;
+CREATE TRIGGER journalists_restored AFTER UPDATE OF deleted_at ON journalists WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', NEW.id, 'created');
+END;
+CREATE TRIGGER journalists_deleted AFTER DELETE ON journalists WHEN OLD.deleted_at IS NULL BEGIN
+	INSERT INTO changes (entity_type, entity_id, operation) VALUES ('journalist', OLD.id, 'deleted');
+END;
+
+-- The search index only holds the documents that are not marked.
+
+CREATE TRIGGER shows_search_trashed AFTER UPDATE OF deleted_at ON shows WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	DELETE FROM search WHERE type = 'show' AND id = NEW.id;
+END;
+CREATE TRIGGER shows_search_restored AFTER UPDATE OF deleted_at ON shows WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('show', NEW.id, NEW.title, NEW.description);
+END;
+CREATE TRIGGER celebrities_search_trashed AFTER UPDATE OF deleted_at ON celebrities WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	DELETE FROM search WHERE type = 'celebrity' AND id = NEW.id;
+END;
+CREATE TRIGGER celebrities_search_restored AFTER UPDATE OF deleted_at ON celebrities WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('celebrity', NEW.id, NEW.name, NEW.bio);
+END;
+CREATE TRIGGER episodes_search_trashed AFTER UPDATE OF deleted_at ON episodes WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	DELETE FROM search WHERE type = 'episode' AND id = NEW.id;
+END;
+CREATE TRIGGER episodes_search_restored AFTER UPDATE OF deleted_at ON episodes WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('episode', NEW.id, NEW.title, NEW.resume);
+END;
+CREATE TRIGGER articles_search_trashed AFTER UPDATE OF deleted_at ON articles WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL BEGIN
+	DELETE FROM search WHERE type = 'article' AND id = NEW.id;
+END;
+CREATE TRIGGER articles_search_restored AFTER UPDATE OF deleted_at ON articles WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL BEGIN
+	INSERT INTO search (type, id, title, text) VALUES ('article', NEW.id, NEW.title, NEW.description);
+END;
@@ -3,30 +3,180 @@ package repository
 import (
 	"context"
 	"int-service/dto"
+	"int-service/models"
+	"int-service/tenant"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type MongoDatabase struct {
 	client *mongo.Client
+	names  MongoNames
+}
+
+// MongoNames names the databases and collections of the Mongo backend.
+type MongoNames struct {
+	// Database holds the catalog, and is the prefix of the database of each
+	// tenant.
+	Database         string
+	ClothingDatabase string
+	// Collections maps the default name of a collection, such as Shows, to
+	// the name it is stored under when it differs.
+	Collections map[string]string
+}
+
+// MongoCollections are the default names of the collections of the Mongo
+// backend.
+var MongoCollections = []string{"Shows", "Seasons", "Episodes", "Celebrities", "Articles", "Genres", "Journalists", "Outbox", "Webhooks", "Trash", "Revisions", "Summer"}
+
+func DefaultMongoNames() MongoNames {
+	return MongoNames{Database: "Project", ClothingDatabase: "Clothing", Collections: map[string]string{}}
+}
+
+// Collection returns the name the collection of default name is stored under.
+func (n MongoNames) Collection(name string) string {
+	if configured, ok := n.Collections[name]; ok {
+		return configured
+	}
+	return name
+}
+
+// TenantDatabase returns the name of the catalog database of a tenant, the
+// default one for "".
+func (n MongoNames) TenantDatabase(tenantID string) string {
+	return tenantDatabase(n.Database, tenantID)
+}
+
+func tenantDatabase(database string, tenantID string) string {
+	if tenantID == "" {
+		return database
+	}
+	return database + "_" + tenantID
 }
 
 func NewMongoDatabase(c *mongo.Client) Repository {
 	return &MongoDatabase{
 		client: c,
+		names:  DefaultMongoNames(),
 	}
 }
 
-func NewMongoDB(c *mongo.Client) ProjectRepository {
+func NewMongoDB(c *mongo.Client, names MongoNames) ProjectRepository {
 	return &MongoDatabase{
 		client: c,
+		names:  names,
 	}
 }
 
+// catalog returns the catalog database of the tenant of ctx.
+func (m *MongoDatabase) catalog(ctx context.Context) *mongo.Database {
+	return m.client.Database(m.names.TenantDatabase(tenant.FromContext(ctx)))
+}
+
+// collection returns the collection of default name of the tenant of ctx.
+func (m *MongoDatabase) collection(ctx context.Context, name string) *mongo.Collection {
+	return m.catalog(ctx).Collection(m.names.Collection(name))
+}
+
+// clothes returns the clothing collection of the tenant of ctx.
+func (m *MongoDatabase) clothes(ctx context.Context) *mongo.Collection {
+	database := tenantDatabase(m.names.ClothingDatabase, tenant.FromContext(ctx))
+	return m.client.Database(database).Collection(m.names.Collection("Summer"))
+}
+
+// mongoError translates the driver errors callers need to tell apart into the
+// domain errors of the models package.
+func mongoError(err error) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return models.ErrNotFound
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return models.ErrAlreadyExists
+	}
+	return err
+}
+
+// setFields builds a $set of the given fields of document, by their bson names.
+func setFields(document interface{}, fields []string) (bson.D, error) {
+	data, err := bson.Marshal(document)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while encoding document")
+	}
+	values := bson.M{}
+	if err := bson.Unmarshal(data, &values); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding document")
+	}
+
+	set := bson.D{}
+	for _, field := range fields {
+		set = append(set, bson.E{Key: field, Value: values[field]})
+	}
+	return bson.D{bson.E{Key: "$set", Value: set}}, nil
+}
+
+// findOptions applies a page to a Find call. The id is used as a tie breaker
+// so documents with equal sort values keep their order between pages.
+func findOptions(page dto.PageDTO) *options.FindOptions {
+	opts := options.Find()
+	if page.SortBy != "" {
+		direction := 1
+		if page.Descending {
+			direction = -1
+		}
+		opts.SetSort(bson.D{{Key: page.SortBy, Value: direction}, {Key: "id", Value: direction}})
+	}
+	if page.Offset > 0 {
+		opts.SetSkip(page.Offset)
+	}
+	if page.Limit > 0 {
+		opts.SetLimit(page.Limit)
+	}
+	return opts
+}
+
+func appendDateRange(query bson.D, key string, after time.Time, before time.Time) bson.D {
+	dateRange := bson.M{}
+	if !after.IsZero() {
+		dateRange["$gte"] = after
+	}
+	if !before.IsZero() {
+		dateRange["$lt"] = before
+	}
+	if len(dateRange) == 0 {
+		return query
+	}
+	return append(query, bson.E{Key: key, Value: dateRange})
+}
+
+func appendMinRating(query bson.D, minRating float64) bson.D {
+	if minRating <= 0 {
+		return query
+	}
+	return append(query, bson.E{Key: "rating", Value: bson.M{"$gte": minRating}})
+}
+
+// pullShortCelebrity removes every credit of the celebrity from the given
+// lists. Each list is pulled separately, so documents where one of the lists
+// is null are still updated.
+func pullShortCelebrity(ctx context.Context, collection *mongo.Collection, celebrityID string, celebrityTypes []string) error {
+	for _, celebrityType := range celebrityTypes {
+		filter := bson.D{bson.E{Key: celebrityType + ".id", Value: celebrityID}}
+		update := bson.M{"$pull": bson.M{celebrityType: bson.M{"id": celebrityID}}}
+
+		_, err := collection.UpdateMany(ctx, filter, update)
+		if err != nil {
+			return errors.Wrap(err, "Error while removing short celebrity from "+celebrityType+" in the Mongo database")
+		}
+	}
+	return nil
+}
+
 func (m *MongoDatabase) CreateClothing(ctx context.Context, newClothing *dto.ClothingDTO) (*dto.ClothingDTO, error) {
-	collection := m.client.Database("Clothing").Collection("Summer")
+	collection := m.clothes(ctx)
 	_, err := collection.InsertOne(ctx, newClothing)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error while inserting the new clothing in the Mongo database")
@@ -36,7 +186,7 @@ func (m *MongoDatabase) CreateClothing(ctx context.Context, newClothing *dto.Clo
 }
 
 func (m *MongoDatabase) DeleteClothing(ctx context.Context, ID string) error {
-	collection := m.client.Database("Clothing").Collection("Summer")
+	collection := m.clothes(ctx)
 	filter := bson.D{bson.E{Key: "id", Value: ID}}
 
 	_, err := collection.DeleteOne(ctx, filter)
@@ -47,7 +197,7 @@ func (m *MongoDatabase) DeleteClothing(ctx context.Context, ID string) error {
 }
 
 func (m *MongoDatabase) GetAll(ctx context.Context) (*dto.ClothesDTO, error) {
-	collection := m.client.Database("Clothing").Collection("Summer")
+	collection := m.clothes(ctx)
 	clothes := dto.ClothesDTO{}
 
 	cursor, err := collection.Find(ctx, bson.D{{}})
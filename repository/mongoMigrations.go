@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoMigrationsCollection records the migrations applied to a Mongo
+// database, a document per version.
+const mongoMigrationsCollection = "_migrations"
+
+// mongoIndexNotFound is the code of the error returned when dropping an index
+// that does not exist.
+const mongoIndexNotFound = 27
+
+// mongoMigration is a versioned change of a Mongo database. Down undoes Up.
+type mongoMigration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database, names MongoNames) error
+	Down    func(ctx context.Context, db *mongo.Database, names MongoNames) error
+}
+
+type mongoMigrationRecord struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"appliedAt"`
+}
+
+// MigrationStep is a migration applied, or reverted, by MigrateMongo.
+type MigrationStep struct {
+	Version  int
+	Name     string
+	Reverted bool
+}
+
+type mongoIndex struct {
+	Collection string
+	Name       string
+	Keys       bson.D
+	Unique     bool
+}
+
+var mongoIDIndexes = []mongoIndex{
+	{Collection: "Shows", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Seasons", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Episodes", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Celebrities", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Articles", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Genres", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Journalists", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Outbox", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Webhooks", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+}
+
+// mongoReferenceIndexes cover the lookups of documents by the documents they
+// belong to or embed, which every cascading update and delete runs.
+var mongoReferenceIndexes = []mongoIndex{
+	{Collection: "Seasons", Name: "showId", Keys: bson.D{bson.E{Key: "showId", Value: 1}}},
+	{Collection: "Episodes", Name: "seasonId", Keys: bson.D{bson.E{Key: "seasonId", Value: 1}}},
+	{Collection: "Shows", Name: "seasons.id", Keys: bson.D{bson.E{Key: "seasons.id", Value: 1}}},
+	{Collection: "Seasons", Name: "episodes.id", Keys: bson.D{bson.E{Key: "episodes.id", Value: 1}}},
+	{Collection: "Shows", Name: "genres.id", Keys: bson.D{bson.E{Key: "genres.id", Value: 1}}},
+	{Collection: "Articles", Name: "journalist.id", Keys: bson.D{bson.E{Key: "journalist.id", Value: 1}}},
+	{Collection: "Shows", Name: "starring.id", Keys: bson.D{bson.E{Key: "starring.id", Value: 1}}},
+	{Collection: "Shows", Name: "directedBy.id", Keys: bson.D{bson.E{Key: "directedBy.id", Value: 1}}},
+	{Collection: "Shows", Name: "producedBy.id", Keys: bson.D{bson.E{Key: "producedBy.id", Value: 1}}},
+	{Collection: "Shows", Name: "writtenBy.id", Keys: bson.D{bson.E{Key: "writtenBy.id", Value: 1}}},
+	{Collection: "Seasons", Name: "directedBy.id", Keys: bson.D{bson.E{Key: "directedBy.id", Value: 1}}},
+	{Collection: "Seasons", Name: "producedBy.id", Keys: bson.D{bson.E{Key: "producedBy.id", Value: 1}}},
+	{Collection: "Seasons", Name: "writtenBy.id", Keys: bson.D{bson.E{Key: "writtenBy.id", Value: 1}}},
+	{Collection: "Episodes", Name: "starring.id", Keys: bson.D{bson.E{Key: "starring.id", Value: 1}}},
+	{Collection: "Episodes", Name: "directedBy.id", Keys: bson.D{bson.E{Key: "directedBy.id", Value: 1}}},
+	{Collection: "Episodes", Name: "producedBy.id", Keys: bson.D{bson.E{Key: "producedBy.id", Value: 1}}},
+	{Collection: "Episodes", Name: "writtenBy.id", Keys: bson.D{bson.E{Key: "writtenBy.id", Value: 1}}},
+}
+
+var mongoShowKeyIndexes = []mongoIndex{
+	{Collection: "Shows", Name: "title_releaseDate", Keys: bson.D{bson.E{Key: "title", Value: 1}, bson.E{Key: "releaseDate", Value: 1}}, Unique: true},
+}
+
+// mongoTrashIndexes cover restoring a deleted document, listing the trash of
+// an entity type and purging the expired items.
+var mongoTrashIndexes = []mongoIndex{
+	{Collection: "Trash", Name: "entityType_id", Keys: bson.D{bson.E{Key: "entityType", Value: 1}, bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Trash", Name: "entityType_deletedAt", Keys: bson.D{bson.E{Key: "entityType", Value: 1}, bson.E{Key: "deletedAt", Value: -1}}},
+	{Collection: "Trash", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+}
+
+// mongoRevisionIndexes cover getting a revision and listing the history of a
+// document, and keep its revision numbers unique.
+var mongoRevisionIndexes = []mongoIndex{
+	{Collection: "Revisions", Name: "id", Keys: bson.D{bson.E{Key: "id", Value: 1}}, Unique: true},
+	{Collection: "Revisions", Name: "entityType_entityId_number", Keys: bson.D{bson.E{Key: "entityType", Value: 1}, bson.E{Key: "entityId", Value: 1}, bson.E{Key: "number", Value: -1}}, Unique: true},
+}
+
+// mongoSoftDeleteIndexes replace the show key index with one that lets a
+// show deleted to the trash keep its title and release date, and cover
+// purging the deleted documents.
+var mongoSoftDeleteIndexes = []mongoIndex{
+	{Collection: "Shows", Name: "title_releaseDate_deletedAt", Keys: bson.D{bson.E{Key: "title", Value: 1}, bson.E{Key: "releaseDate", Value: 1}, bson.E{Key: "deletedAt", Value: 1}}, Unique: true},
+	{Collection: "Shows", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Seasons", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Episodes", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Celebrities", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Articles", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Genres", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+	{Collection: "Journalists", Name: "deletedAt", Keys: bson.D{bson.E{Key: "deletedAt", Value: 1}}},
+}
+
+// mongoMigrations are the migrations of the Mongo database, by increasing
+// version. Released migrations must not change: add a new one instead.
+var mongoMigrations = []mongoMigration{
+	{Version: 1, Name: "id_indexes", Up: createMongoIndexes(mongoIDIndexes), Down: dropMongoIndexes(mongoIDIndexes)},
+	{Version: 2, Name: "reference_indexes", Up: createMongoIndexes(mongoReferenceIndexes), Down: dropMongoIndexes(mongoReferenceIndexes)},
+	{Version: 3, Name: "show_key_index", Up: createMongoIndexes(mongoShowKeyIndexes), Down: dropMongoIndexes(mongoShowKeyIndexes)},
+	{Version: 4, Name: "trash_indexes", Up: createMongoIndexes(mongoTrashIndexes), Down: dropMongoIndexes(mongoTrashIndexes)},
+	{Version: 5, Name: "revision_indexes", Up: createMongoIndexes(mongoRevisionIndexes), Down: dropMongoIndexes(mongoRevisionIndexes)},
+	{Version: 6, Name: "soft_delete_indexes", Up: replaceMongoIndexes(mongoShowKeyIndexes, mongoSoftDeleteIndexes), Down: replaceMongoIndexes(mongoSoftDeleteIndexes, mongoShowKeyIndexes)},
+	{Version: 7, Name: "change_stream_pre_images", Up: setMongoPreImages(true), Down: setMongoPreImages(false)},
+}
+
+// createMongoIndexes creates the given indexes. Creating an index that already
+// exists with the same keys and options is a no-op, so a migration that failed
+// halfway can be run again. A unique index fails on the duplicates already
+// stored, which have to be fixed first.
+func createMongoIndexes(indexes []mongoIndex) func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+	return func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+		for _, index := range indexes {
+			model := mongo.IndexModel{
+				Keys:    index.Keys,
+				Options: options.Index().SetName(index.Name).SetUnique(index.Unique),
+			}
+			if _, err := db.Collection(names.Collection(index.Collection)).Indexes().CreateOne(ctx, model); err != nil {
+				return errors.Wrap(err, "Error while creating the index "+index.Name+" of "+index.Collection)
+			}
+		}
+		return nil
+	}
+}
+
+func dropMongoIndexes(indexes []mongoIndex) func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+	return func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+		for _, index := range indexes {
+			_, err := db.Collection(names.Collection(index.Collection)).Indexes().DropOne(ctx, index.Name)
+			var commandErr mongo.CommandError
+			if errors.As(err, &commandErr) && commandErr.Code == mongoIndexNotFound {
+				continue
+			}
+			if err != nil {
+				return errors.Wrap(err, "Error while dropping the index "+index.Name+" of "+index.Collection)
+			}
+		}
+		return nil
+	}
+}
+
+// replaceMongoIndexes creates the indexes added before dropping the ones
+// removed, so the documents stay covered in between.
+func replaceMongoIndexes(removed []mongoIndex, added []mongoIndex) func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+	return func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+		if err := createMongoIndexes(added)(ctx, db, names); err != nil {
+			return err
+		}
+		return dropMongoIndexes(removed)(ctx, db, names)
+	}
+}
+
+// setMongoPreImages turns the pre-images of the catalog collections on or
+// off. Change events then carry the document before the change, which is the
+// only way to know the id of a deleted document. It needs Mongo 6.0.
+func setMongoPreImages(enabled bool) func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+	return func(ctx context.Context, db *mongo.Database, names MongoNames) error {
+		for _, source := range changeSources {
+			command := bson.D{
+				bson.E{Key: "collMod", Value: names.Collection(source.Collection)},
+				bson.E{Key: "changeStreamPreAndPostImages", Value: bson.D{bson.E{Key: "enabled", Value: enabled}}},
+			}
+			if err := db.RunCommand(ctx, command).Err(); err != nil {
+				return errors.Wrap(err, "Error while setting the pre-images of "+source.Collection)
+			}
+		}
+		return nil
+	}
+}
+
+// LatestMongoMigration returns the version of the last Mongo migration.
+func LatestMongoMigration() int {
+	return mongoMigrations[len(mongoMigrations)-1].Version
+}
+
+// MigrateMongo applies the pending migrations of the catalog database of a
+// tenant up to the version target, or reverts the applied ones above it, and
+// returns what it did in order. A
+// negative target stands for the latest version. Each migration is recorded
+// in _migrations once it succeeded, so a failed one is run again next time.
+// Migrations are idempotent, and a migration recorded meanwhile by another
+// server is simply skipped.
+func MigrateMongo(ctx context.Context, client *mongo.Client, names MongoNames, tenantID string, target int) ([]MigrationStep, error) {
+	if target < 0 {
+		target = LatestMongoMigration()
+	}
+	if target > LatestMongoMigration() {
+		return nil, errors.Errorf("There is no Mongo migration %d, the latest is %d", target, LatestMongoMigration())
+	}
+	db := client.Database(names.TenantDatabase(tenantID))
+	records := db.Collection(mongoMigrationsCollection)
+
+	applied, err := appliedMongoMigrations(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []MigrationStep{}
+	for _, migration := range mongoMigrations {
+		if migration.Version > target || applied[migration.Version] {
+			continue
+		}
+		if err := migration.Up(ctx, db, names); err != nil {
+			return steps, errors.Wrapf(err, "Error while applying the Mongo migration %d %s", migration.Version, migration.Name)
+		}
+		record := mongoMigrationRecord{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now().UTC()}
+		if _, err := records.InsertOne(ctx, record); err != nil && !mongo.IsDuplicateKeyError(err) {
+			return steps, errors.Wrapf(err, "Error while recording the Mongo migration %d %s", migration.Version, migration.Name)
+		}
+		steps = append(steps, MigrationStep{Version: migration.Version, Name: migration.Name})
+	}
+
+	for i := len(mongoMigrations) - 1; i >= 0; i-- {
+		migration := mongoMigrations[i]
+		if migration.Version <= target || !applied[migration.Version] {
+			continue
+		}
+		if err := migration.Down(ctx, db, names); err != nil {
+			return steps, errors.Wrapf(err, "Error while reverting the Mongo migration %d %s", migration.Version, migration.Name)
+		}
+		if _, err := records.DeleteOne(ctx, bson.D{bson.E{Key: "_id", Value: migration.Version}}); err != nil {
+			return steps, errors.Wrapf(err, "Error while recording the revert of the Mongo migration %d %s", migration.Version, migration.Name)
+		}
+		steps = append(steps, MigrationStep{Version: migration.Version, Name: migration.Name, Reverted: true})
+	}
+	return steps, nil
+}
+
+func appliedMongoMigrations(ctx context.Context, records *mongo.Collection) (map[int]bool, error) {
+	cursor, err := records.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the applied Mongo migrations")
+	}
+	defer cursor.Close(ctx)
+
+	applied := map[int]bool{}
+	for cursor.Next(ctx) {
+		record := mongoMigrationRecord{}
+		if err := cursor.Decode(&record); err != nil {
+			return nil, errors.Wrap(err, "Error while decoding an applied Mongo migration")
+		}
+		applied[record.Version] = true
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "Error while reading the applied Mongo migrations")
+	}
+	return applied, nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestMongoMigrations checks the migrations are numbered in sequence, can be
+// reverted, and do not reuse an index name of a collection.
+func TestMongoMigrations(t *testing.T) {
+	names := map[string]bool{}
+	for i, migration := range mongoMigrations {
+		if migration.Version != i+1 || migration.Up == nil || migration.Down == nil {
+			t.Errorf("the Mongo migration %d is version %d, want version %d with an up and a down", i, migration.Version, i+1)
+		}
+		if names[migration.Name] {
+			t.Errorf("the Mongo migrations have two named %s", migration.Name)
+		}
+		names[migration.Name] = true
+	}
+	if LatestMongoMigration() != len(mongoMigrations) {
+		t.Errorf("the latest Mongo migration is %d of %d", LatestMongoMigration(), len(mongoMigrations))
+	}
+
+	// The indexes of the latest version, after the soft delete replaced the
+	// show key.
+	latest := [][]mongoIndex{mongoIDIndexes, mongoReferenceIndexes, mongoTrashIndexes, mongoRevisionIndexes, mongoSoftDeleteIndexes}
+	indexes := map[string]bool{}
+	for _, list := range latest {
+		for _, index := range list {
+			key := index.Collection + "." + index.Name
+			if indexes[key] || len(index.Keys) == 0 {
+				t.Errorf("the index %s is defined twice or has no keys", key)
+			}
+			indexes[key] = true
+		}
+	}
+	for _, index := range mongoShowKeyIndexes {
+		if indexes[index.Collection+"."+index.Name] {
+			t.Errorf("the show key index %s is still defined at the latest version", index.Name)
+		}
+	}
+
+	// An unknown target fails before the database is reached.
+	_, err := MigrateMongo(context.Background(), nil, MongoNames{Database: "catalog"}, "", LatestMongoMigration()+1)
+	if err == nil || !strings.Contains(err.Error(), "There is no Mongo migration") {
+		t.Errorf("migrating to an unknown version returned %v", err)
+	}
+}
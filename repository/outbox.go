@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Statuses of a webhook delivery.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+// OutboxRepository keeps the events to deliver to the webhooks. Events are
+// added with the context of the transaction writing the change they report,
+// so they are stored if and only if the change is.
+type OutboxRepository interface {
+	AddOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error
+	// ListDueOutboxEvents returns the oldest events, up to limit, that are not
+	// dispatched yet or have a delivery due by now.
+	ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) (dto.OutboxEventsDTO, error)
+	UpdateOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error
+	DeleteOutboxEvent(ctx context.Context, ID string) error
+	// ListDeadLetters returns the events with a delivery given up, newest
+	// first.
+	ListDeadLetters(ctx context.Context, page dto.PageDTO) (dto.OutboxEventsDTO, int64, error)
+	// PurgeDeadLetters deletes the dead letters created before the given
+	// time with no delivery pending anymore, and returns how many there
+	// were.
+	PurgeDeadLetters(ctx context.Context, before time.Time) (int64, error)
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, newWebhook *dto.WebhookDTO) (*dto.WebhookDTO, error)
+	ListWebhooks(ctx context.Context) (dto.WebhooksDTO, error)
+	DeleteWebhook(ctx context.Context, ID string) error
+}
+
+func dueOutboxFilter(now time.Time) bson.D {
+	return bson.D{bson.E{Key: "$or", Value: bson.A{
+		bson.D{bson.E{Key: "dispatched", Value: false}},
+		bson.D{
+			bson.E{Key: "pending", Value: true},
+			bson.E{Key: "nextAttemptAt", Value: bson.D{bson.E{Key: "$lte", Value: now}}},
+		},
+	}}}
+}
+
+func (m *MongoDatabase) AddOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	collection := m.collection(ctx, "Outbox")
+	_, err := collection.InsertOne(ctx, event)
+	if err != nil {
+		return errors.Wrap(mongoError(err), "Error while inserting the outbox event in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) (dto.OutboxEventsDTO, error) {
+	collection := m.collection(ctx, "Outbox")
+	events := dto.OutboxEventsDTO{}
+	opts := options.Find().SetSort(bson.D{bson.E{Key: "createdAt", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, dueOutboxFilter(now), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding due outbox events in the Mongo database")
+	}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding outbox events from the Mongo database")
+	}
+	return events, nil
+}
+
+func (m *MongoDatabase) UpdateOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	collection := m.collection(ctx, "Outbox")
+	filter := bson.D{bson.E{Key: "id", Value: event.ID}}
+	result, err := collection.ReplaceOne(ctx, filter, event)
+	if err != nil {
+		return errors.Wrap(err, "Error while updating the outbox event in the Mongo database")
+	}
+	if result.MatchedCount == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while updating the outbox event in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) DeleteOutboxEvent(ctx context.Context, ID string) error {
+	collection := m.collection(ctx, "Outbox")
+	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	if _, err := collection.DeleteOne(ctx, filter); err != nil {
+		return errors.Wrap(err, "Error while deleting the outbox event from the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) ListDeadLetters(ctx context.Context, page dto.PageDTO) (dto.OutboxEventsDTO, int64, error) {
+	collection := m.collection(ctx, "Outbox")
+	events := dto.OutboxEventsDTO{}
+	query := bson.D{bson.E{Key: "dead", Value: true}}
+
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting dead letters in the Mongo database")
+	}
+	page.SortBy, page.Descending = "createdAt", true
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding dead letters in the Mongo database")
+	}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, 0, errors.Wrap(err, "Error while decoding dead letters from the Mongo database")
+	}
+	return events, total, nil
+}
+
+func (m *MongoDatabase) PurgeDeadLetters(ctx context.Context, before time.Time) (int64, error) {
+	collection := m.collection(ctx, "Outbox")
+	filter := bson.D{
+		bson.E{Key: "dead", Value: true},
+		bson.E{Key: "pending", Value: false},
+		bson.E{Key: "createdAt", Value: bson.D{bson.E{Key: "$lt", Value: before}}},
+	}
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the dead letters of the Mongo database")
+	}
+	return result.DeletedCount, nil
+}
+
+func (m *MongoDatabase) CreateWebhook(ctx context.Context, newWebhook *dto.WebhookDTO) (*dto.WebhookDTO, error) {
+	collection := m.collection(ctx, "Webhooks")
+	_, err := collection.InsertOne(ctx, newWebhook)
+	if err != nil {
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new webhook in the Mongo database")
+	}
+	return newWebhook, nil
+}
+
+func (m *MongoDatabase) ListWebhooks(ctx context.Context) (dto.WebhooksDTO, error) {
+	collection := m.collection(ctx, "Webhooks")
+	webhooks := dto.WebhooksDTO{}
+	cursor, err := collection.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{bson.E{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding webhooks in the Mongo database")
+	}
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding webhooks from the Mongo database")
+	}
+	return webhooks, nil
+}
+
+func (m *MongoDatabase) DeleteWebhook(ctx context.Context, ID string) error {
+	collection := m.collection(ctx, "Webhooks")
+	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting webhook from the Mongo database")
+	}
+	if result.DeletedCount == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while deleting webhook from the Mongo database")
+	}
+	return nil
+}
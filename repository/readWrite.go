@@ -1,6 +1,54 @@
 package repository
 
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileLock serializes every read-modify-write cycle on the data files, so
+// concurrent requests in this process cannot interleave and lose updates.
+var fileLock sync.RWMutex
+
 type DataManipulator interface {
 	ReadData(fileName string, fileData interface{}) error
 	WriteFile(fileName string, result interface{}) error
-}
\ No newline at end of file
+}
+
+// writeFileAtomic writes the data to a temporary file next to the target and
+// renames it over the target, so readers never observe a half written file.
+func writeFileAtomic(fileName string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(fileName), filepath.Base(fileName)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fileName)
+}
+
+// syncDir flushes the entries of a directory, such as the files renamed into
+// it.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
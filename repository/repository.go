@@ -19,4 +19,12 @@ type ProjectRepository interface {
 	ArticleRepository
 	GenreRepository
 	JournalistRepository
+	SearchRepository
+	ChangeWatcher
+	OutboxRepository
+	WebhookRepository
+	TrashRepository
+	RevisionRepository
+	UnitOfWork
+	HealthChecker
 }
\ No newline at end of file
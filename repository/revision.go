@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RevisionRepository keeps the history of the catalog documents, a revision
+// per create and update. Revisions are added with the context of the
+// transaction writing the change they record.
+type RevisionRepository interface {
+	AddRevision(ctx context.Context, revision *dto.RevisionDTO) error
+	GetRevision(ctx context.Context, ID string) (*dto.RevisionDTO, error)
+	// ListRevisions returns the revisions of a document, latest first.
+	ListRevisions(ctx context.Context, entityType string, entityID string, page dto.PageDTO) (dto.RevisionsDTO, int64, error)
+	// PruneRevisions deletes the revisions of every document but its keep
+	// latest ones, and returns how many there were. Revisions are numbered
+	// in sequence, so these are the ones numbered keep or more below the
+	// latest.
+	PruneRevisions(ctx context.Context, keep int) (int64, error)
+}
+
+func (m *MongoDatabase) AddRevision(ctx context.Context, revision *dto.RevisionDTO) error {
+	collection := m.collection(ctx, "Revisions")
+	if _, err := collection.InsertOne(ctx, revision); err != nil {
+		return errors.Wrap(mongoError(err), "Error while inserting the revision in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) GetRevision(ctx context.Context, ID string) (*dto.RevisionDTO, error) {
+	collection := m.collection(ctx, "Revisions")
+	revision := &dto.RevisionDTO{}
+	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	if err := collection.FindOne(ctx, filter).Decode(revision); err != nil {
+		return nil, errors.Wrap(mongoError(err), "Error while getting the revision from the Mongo database")
+	}
+	return revision, nil
+}
+
+func (m *MongoDatabase) ListRevisions(ctx context.Context, entityType string, entityID string, page dto.PageDTO) (dto.RevisionsDTO, int64, error) {
+	collection := m.collection(ctx, "Revisions")
+	revisions := dto.RevisionsDTO{}
+	query := bson.D{bson.E{Key: "entityType", Value: entityType}, bson.E{Key: "entityId", Value: entityID}}
+
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting revisions in the Mongo database")
+	}
+	page.SortBy, page.Descending = "number", true
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding revisions in the Mongo database")
+	}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, 0, errors.Wrap(err, "Error while decoding revisions from the Mongo database")
+	}
+	return revisions, total, nil
+}
+
+func (m *MongoDatabase) PruneRevisions(ctx context.Context, keep int) (int64, error) {
+	collection := m.collection(ctx, "Revisions")
+	pipeline := mongo.Pipeline{
+		bson.D{bson.E{Key: "$group", Value: bson.D{
+			bson.E{Key: "_id", Value: bson.D{bson.E{Key: "entityType", Value: "$entityType"}, bson.E{Key: "entityId", Value: "$entityId"}}},
+			bson.E{Key: "latest", Value: bson.D{bson.E{Key: "$max", Value: "$number"}}},
+			bson.E{Key: "count", Value: bson.D{bson.E{Key: "$sum", Value: 1}}},
+		}}},
+		bson.D{bson.E{Key: "$match", Value: bson.D{bson.E{Key: "count", Value: bson.D{bson.E{Key: "$gt", Value: keep}}}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while finding the revisions to prune in the Mongo database")
+	}
+	documents := []struct {
+		ID struct {
+			EntityType string `bson:"entityType"`
+			EntityID   string `bson:"entityId"`
+		} `bson:"_id"`
+		Latest int64 `bson:"latest"`
+	}{}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return 0, errors.Wrap(err, "Error while decoding the revisions to prune from the Mongo database")
+	}
+	pruned := int64(0)
+	for _, document := range documents {
+		filter := bson.D{
+			bson.E{Key: "entityType", Value: document.ID.EntityType},
+			bson.E{Key: "entityId", Value: document.ID.EntityID},
+			bson.E{Key: "number", Value: bson.D{bson.E{Key: "$lte", Value: document.Latest - int64(keep)}}},
+		}
+		result, err := collection.DeleteMany(ctx, filter)
+		if err != nil {
+			return pruned, errors.Wrap(err, "Error while pruning the revisions of the Mongo database")
+		}
+		pruned += result.DeletedCount
+	}
+	return pruned, nil
+}
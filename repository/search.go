@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ShowSearchType      = "show"
+	CelebritySearchType = "celebrity"
+	EpisodeSearchType   = "episode"
+	ArticleSearchType   = "article"
+)
+
+const searchIndexName = "search"
+
+type SearchRepository interface {
+	Search(ctx context.Context, query string, types []string, page dto.PageDTO) (dto.SearchHitsDTO, int64, error)
+}
+
+// searchSource describes the searchable fields of a collection. Matches in
+// the title weigh more than matches in the text.
+type searchSource struct {
+	Type        string
+	Collection  string
+	TitleField  string
+	TextField   string
+	TitleWeight int
+	TextWeight  int
+}
+
+var searchSources = []searchSource{
+	{Type: ShowSearchType, Collection: "Shows", TitleField: "title", TextField: "description", TitleWeight: 10, TextWeight: 1},
+	{Type: CelebritySearchType, Collection: "Celebrities", TitleField: "name", TextField: "bio", TitleWeight: 10, TextWeight: 1},
+	{Type: EpisodeSearchType, Collection: "Episodes", TitleField: "title", TextField: "resume", TitleWeight: 10, TextWeight: 1},
+	{Type: ArticleSearchType, Collection: "Articles", TitleField: "title", TextField: "description", TitleWeight: 10, TextWeight: 1},
+}
+
+func searchSourcesOf(types []string) []searchSource {
+	if len(types) == 0 {
+		return searchSources
+	}
+	sources := []searchSource{}
+	for _, source := range searchSources {
+		for _, searchType := range types {
+			if source.Type == searchType {
+				sources = append(sources, source)
+				break
+			}
+		}
+	}
+	return sources
+}
+
+// sortSearchHits orders hits by descending score, then by type and id so
+// pages stay stable between calls.
+func sortSearchHits(hits dto.SearchHitsDTO) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Type != hits[j].Type {
+			return hits[i].Type < hits[j].Type
+		}
+		return hits[i].ID < hits[j].ID
+	})
+}
+
+// CreateTextIndexes creates the text indexes the Mongo Search relies on in
+// the catalog of a tenant. Creating an index that already exists with the
+// same options is a no-op.
+func CreateTextIndexes(ctx context.Context, client *mongo.Client, names MongoNames, tenantID string) error {
+	for _, source := range searchSources {
+		collection := client.Database(names.TenantDatabase(tenantID)).Collection(names.Collection(source.Collection))
+		index := mongo.IndexModel{
+			Keys: bson.D{
+				bson.E{Key: source.TitleField, Value: "text"},
+				bson.E{Key: source.TextField, Value: "text"},
+			},
+			Options: options.Index().SetName(searchIndexName).SetWeights(bson.D{
+				bson.E{Key: source.TitleField, Value: source.TitleWeight},
+				bson.E{Key: source.TextField, Value: source.TextWeight},
+			}),
+		}
+		if _, err := collection.Indexes().CreateOne(ctx, index); err != nil {
+			return errors.Wrap(err, "Error while creating the text index of "+source.Collection)
+		}
+	}
+	return nil
+}
+
+// Search runs a text query on every requested collection. Each collection
+// returns its best Offset+Limit hits, which are merged by score before the
+// page is cut out of them.
+func (m *MongoDatabase) Search(ctx context.Context, query string, types []string, page dto.PageDTO) (dto.SearchHitsDTO, int64, error) {
+	filter := bson.D{bson.E{Key: "$text", Value: bson.D{bson.E{Key: "$search", Value: query}}}, notDeleted}
+	score := bson.D{bson.E{Key: "$meta", Value: "textScore"}}
+
+	hits := dto.SearchHitsDTO{}
+	var total int64
+	for _, source := range searchSourcesOf(types) {
+		collection := m.collection(ctx, source.Collection)
+
+		count, err := collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "Error while counting "+source.Type+" search hits in the Mongo database")
+		}
+		total += count
+
+		opts := options.Find().
+			SetProjection(bson.D{
+				bson.E{Key: "id", Value: 1},
+				bson.E{Key: source.TitleField, Value: 1},
+				bson.E{Key: source.TextField, Value: 1},
+				bson.E{Key: "score", Value: score},
+			}).
+			SetSort(bson.D{bson.E{Key: "score", Value: score}})
+		if page.Limit > 0 {
+			opts.SetLimit(page.Offset + page.Limit)
+		}
+		cursor, err := collection.Find(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "Error while searching "+source.Collection+" in the Mongo database")
+		}
+		for cursor.Next(ctx) {
+			document := bson.M{}
+			if err := cursor.Decode(&document); err != nil {
+				return nil, 0, errors.Wrap(err, "Error while decoding search hit")
+			}
+			hit := &dto.SearchHitDTO{Type: source.Type}
+			hit.ID, _ = document["id"].(string)
+			hit.Title, _ = document[source.TitleField].(string)
+			hit.Text, _ = document[source.TextField].(string)
+			hit.Score, _ = document["score"].(float64)
+			hits = append(hits, hit)
+		}
+		if err := cursor.Err(); err != nil {
+			return nil, 0, errors.Wrap(err, "Error with the cursor")
+		}
+		cursor.Close(ctx)
+	}
+
+	sortSearchHits(hits)
+	start, end := pageBounds(len(hits), page)
+	return hits[start:end], total, nil
+}
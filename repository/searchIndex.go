@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// SearchTerms splits text into the lower case words the search indexes on.
+func SearchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+type posting struct {
+	document int
+	weight   float64
+}
+
+// invertedIndex maps every term of the catalog to the documents containing
+// it. Scores add the field weight of each occurrence, scaled by how rare the
+// term is, so they rank like the Mongo text score without being equal to it.
+type invertedIndex struct {
+	documents []*dto.SearchHitDTO
+	postings  map[string][]posting
+}
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{
+		postings: map[string][]posting{},
+	}
+}
+
+func (idx *invertedIndex) add(source searchSource, ID string, title string, text string) {
+	document := len(idx.documents)
+	idx.documents = append(idx.documents, &dto.SearchHitDTO{
+		Type:  source.Type,
+		ID:    ID,
+		Title: title,
+		Text:  text,
+	})
+
+	weights := map[string]float64{}
+	for _, term := range SearchTerms(title) {
+		weights[term] += float64(source.TitleWeight)
+	}
+	for _, term := range SearchTerms(text) {
+		weights[term] += float64(source.TextWeight)
+	}
+	for term, weight := range weights {
+		idx.postings[term] = append(idx.postings[term], posting{document: document, weight: weight})
+	}
+}
+
+func (idx *invertedIndex) search(query string, types []string) dto.SearchHitsDTO {
+	allowed := map[string]bool{}
+	for _, source := range searchSourcesOf(types) {
+		allowed[source.Type] = true
+	}
+
+	scores := map[int]float64{}
+	seen := map[string]bool{}
+	for _, term := range SearchTerms(query) {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		postings := idx.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		rarity := math.Log(1 + float64(len(idx.documents))/float64(len(postings)))
+		for _, p := range postings {
+			if allowed[idx.documents[p.document].Type] {
+				scores[p.document] += p.weight * rarity
+			}
+		}
+	}
+
+	hits := dto.SearchHitsDTO{}
+	for document, score := range scores {
+		hit := *idx.documents[document]
+		hit.Score = score
+		hits = append(hits, &hit)
+	}
+	sortSearchHits(hits)
+	return hits
+}
+
+func buildInvertedIndex(c *catalog) *invertedIndex {
+	idx := newInvertedIndex()
+	for _, source := range searchSources {
+		switch source.Type {
+		case ShowSearchType:
+			for _, show := range c.Shows {
+				if show.DeletedAt == nil {
+					idx.add(source, show.ID, show.Title, show.Description)
+				}
+			}
+		case CelebritySearchType:
+			for _, celebrity := range c.Celebrities {
+				if celebrity.DeletedAt == nil {
+					idx.add(source, celebrity.ID, celebrity.Name, celebrity.Bio)
+				}
+			}
+		case EpisodeSearchType:
+			for _, episode := range c.Episodes {
+				if episode.DeletedAt == nil {
+					idx.add(source, episode.ID, episode.Title, episode.Resume)
+				}
+			}
+		case ArticleSearchType:
+			for _, article := range c.Articles {
+				if article.DeletedAt == nil {
+					idx.add(source, article.ID, article.Title, article.Description)
+				}
+			}
+		}
+	}
+	return idx
+}
+
+// catalogSearch caches the inverted index of a CatalogDatabase. Every update
+// marks it stale and the next search rebuilds it from the catalog.
+type catalogSearch struct {
+	mu    sync.Mutex
+	index *invertedIndex
+	stale bool
+}
+
+func (s *catalogSearch) invalidate() {
+	s.mu.Lock()
+	s.stale = true
+	s.mu.Unlock()
+}
+
+func (m *CatalogDatabase) Search(ctx context.Context, query string, types []string, page dto.PageDTO) (dto.SearchHitsDTO, int64, error) {
+	m.search.mu.Lock()
+	defer m.search.mu.Unlock()
+
+	if m.search.index == nil || m.search.stale {
+		m.search.stale = false
+		err := m.view(ctx, func(c *catalog) error {
+			m.search.index = buildInvertedIndex(c)
+			return nil
+		})
+		if err != nil {
+			m.search.index = nil
+			return nil, 0, errors.Wrap(err, "Error while indexing the catalog database")
+		}
+	}
+
+	hits := m.search.index.search(query, types)
+	start, end := pageBounds(len(hits), page)
+	return hits[start:end], int64(len(hits)), nil
+}
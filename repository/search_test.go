@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{}},
+		{"  ,;!  ", []string{}},
+		{"Dark", []string{"dark"}},
+		{"The DARK past, of Winden!", []string{"the", "dark", "past", "of", "winden"}},
+		{"S01E02: time-travel", []string{"s01e02", "time", "travel"}},
+		{"Café Müller", []string{"café", "müller"}},
+		{"don't", []string{"don", "t"}},
+	}
+	for _, tt := range tests {
+		if got := SearchTerms(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTerms(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+// addSearchCatalog writes a document of every searchable type, and an article
+// deleted right away.
+func addSearchCatalog(t *testing.T, repo ProjectRepository) {
+	t.Helper()
+	ctx := context.Background()
+	writes := []error{
+		second(repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark", Description: "A German mystery about time travel."})),
+		second(repo.CreateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", SeasonID: "season-1", Title: "Secrets", Resume: "The dark past of Winden comes back."})),
+		second(repo.CreateCelebrity(ctx, &dto.CelebrityDTO{ID: "c1", Name: "Louis Hofmann", Bio: "Actor of Dark and of Center of My World."})),
+		second(repo.CreateArticle(ctx, &dto.ArticleDTO{ID: "a1", Title: "Dark ends after three seasons", Description: "The mystery of Winden is solved."})),
+		second(repo.CreateArticle(ctx, &dto.ArticleDTO{ID: "a2", Title: "Dark is deleted", Description: "Gone."})),
+		repo.DeleteArticle(ctx, "a2"),
+	}
+	for _, err := range writes {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func second(_ interface{}, err error) error {
+	return err
+}
+
+// TestSearchRanking checks the hits of every type come ranked together.
+// Each group of want holds hits ranked above the next group, in any order
+// among themselves, since the backends score equal matches differently.
+func TestSearchRanking(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		types []string
+		want  [][]string
+	}{
+		{name: "title matches first", query: "dark", want: [][]string{{"s1", "a1"}, {"e1", "c1"}}},
+		{name: "case and punctuation", query: "DARK!", want: [][]string{{"s1", "a1"}, {"e1", "c1"}}},
+		{name: "more matched words first", query: "mystery winden", want: [][]string{{"a1"}, {"s1", "e1"}}},
+		{name: "one type", query: "dark", types: []string{ArticleSearchType}, want: [][]string{{"a1"}}},
+		{name: "several types", query: "dark", types: []string{CelebritySearchType, EpisodeSearchType}, want: [][]string{{"e1", "c1"}}},
+		{name: "no match", query: "nothing", want: [][]string{}},
+	}
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			addSearchCatalog(t, repo)
+			for _, tt := range tests {
+				hits, total, err := repo.Search(context.Background(), tt.query, tt.types, dto.PageDTO{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := [][]string{}
+				for _, group := range tt.want {
+					if len(hits) < len(group) {
+						break
+					}
+					IDs := []string{}
+					for _, hit := range hits[:len(group)] {
+						IDs = append(IDs, hit.ID)
+					}
+					hits = hits[len(group):]
+					sort.Strings(IDs)
+					got = append(got, IDs)
+				}
+				for _, hit := range hits {
+					got = append(got, []string{hit.ID})
+				}
+				want := [][]string{}
+				count := 0
+				for _, group := range tt.want {
+					sorted := append([]string{}, group...)
+					sort.Strings(sorted)
+					want = append(want, sorted)
+					count += len(group)
+				}
+				if !reflect.DeepEqual(got, want) || total != int64(count) {
+					t.Errorf("%s: Search(%q, %q) ranked %q of %d, want %q", tt.name, tt.query, tt.types, got, total, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchPages(t *testing.T) {
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			addSearchCatalog(t, repo)
+			all, _, err := repo.Search(context.Background(), "dark", nil, dto.PageDTO{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for offset := range all {
+				page, total, err := repo.Search(context.Background(), "dark", nil, dto.PageDTO{Offset: int64(offset), Limit: 1})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if total != int64(len(all)) || len(page) != 1 || page[0].ID != all[offset].ID {
+					t.Errorf("page at %d holds %d hits of %d, want %s", offset, len(page), total, all[offset].ID)
+				}
+			}
+		})
+	}
+}
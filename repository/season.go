@@ -13,29 +13,35 @@ type SeasonRepository interface {
 	CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (*dto.SeasonDTO, error)
 	AddShortEpisode(ctx context.Context, seasonID string, newEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error)
 	GetSeason(ctx context.Context, seasonID string) (*dto.SeasonDTO, error)
-	UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO) (*dto.SeasonDTO, error)
+	UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (*dto.SeasonDTO, error)
 	UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error)
 	UpdateShortCelebritiesInSeasons(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error)
 	UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (*dto.SeasonDTO, error)
 	DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error
 	DeleteShortCelebritiesPostersInSeason(ctx context.Context, celebrityID string, image string, celebrityType string) error
 	ListShowSeasons(ctx context.Context, ID string) (dto.SeasonsDTO, error)
-	ListSeasonsCollection(ctx context.Context) (dto.SeasonsDTO, error)
+	ListSeasonsCollection(ctx context.Context, filter dto.SeasonFilterDTO, page dto.PageDTO) (dto.SeasonsDTO, int64, error)
+	DeleteSeason(ctx context.Context, ID string) error
+	RemoveShortEpisode(ctx context.Context, episodeID string) error
+	RemoveShortCelebrityInSeasons(ctx context.Context, celebrityID string) error
 }
 
+// SeasonFields are the fields of a season an update can write.
+var SeasonFields = []string{"title", "trailerUrl", "postersPath", "resume", "rating", "releaseDate", "writtenBy", "producedBy", "directedBy", "episodes"}
+
 func (m *MongoDatabase) CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (*dto.SeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
+	collection := m.collection(ctx, "Seasons")
 	newSeason.PostersPath = []string{}
 	_, err := collection.InsertOne(ctx, newSeason)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting new season in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting new season in the Mongo database")
 	}
 	return newSeason, nil
 }
 
 func (m *MongoDatabase) AddShortEpisode(ctx context.Context, seasonID string, newEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "id", Value: seasonID}}
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "id", Value: seasonID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"episodes": bson.M{
 			"id":          newEpisode.ID,
@@ -59,44 +65,39 @@ func (m *MongoDatabase) AddShortEpisode(ctx context.Context, seasonID string, ne
 }
 
 func (m *MongoDatabase) GetSeason(ctx context.Context, ID string) (*dto.SeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	season := dto.SeasonDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&season)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding season by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding season by id from the Mongo database")
 	}
 	return &season, nil
 }
 
-func (m *MongoDatabase) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO) (*dto.SeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "id", Value: updatedSeason.ID}}
-	update := bson.D{
-		bson.E{Key: "$set", Value: bson.D{
-			bson.E{Key: "title", Value: updatedSeason.Title},
-			bson.E{Key: "trailerUrl", Value: updatedSeason.TrailerURL},
-			bson.E{Key: "postersPath", Value: updatedSeason.PostersPath},
-			bson.E{Key: "resume", Value: updatedSeason.Resume},
-			bson.E{Key: "rating", Value: updatedSeason.Rating},
-			bson.E{Key: "releaseDate", Value: updatedSeason.ReleaseDate},
-			bson.E{Key: "writtenBy", Value: updatedSeason.WrittenBy},
-			bson.E{Key: "producedBy", Value: updatedSeason.ProducedBy},
-			bson.E{Key: "directedBy", Value: updatedSeason.DirectedBy},
-			bson.E{Key: "episodes", Value: updatedSeason.Episodes},
-		}},
+func (m *MongoDatabase) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (*dto.SeasonDTO, error) {
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "id", Value: updatedSeason.ID}, notDeleted}
+	update, err := setFields(updatedSeason, fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating season in the Mongo database")
 	}
 
-	_, err := collection.UpdateOne(ctx, filter, update)
+	after := options.After
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+	}
+	season := dto.SeasonDTO{}
+	err = collection.FindOneAndUpdate(ctx, filter, update, &opt).Decode(&season)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating season in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating season in the Mongo database")
 	}
-	return updatedSeason, nil
+	return &season, nil
 }
 
 func (m *MongoDatabase) UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
+	collection := m.collection(ctx, "Seasons")
 	_, err := collection.UpdateOne(
 		ctx,
 		bson.D{bson.E{Key: "episodes.id", Value: updatedEpisode.ID}},
@@ -118,8 +119,8 @@ func (m *MongoDatabase) UpdateShortEpisode(ctx context.Context, updatedEpisode *
 }
 
 func (m *MongoDatabase) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (*dto.SeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "id", Value: seasonID}}
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "id", Value: seasonID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -133,15 +134,15 @@ func (m *MongoDatabase) UploadSeasonPosters(ctx context.Context, seasonID string
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedSeason)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while uploading season posters in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while uploading season posters in the Mongo database")
 	}
 
 	return &updatedSeason, nil
 }
 
 func (m *MongoDatabase) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "id", Value: seasonID}}
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "id", Value: seasonID}, notDeleted}
 	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -154,7 +155,7 @@ func (m *MongoDatabase) DeleteSeasonPoster(ctx context.Context, seriesID string,
 }
 
 func (m *MongoDatabase) DeleteShortCelebritiesPostersInSeason(ctx context.Context, celebrityID string, image string, celebrityType string) error {
-	collection := m.client.Database("Project").Collection("Seasons")
+	collection := m.collection(ctx, "Seasons")
 	filter := bson.D{bson.E{Key: celebrityType + ".id", Value: celebrityID}}
 	posterPath := "/celebrities/" + celebrityID + "/" + image
 	update := bson.M{"$pull": bson.M{celebrityType + ".$.postersPath": posterPath}}
@@ -167,8 +168,8 @@ func (m *MongoDatabase) DeleteShortCelebritiesPostersInSeason(ctx context.Contex
 }
 
 func (m *MongoDatabase) ListShowSeasons(ctx context.Context, showID string) (dto.SeasonsDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
-	filter := bson.D{bson.E{Key: "showId", Value: showID}}
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "showId", Value: showID}, notDeleted}
 	seasons := dto.SeasonsDTO{}
 
 	cursor, err := collection.Find(ctx, filter)
@@ -191,33 +192,43 @@ func (m *MongoDatabase) ListShowSeasons(ctx context.Context, showID string) (dto
 	return seasons, nil
 }
 
-func (m *MongoDatabase) ListSeasonsCollection(ctx context.Context) (dto.SeasonsDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
+func (m *MongoDatabase) ListSeasonsCollection(ctx context.Context, filter dto.SeasonFilterDTO, page dto.PageDTO) (dto.SeasonsDTO, int64, error) {
+	collection := m.collection(ctx, "Seasons")
 	seasons := dto.SeasonsDTO{}
+	query := bson.D{notDeleted}
+	if filter.ShowID != "" {
+		query = append(query, bson.E{Key: "showId", Value: filter.ShowID})
+	}
+	query = appendDateRange(query, "releaseDate", filter.ReleasedAfter, filter.ReleasedBefore)
+	query = appendMinRating(query, filter.MinRating)
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting seasons in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all show seasons from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while finding all show seasons from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		season := dto.SeasonDTO{}
 		err = cursor.Decode(&season)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding showSeason")
+			return nil, 0, errors.Wrap(err, "Error while decoding showSeason")
 		}
 		seasons = append(seasons, &season)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return seasons, nil
+	return seasons, total, nil
 }
 
 func (m *MongoDatabase) UpdateShortCelebritiesInSeasons(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Seasons")
+	collection := m.collection(ctx, "Seasons")
 	_, err := collection.UpdateMany(
 		ctx,
 		bson.D{bson.E{Key: celebrityType + ".id", Value: updatedCelebrity.ID}},
@@ -234,3 +245,27 @@ func (m *MongoDatabase) UpdateShortCelebritiesInSeasons(ctx context.Context, upd
 	}
 	return updatedCelebrity, nil
 }
+
+func (m *MongoDatabase) DeleteSeason(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Seasons", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting season from the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RemoveShortEpisode(ctx context.Context, episodeID string) error {
+	collection := m.collection(ctx, "Seasons")
+	filter := bson.D{bson.E{Key: "episodes.id", Value: episodeID}}
+	update := bson.M{"$pull": bson.M{"episodes": bson.M{"id": episodeID}}}
+
+	_, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short episode from seasons in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RemoveShortCelebrityInSeasons(ctx context.Context, celebrityID string) error {
+	collection := m.collection(ctx, "Seasons")
+	return pullShortCelebrity(ctx, collection, celebrityID, []string{"directedBy", "writtenBy", "producedBy"})
+}
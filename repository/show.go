@@ -14,31 +14,38 @@ type ShowRepository interface {
 	CreateShow(ctx context.Context, newShow *dto.ShowDTO) (*dto.ShowDTO, error)
 	AddShortSeason(ctx context.Context, showID string, season *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error)
 	GetShow(ctx context.Context, ID string) (*dto.ShowDTO, error)
-	UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO) (*dto.ShowDTO, error)
+	UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (*dto.ShowDTO, error)
 	UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error)
 	UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error)
-	ListShows(ctx context.Context) (dto.ShowsDTO, error)
+	ListShows(ctx context.Context, filter dto.ShowFilterDTO, page dto.PageDTO) (dto.ShowsDTO, int64, error)
 	UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error)
 	DeleteSeriesPoster(ctx context.Context, ID string, image string) error
 	UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error)
 	DeleteMoviePoster(ctx context.Context, ID string, image string) error
 	DeleteShortCelebritiesPostersInShow(ctx context.Context, ID string, image string, celebrityType string) error
 	DeleteShortSeasonPostersInShow(ctx context.Context, seriesID string, seasonID string, image string) error
+	DeleteShow(ctx context.Context, ID string) error
+	RemoveShortSeason(ctx context.Context, seasonID string) error
+	RemoveShortCelebrityInShows(ctx context.Context, celebrityID string) error
+	RemoveShortGenre(ctx context.Context, genreID string) error
 }
 
+// ShowFields are the fields of a show an update can write.
+var ShowFields = []string{"title", "type", "postersPath", "releaseDate", "endDate", "rating", "length", "trailerUrl", "genres", "directedBy", "producedBy", "writtenBy", "starring", "description", "seasons"}
+
 func (m *MongoDatabase) CreateShow(ctx context.Context, newShow *dto.ShowDTO) (*dto.ShowDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
+	collection := m.collection(ctx, "Shows")
 	newShow.PostersPath = []string{}
 	_, err := collection.InsertOne(ctx, newShow)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while inserting the new show in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while inserting the new show in the Mongo database")
 	}
 	return newShow, nil
 }
 
 func (m *MongoDatabase) AddShortSeason(ctx context.Context, showID string, newSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: showID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: showID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"seasons": bson.M{
 			"id":          newSeason.ID,
@@ -54,49 +61,39 @@ func (m *MongoDatabase) AddShortSeason(ctx context.Context, showID string, newSe
 }
 
 func (m *MongoDatabase) GetShow(ctx context.Context, ID string) (*dto.ShowDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	show := dto.ShowDTO{}
 
 	err := collection.FindOne(ctx, filter).Decode(&show)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding show by id from the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while finding show by id from the Mongo database")
 	}
 	return &show, nil
 }
 
-func (m *MongoDatabase) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO) (*dto.ShowDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: updatedShow.ID}}
-	update := bson.D{
-		bson.E{Key: "$set", Value: bson.D{
-			bson.E{Key: "title", Value: updatedShow.Title},
-			bson.E{Key: "releaseDate", Value: updatedShow.ReleaseDate},
-			bson.E{Key: "type", Value: updatedShow.Type},
-			bson.E{Key: "description", Value: updatedShow.Description},
-			bson.E{Key: "endDate", Value: updatedShow.EndDate},
-			bson.E{Key: "genres", Value: updatedShow.Genres},
-			bson.E{Key: "postersPath", Value: updatedShow.PostersPath},
-			bson.E{Key: "trailerUrl", Value: updatedShow.TrailerURL},
-			bson.E{Key: "rating", Value: updatedShow.Rating},
-			bson.E{Key: "length", Value: updatedShow.Length},
-			bson.E{Key: "directedBy", Value: updatedShow.DirectedBy},
-			bson.E{Key: "writtenBy", Value: updatedShow.WrittenBy},
-			bson.E{Key: "producedBy", Value: updatedShow.ProducedBy},
-			bson.E{Key: "starring", Value: updatedShow.Starring},
-			bson.E{Key: "seasons", Value: updatedShow.Seasons},
-		}},
+func (m *MongoDatabase) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (*dto.ShowDTO, error) {
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: updatedShow.ID}, notDeleted}
+	update, err := setFields(updatedShow, fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating show in the Mongo database")
 	}
 
-	_, err := collection.UpdateOne(ctx, filter, update)
+	after := options.After
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+	}
+	show := dto.ShowDTO{}
+	err = collection.FindOneAndUpdate(ctx, filter, update, &opt).Decode(&show)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating show in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating show in the Mongo database")
 	}
-	return updatedShow, nil
+	return &show, nil
 }
 
 func (m *MongoDatabase) UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
+	collection := m.collection(ctx, "Shows")
 	condition := bson.D{bson.E{Key: "seasons.id", Value: updatedSeason.ID}}
 	update := bson.D{
 		bson.E{Key: "$set", Value: bson.D{
@@ -113,7 +110,7 @@ func (m *MongoDatabase) UpdateShortSeason(ctx context.Context, updatedSeason *dt
 }
 
 func (m *MongoDatabase) UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
+	collection := m.collection(ctx, "Shows")
 	condition := bson.D{bson.E{Key: celebrityType + ".id", Value: updatedCelebrity.ID}}
 	update := bson.D{
 		{Key: "$set", Value: bson.D{
@@ -129,34 +126,47 @@ func (m *MongoDatabase) UpdateShortCelebritiesInShow(ctx context.Context, update
 	return updatedCelebrity, nil
 }
 
-func (m *MongoDatabase) ListShows(ctx context.Context) (dto.ShowsDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
+func (m *MongoDatabase) ListShows(ctx context.Context, filter dto.ShowFilterDTO, page dto.PageDTO) (dto.ShowsDTO, int64, error) {
+	collection := m.collection(ctx, "Shows")
 	shows := dto.ShowsDTO{}
+	query := bson.D{notDeleted}
+	if filter.Type != "" {
+		query = append(query, bson.E{Key: "type", Value: filter.Type})
+	}
+	if filter.GenreID != "" {
+		query = append(query, bson.E{Key: "genres.id", Value: filter.GenreID})
+	}
+	query = appendDateRange(query, "releaseDate", filter.ReleasedAfter, filter.ReleasedBefore)
+	query = appendMinRating(query, filter.MinRating)
 
-	cursor, err := collection.Find(ctx, bson.D{{}})
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting shows in the Mongo database")
+	}
+	cursor, err := collection.Find(ctx, query, findOptions(page))
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while finding all shows from the Mongo database")
+		return nil, 0, errors.Wrap(err, "Error while finding all shows from the Mongo database")
 	}
 
 	for cursor.Next(ctx) {
 		show := dto.ShowDTO{}
 		err = cursor.Decode(&show)
 		if err != nil {
-			return nil, errors.Wrap(err, "Error while decoding show")
+			return nil, 0, errors.Wrap(err, "Error while decoding show")
 		}
 		shows = append(shows, &show)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, errors.Wrap(err, "Error with the cursor")
+		return nil, 0, errors.Wrap(err, "Error with the cursor")
 	}
 	cursor.Close(ctx)
 
-	return shows, nil
+	return shows, total, nil
 }
 
 func (m *MongoDatabase) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -170,15 +180,15 @@ func (m *MongoDatabase) UploadSeriesPosters(ctx context.Context, ID string, post
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedSeries)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating series in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating series in the Mongo database")
 	}
 
 	return &updatedSeries, nil
 }
 
 func (m *MongoDatabase) DeleteSeriesPoster(ctx context.Context, ID string, image string) error {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	posterPath := "/series/" + ID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -191,8 +201,8 @@ func (m *MongoDatabase) DeleteSeriesPoster(ctx context.Context, ID string, image
 }
 
 func (m *MongoDatabase) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	update := bson.M{"$push": bson.M{
 		"postersPath": bson.M{
 			"$each": postersPath}}}
@@ -206,15 +216,15 @@ func (m *MongoDatabase) UploadMoviePosters(ctx context.Context, ID string, poste
 	resp := collection.FindOneAndUpdate(ctx, filter, update, &opt)
 	err := resp.Decode(&updatedMovie)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while updating movie in the Mongo database")
+		return nil, errors.Wrap(mongoError(err), "Error while updating movie in the Mongo database")
 	}
 
 	return &updatedMovie, nil
 }
 
 func (m *MongoDatabase) DeleteMoviePoster(ctx context.Context, ID string, image string) error {
-	collection := m.client.Database("Project").Collection("Shows")
-	filter := bson.D{bson.E{Key: "id", Value: ID}}
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
 	posterPath := "/movie/" + ID + "/" + image
 	update := bson.M{"$pull": bson.M{"postersPath": posterPath}}
 
@@ -227,7 +237,7 @@ func (m *MongoDatabase) DeleteMoviePoster(ctx context.Context, ID string, image
 }
 
 func (m *MongoDatabase) DeleteShortCelebritiesPostersInShow(ctx context.Context, celebrityID string, image string, celebrityType string) error {
-	collection := m.client.Database("Project").Collection("Shows")
+	collection := m.collection(ctx, "Shows")
 	filter := bson.D{bson.E{Key: celebrityType + ".id", Value: celebrityID}}
 	posterPath := "/celebrities/" + celebrityID + "/" + image
 	update := bson.M{"$pull": bson.M{celebrityType + ".$.postersPath": posterPath}}
@@ -240,7 +250,7 @@ func (m *MongoDatabase) DeleteShortCelebritiesPostersInShow(ctx context.Context,
 }
 
 func (m *MongoDatabase) DeleteShortSeasonPostersInShow(ctx context.Context, seriesID string, seasonID string, image string) error {
-	collection := m.client.Database("Project").Collection("Shows")
+	collection := m.collection(ctx, "Shows")
 	filter := bson.D{bson.E{Key: "seasons.id", Value: seasonID}}
 	posterPath := "/series/" + seriesID + "/" + seasonID + "/" + image
 	update := bson.M{"$pull": bson.M{"seasons.$.postersPath": posterPath}}
@@ -251,3 +261,39 @@ func (m *MongoDatabase) DeleteShortSeasonPostersInShow(ctx context.Context, seri
 	}
 	return nil
 }
+
+func (m *MongoDatabase) DeleteShow(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "Shows", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting show from the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RemoveShortSeason(ctx context.Context, seasonID string) error {
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "seasons.id", Value: seasonID}}
+	update := bson.M{"$pull": bson.M{"seasons": bson.M{"id": seasonID}}}
+
+	_, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short season from shows in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RemoveShortCelebrityInShows(ctx context.Context, celebrityID string) error {
+	collection := m.collection(ctx, "Shows")
+	return pullShortCelebrity(ctx, collection, celebrityID, []string{"starring", "directedBy", "writtenBy", "producedBy"})
+}
+
+func (m *MongoDatabase) RemoveShortGenre(ctx context.Context, genreID string) error {
+	collection := m.collection(ctx, "Shows")
+	filter := bson.D{bson.E{Key: "genres.id", Value: genreID}}
+	update := bson.M{"$pull": bson.M{"genres": bson.M{"id": genreID}}}
+
+	_, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short genre from shows in the Mongo database")
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"int-service/dto"
+	"int-service/models"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// sqlChangesPollInterval is how often a caught up stream looks for new
+	// rows in the changes table.
+	sqlChangesPollInterval = 500 * time.Millisecond
+	sqlChangesBatchSize    = 100
+)
+
+// sqlChange is a row of the changes table, which the triggers of the
+// migrations fill in the transaction of every write.
+type sqlChange struct {
+	Sequence   int64
+	EntityType string
+	EntityID   string
+	Operation  string
+	Time       time.Time
+}
+
+// follows reports whether c only repeats previous, as a write of several
+// rows of one document does.
+func (c *sqlChange) follows(previous *sqlChange) bool {
+	return previous != nil && c.Operation == OperationUpdated && previous.Operation != OperationDeleted &&
+		c.EntityType == previous.EntityType && c.EntityID == previous.EntityID
+}
+
+// changesStart returns the sequence number of the first change of a stream.
+// The sequence numbers are stored with the changes, so tokens stay valid
+// across restarts while their change is in the table.
+func (m *SQLDatabase) changesStart(ctx context.Context, resumeToken string) (int64, error) {
+	var oldest, latest sql.NullInt64
+	err := m.db.QueryRowContext(ctx, "SELECT MIN(seq), MAX(seq) FROM changes").Scan(&oldest, &latest)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while reading the change history of the SQL database")
+	}
+	if resumeToken == "" {
+		return latest.Int64 + 1, nil
+	}
+	sequence, err := strconv.ParseInt(resumeToken, 10, 64)
+	if err != nil || sequence < 0 || sequence > latest.Int64 {
+		return 0, models.NewFieldError("resumeToken", "is not a token of this database")
+	}
+	if sequence < oldest.Int64-1 {
+		return 0, models.NewFieldError("resumeToken", "is older than the change history")
+	}
+	return sequence + 1, nil
+}
+
+// changesSince returns the changes from the sequence number next on.
+func (m *SQLDatabase) changesSince(ctx context.Context, next int64) ([]*sqlChange, error) {
+	var oldest sql.NullInt64
+	if err := m.db.QueryRowContext(ctx, "SELECT MIN(seq) FROM changes").Scan(&oldest); err != nil {
+		return nil, errors.Wrap(err, "Error while reading the change history of the SQL database")
+	}
+	if oldest.Valid && next < oldest.Int64 {
+		return nil, errors.Wrap(models.ErrResourceExhausted, "The change stream fell behind the change history")
+	}
+	changes := []*sqlChange{}
+	query := "SELECT seq, entity_type, entity_id, operation, changed_at FROM changes WHERE seq >= ? ORDER BY seq LIMIT ?"
+	err := m.query(ctx, query, []interface{}{next, sqlChangesBatchSize}, func(rows *sql.Rows) error {
+		change := &sqlChange{}
+		err := rows.Scan(&change.Sequence, &change.EntityType, &change.EntityID, &change.Operation, sqlTimeScanner{&change.Time})
+		changes = append(changes, change)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the changes of the SQL database")
+	}
+	return changes, nil
+}
+
+// changedDocument reads the current document of a change.
+func (m *SQLDatabase) changedDocument(ctx context.Context, entityType string, ID string) (interface{}, error) {
+	switch entityType {
+	case ShowEntity:
+		return m.getShow(ctx, ID)
+	case SeasonEntity:
+		return m.getSeason(ctx, ID)
+	case EpisodeEntity:
+		return m.getEpisode(ctx, ID)
+	case CelebrityEntity:
+		return m.getCelebrity(ctx, ID)
+	case ArticleEntity:
+		return m.getArticle(ctx, ID)
+	case GenreEntity:
+		return m.findGenre(ctx, " WHERE id = ? AND deleted_at IS NULL", ID)
+	case JournalistEntity:
+		return m.findJournalist(ctx, " WHERE id = ? AND deleted_at IS NULL", ID)
+	}
+	return nil, models.ErrNotFound
+}
+
+// WatchChanges polls the changes table. A write touching several rows of a
+// document logs a change for each, which the stream reports once, and the
+// documents are read when their change is, so an update followed by a
+// deletion is only reported as the deletion.
+func (m *SQLDatabase) WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *dto.ChangeEventDTO) error) error {
+	next, err := m.changesStart(ctx, resumeToken)
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(sqlChangesPollInterval)
+	defer ticker.Stop()
+
+	var previous *sqlChange
+	for {
+		changes, err := m.changesSince(ctx, next)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		for _, change := range changes {
+			next = change.Sequence + 1
+			repeated := change.follows(previous)
+			previous = change
+			if repeated || !watchesEntity(entityTypes, change.EntityType) {
+				continue
+			}
+			event := &dto.ChangeEventDTO{
+				EntityType:  change.EntityType,
+				Operation:   change.Operation,
+				ID:          change.EntityID,
+				ResumeToken: strconv.FormatInt(change.Sequence, 10),
+				Time:        change.Time,
+			}
+			if change.Operation != OperationDeleted {
+				event.Document, err = m.changedDocument(ctx, change.EntityType, change.EntityID)
+				if errors.Is(err, models.ErrNotFound) {
+					continue
+				}
+				if err != nil {
+					return errors.Wrap(err, "Error while reading a changed document")
+				}
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if len(changes) == sqlChangesBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,2235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"int-service/dto"
+	"int-service/models"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// SQLDatabase stores the catalog in SQLite, in the normalized schema of
+// migrations/sqlite. The lists of a document live in child tables, and the
+// short documents it embeds, such as the seasons of a show or the names and
+// posters of its celebrities, are joined from their own tables on read, so
+// the methods keeping those copies up to date in Mongo have nothing to do.
+type SQLDatabase struct {
+	db *sql.DB
+}
+
+func NewSQLDB(db *sql.DB) ProjectRepository {
+	return &SQLDatabase{
+		db: db,
+	}
+}
+
+// sqlTimeLayout sorts like the times it formats, so dates compare as text.
+// Times are kept to the millisecond like in Mongo.
+const sqlTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// sqlChunkSize bounds the ids of an IN list, under the SQLite variable limit.
+const sqlChunkSize = 500
+
+// Kinds of the credits of a show, season or episode, named after their list.
+const (
+	creditDirectedBy = "directedBy"
+	creditProducedBy = "producedBy"
+	creditWrittenBy  = "writtenBy"
+	creditStarring   = "starring"
+)
+
+func sqlTime(t time.Time) string {
+	return t.UTC().Truncate(time.Millisecond).Format(sqlTimeLayout)
+}
+
+// sqlTimeScanner scans a time stored by sqlTime.
+type sqlTimeScanner struct {
+	t *time.Time
+}
+
+func (s sqlTimeScanner) Scan(value interface{}) error {
+	text, ok := value.(string)
+	if !ok {
+		return errors.Errorf("Error while reading a time stored as %T", value)
+	}
+	t, err := time.Parse(sqlTimeLayout, text)
+	if err != nil {
+		return errors.Wrap(err, "Error while reading a time")
+	}
+	*s.t = t
+	return nil
+}
+
+// sqlError translates the driver errors callers need to tell apart into the
+// domain errors of the models package.
+func sqlError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrNotFound
+	}
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && (sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY || sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE) {
+		return models.ErrAlreadyExists
+	}
+	return err
+}
+
+type sqlQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type sqlScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// querier runs the statements of ctx in its transaction, if any. Every
+// statement goes through it: a write on another connection than the one of
+// a running transaction would wait for it forever.
+func (m *SQLDatabase) querier(ctx context.Context) sqlQuerier {
+	if tx := m.transaction(ctx); tx != nil {
+		return tx
+	}
+	return m.db
+}
+
+// query calls scan on every row of a query. The rows are closed before it
+// returns, so the caller can run the next statement on the same transaction.
+func (m *SQLDatabase) query(ctx context.Context, query string, args []interface{}, scan func(rows *sql.Rows) error) error {
+	rows, err := m.querier(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// queryIn runs query, whose %s is the placeholder of an IN list, for the
+// given ids in chunks of sqlChunkSize.
+func (m *SQLDatabase) queryIn(ctx context.Context, query string, ids []string, scan func(rows *sql.Rows) error) error {
+	for start := 0; start < len(ids); start += sqlChunkSize {
+		end := start + sqlChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		placeholders, args := sqlIn(ids[start:end])
+		if err := m.query(ctx, fmt.Sprintf(query, placeholders), args, scan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sqlIn(values []string) (string, []interface{}) {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "), args
+}
+
+func (m *SQLDatabase) count(ctx context.Context, table string, where sqlWhere) (int64, error) {
+	var total int64
+	err := m.querier(ctx).QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table+where.String(), where.args...).Scan(&total)
+	return total, err
+}
+
+// exists returns models.ErrNotFound when table has no row of the id.
+func (m *SQLDatabase) exists(ctx context.Context, table string, ID string) error {
+	var found int
+	err := m.querier(ctx).QueryRowContext(ctx, "SELECT 1 FROM "+table+" WHERE id = ?", ID).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrNotFound
+	}
+	return err
+}
+
+// existsNotDeleted returns models.ErrNotFound when table has no row of the id
+// that is not marked deleted.
+func (m *SQLDatabase) existsNotDeleted(ctx context.Context, table string, ID string) error {
+	var found int
+	err := m.querier(ctx).QueryRowContext(ctx, "SELECT 1 FROM "+table+" WHERE id = ? AND deleted_at IS NULL", ID).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrNotFound
+	}
+	return err
+}
+
+func (m *SQLDatabase) insert(ctx context.Context, table string, values map[string]interface{}) error {
+	columns := []string{}
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	args := []interface{}{}
+	for _, column := range columns {
+		args = append(args, values[column])
+	}
+	query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES (" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+	_, err := m.querier(ctx).ExecContext(ctx, query, args...)
+	return err
+}
+
+// updateColumns writes the columns of the given fields, as mapped by
+// fieldColumns, from values. Fields without columns are left to the caller.
+func (m *SQLDatabase) updateColumns(ctx context.Context, table string, ID string, fields []string, fieldColumns map[string][]string, values map[string]interface{}) error {
+	set := []string{}
+	args := []interface{}{}
+	for _, field := range fields {
+		for _, column := range fieldColumns[field] {
+			set = append(set, column+" = ?")
+			args = append(args, values[column])
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, ID)
+	_, err := m.querier(ctx).ExecContext(ctx, "UPDATE "+table+" SET "+strings.Join(set, ", ")+" WHERE id = ?", args...)
+	return err
+}
+
+// deleteRow returns models.ErrNotFound when table has no row of the id.
+func (m *SQLDatabase) deleteRow(ctx context.Context, table string, ID string) error {
+	result, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table+" WHERE id = ?", ID)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// markDeleted marks the row of the id deleted, and returns models.ErrNotFound
+// when table has no row of the id that is not marked already.
+func (m *SQLDatabase) markDeleted(ctx context.Context, table string, ID string) error {
+	result, err := m.querier(ctx).ExecContext(ctx, "UPDATE "+table+" SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", sqlTime(time.Now()), ID)
+	if err != nil {
+		return err
+	}
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if marked == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// sqlWhere collects the conditions of a list filter.
+type sqlWhere struct {
+	conditions []string
+	args       []interface{}
+}
+
+func (w *sqlWhere) add(condition string, args ...interface{}) {
+	w.conditions = append(w.conditions, condition)
+	w.args = append(w.args, args...)
+}
+
+func (w *sqlWhere) addDateRange(column string, after time.Time, before time.Time) {
+	if !after.IsZero() {
+		w.add(column+" >= ?", sqlTime(after))
+	}
+	if !before.IsZero() {
+		w.add(column+" < ?", sqlTime(before))
+	}
+}
+
+func (w *sqlWhere) addMinRating(minRating float64) {
+	if minRating > 0 {
+		w.add("rating >= ?", minRating)
+	}
+}
+
+func (w sqlWhere) String() string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conditions, " AND ")
+}
+
+// sqlPage orders and cuts a list like findOptions, the id breaking ties, and
+// keeps the insertion order when the page has no sort field.
+func sqlPage(page dto.PageDTO, sortColumns map[string]string) (string, []interface{}, error) {
+	order := " ORDER BY rowid"
+	if page.SortBy != "" {
+		column, ok := sortColumns[page.SortBy]
+		if !ok {
+			return "", nil, errors.Errorf("Error while sorting by %s, which is not a sort field", page.SortBy)
+		}
+		direction := " ASC"
+		if page.Descending {
+			direction = " DESC"
+		}
+		order = " ORDER BY " + column + direction + ", id" + direction
+	}
+	limit := page.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	return order + " LIMIT ? OFFSET ?", []interface{}{limit, page.Offset}, nil
+}
+
+//------LISTS------
+
+// sqlChildTable is a table holding a list of the rows of another table.
+type sqlChildTable struct {
+	Name  string
+	Owner string
+}
+
+var (
+	showPostersTable          = sqlChildTable{Name: "show_posters", Owner: "show_id"}
+	showGenresTable           = sqlChildTable{Name: "show_genres", Owner: "show_id"}
+	showCreditsTable          = sqlChildTable{Name: "show_credits", Owner: "show_id"}
+	seasonPostersTable        = sqlChildTable{Name: "season_posters", Owner: "season_id"}
+	seasonCreditsTable        = sqlChildTable{Name: "season_credits", Owner: "season_id"}
+	episodePostersTable       = sqlChildTable{Name: "episode_posters", Owner: "episode_id"}
+	episodeCreditsTable       = sqlChildTable{Name: "episode_credits", Owner: "episode_id"}
+	celebrityPostersTable     = sqlChildTable{Name: "celebrity_posters", Owner: "celebrity_id"}
+	celebrityOccupationsTable = sqlChildTable{Name: "celebrity_occupations", Owner: "celebrity_id"}
+	articlePostersTable       = sqlChildTable{Name: "article_posters", Owner: "article_id"}
+)
+
+// loadStrings returns the values of column of a list table by owner.
+func (m *SQLDatabase) loadStrings(ctx context.Context, table sqlChildTable, column string, ids []string) (map[string][]string, error) {
+	values := map[string][]string{}
+	query := "SELECT " + table.Owner + ", " + column + " FROM " + table.Name + " WHERE " + table.Owner + " IN (%s) ORDER BY " + table.Owner + ", position"
+	err := m.queryIn(ctx, query, ids, func(rows *sql.Rows) error {
+		var owner, value string
+		if err := rows.Scan(&owner, &value); err != nil {
+			return err
+		}
+		values[owner] = append(values[owner], value)
+		return nil
+	})
+	return values, err
+}
+
+func (m *SQLDatabase) loadPosters(ctx context.Context, table sqlChildTable, ids []string) (map[string][]string, error) {
+	return m.loadStrings(ctx, table, "path", ids)
+}
+
+// stringsOf never returns nil, like the lists of the documents written by
+// the service.
+func stringsOf(values map[string][]string, ID string) []string {
+	if list, ok := values[ID]; ok {
+		return list
+	}
+	return []string{}
+}
+
+func (m *SQLDatabase) replaceStrings(ctx context.Context, table sqlChildTable, column string, ID string, values []string) error {
+	_, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table.Name+" WHERE "+table.Owner+" = ?", ID)
+	if err != nil {
+		return err
+	}
+	for position, value := range values {
+		query := "INSERT INTO " + table.Name + " (" + table.Owner + ", position, " + column + ") VALUES (?, ?, ?)"
+		if _, err := m.querier(ctx).ExecContext(ctx, query, ID, position, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDatabase) replacePosters(ctx context.Context, table sqlChildTable, ID string, paths []string) error {
+	return m.replaceStrings(ctx, table, "path", ID, paths)
+}
+
+func (m *SQLDatabase) appendPosters(ctx context.Context, table sqlChildTable, ID string, paths []string) error {
+	for _, path := range paths {
+		query := "INSERT INTO " + table.Name + " (" + table.Owner + ", position, path) SELECT ?, COALESCE(MAX(position) + 1, 0), ? FROM " + table.Name + " WHERE " + table.Owner + " = ?"
+		if _, err := m.querier(ctx).ExecContext(ctx, query, ID, path, ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletePoster removes every poster of the path, like a Mongo $pull.
+func (m *SQLDatabase) deletePoster(ctx context.Context, table sqlChildTable, ID string, path string) error {
+	_, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table.Name+" WHERE "+table.Owner+" = ? AND path = ?", ID, path)
+	return err
+}
+
+// sqlCredit is a celebrity credited in a show, season or episode. Name and
+// posters are the current ones of the celebrity, and stay empty when it does
+// not exist.
+type sqlCredit struct {
+	Kind        string
+	ID          string
+	Name        string
+	RoleName    string
+	PostersPath []string
+}
+
+func (m *SQLDatabase) loadCredits(ctx context.Context, table sqlChildTable, ids []string) (map[string][]*sqlCredit, error) {
+	credits := map[string][]*sqlCredit{}
+	celebrityIDs := []string{}
+	query := "SELECT credit." + table.Owner + ", credit.kind, credit.celebrity_id, credit.role_name, COALESCE(celebrities.name, '') FROM " + table.Name + " AS credit " +
+		"LEFT JOIN celebrities ON celebrities.id = credit.celebrity_id WHERE credit." + table.Owner + " IN (%s) ORDER BY credit." + table.Owner + ", credit.kind, credit.position"
+	err := m.queryIn(ctx, query, ids, func(rows *sql.Rows) error {
+		var owner string
+		credit := &sqlCredit{}
+		if err := rows.Scan(&owner, &credit.Kind, &credit.ID, &credit.RoleName, &credit.Name); err != nil {
+			return err
+		}
+		credits[owner] = append(credits[owner], credit)
+		celebrityIDs = append(celebrityIDs, credit.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	posters, err := m.loadPosters(ctx, celebrityPostersTable, celebrityIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, ownerCredits := range credits {
+		for _, credit := range ownerCredits {
+			credit.PostersPath = stringsOf(posters, credit.ID)
+		}
+	}
+	return credits, nil
+}
+
+func filmCrewsOf(credits []*sqlCredit, kind string) dto.FilmCrewsDTO {
+	crews := dto.FilmCrewsDTO{}
+	for _, credit := range credits {
+		if credit.Kind == kind {
+			crews = append(crews, &dto.FilmCrewDTO{ID: credit.ID, Name: credit.Name, PostersPath: credit.PostersPath})
+		}
+	}
+	return crews
+}
+
+func shortCelebritiesOf(credits []*sqlCredit, kind string) dto.ShortCelebritiesDTO {
+	celebrities := dto.ShortCelebritiesDTO{}
+	for _, credit := range credits {
+		if credit.Kind == kind {
+			celebrities = append(celebrities, &dto.ShortCelebrityDTO{ID: credit.ID, Name: credit.Name, RoleName: credit.RoleName, PostersPath: credit.PostersPath})
+		}
+	}
+	return celebrities
+}
+
+// replaceCredits writes the credits of one kind. Only the ids and role names
+// are stored, names and posters are read from the celebrities.
+func (m *SQLDatabase) replaceCredits(ctx context.Context, table sqlChildTable, ID string, kind string, credits []*sqlCredit) error {
+	_, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table.Name+" WHERE "+table.Owner+" = ? AND kind = ?", ID, kind)
+	if err != nil {
+		return err
+	}
+	for position, credit := range credits {
+		query := "INSERT INTO " + table.Name + " (" + table.Owner + ", kind, position, celebrity_id, role_name) VALUES (?, ?, ?, ?, ?)"
+		if _, err := m.querier(ctx).ExecContext(ctx, query, ID, kind, position, credit.ID, credit.RoleName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func filmCrewCredits(crews dto.FilmCrewsDTO) []*sqlCredit {
+	credits := []*sqlCredit{}
+	for _, crew := range crews {
+		if crew != nil {
+			credits = append(credits, &sqlCredit{ID: crew.ID})
+		}
+	}
+	return credits
+}
+
+func shortCelebrityCredits(celebrities dto.ShortCelebritiesDTO) []*sqlCredit {
+	credits := []*sqlCredit{}
+	for _, celebrity := range celebrities {
+		if celebrity != nil {
+			credits = append(credits, &sqlCredit{ID: celebrity.ID, RoleName: celebrity.RoleName})
+		}
+	}
+	return credits
+}
+
+// removeCredits removes every credit of a celebrity from table.
+func (m *SQLDatabase) removeCredits(ctx context.Context, table sqlChildTable, celebrityID string) error {
+	_, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table.Name+" WHERE celebrity_id = ?", celebrityID)
+	return err
+}
+
+//------SHOWS------
+
+const showColumns = "id, title, type, release_date, end_date, rating, length_hours, length_minutes, trailer_url, description"
+
+var showSortColumns = map[string]string{"title": "title", "releaseDate": "release_date", "rating": "rating"}
+
+var showFieldColumns = map[string][]string{
+	"title":       {"title"},
+	"type":        {"type"},
+	"releaseDate": {"release_date"},
+	"endDate":     {"end_date"},
+	"rating":      {"rating"},
+	"length":      {"length_hours", "length_minutes"},
+	"trailerUrl":  {"trailer_url"},
+	"description": {"description"},
+}
+
+func showValues(show *dto.ShowDTO) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             show.ID,
+		"title":          show.Title,
+		"type":           show.Type,
+		"release_date":   sqlTime(show.ReleaseDate),
+		"end_date":       sqlTime(show.EndDate),
+		"rating":         show.Rating,
+		"length_hours":   show.Length.Hours,
+		"length_minutes": show.Length.Minutes,
+		"trailer_url":    show.TrailerURL,
+		"description":    show.Description,
+	}
+}
+
+func (m *SQLDatabase) findShows(ctx context.Context, clause string, args []interface{}) (dto.ShowsDTO, error) {
+	shows := dto.ShowsDTO{}
+	err := m.query(ctx, "SELECT "+showColumns+" FROM shows"+clause, args, func(rows *sql.Rows) error {
+		show := &dto.ShowDTO{}
+		err := rows.Scan(&show.ID, &show.Title, &show.Type, sqlTimeScanner{&show.ReleaseDate}, sqlTimeScanner{&show.EndDate},
+			&show.Rating, &show.Length.Hours, &show.Length.Minutes, &show.TrailerURL, &show.Description)
+		shows = append(shows, show)
+		return err
+	})
+	if err != nil || len(shows) == 0 {
+		return shows, err
+	}
+
+	ids := []string{}
+	for _, show := range shows {
+		ids = append(ids, show.ID)
+	}
+	posters, err := m.loadPosters(ctx, showPostersTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	genres := map[string]dto.ShortGenresDTO{}
+	query := "SELECT show_genres.show_id, show_genres.genre_id, COALESCE(genres.name, '') FROM show_genres " +
+		"LEFT JOIN genres ON genres.id = show_genres.genre_id WHERE show_genres.show_id IN (%s) ORDER BY show_genres.show_id, show_genres.position"
+	err = m.queryIn(ctx, query, ids, func(rows *sql.Rows) error {
+		var showID string
+		genre := &dto.ShortGenreDTO{}
+		if err := rows.Scan(&showID, &genre.ID, &genre.Name); err != nil {
+			return err
+		}
+		genres[showID] = append(genres[showID], genre)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	credits, err := m.loadCredits(ctx, showCreditsTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	seasons := map[string]dto.ShortSeasonsDTO{}
+	seasonIDs := []string{}
+	err = m.queryIn(ctx, "SELECT show_id, id, title, rating FROM seasons WHERE show_id IN (%s) AND deleted_at IS NULL ORDER BY rowid", ids, func(rows *sql.Rows) error {
+		var showID string
+		season := &dto.ShortSeasonDTO{}
+		if err := rows.Scan(&showID, &season.ID, &season.Title, &season.Rating); err != nil {
+			return err
+		}
+		seasons[showID] = append(seasons[showID], season)
+		seasonIDs = append(seasonIDs, season.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	seasonPostersByID, err := m.loadPosters(ctx, seasonPostersTable, seasonIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, show := range shows {
+		show.PostersPath = stringsOf(posters, show.ID)
+		show.Genres = dto.ShortGenresDTO{}
+		show.Genres = append(show.Genres, genres[show.ID]...)
+		show.DirectedBy = filmCrewsOf(credits[show.ID], creditDirectedBy)
+		show.ProducedBy = filmCrewsOf(credits[show.ID], creditProducedBy)
+		show.WrittenBy = filmCrewsOf(credits[show.ID], creditWrittenBy)
+		show.Starring = shortCelebritiesOf(credits[show.ID], creditStarring)
+		show.Seasons = dto.ShortSeasonsDTO{}
+		for _, season := range seasons[show.ID] {
+			season.PostersPath = stringsOf(seasonPostersByID, season.ID)
+			show.Seasons = append(show.Seasons, season)
+		}
+	}
+	return shows, nil
+}
+
+func (m *SQLDatabase) getShow(ctx context.Context, ID string) (*dto.ShowDTO, error) {
+	shows, err := m.findShows(ctx, " WHERE id = ? AND deleted_at IS NULL", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(shows) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return shows[0], nil
+}
+
+// writeShowLists writes the lists among fields. The seasons are the ones of
+// the show in the seasons table and are not written.
+func (m *SQLDatabase) writeShowLists(ctx context.Context, show *dto.ShowDTO, fields []string) error {
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "postersPath":
+			err = m.replacePosters(ctx, showPostersTable, show.ID, show.PostersPath)
+		case "genres":
+			genreIDs := []string{}
+			for _, genre := range show.Genres {
+				if genre != nil {
+					genreIDs = append(genreIDs, genre.ID)
+				}
+			}
+			err = m.replaceStrings(ctx, showGenresTable, "genre_id", show.ID, genreIDs)
+		case creditDirectedBy:
+			err = m.replaceCredits(ctx, showCreditsTable, show.ID, field, filmCrewCredits(show.DirectedBy))
+		case creditProducedBy:
+			err = m.replaceCredits(ctx, showCreditsTable, show.ID, field, filmCrewCredits(show.ProducedBy))
+		case creditWrittenBy:
+			err = m.replaceCredits(ctx, showCreditsTable, show.ID, field, filmCrewCredits(show.WrittenBy))
+		case creditStarring:
+			err = m.replaceCredits(ctx, showCreditsTable, show.ID, field, shortCelebrityCredits(show.Starring))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDatabase) CreateShow(ctx context.Context, newShow *dto.ShowDTO) (*dto.ShowDTO, error) {
+	newShow.PostersPath = []string{}
+	var show *dto.ShowDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.insert(ctx, "shows", showValues(newShow)); err != nil {
+			return err
+		}
+		if err := m.writeShowLists(ctx, newShow, []string{"genres", creditDirectedBy, creditProducedBy, creditWrittenBy, creditStarring}); err != nil {
+			return err
+		}
+		var err error
+		show, err = m.getShow(ctx, newShow.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting the new show in the SQL database")
+	}
+	return show, nil
+}
+
+// AddShortSeason has nothing to write, the seasons of a show are read from
+// the seasons table.
+func (m *SQLDatabase) AddShortSeason(ctx context.Context, showID string, newSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
+	return newSeason, nil
+}
+
+func (m *SQLDatabase) GetShow(ctx context.Context, ID string) (*dto.ShowDTO, error) {
+	show, err := m.getShow(ctx, ID)
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while finding show by id from the SQL database")
+	}
+	return show, nil
+}
+
+func (m *SQLDatabase) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (*dto.ShowDTO, error) {
+	var show *dto.ShowDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "shows", updatedShow.ID); err != nil {
+			return err
+		}
+		if err := m.updateColumns(ctx, "shows", updatedShow.ID, fields, showFieldColumns, showValues(updatedShow)); err != nil {
+			return err
+		}
+		if err := m.writeShowLists(ctx, updatedShow, fields); err != nil {
+			return err
+		}
+		var err error
+		show, err = m.getShow(ctx, updatedShow.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while updating show in the SQL database")
+	}
+	return show, nil
+}
+
+func (m *SQLDatabase) UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
+	return updatedSeason, nil
+}
+
+// UpdateShortCelebritiesInShow has nothing to write, the names and posters
+// of the credits are read from the celebrities.
+func (m *SQLDatabase) UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	return updatedCelebrity, nil
+}
+
+func (m *SQLDatabase) ListShows(ctx context.Context, filter dto.ShowFilterDTO, page dto.PageDTO) (dto.ShowsDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	if filter.Type != "" {
+		where.add("type = ?", filter.Type)
+	}
+	if filter.GenreID != "" {
+		where.add("id IN (SELECT show_id FROM show_genres WHERE genre_id = ?)", filter.GenreID)
+	}
+	where.addDateRange("release_date", filter.ReleasedAfter, filter.ReleasedBefore)
+	where.addMinRating(filter.MinRating)
+
+	total, err := m.count(ctx, "shows", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting shows in the SQL database")
+	}
+	clause, args, err := sqlPage(page, showSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	shows, err := m.findShows(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all shows from the SQL database")
+	}
+	return shows, total, nil
+}
+
+func (m *SQLDatabase) uploadShowPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	var show *dto.ShowDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "shows", ID); err != nil {
+			return err
+		}
+		if err := m.appendPosters(ctx, showPostersTable, ID, postersPath); err != nil {
+			return err
+		}
+		var err error
+		show, err = m.getShow(ctx, ID)
+		return err
+	})
+	return show, sqlError(err)
+}
+
+func (m *SQLDatabase) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	show, err := m.uploadShowPosters(ctx, ID, postersPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating series in the SQL database")
+	}
+	return show, nil
+}
+
+func (m *SQLDatabase) DeleteSeriesPoster(ctx context.Context, ID string, image string) error {
+	if err := m.deletePoster(ctx, showPostersTable, ID, "/series/"+ID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while updating deleted series poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (*dto.ShowDTO, error) {
+	show, err := m.uploadShowPosters(ctx, ID, postersPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating movie in the SQL database")
+	}
+	return show, nil
+}
+
+func (m *SQLDatabase) DeleteMoviePoster(ctx context.Context, ID string, image string) error {
+	if err := m.deletePoster(ctx, showPostersTable, ID, "/movie/"+ID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while updating deleted movie poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) DeleteShortCelebritiesPostersInShow(ctx context.Context, ID string, image string, celebrityType string) error {
+	return nil
+}
+
+func (m *SQLDatabase) DeleteShortSeasonPostersInShow(ctx context.Context, seriesID string, seasonID string, image string) error {
+	return nil
+}
+
+func (m *SQLDatabase) DeleteShow(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "shows", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting show from the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortSeason(ctx context.Context, seasonID string) error {
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortCelebrityInShows(ctx context.Context, celebrityID string) error {
+	if err := m.removeCredits(ctx, showCreditsTable, celebrityID); err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from shows in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortGenre(ctx context.Context, genreID string) error {
+	_, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM show_genres WHERE genre_id = ?", genreID)
+	if err != nil {
+		return errors.Wrap(err, "Error while removing short genre from shows in the SQL database")
+	}
+	return nil
+}
+
+//------SEASONS------
+
+const seasonColumns = "id, show_id, title, trailer_url, resume, rating, release_date"
+
+var seasonSortColumns = map[string]string{"title": "title", "releaseDate": "release_date", "rating": "rating"}
+
+var seasonFieldColumns = map[string][]string{
+	"title":       {"title"},
+	"trailerUrl":  {"trailer_url"},
+	"resume":      {"resume"},
+	"rating":      {"rating"},
+	"releaseDate": {"release_date"},
+}
+
+func seasonValues(season *dto.SeasonDTO) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           season.ID,
+		"show_id":      season.ShowID,
+		"title":        season.Title,
+		"trailer_url":  season.TrailerURL,
+		"resume":       season.Resume,
+		"rating":       season.Rating,
+		"release_date": sqlTime(season.ReleaseDate),
+	}
+}
+
+func (m *SQLDatabase) findSeasons(ctx context.Context, clause string, args []interface{}) (dto.SeasonsDTO, error) {
+	seasons := dto.SeasonsDTO{}
+	err := m.query(ctx, "SELECT "+seasonColumns+" FROM seasons"+clause, args, func(rows *sql.Rows) error {
+		season := &dto.SeasonDTO{}
+		err := rows.Scan(&season.ID, &season.ShowID, &season.Title, &season.TrailerURL, &season.Resume, &season.Rating, sqlTimeScanner{&season.ReleaseDate})
+		seasons = append(seasons, season)
+		return err
+	})
+	if err != nil || len(seasons) == 0 {
+		return seasons, err
+	}
+
+	ids := []string{}
+	for _, season := range seasons {
+		ids = append(ids, season.ID)
+	}
+	posters, err := m.loadPosters(ctx, seasonPostersTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	credits, err := m.loadCredits(ctx, seasonCreditsTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	episodes := map[string]dto.ShortEpisodesDTO{}
+	episodeIDs := []string{}
+	err = m.queryIn(ctx, "SELECT season_id, id, title, rating, resume FROM episodes WHERE season_id IN (%s) AND deleted_at IS NULL ORDER BY rowid", ids, func(rows *sql.Rows) error {
+		var seasonID string
+		episode := &dto.ShortEpisodeDTO{}
+		if err := rows.Scan(&seasonID, &episode.ID, &episode.Title, &episode.Rating, &episode.Resume); err != nil {
+			return err
+		}
+		episodes[seasonID] = append(episodes[seasonID], episode)
+		episodeIDs = append(episodeIDs, episode.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	episodePostersByID, err := m.loadPosters(ctx, episodePostersTable, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, season := range seasons {
+		season.PostersPath = stringsOf(posters, season.ID)
+		season.WrittenBy = filmCrewsOf(credits[season.ID], creditWrittenBy)
+		season.ProducedBy = filmCrewsOf(credits[season.ID], creditProducedBy)
+		season.DirectedBy = filmCrewsOf(credits[season.ID], creditDirectedBy)
+		season.Episodes = dto.ShortEpisodesDTO{}
+		for _, episode := range episodes[season.ID] {
+			episode.PostersPath = stringsOf(episodePostersByID, episode.ID)
+			season.Episodes = append(season.Episodes, episode)
+		}
+	}
+	return seasons, nil
+}
+
+func (m *SQLDatabase) getSeason(ctx context.Context, ID string) (*dto.SeasonDTO, error) {
+	seasons, err := m.findSeasons(ctx, " WHERE id = ? AND deleted_at IS NULL", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return seasons[0], nil
+}
+
+// writeSeasonLists writes the lists among fields. The episodes are the ones
+// of the season in the episodes table and are not written.
+func (m *SQLDatabase) writeSeasonLists(ctx context.Context, season *dto.SeasonDTO, fields []string) error {
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "postersPath":
+			err = m.replacePosters(ctx, seasonPostersTable, season.ID, season.PostersPath)
+		case creditWrittenBy:
+			err = m.replaceCredits(ctx, seasonCreditsTable, season.ID, field, filmCrewCredits(season.WrittenBy))
+		case creditProducedBy:
+			err = m.replaceCredits(ctx, seasonCreditsTable, season.ID, field, filmCrewCredits(season.ProducedBy))
+		case creditDirectedBy:
+			err = m.replaceCredits(ctx, seasonCreditsTable, season.ID, field, filmCrewCredits(season.DirectedBy))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDatabase) CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (*dto.SeasonDTO, error) {
+	newSeason.PostersPath = []string{}
+	var season *dto.SeasonDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.insert(ctx, "seasons", seasonValues(newSeason)); err != nil {
+			return err
+		}
+		if err := m.writeSeasonLists(ctx, newSeason, []string{creditWrittenBy, creditProducedBy, creditDirectedBy}); err != nil {
+			return err
+		}
+		var err error
+		season, err = m.getSeason(ctx, newSeason.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting new season in the SQL database")
+	}
+	return season, nil
+}
+
+// AddShortEpisode has nothing to write, the episodes of a season are read
+// from the episodes table.
+func (m *SQLDatabase) AddShortEpisode(ctx context.Context, seasonID string, newEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
+	return newEpisode, nil
+}
+
+func (m *SQLDatabase) GetSeason(ctx context.Context, ID string) (*dto.SeasonDTO, error) {
+	season, err := m.getSeason(ctx, ID)
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while finding season by id from the SQL database")
+	}
+	return season, nil
+}
+
+func (m *SQLDatabase) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (*dto.SeasonDTO, error) {
+	var season *dto.SeasonDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "seasons", updatedSeason.ID); err != nil {
+			return err
+		}
+		if err := m.updateColumns(ctx, "seasons", updatedSeason.ID, fields, seasonFieldColumns, seasonValues(updatedSeason)); err != nil {
+			return err
+		}
+		if err := m.writeSeasonLists(ctx, updatedSeason, fields); err != nil {
+			return err
+		}
+		var err error
+		season, err = m.getSeason(ctx, updatedSeason.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while updating season in the SQL database")
+	}
+	return season, nil
+}
+
+func (m *SQLDatabase) UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
+	return updatedEpisode, nil
+}
+
+func (m *SQLDatabase) UpdateShortCelebritiesInSeasons(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	return updatedCelebrity, nil
+}
+
+func (m *SQLDatabase) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (*dto.SeasonDTO, error) {
+	var season *dto.SeasonDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "seasons", seasonID); err != nil {
+			return err
+		}
+		if err := m.appendPosters(ctx, seasonPostersTable, seasonID, postersPath); err != nil {
+			return err
+		}
+		var err error
+		season, err = m.getSeason(ctx, seasonID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while uploading season posters in the SQL database")
+	}
+	return season, nil
+}
+
+func (m *SQLDatabase) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error {
+	if err := m.deletePoster(ctx, seasonPostersTable, seasonID, "/series/"+seriesID+"/"+seasonID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while deleting season poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) DeleteShortCelebritiesPostersInSeason(ctx context.Context, celebrityID string, image string, celebrityType string) error {
+	return nil
+}
+
+func (m *SQLDatabase) ListShowSeasons(ctx context.Context, showID string) (dto.SeasonsDTO, error) {
+	seasons, err := m.findSeasons(ctx, " WHERE show_id = ? AND deleted_at IS NULL ORDER BY rowid", []interface{}{showID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all seasons from the SQL database")
+	}
+	return seasons, nil
+}
+
+func (m *SQLDatabase) ListSeasonsCollection(ctx context.Context, filter dto.SeasonFilterDTO, page dto.PageDTO) (dto.SeasonsDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	if filter.ShowID != "" {
+		where.add("show_id = ?", filter.ShowID)
+	}
+	where.addDateRange("release_date", filter.ReleasedAfter, filter.ReleasedBefore)
+	where.addMinRating(filter.MinRating)
+
+	total, err := m.count(ctx, "seasons", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting seasons in the SQL database")
+	}
+	clause, args, err := sqlPage(page, seasonSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	seasons, err := m.findSeasons(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all show seasons from the SQL database")
+	}
+	return seasons, total, nil
+}
+
+func (m *SQLDatabase) DeleteSeason(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "seasons", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting season from the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortEpisode(ctx context.Context, episodeID string) error {
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortCelebrityInSeasons(ctx context.Context, celebrityID string) error {
+	if err := m.removeCredits(ctx, seasonCreditsTable, celebrityID); err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from seasons in the SQL database")
+	}
+	return nil
+}
+
+//------EPISODES------
+
+const episodeColumns = "id, season_id, title, trailer_url, length_hours, length_minutes, rating, resume"
+
+var episodeSortColumns = map[string]string{"title": "title", "rating": "rating"}
+
+var episodeFieldColumns = map[string][]string{
+	"title":      {"title"},
+	"trailerUrl": {"trailer_url"},
+	"length":     {"length_hours", "length_minutes"},
+	"rating":     {"rating"},
+	"resume":     {"resume"},
+}
+
+func episodeValues(episode *dto.EpisodeDTO) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             episode.ID,
+		"season_id":      episode.SeasonID,
+		"title":          episode.Title,
+		"trailer_url":    episode.TrailerURL,
+		"length_hours":   episode.Length.Hours,
+		"length_minutes": episode.Length.Minutes,
+		"rating":         episode.Rating,
+		"resume":         episode.Resume,
+	}
+}
+
+func (m *SQLDatabase) findEpisodes(ctx context.Context, clause string, args []interface{}) (dto.EpisodesDTO, error) {
+	episodes := dto.EpisodesDTO{}
+	err := m.query(ctx, "SELECT "+episodeColumns+" FROM episodes"+clause, args, func(rows *sql.Rows) error {
+		episode := &dto.EpisodeDTO{}
+		err := rows.Scan(&episode.ID, &episode.SeasonID, &episode.Title, &episode.TrailerURL, &episode.Length.Hours, &episode.Length.Minutes, &episode.Rating, &episode.Resume)
+		episodes = append(episodes, episode)
+		return err
+	})
+	if err != nil || len(episodes) == 0 {
+		return episodes, err
+	}
+
+	ids := []string{}
+	for _, episode := range episodes {
+		ids = append(ids, episode.ID)
+	}
+	posters, err := m.loadPosters(ctx, episodePostersTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	credits, err := m.loadCredits(ctx, episodeCreditsTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, episode := range episodes {
+		episode.PostersPath = stringsOf(posters, episode.ID)
+		episode.WrittenBy = filmCrewsOf(credits[episode.ID], creditWrittenBy)
+		episode.ProducedBy = filmCrewsOf(credits[episode.ID], creditProducedBy)
+		episode.DirectedBy = filmCrewsOf(credits[episode.ID], creditDirectedBy)
+		episode.Starring = shortCelebritiesOf(credits[episode.ID], creditStarring)
+	}
+	return episodes, nil
+}
+
+func (m *SQLDatabase) getEpisode(ctx context.Context, ID string) (*dto.EpisodeDTO, error) {
+	episodes, err := m.findEpisodes(ctx, " WHERE id = ? AND deleted_at IS NULL", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(episodes) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return episodes[0], nil
+}
+
+func (m *SQLDatabase) writeEpisodeLists(ctx context.Context, episode *dto.EpisodeDTO, fields []string) error {
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "postersPath":
+			err = m.replacePosters(ctx, episodePostersTable, episode.ID, episode.PostersPath)
+		case creditWrittenBy:
+			err = m.replaceCredits(ctx, episodeCreditsTable, episode.ID, field, filmCrewCredits(episode.WrittenBy))
+		case creditProducedBy:
+			err = m.replaceCredits(ctx, episodeCreditsTable, episode.ID, field, filmCrewCredits(episode.ProducedBy))
+		case creditDirectedBy:
+			err = m.replaceCredits(ctx, episodeCreditsTable, episode.ID, field, filmCrewCredits(episode.DirectedBy))
+		case creditStarring:
+			err = m.replaceCredits(ctx, episodeCreditsTable, episode.ID, field, shortCelebrityCredits(episode.Starring))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDatabase) CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (*dto.EpisodeDTO, error) {
+	newEpisode.PostersPath = []string{}
+	var episode *dto.EpisodeDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.insert(ctx, "episodes", episodeValues(newEpisode)); err != nil {
+			return err
+		}
+		if err := m.writeEpisodeLists(ctx, newEpisode, []string{creditWrittenBy, creditProducedBy, creditDirectedBy, creditStarring}); err != nil {
+			return err
+		}
+		var err error
+		episode, err = m.getEpisode(ctx, newEpisode.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting new episode in the SQL database")
+	}
+	return episode, nil
+}
+
+func (m *SQLDatabase) GetEpisode(ctx context.Context, ID string) (*dto.EpisodeDTO, error) {
+	episode, err := m.getEpisode(ctx, ID)
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while finding episode by id from the SQL database")
+	}
+	return episode, nil
+}
+
+func (m *SQLDatabase) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (*dto.EpisodeDTO, error) {
+	var episode *dto.EpisodeDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "episodes", updatedEpisode.ID); err != nil {
+			return err
+		}
+		if err := m.updateColumns(ctx, "episodes", updatedEpisode.ID, fields, episodeFieldColumns, episodeValues(updatedEpisode)); err != nil {
+			return err
+		}
+		if err := m.writeEpisodeLists(ctx, updatedEpisode, fields); err != nil {
+			return err
+		}
+		var err error
+		episode, err = m.getEpisode(ctx, updatedEpisode.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while updating episode in the SQL database")
+	}
+	return episode, nil
+}
+
+func (m *SQLDatabase) UpdateShortCelebritiesInEpisode(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	return updatedCelebrity, nil
+}
+
+func (m *SQLDatabase) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (*dto.EpisodeDTO, error) {
+	var episode *dto.EpisodeDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "episodes", episodeID); err != nil {
+			return err
+		}
+		if err := m.appendPosters(ctx, episodePostersTable, episodeID, postersPath); err != nil {
+			return err
+		}
+		var err error
+		episode, err = m.getEpisode(ctx, episodeID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while uploading episode posters in the SQL database")
+	}
+	return episode, nil
+}
+
+func (m *SQLDatabase) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error {
+	if err := m.deletePoster(ctx, episodePostersTable, episodeID, "/series/"+seriesID+"/"+seasonID+"/"+episodeID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while deleting episode poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) DeleteShortCelebritiesPostersInEpisode(ctx context.Context, celebrityID string, image string, celebrityType string) error {
+	return nil
+}
+
+func (m *SQLDatabase) ListSeasonEpisodes(ctx context.Context, seasonID string) (dto.EpisodesDTO, error) {
+	episodes, err := m.findEpisodes(ctx, " WHERE season_id = ? AND deleted_at IS NULL ORDER BY rowid", []interface{}{seasonID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all episodes from the SQL database")
+	}
+	return episodes, nil
+}
+
+func (m *SQLDatabase) ListCollectionEpisodes(ctx context.Context, filter dto.EpisodeFilterDTO, page dto.PageDTO) (dto.EpisodesDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	if filter.SeasonID != "" {
+		where.add("season_id = ?", filter.SeasonID)
+	}
+	where.addMinRating(filter.MinRating)
+
+	total, err := m.count(ctx, "episodes", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting episodes in the SQL database")
+	}
+	clause, args, err := sqlPage(page, episodeSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	episodes, err := m.findEpisodes(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all episodes from the SQL database")
+	}
+	return episodes, total, nil
+}
+
+func (m *SQLDatabase) DeleteEpisode(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "episodes", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting episode from the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) RemoveShortCelebrityInEpisodes(ctx context.Context, celebrityID string) error {
+	if err := m.removeCredits(ctx, episodeCreditsTable, celebrityID); err != nil {
+		return errors.Wrap(err, "Error while removing short celebrity from episodes in the SQL database")
+	}
+	return nil
+}
+
+//------CELEBRITIES------
+
+const celebrityColumns = "id, name, date_of_birth, date_of_death, place_of_birth, gender, bio"
+
+var celebritySortColumns = map[string]string{"name": "name", "dateOfBirth": "date_of_birth"}
+
+var celebrityFieldColumns = map[string][]string{
+	"name":         {"name"},
+	"dateOfBirth":  {"date_of_birth"},
+	"dateOfDeath":  {"date_of_death"},
+	"placeOfBirth": {"place_of_birth"},
+	"gender":       {"gender"},
+	"bio":          {"bio"},
+}
+
+func celebrityValues(celebrity *dto.CelebrityDTO) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             celebrity.ID,
+		"name":           celebrity.Name,
+		"date_of_birth":  sqlTime(celebrity.DateOfBirth),
+		"date_of_death":  sqlTime(celebrity.DateOfDeath),
+		"place_of_birth": celebrity.PlaceOfBirth,
+		"gender":         string(celebrity.Gender),
+		"bio":            celebrity.Bio,
+	}
+}
+
+func (m *SQLDatabase) findCelebrities(ctx context.Context, clause string, args []interface{}) (dto.CelebritiesDTO, error) {
+	celebrities := dto.CelebritiesDTO{}
+	err := m.query(ctx, "SELECT "+celebrityColumns+" FROM celebrities"+clause, args, func(rows *sql.Rows) error {
+		celebrity := &dto.CelebrityDTO{}
+		err := rows.Scan(&celebrity.ID, &celebrity.Name, sqlTimeScanner{&celebrity.DateOfBirth}, sqlTimeScanner{&celebrity.DateOfDeath},
+			&celebrity.PlaceOfBirth, &celebrity.Gender, &celebrity.Bio)
+		celebrities = append(celebrities, celebrity)
+		return err
+	})
+	if err != nil || len(celebrities) == 0 {
+		return celebrities, err
+	}
+
+	ids := []string{}
+	for _, celebrity := range celebrities {
+		ids = append(ids, celebrity.ID)
+	}
+	occupations, err := m.loadStrings(ctx, celebrityOccupationsTable, "occupation", ids)
+	if err != nil {
+		return nil, err
+	}
+	posters, err := m.loadPosters(ctx, celebrityPostersTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, celebrity := range celebrities {
+		celebrity.Occupation = stringsOf(occupations, celebrity.ID)
+		celebrity.PostersPath = stringsOf(posters, celebrity.ID)
+	}
+	return celebrities, nil
+}
+
+func (m *SQLDatabase) getCelebrity(ctx context.Context, ID string) (*dto.CelebrityDTO, error) {
+	celebrities, err := m.findCelebrities(ctx, " WHERE id = ? AND deleted_at IS NULL", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(celebrities) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return celebrities[0], nil
+}
+
+func (m *SQLDatabase) writeCelebrityLists(ctx context.Context, celebrity *dto.CelebrityDTO, fields []string) error {
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "occupation":
+			err = m.replaceStrings(ctx, celebrityOccupationsTable, "occupation", celebrity.ID, celebrity.Occupation)
+		case "postersPath":
+			err = m.replacePosters(ctx, celebrityPostersTable, celebrity.ID, celebrity.PostersPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SQLDatabase) CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (*dto.CelebrityDTO, error) {
+	newCelebrity.PostersPath = []string{}
+	var celebrity *dto.CelebrityDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.insert(ctx, "celebrities", celebrityValues(newCelebrity)); err != nil {
+			return err
+		}
+		if err := m.writeCelebrityLists(ctx, newCelebrity, []string{"occupation"}); err != nil {
+			return err
+		}
+		var err error
+		celebrity, err = m.getCelebrity(ctx, newCelebrity.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting new celebrity in the SQL database")
+	}
+	return celebrity, nil
+}
+
+func (m *SQLDatabase) GetCelebrity(ctx context.Context, ID string) (*dto.CelebrityDTO, error) {
+	celebrity, err := m.getCelebrity(ctx, ID)
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while finding celebrity by id from the SQL database")
+	}
+	return celebrity, nil
+}
+
+func (m *SQLDatabase) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (*dto.CelebrityDTO, error) {
+	var celebrity *dto.CelebrityDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "celebrities", updatedCelebrity.ID); err != nil {
+			return err
+		}
+		if err := m.updateColumns(ctx, "celebrities", updatedCelebrity.ID, fields, celebrityFieldColumns, celebrityValues(updatedCelebrity)); err != nil {
+			return err
+		}
+		if err := m.writeCelebrityLists(ctx, updatedCelebrity, fields); err != nil {
+			return err
+		}
+		var err error
+		celebrity, err = m.getCelebrity(ctx, updatedCelebrity.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while updating celebrity in the SQL database")
+	}
+	return celebrity, nil
+}
+
+func (m *SQLDatabase) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (*dto.CelebrityDTO, error) {
+	var celebrity *dto.CelebrityDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "celebrities", ID); err != nil {
+			return err
+		}
+		if err := m.appendPosters(ctx, celebrityPostersTable, ID, postersPath); err != nil {
+			return err
+		}
+		var err error
+		celebrity, err = m.getCelebrity(ctx, ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while uploading celebrity posters in the SQL database")
+	}
+	return celebrity, nil
+}
+
+func (m *SQLDatabase) DeleteCelebrityPoster(ctx context.Context, ID string, image string) error {
+	if err := m.deletePoster(ctx, celebrityPostersTable, ID, "/celebrities/"+ID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) ListCelebrities(ctx context.Context, filter dto.CelebrityFilterDTO, page dto.PageDTO) (dto.CelebritiesDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	if filter.Occupation != "" {
+		where.add("id IN (SELECT celebrity_id FROM celebrity_occupations WHERE occupation = ?)", filter.Occupation)
+	}
+
+	total, err := m.count(ctx, "celebrities", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting celebrities in the SQL database")
+	}
+	clause, args, err := sqlPage(page, celebritySortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	celebrities, err := m.findCelebrities(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all celebrities from the SQL database")
+	}
+	return celebrities, total, nil
+}
+
+func (m *SQLDatabase) DeleteCelebrity(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "celebrities", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting celebrity from the SQL database")
+	}
+	return nil
+}
+
+//------ARTICLES------
+
+const articleColumns = "id, title, release_date, description, journalist_id"
+
+var articleSortColumns = map[string]string{"releaseDate": "release_date", "title": "title"}
+
+func articleValues(article *dto.ArticleDTO) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            article.ID,
+		"title":         article.Title,
+		"release_date":  sqlTime(article.ReleaseDate),
+		"description":   article.Description,
+		"journalist_id": article.Journalist.ID,
+	}
+}
+
+func (m *SQLDatabase) findArticles(ctx context.Context, clause string, args []interface{}) (dto.ArticlesDTO, error) {
+	articles := dto.ArticlesDTO{}
+	err := m.query(ctx, "SELECT "+articleColumns+" FROM articles"+clause, args, func(rows *sql.Rows) error {
+		article := &dto.ArticleDTO{}
+		err := rows.Scan(&article.ID, &article.Title, sqlTimeScanner{&article.ReleaseDate}, &article.Description, &article.Journalist.ID)
+		articles = append(articles, article)
+		return err
+	})
+	if err != nil || len(articles) == 0 {
+		return articles, err
+	}
+
+	ids := []string{}
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+	posters, err := m.loadPosters(ctx, articlePostersTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, article := range articles {
+		article.PostersPath = stringsOf(posters, article.ID)
+	}
+	return articles, nil
+}
+
+func (m *SQLDatabase) getArticle(ctx context.Context, ID string) (*dto.ArticleDTO, error) {
+	articles, err := m.findArticles(ctx, " WHERE id = ? AND deleted_at IS NULL", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(articles) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return articles[0], nil
+}
+
+func (m *SQLDatabase) CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
+	newArticle.PostersPath = []string{}
+	var article *dto.ArticleDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.insert(ctx, "articles", articleValues(newArticle)); err != nil {
+			return err
+		}
+		var err error
+		article, err = m.getArticle(ctx, newArticle.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting the new article in the SQL database")
+	}
+	return article, nil
+}
+
+func (m *SQLDatabase) GetArticle(ctx context.Context, ID string) (*dto.ArticleDTO, error) {
+	article, err := m.getArticle(ctx, ID)
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while finding article by id from the SQL database")
+	}
+	return article, nil
+}
+
+// UpdateArticle writes every field, and like the Mongo one does nothing for
+// an article that does not exist.
+func (m *SQLDatabase) UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (*dto.ArticleDTO, error) {
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "articles", updatedArticle.ID); err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		fields := []string{"title", "releaseDate", "description", "journalist"}
+		fieldColumns := map[string][]string{"title": {"title"}, "releaseDate": {"release_date"}, "description": {"description"}, "journalist": {"journalist_id"}}
+		if err := m.updateColumns(ctx, "articles", updatedArticle.ID, fields, fieldColumns, articleValues(updatedArticle)); err != nil {
+			return err
+		}
+		return m.replacePosters(ctx, articlePostersTable, updatedArticle.ID, updatedArticle.PostersPath)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating article in the SQL database")
+	}
+	return updatedArticle, nil
+}
+
+func (m *SQLDatabase) ListArticles(ctx context.Context, filter dto.ArticleFilterDTO, page dto.PageDTO) (dto.ArticlesDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	if filter.JournalistID != "" {
+		where.add("journalist_id = ?", filter.JournalistID)
+	}
+	where.addDateRange("release_date", filter.ReleasedAfter, filter.ReleasedBefore)
+
+	total, err := m.count(ctx, "articles", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting articles in the SQL database")
+	}
+	clause, args, err := sqlPage(page, articleSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	articles, err := m.findArticles(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all articles from the SQL database")
+	}
+	return articles, total, nil
+}
+
+func (m *SQLDatabase) ListArticlesByJournalist(ctx context.Context, journalistID string) (dto.ArticlesDTO, error) {
+	articles, err := m.findArticles(ctx, " WHERE journalist_id = ? AND deleted_at IS NULL ORDER BY rowid", []interface{}{journalistID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding all articles by journalist id from the SQL database")
+	}
+	return articles, nil
+}
+
+func (m *SQLDatabase) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (*dto.ArticleDTO, error) {
+	var article *dto.ArticleDTO
+	err := m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.existsNotDeleted(ctx, "articles", ID); err != nil {
+			return err
+		}
+		if err := m.appendPosters(ctx, articlePostersTable, ID, postersPath); err != nil {
+			return err
+		}
+		var err error
+		article, err = m.getArticle(ctx, ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while uploading article posters in the SQL database")
+	}
+	return article, nil
+}
+
+func (m *SQLDatabase) DeleteArticlePoster(ctx context.Context, ID string, image string) error {
+	if err := m.deletePoster(ctx, articlePostersTable, ID, "/articles/"+ID+"/"+image); err != nil {
+		return errors.Wrap(err, "Error while deleting article poster in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) DeleteArticle(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "articles", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting article from the SQL database")
+	}
+	return nil
+}
+
+//------GENRES------
+
+var genreSortColumns = map[string]string{"name": "name"}
+
+func (m *SQLDatabase) findGenres(ctx context.Context, clause string, args []interface{}) (dto.GenresDTO, error) {
+	genres := dto.GenresDTO{}
+	err := m.query(ctx, "SELECT id, name, description FROM genres"+clause, args, func(rows *sql.Rows) error {
+		genre := &dto.GenreDTO{}
+		err := rows.Scan(&genre.ID, &genre.Name, &genre.Description)
+		genres = append(genres, genre)
+		return err
+	})
+	return genres, err
+}
+
+func (m *SQLDatabase) findGenre(ctx context.Context, clause string, args ...interface{}) (*dto.GenreDTO, error) {
+	genres, err := m.findGenres(ctx, clause+" LIMIT 1", args)
+	if err != nil {
+		return nil, err
+	}
+	if len(genres) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return genres[0], nil
+}
+
+func (m *SQLDatabase) CreateGenre(ctx context.Context, newGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
+	err := m.insert(ctx, "genres", map[string]interface{}{"id": newGenre.ID, "name": newGenre.Name, "description": newGenre.Description})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting the new genre in the SQL database")
+	}
+	return newGenre, nil
+}
+
+func (m *SQLDatabase) GetGenreByName(ctx context.Context, name string) (*dto.GenreDTO, error) {
+	genre, err := m.findGenre(ctx, " WHERE name = ? AND deleted_at IS NULL ORDER BY rowid", name)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding genre by name from the SQL database")
+	}
+	return genre, nil
+}
+
+func (m *SQLDatabase) GetGenre(ctx context.Context, ID string) (*dto.GenreDTO, error) {
+	genre, err := m.findGenre(ctx, " WHERE id = ? AND deleted_at IS NULL", ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding genre by id from the SQL database")
+	}
+	return genre, nil
+}
+
+func (m *SQLDatabase) UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (*dto.GenreDTO, error) {
+	_, err := m.querier(ctx).ExecContext(ctx, "UPDATE genres SET name = ?, description = ? WHERE id = ? AND deleted_at IS NULL", updatedGenre.Name, updatedGenre.Description, updatedGenre.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating genre in the SQL database")
+	}
+	return updatedGenre, nil
+}
+
+func (m *SQLDatabase) ListGenres(ctx context.Context, page dto.PageDTO) (dto.GenresDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	total, err := m.count(ctx, "genres", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting genres in the SQL database")
+	}
+	clause, args, err := sqlPage(page, genreSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	genres, err := m.findGenres(ctx, where.String()+clause, args)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all genres from the SQL database")
+	}
+	return genres, total, nil
+}
+
+func (m *SQLDatabase) DeleteGenre(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "genres", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting genre from the SQL database")
+	}
+	return nil
+}
+
+//------JOURNALISTS------
+
+var journalistSortColumns = map[string]string{"name": "name"}
+
+func (m *SQLDatabase) findJournalists(ctx context.Context, clause string, args []interface{}) (dto.JournalistsDTO, error) {
+	journalists := dto.JournalistsDTO{}
+	err := m.query(ctx, "SELECT id, name FROM journalists"+clause, args, func(rows *sql.Rows) error {
+		journalist := &dto.JournalistDTO{}
+		err := rows.Scan(&journalist.ID, &journalist.Name)
+		journalists = append(journalists, journalist)
+		return err
+	})
+	return journalists, err
+}
+
+func (m *SQLDatabase) findJournalist(ctx context.Context, clause string, args ...interface{}) (*dto.JournalistDTO, error) {
+	journalists, err := m.findJournalists(ctx, clause+" LIMIT 1", args)
+	if err != nil {
+		return nil, err
+	}
+	if len(journalists) == 0 {
+		return nil, models.ErrNotFound
+	}
+	return journalists[0], nil
+}
+
+func (m *SQLDatabase) CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
+	err := m.insert(ctx, "journalists", map[string]interface{}{"id": newJournalist.ID, "name": newJournalist.Name})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting the new journalist in the SQL database")
+	}
+	return newJournalist, nil
+}
+
+func (m *SQLDatabase) GetJournalistByName(ctx context.Context, name string) (*dto.JournalistDTO, error) {
+	journalist, err := m.findJournalist(ctx, " WHERE name = ? AND deleted_at IS NULL ORDER BY rowid", name)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding journalist by name from the SQL database")
+	}
+	return journalist, nil
+}
+
+func (m *SQLDatabase) GetJournalist(ctx context.Context, ID string) (*dto.JournalistDTO, error) {
+	journalist, err := m.findJournalist(ctx, " WHERE id = ? AND deleted_at IS NULL", ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding journalist by id from the SQL database")
+	}
+	return journalist, nil
+}
+
+func (m *SQLDatabase) UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (*dto.JournalistDTO, error) {
+	_, err := m.querier(ctx).ExecContext(ctx, "UPDATE journalists SET name = ? WHERE id = ? AND deleted_at IS NULL", updatedJournalist.Name, updatedJournalist.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while updating journalist in the SQL database")
+	}
+	return updatedJournalist, nil
+}
+
+func (m *SQLDatabase) ListJournalists(ctx context.Context, page dto.PageDTO) (dto.JournalistsDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("deleted_at IS NULL")
+	total, err := m.count(ctx, "journalists", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting journalists in the SQL database")
+	}
+	clause, args, err := sqlPage(page, journalistSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	journalists, err := m.findJournalists(ctx, where.String()+clause, args)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding all journalists from the SQL database")
+	}
+	return journalists, total, nil
+}
+
+func (m *SQLDatabase) DeleteJournalist(ctx context.Context, ID string) error {
+	if err := m.markDeleted(ctx, "journalists", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting journalist from the SQL database")
+	}
+	return nil
+}
+
+//------SEARCH------
+
+// Search matches the words of the query against the search table, ranked
+// by BM25 with the title weighing ten times the text like in Mongo.
+func (m *SQLDatabase) Search(ctx context.Context, query string, types []string, page dto.PageDTO) (dto.SearchHitsDTO, int64, error) {
+	terms := []string{}
+	for _, term := range SearchTerms(query) {
+		terms = append(terms, `"`+term+`"`)
+	}
+	if len(terms) == 0 {
+		return dto.SearchHitsDTO{}, 0, nil
+	}
+	where := sqlWhere{}
+	where.add("search MATCH ?", strings.Join(terms, " OR "))
+	if len(types) > 0 {
+		placeholders, args := sqlIn(types)
+		where.add("type IN ("+placeholders+")", args...)
+	}
+
+	total, err := m.count(ctx, "search", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting search hits in the SQL database")
+	}
+	limit := page.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	hits := dto.SearchHitsDTO{}
+	query = "SELECT type, id, title, text, -bm25(search, 0, 0, 10, 1) AS score FROM search" + where.String() + " ORDER BY score DESC, type, id LIMIT ? OFFSET ?"
+	err = m.query(ctx, query, append(where.args, limit, page.Offset), func(rows *sql.Rows) error {
+		hit := &dto.SearchHitDTO{}
+		err := rows.Scan(&hit.Type, &hit.ID, &hit.Title, &hit.Text, &hit.Score)
+		hits = append(hits, hit)
+		return err
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while searching the SQL database")
+	}
+	return hits, total, nil
+}
+
+//------OUTBOX------
+
+const outboxColumns = "id, type, entity_type, entity_id, payload, created_at, dispatched, pending, dead, next_attempt_at, deliveries"
+
+var deadLetterSortColumns = map[string]string{"createdAt": "created_at"}
+
+func outboxValues(event *dto.OutboxEventDTO) (map[string]interface{}, error) {
+	deliveries, err := json.Marshal(event.Deliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while encoding the deliveries of the outbox event")
+	}
+	return map[string]interface{}{
+		"id":              event.ID,
+		"type":            event.Type,
+		"entity_type":     event.EntityType,
+		"entity_id":       event.EntityID,
+		"payload":         event.Payload,
+		"created_at":      sqlTime(event.CreatedAt),
+		"dispatched":      event.Dispatched,
+		"pending":         event.Pending,
+		"dead":            event.Dead,
+		"next_attempt_at": sqlTime(event.NextAttemptAt),
+		"deliveries":      string(deliveries),
+	}, nil
+}
+
+func (m *SQLDatabase) findOutboxEvents(ctx context.Context, clause string, args []interface{}) (dto.OutboxEventsDTO, error) {
+	events := dto.OutboxEventsDTO{}
+	err := m.query(ctx, "SELECT "+outboxColumns+" FROM outbox_events"+clause, args, func(rows *sql.Rows) error {
+		event := &dto.OutboxEventDTO{}
+		var deliveries string
+		err := rows.Scan(&event.ID, &event.Type, &event.EntityType, &event.EntityID, &event.Payload, sqlTimeScanner{&event.CreatedAt},
+			&event.Dispatched, &event.Pending, &event.Dead, sqlTimeScanner{&event.NextAttemptAt}, &deliveries)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(deliveries), &event.Deliveries); err != nil {
+			return errors.Wrap(err, "Error while decoding the deliveries of the outbox event")
+		}
+		events = append(events, event)
+		return nil
+	})
+	return events, err
+}
+
+func (m *SQLDatabase) AddOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	values, err := outboxValues(event)
+	if err != nil {
+		return err
+	}
+	if err := m.insert(ctx, "outbox_events", values); err != nil {
+		return errors.Wrap(sqlError(err), "Error while inserting the outbox event in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) (dto.OutboxEventsDTO, error) {
+	events, err := m.findOutboxEvents(ctx, " WHERE dispatched = 0 OR (pending = 1 AND next_attempt_at <= ?) ORDER BY created_at, rowid LIMIT ?", []interface{}{sqlTime(now), limit})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding due outbox events in the SQL database")
+	}
+	return events, nil
+}
+
+func (m *SQLDatabase) UpdateOutboxEvent(ctx context.Context, event *dto.OutboxEventDTO) error {
+	values, err := outboxValues(event)
+	if err != nil {
+		return err
+	}
+	fields := []string{"dispatched", "pending", "dead", "next_attempt_at", "deliveries"}
+	fieldColumns := map[string][]string{}
+	for _, field := range fields {
+		fieldColumns[field] = []string{field}
+	}
+	err = m.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := m.exists(ctx, "outbox_events", event.ID); err != nil {
+			return err
+		}
+		return m.updateColumns(ctx, "outbox_events", event.ID, fields, fieldColumns, values)
+	})
+	if err != nil {
+		return errors.Wrap(sqlError(err), "Error while updating the outbox event in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) DeleteOutboxEvent(ctx context.Context, ID string) error {
+	if _, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM outbox_events WHERE id = ?", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting the outbox event from the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) ListDeadLetters(ctx context.Context, page dto.PageDTO) (dto.OutboxEventsDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("dead = 1")
+	total, err := m.count(ctx, "outbox_events", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting dead letters in the SQL database")
+	}
+	page.SortBy, page.Descending = "createdAt", true
+	clause, args, err := sqlPage(page, deadLetterSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	events, err := m.findOutboxEvents(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding dead letters in the SQL database")
+	}
+	return events, total, nil
+}
+
+func (m *SQLDatabase) PurgeDeadLetters(ctx context.Context, before time.Time) (int64, error) {
+	result, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM outbox_events WHERE dead = 1 AND pending = 0 AND created_at < ?", sqlTime(before))
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the dead letters of the SQL database")
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the dead letters of the SQL database")
+	}
+	return purged, nil
+}
+
+//------WEBHOOKS------
+
+func (m *SQLDatabase) CreateWebhook(ctx context.Context, newWebhook *dto.WebhookDTO) (*dto.WebhookDTO, error) {
+	eventTypes, err := json.Marshal(newWebhook.EventTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while encoding the event types of the webhook")
+	}
+	err = m.insert(ctx, "webhooks", map[string]interface{}{
+		"id":          newWebhook.ID,
+		"url":         newWebhook.URL,
+		"secret":      newWebhook.Secret,
+		"event_types": string(eventTypes),
+		"created_at":  sqlTime(newWebhook.CreatedAt),
+	})
+	if err != nil {
+		return nil, errors.Wrap(sqlError(err), "Error while inserting the new webhook in the SQL database")
+	}
+	return newWebhook, nil
+}
+
+func (m *SQLDatabase) ListWebhooks(ctx context.Context) (dto.WebhooksDTO, error) {
+	webhooks := dto.WebhooksDTO{}
+	err := m.query(ctx, "SELECT id, url, secret, event_types, created_at FROM webhooks ORDER BY created_at, rowid", nil, func(rows *sql.Rows) error {
+		webhook := &dto.WebhookDTO{}
+		var eventTypes string
+		if err := rows.Scan(&webhook.ID, &webhook.URL, &webhook.Secret, &eventTypes, sqlTimeScanner{&webhook.CreatedAt}); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(eventTypes), &webhook.EventTypes); err != nil {
+			return errors.Wrap(err, "Error while decoding the event types of the webhook")
+		}
+		webhooks = append(webhooks, webhook)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while finding webhooks in the SQL database")
+	}
+	return webhooks, nil
+}
+
+func (m *SQLDatabase) DeleteWebhook(ctx context.Context, ID string) error {
+	if err := m.deleteRow(ctx, "webhooks", ID); err != nil {
+		return errors.Wrap(err, "Error while deleting webhook from the SQL database")
+	}
+	return nil
+}
+
+//------TRASH------
+
+const trashColumns = "id, entity_type, name, parent_id, deleted_at, deleted_by, payload"
+
+var trashSortColumns = map[string]string{"deletedAt": "deleted_at"}
+
+// sqlEntityTables are the tables of the documents of each entity type.
+var sqlEntityTables = map[string]string{
+	ShowEntity:       "shows",
+	SeasonEntity:     "seasons",
+	EpisodeEntity:    "episodes",
+	CelebrityEntity:  "celebrities",
+	ArticleEntity:    "articles",
+	GenreEntity:      "genres",
+	JournalistEntity: "journalists",
+}
+
+func (m *SQLDatabase) findTrashItems(ctx context.Context, clause string, args []interface{}) (dto.TrashItemsDTO, error) {
+	items := dto.TrashItemsDTO{}
+	err := m.query(ctx, "SELECT "+trashColumns+" FROM trash"+clause, args, func(rows *sql.Rows) error {
+		item := &dto.TrashItemDTO{}
+		err := rows.Scan(&item.ID, &item.EntityType, &item.Name, &item.ParentID, sqlTimeScanner{&item.DeletedAt}, &item.DeletedBy, &item.Payload)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+func (m *SQLDatabase) AddTrashItem(ctx context.Context, item *dto.TrashItemDTO) error {
+	err := m.insert(ctx, "trash", map[string]interface{}{
+		"id":          item.ID,
+		"entity_type": item.EntityType,
+		"name":        item.Name,
+		"parent_id":   item.ParentID,
+		"deleted_at":  sqlTime(item.DeletedAt),
+		"deleted_by":  item.DeletedBy,
+		"payload":     item.Payload,
+	})
+	if err != nil {
+		return errors.Wrap(sqlError(err), "Error while inserting the trash item in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) GetTrashItem(ctx context.Context, entityType string, ID string) (*dto.TrashItemDTO, error) {
+	items, err := m.findTrashItems(ctx, " WHERE entity_type = ? AND id = ?", []interface{}{entityType, ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting the trash item from the SQL database")
+	}
+	if len(items) == 0 {
+		return nil, errors.Wrap(models.ErrNotFound, "Error while getting the trash item from the SQL database")
+	}
+	return items[0], nil
+}
+
+func (m *SQLDatabase) ListTrashItems(ctx context.Context, filter dto.TrashFilterDTO, page dto.PageDTO) (dto.TrashItemsDTO, int64, error) {
+	where := sqlWhere{}
+	if filter.EntityType != "" {
+		where.add("entity_type = ?", filter.EntityType)
+	}
+	if filter.ParentID != "" {
+		where.add("parent_id = ?", filter.ParentID)
+	}
+	total, err := m.count(ctx, "trash", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting trash items in the SQL database")
+	}
+	page.SortBy, page.Descending = "deletedAt", true
+	clause, args, err := sqlPage(page, trashSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	items, err := m.findTrashItems(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding trash items in the SQL database")
+	}
+	return items, total, nil
+}
+
+func (m *SQLDatabase) DeleteTrashItem(ctx context.Context, entityType string, ID string) error {
+	result, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM trash WHERE entity_type = ? AND id = ?", entityType, ID)
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting the trash item from the SQL database")
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting the trash item from the SQL database")
+	}
+	if deleted == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while deleting the trash item from the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) RestoreDocument(ctx context.Context, entityType string, ID string) error {
+	table, ok := sqlEntityTables[entityType]
+	if !ok {
+		return errors.Wrap(models.ErrNotFound, "Error while restoring the "+entityType+" in the SQL database")
+	}
+	result, err := m.querier(ctx).ExecContext(ctx, "UPDATE "+table+" SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL", ID)
+	if err != nil {
+		return errors.Wrap(err, "Error while restoring the "+entityType+" in the SQL database")
+	}
+	restored, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Error while restoring the "+entityType+" in the SQL database")
+	}
+	if restored == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while restoring the "+entityType+" in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) PurgeTrashItems(ctx context.Context, before time.Time) (int64, error) {
+	for _, table := range sqlEntityTables {
+		if _, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM "+table+" WHERE deleted_at < ?", sqlTime(before)); err != nil {
+			return 0, errors.Wrap(err, "Error while purging the deleted documents of the SQL database")
+		}
+	}
+	result, err := m.querier(ctx).ExecContext(ctx, "DELETE FROM trash WHERE deleted_at < ?", sqlTime(before))
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the trash of the SQL database")
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the trash of the SQL database")
+	}
+	return purged, nil
+}
+
+//------REVISIONS------
+
+const revisionColumns = "id, entity_type, entity_id, number, actor, method, created_at, snapshot"
+
+var revisionSortColumns = map[string]string{"number": "number"}
+
+func (m *SQLDatabase) findRevisions(ctx context.Context, clause string, args []interface{}) (dto.RevisionsDTO, error) {
+	revisions := dto.RevisionsDTO{}
+	err := m.query(ctx, "SELECT "+revisionColumns+" FROM revisions"+clause, args, func(rows *sql.Rows) error {
+		revision := &dto.RevisionDTO{}
+		err := rows.Scan(&revision.ID, &revision.EntityType, &revision.EntityID, &revision.Number, &revision.Actor, &revision.Method, sqlTimeScanner{&revision.CreatedAt}, &revision.Snapshot)
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, revision)
+		return nil
+	})
+	return revisions, err
+}
+
+func (m *SQLDatabase) AddRevision(ctx context.Context, revision *dto.RevisionDTO) error {
+	err := m.insert(ctx, "revisions", map[string]interface{}{
+		"id":          revision.ID,
+		"entity_type": revision.EntityType,
+		"entity_id":   revision.EntityID,
+		"number":      revision.Number,
+		"actor":       revision.Actor,
+		"method":      revision.Method,
+		"created_at":  sqlTime(revision.CreatedAt),
+		"snapshot":    revision.Snapshot,
+	})
+	if err != nil {
+		return errors.Wrap(sqlError(err), "Error while inserting the revision in the SQL database")
+	}
+	return nil
+}
+
+func (m *SQLDatabase) GetRevision(ctx context.Context, ID string) (*dto.RevisionDTO, error) {
+	revisions, err := m.findRevisions(ctx, " WHERE id = ?", []interface{}{ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting the revision from the SQL database")
+	}
+	if len(revisions) == 0 {
+		return nil, errors.Wrap(models.ErrNotFound, "Error while getting the revision from the SQL database")
+	}
+	return revisions[0], nil
+}
+
+func (m *SQLDatabase) ListRevisions(ctx context.Context, entityType string, entityID string, page dto.PageDTO) (dto.RevisionsDTO, int64, error) {
+	where := sqlWhere{}
+	where.add("entity_type = ?", entityType)
+	where.add("entity_id = ?", entityID)
+	total, err := m.count(ctx, "revisions", where)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting revisions in the SQL database")
+	}
+	page.SortBy, page.Descending = "number", true
+	clause, args, err := sqlPage(page, revisionSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	revisions, err := m.findRevisions(ctx, where.String()+clause, append(where.args, args...))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding revisions in the SQL database")
+	}
+	return revisions, total, nil
+}
+
+func (m *SQLDatabase) PruneRevisions(ctx context.Context, keep int) (int64, error) {
+	query := "DELETE FROM revisions WHERE number <= (SELECT MAX(latest.number) FROM revisions latest" +
+		" WHERE latest.entity_type = revisions.entity_type AND latest.entity_id = revisions.entity_id) - ?"
+	result, err := m.querier(ctx).ExecContext(ctx, query, keep)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while pruning the revisions of the SQL database")
+	}
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while pruning the revisions of the SQL database")
+	}
+	return pruned, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//go:embed migrations/sqlite/*.sql
+var sqliteMigrations embed.FS
+
+// sqlMigration is an embedded migration file, named VERSION_NAME.sql.
+type sqlMigration struct {
+	Version int
+	Name    string
+	File    string
+}
+
+func listSQLMigrations() ([]sqlMigration, error) {
+	entries, err := sqliteMigrations.ReadDir("migrations/sqlite")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while reading the SQL migrations")
+	}
+	migrations := []sqlMigration{}
+	for _, entry := range entries {
+		number, name, _ := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), "_")
+		version, err := strconv.Atoi(number)
+		if err != nil {
+			return nil, errors.Errorf("The SQL migration %s does not start with a version", entry.Name())
+		}
+		migrations = append(migrations, sqlMigration{Version: version, Name: name, File: path.Join("migrations/sqlite", entry.Name())})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrateSQL brings the schema of a SQLite database up to date with the
+// embedded migrations. The applied versions are recorded in
+// schema_migrations, and each pending migration runs in its own transaction
+// so a failed one leaves the database at the previous version.
+func MigrateSQL(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	name       TEXT NOT NULL,
+	applied_at TEXT NOT NULL
+)`)
+	if err != nil {
+		return errors.Wrap(err, "Error while creating the schema_migrations table")
+	}
+	migrations, err := listSQLMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		err := func() error {
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return err
+			}
+			defer tx.Rollback()
+
+			var applied int
+			err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_migrations WHERE version = ?", migration.Version).Scan(&applied)
+			if err != nil || applied > 0 {
+				return err
+			}
+			script, err := sqliteMigrations.ReadFile(migration.File)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+				migration.Version, migration.Name, sqlTime(time.Now()))
+			if err != nil {
+				return err
+			}
+			return tx.Commit()
+		}()
+		if err != nil {
+			return errors.Wrapf(err, "Error while applying the SQL migration %d %s", migration.Version, migration.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func appliedSQLMigrations(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+	rows, err := db.Query("SELECT version FROM schema_migrations ORDER BY version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	versions := []int{}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return versions
+}
+
+// TestMigrateSQL checks the migrations are applied once each, and that a
+// failed one is rolled back without being recorded.
+func TestMigrateSQL(t *testing.T) {
+	ctx := context.Background()
+	migrations, err := listSQLMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{}
+	for i, migration := range migrations {
+		if migration.Version != i+1 || migration.Name == "" {
+			t.Errorf("the SQL migration %d is %+v, want version %d with a name", i, migration, i+1)
+		}
+		want = append(want, migration.Version)
+	}
+
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "catalog.db")+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for i := 0; i < 2; i++ {
+		if err := MigrateSQL(ctx, db); err != nil {
+			t.Fatalf("run %d: %v", i+1, err)
+		}
+		if applied := appliedSQLMigrations(t, db); !reflect.DeepEqual(applied, want) {
+			t.Fatalf("run %d applied the migrations %v, want %v", i+1, applied, want)
+		}
+	}
+
+	// Forgetting the revisions migration runs it again, on a table that
+	// already exists.
+	if _, err := db.Exec("DELETE FROM schema_migrations WHERE version = 3"); err != nil {
+		t.Fatal(err)
+	}
+	err = MigrateSQL(ctx, db)
+	if err == nil || !strings.Contains(err.Error(), "Error while applying the SQL migration 3 revisions") {
+		t.Fatalf("the failed migration returned %v", err)
+	}
+	if applied := appliedSQLMigrations(t, db); !reflect.DeepEqual(applied, []int{1, 2, 4}) {
+		t.Errorf("the failed migration left the migrations %v recorded", applied)
+	}
+}
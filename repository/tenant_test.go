@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/tenant"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoTestURIEnv names the Mongo server the tenant isolation tests run
+// against. They are skipped when it is not set.
+const mongoTestURIEnv = "INT_SERVICE_TEST_MONGO_URI"
+
+func TestMongoTenantDatabases(t *testing.T) {
+	client, err := mongo.NewClient(options.Client().ApplyURI("mongodb://localhost"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMongoDB(client, MongoNames{Database: "Project", ClothingDatabase: "Clothing", Collections: map[string]string{"Shows": "Programs"}}).(*MongoDatabase)
+
+	tests := []struct {
+		tenantID string
+		catalog  string
+		clothing string
+	}{
+		{tenantID: "", catalog: "Project", clothing: "Clothing"},
+		{tenantID: "acme", catalog: "Project_acme", clothing: "Clothing_acme"},
+		{tenantID: "globex", catalog: "Project_globex", clothing: "Clothing_globex"},
+	}
+	for _, tt := range tests {
+		ctx := tenant.ContextWithID(context.Background(), tt.tenantID)
+		for _, name := range MongoCollections {
+			if name == "Summer" {
+				continue
+			}
+			if got := m.collection(ctx, name).Database().Name(); got != tt.catalog {
+				t.Errorf("tenant %q: collection %s is in database %s, want %s", tt.tenantID, name, got, tt.catalog)
+			}
+		}
+		if got := m.collection(ctx, "Shows").Name(); got != "Programs" {
+			t.Errorf("tenant %q: Shows collection is named %s, want Programs", tt.tenantID, got)
+		}
+		if got := m.clothes(ctx).Database().Name(); got != tt.clothing {
+			t.Errorf("tenant %q: clothes are in database %s, want %s", tt.tenantID, got, tt.clothing)
+		}
+	}
+}
+
+// TestMongoTenantIsolation writes the catalog of one tenant and checks the
+// other tenant can neither read nor write it.
+func TestMongoTenantIsolation(t *testing.T) {
+	uri := os.Getenv(mongoTestURIEnv)
+	if uri == "" {
+		t.Skip(mongoTestURIEnv + " is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect(context.Background())
+
+	names := DefaultMongoNames()
+	names.Database = "IntServiceTenantTest"
+	names.ClothingDatabase = "IntServiceTenantTestClothing"
+	repo := NewMongoDB(client, names)
+	acme := tenant.ContextWithID(ctx, "acme")
+	globex := tenant.ContextWithID(ctx, "globex")
+	for _, tenantID := range []string{"acme", "globex"} {
+		tenantID := tenantID
+		t.Cleanup(func() { client.Database(names.TenantDatabase(tenantID)).Drop(context.Background()) })
+	}
+
+	created, err := repo.CreateGenre(acme, &dto.GenreDTO{ID: "genre-acme", Name: "Drama", Description: "Of acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.GetGenre(globex, created.ID); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("globex read the genre of acme by id: %v", err)
+	}
+	if _, err := repo.GetGenreByName(globex, "Drama"); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("globex read the genre of acme by name: %v", err)
+	}
+	if genres, total, err := repo.ListGenres(globex, dto.PageDTO{}); err != nil || len(genres) != 0 || total != 0 {
+		t.Errorf("globex listed %d genres of %d: %v", len(genres), total, err)
+	}
+	// Writes of globex miss the genre of acme, whatever they answer.
+	repo.UpdateGenre(globex, &dto.GenreDTO{ID: created.ID, Name: "Drama", Description: "Of globex"})
+	repo.DeleteGenre(globex, created.ID)
+
+	got, err := repo.GetGenre(acme, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Description != "Of acme" || got.DeletedAt != nil {
+		t.Errorf("globex changed the genre of acme to %+v", got)
+	}
+
+	if _, err := repo.CreateGenre(globex, &dto.GenreDTO{ID: "genre-globex", Name: "Drama", Description: "Of globex"}); err != nil {
+		t.Errorf("globex cannot create a genre named like one of acme: %v", err)
+	}
+	if genres, _, err := repo.ListGenres(acme, dto.PageDTO{}); err != nil || len(genres) != 1 {
+		t.Errorf("acme lists %d genres: %v", len(genres), err)
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TrashRepository keeps the deleted documents until they are restored or
+// purged. Deleting a document only marks it with its deletedAt time, which
+// every Get, List, Search and update of the document skips, and the delete
+// is added to the trash with the context of the transaction deleting it.
+type TrashRepository interface {
+	AddTrashItem(ctx context.Context, item *dto.TrashItemDTO) error
+	// RestoreDocument clears the deletedAt mark of a deleted document.
+	RestoreDocument(ctx context.Context, entityType string, ID string) error
+	GetTrashItem(ctx context.Context, entityType string, ID string) (*dto.TrashItemDTO, error)
+	// ListTrashItems returns the matching items, most recently deleted
+	// first.
+	ListTrashItems(ctx context.Context, filter dto.TrashFilterDTO, page dto.PageDTO) (dto.TrashItemsDTO, int64, error)
+	DeleteTrashItem(ctx context.Context, entityType string, ID string) error
+	// PurgeTrashItems deletes the items deleted before the given time, and
+	// the documents marked deleted before it for good, and returns how many
+	// items there were.
+	PurgeTrashItems(ctx context.Context, before time.Time) (int64, error)
+}
+
+// notDeleted matches the documents that are not in the trash.
+var notDeleted = bson.E{Key: "deletedAt", Value: bson.D{bson.E{Key: "$exists", Value: false}}}
+
+// deletedBefore matches the documents put in the trash before the given time.
+func deletedBefore(before time.Time) bson.D {
+	return bson.D{bson.E{Key: "deletedAt", Value: bson.D{bson.E{Key: "$lt", Value: before}}}}
+}
+
+// markDeleted puts the document ID of collection in the trash.
+func (m *MongoDatabase) markDeleted(ctx context.Context, collection string, ID string) error {
+	filter := bson.D{bson.E{Key: "id", Value: ID}, notDeleted}
+	update := bson.D{bson.E{Key: "$set", Value: bson.D{bson.E{Key: "deletedAt", Value: time.Now().UTC()}}}}
+	result, err := m.collection(ctx, collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func trashQuery(filter dto.TrashFilterDTO) bson.D {
+	query := bson.D{}
+	if filter.EntityType != "" {
+		query = append(query, bson.E{Key: "entityType", Value: filter.EntityType})
+	}
+	if filter.ParentID != "" {
+		query = append(query, bson.E{Key: "parentId", Value: filter.ParentID})
+	}
+	return query
+}
+
+func (m *MongoDatabase) AddTrashItem(ctx context.Context, item *dto.TrashItemDTO) error {
+	collection := m.collection(ctx, "Trash")
+	if _, err := collection.InsertOne(ctx, item); err != nil {
+		return errors.Wrap(mongoError(err), "Error while inserting the trash item in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) RestoreDocument(ctx context.Context, entityType string, ID string) error {
+	source, ok := changeSourceOf(entityType)
+	if !ok {
+		return errors.Wrap(models.ErrInvalidArgument, "Unknown entity type "+entityType)
+	}
+	filter := bson.D{bson.E{Key: "id", Value: ID}, bson.E{Key: "deletedAt", Value: bson.D{bson.E{Key: "$exists", Value: true}}}}
+	update := bson.D{bson.E{Key: "$unset", Value: bson.D{bson.E{Key: "deletedAt", Value: ""}}}}
+	result, err := m.collection(ctx, source.Collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return errors.Wrap(err, "Error while restoring the "+entityType+" in the Mongo database")
+	}
+	if result.MatchedCount == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while restoring the "+entityType+" in the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) GetTrashItem(ctx context.Context, entityType string, ID string) (*dto.TrashItemDTO, error) {
+	collection := m.collection(ctx, "Trash")
+	item := &dto.TrashItemDTO{}
+	filter := bson.D{bson.E{Key: "entityType", Value: entityType}, bson.E{Key: "id", Value: ID}}
+	if err := collection.FindOne(ctx, filter).Decode(item); err != nil {
+		return nil, errors.Wrap(mongoError(err), "Error while getting the trash item from the Mongo database")
+	}
+	return item, nil
+}
+
+func (m *MongoDatabase) ListTrashItems(ctx context.Context, filter dto.TrashFilterDTO, page dto.PageDTO) (dto.TrashItemsDTO, int64, error) {
+	collection := m.collection(ctx, "Trash")
+	items := dto.TrashItemsDTO{}
+	query := trashQuery(filter)
+
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while counting trash items in the Mongo database")
+	}
+	page.SortBy, page.Descending = "deletedAt", true
+	cursor, err := collection.Find(ctx, query, findOptions(page))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error while finding trash items in the Mongo database")
+	}
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, 0, errors.Wrap(err, "Error while decoding trash items from the Mongo database")
+	}
+	return items, total, nil
+}
+
+func (m *MongoDatabase) DeleteTrashItem(ctx context.Context, entityType string, ID string) error {
+	collection := m.collection(ctx, "Trash")
+	filter := bson.D{bson.E{Key: "entityType", Value: entityType}, bson.E{Key: "id", Value: ID}}
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return errors.Wrap(err, "Error while deleting the trash item from the Mongo database")
+	}
+	if result.DeletedCount == 0 {
+		return errors.Wrap(models.ErrNotFound, "Error while deleting the trash item from the Mongo database")
+	}
+	return nil
+}
+
+func (m *MongoDatabase) PurgeTrashItems(ctx context.Context, before time.Time) (int64, error) {
+	result, err := m.collection(ctx, "Trash").DeleteMany(ctx, deletedBefore(before))
+	if err != nil {
+		return 0, errors.Wrap(err, "Error while purging the trash of the Mongo database")
+	}
+	for _, source := range changeSources {
+		if _, err := m.collection(ctx, source.Collection).DeleteMany(ctx, deletedBefore(before)); err != nil {
+			return 0, errors.Wrap(err, "Error while purging the deleted "+source.Collection+" of the Mongo database")
+		}
+	}
+	return result.DeletedCount, nil
+}
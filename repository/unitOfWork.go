@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// UnitOfWork groups repository calls into one all-or-nothing operation.
+type UnitOfWork interface {
+	// WithTransaction calls fn with a context that makes every repository call
+	// made with it part of one transaction. The transaction commits when fn
+	// returns nil and rolls back otherwise. Calling WithTransaction with the
+	// context of a running transaction joins it.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// WithTransaction runs fn in a Mongo transaction, which needs a replica set
+// or a sharded cluster. The driver retries fn on transient transaction errors.
+func (m *MongoDatabase) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+	session, err := m.client.StartSession()
+	if err != nil {
+		return errors.Wrap(err, "Error while starting a Mongo session")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
+
+type catalogTransactionKey struct {
+	db *CatalogDatabase
+}
+
+func (m *CatalogDatabase) transaction(ctx context.Context) *catalog {
+	transaction, _ := ctx.Value(catalogTransactionKey{db: m}).(*catalog)
+	return transaction
+}
+
+// WithTransaction holds the store for the whole of fn and lets it work on a
+// copy of the catalog, which replaces the stored one only when fn succeeds.
+func (m *CatalogDatabase) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.transaction(ctx) != nil {
+		return fn(ctx)
+	}
+	defer m.search.invalidate()
+	return m.store.update(func(c *catalog) error {
+		working := catalog{}
+		if err := clone(c, &working); err != nil {
+			return errors.Wrap(err, "Error while copying the catalog")
+		}
+		if err := fn(context.WithValue(ctx, catalogTransactionKey{db: m}, &working)); err != nil {
+			return err
+		}
+		*c = working
+		return m.changes.publish(c)
+	})
+}
+
+type sqlTransactionKey struct {
+	db *SQLDatabase
+}
+
+func (m *SQLDatabase) transaction(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(sqlTransactionKey{db: m}).(*sql.Tx)
+	return tx
+}
+
+// WithTransaction runs fn in a SQL transaction. The database is opened with
+// immediate transactions, so the ones writing wait for each other on begin
+// rather than failing on their first write.
+func (m *SQLDatabase) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.transaction(ctx) != nil {
+		return fn(ctx)
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "Error while starting a SQL transaction")
+	}
+	if err := fn(context.WithValue(ctx, sqlTransactionKey{db: m}, tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "Error while committing the SQL transaction")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"testing"
+)
+
+// TestTransactionRollback fails a unit of work after some of its writes and
+// checks none of them is kept, while a unit of work that succeeds keeps all
+// of them.
+func TestTransactionRollback(t *testing.T) {
+	failure := errors.New("failure midway")
+	for name, repo := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			createShow(t, repo, &dto.ShowDTO{ID: "s1", Title: "Dark"})
+			createSeason(t, repo, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1"})
+
+			err := repo.WithTransaction(ctx, func(ctx context.Context) error {
+				if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"}); err != nil {
+					return err
+				}
+				if err := repo.DeleteSeason(ctx, "se1"); err != nil {
+					return err
+				}
+				// A nested unit of work joins the outer one, and is rolled
+				// back with it.
+				err := repo.WithTransaction(ctx, func(ctx context.Context) error {
+					return repo.RemoveShortSeason(ctx, "se1")
+				})
+				if err != nil {
+					return err
+				}
+				if _, err := repo.GetSeason(ctx, "se1"); err == nil {
+					t.Error("the unit of work does not read its own deletion")
+				}
+				return failure
+			})
+			if !errors.Is(err, failure) {
+				t.Fatalf("WithTransaction returned %v, want the error of the unit of work", err)
+			}
+			_, err = repo.GetGenre(ctx, "g1")
+			checkNotFound(t, "GetGenre of a rolled back genre", err)
+			if _, err := repo.GetSeason(ctx, "se1"); err != nil {
+				t.Errorf("GetSeason of a rolled back deletion returned %v", err)
+			}
+			show, err := repo.GetShow(ctx, "s1")
+			check(t, err)
+			if len(show.Seasons) != 1 {
+				t.Errorf("the show holds %d short seasons after the rollback, want 1", len(show.Seasons))
+			}
+
+			check(t, repo.WithTransaction(ctx, func(ctx context.Context) error {
+				if _, err := repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"}); err != nil {
+					return err
+				}
+				return repo.DeleteSeason(ctx, "se1")
+			}))
+			_, err = repo.GetGenre(ctx, "g1")
+			check(t, err)
+			_, err = repo.GetSeason(ctx, "se1")
+			checkNotFound(t, "GetSeason of a committed deletion", err)
+		})
+	}
+}
@@ -34,7 +34,7 @@ func (x *XMLType) WriteFile(fileName string, fileData interface{}) error {
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(fileName+x.extension, data, 0644)
+	err = writeFileAtomic(fileName+x.extension, data, 0644)
 	if err != nil {
 		return err
 	}
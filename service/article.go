@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"int-service/auth"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,22 +16,39 @@ type ArticleServicer interface {
 	CreateArticle(ctx context.Context, title string, releaseDate time.Time, postersPath []string, description string, journalistName string) (models.ResponseModeler, error)
 	GetArticle(ctx context.Context, ID string) (models.ResponseModeler, error)
 	UpdateArticle(ctx context.Context, ID string, title string, releaseDate time.Time, postersPath []string, description string, journalistModel *models.Journalist) (models.ResponseModeler, error)
-	ListArticles(ctx context.Context,elementCount int) ([]models.ResponseModeler, error)
+	ListArticles(ctx context.Context, filter models.ArticleFilter, options models.ListOptions) (*models.ListResult, error)
 	ListArticlesByJournalist(ctx context.Context, journalistID string) ([]models.ResponseModeler, error)
 	UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteArticlePoster(ctx context.Context, ID string, image string) error
+	DeleteArticle(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateArticle(ctx context.Context, title string, releaseDate time.Time, postersPath []string, description string, journalistName string) (models.ResponseModeler, error) {
 	search, err := s.repository.GetJournalistByName(ctx, journalistName)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error while getting journalist with name : "+search.Name)
+		s.log(ctx).Error("Error while getting journalist with name : " + journalistName)
+		return nil, errors.Wrap(err, "Error while getting journalist with name : "+journalistName)
+	}
+	if err := checkArticleAuthor(ctx, search.ID); err != nil {
+		return nil, err
 	}
 	article := toArticleDTO(uuid.New().String(), title, releaseDate, postersPath, description, search.ID)
-	resp, err := s.repository.CreateArticle(ctx, article)
+	var resp *dto.ArticleDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.CreateArticle(ctx, article)
+		if err != nil {
+			s.log(ctx).Error("Error while creating article")
+			return errors.Wrap(err, "Error while creating article")
+		}
+		if err := s.addRevision(ctx, repository.ArticleEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the article revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while creating article")
-		return nil, errors.Wrap(err, "Error while creating article")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -37,40 +56,76 @@ func (s *projectService) CreateArticle(ctx context.Context, title string, releas
 func (s *projectService) GetArticle(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetArticle(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting article by id")
+		s.log(ctx).Error("Error while getting article by id")
 		return nil, errors.Wrap(err, "Error while getting article by id")
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) UpdateArticle(ctx context.Context, ID string, title string, releaseDate time.Time, postersPath []string, description string, journalistModel *models.Journalist) (models.ResponseModeler, error) {
+	if err := s.checkArticleOwner(ctx, ID); err != nil {
+		return nil, err
+	}
+	if err := checkArticleAuthor(ctx, journalistModel.ID); err != nil {
+		return nil, err
+	}
 	updatedArticle := toArticleDTO(ID, title, releaseDate, postersPath, description, journalistModel.ID)
-	resp, err := s.repository.UpdateArticle(ctx, updatedArticle)
+	var resp *dto.ArticleDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if _, err := s.repository.UpdateArticle(ctx, updatedArticle); err != nil {
+			s.log(ctx).Error("Error while updating article")
+			return errors.Wrap(err, "Error while updating article")
+		}
+		// The update does not fail on a missing article, reading it back does.
+		resp, err = s.repository.GetArticle(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting article by id")
+			return errors.Wrap(err, "Error while getting article by id")
+		}
+		if err := s.addRevision(ctx, repository.ArticleEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the article revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating article")
-		return nil, errors.New("Error while updating article")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) ListArticles(ctx context.Context,elementCount int) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListArticles(ctx,elementCount)
+func (s *projectService) ListArticles(ctx context.Context, filter models.ArticleFilter, options models.ListOptions) (*models.ListResult, error) {
+	if options.SortBy == "" {
+		options.SortBy = "releaseDate"
+		options.Descending = true
+	}
+	page, err := toPageDTO(options, articleSortFields)
+	if err != nil {
+		return nil, err
+	}
+	filterDTO := dto.ArticleFilterDTO{
+		JournalistID:   filter.JournalistID,
+		ReleasedAfter:  filter.ReleasedAfter,
+		ReleasedBefore: filter.ReleasedBefore,
+	}
+	resp, total, err := s.repository.ListArticles(ctx, filterDTO, page)
 	if err != nil {
-		s.logger.Error("Error while listing all articles")
-		return nil, errors.New("Error while listing all articles")
+		s.log(ctx).Error("Error while listing all articles")
+		return nil, errors.Wrap(err, "Error while listing all articles")
 	}
 	articles := []models.ResponseModeler{}
 	for _, article := range resp {
 		articles = append(articles, article.ToModel())
 	}
-	return articles, nil
+	return newListResult(articles, page, total), nil
 }
 
 func (s *projectService) ListArticlesByJournalist(ctx context.Context, journalistID string) ([]models.ResponseModeler, error) {
 	resp, err := s.repository.ListArticlesByJournalist(ctx, journalistID)
 	if err != nil {
-		s.logger.Error("Error while listing all articles by journalist Id")
-		return nil, errors.New("Error while listing all articles by journalist Id")
+		s.log(ctx).Error("Error while listing all articles by journalist Id")
+		return nil, errors.Wrap(err, "Error while listing all articles by journalist Id")
 	}
 	articles := []models.ResponseModeler{}
 	for _, article := range resp {
@@ -80,19 +135,102 @@ func (s *projectService) ListArticlesByJournalist(ctx context.Context, journalis
 }
 
 func (s *projectService) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadArticlePosters(ctx, ID, postersPath)
+	if err := s.checkArticleOwner(ctx, ID); err != nil {
+		return nil, err
+	}
+	var resp *dto.ArticleDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadArticlePosters(ctx, ID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while updating article posters")
+			return errors.Wrap(err, "Error while updating article posters")
+		}
+		return s.addArticleRevision(ctx, ID)
+	})
 	if err != nil {
-		s.logger.Error("Error while updating article posters")
-		return nil, errors.Wrap(err, "Error while updating article posters")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteArticlePoster(ctx context.Context, ID string, image string) error {
-	err := s.repository.DeleteArticlePoster(ctx, ID, image)
+	if err := s.checkArticleOwner(ctx, ID); err != nil {
+		return err
+	}
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteArticlePoster(ctx, ID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while updating deleted article poster in database")
+			return errors.Wrap(err, "Error while updating deleted article poster in database")
+		}
+		return s.addArticleRevision(ctx, ID)
+	})
+}
+
+// addArticleRevision records the article ID as stored after a change of its
+// posters.
+func (s *projectService) addArticleRevision(ctx context.Context, ID string) error {
+	article, err := s.repository.GetArticle(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while updating deleted article poster in database")
-		return errors.Wrap(err, "Error while updating deleted article poster in database")
+		s.log(ctx).Error("Error while getting article by id")
+		return errors.Wrap(err, "Error while getting article by id")
+	}
+	if err := s.addRevision(ctx, repository.ArticleEntity, ID, article); err != nil {
+		s.log(ctx).Error("Error while recording the article revision")
+		return err
+	}
+	return nil
+}
+
+func (s *projectService) DeleteArticle(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		if err := s.checkArticleOwner(ctx, ID); err != nil {
+			return err
+		}
+		article, err := s.repository.GetArticle(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting article by id")
+			return errors.Wrap(err, "Error while getting article by id")
+		}
+		if err := s.repository.DeleteArticle(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting article")
+			return errors.Wrap(err, "Error while deleting article")
+		}
+		trashed := &trashedDocuments{Articles: dto.ArticlesDTO{article}}
+		if err := s.addToTrash(ctx, repository.ArticleEntity, ID, deletedAt, article.Title, article.Journalist.ID, trashed); err != nil {
+			s.log(ctx).Error("Error while adding article to the trash")
+			return err
+		}
+		return nil
+	})
+}
+
+// checkArticleOwner rejects callers restricted to their own articles when
+// the stored article was written by another journalist.
+func (s *projectService) checkArticleOwner(ctx context.Context, ID string) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil || !principal.OwnArticlesOnly() {
+		return nil
+	}
+	article, err := s.repository.GetArticle(ctx, ID)
+	if err != nil {
+		s.log(ctx).Error("Error while getting article by id")
+		return errors.Wrap(err, "Error while getting article by id")
+	}
+	return checkArticleAuthor(ctx, article.Journalist.ID)
+}
+
+// checkArticleAuthor rejects callers restricted to their own articles when
+// journalistID, the ShortJournalist ID of an article, is not theirs.
+func checkArticleAuthor(ctx context.Context, journalistID string) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil || !principal.OwnArticlesOnly() {
+		return nil
+	}
+	if principal.JournalistID == "" || principal.JournalistID != journalistID {
+		return errors.Wrap(models.ErrPermissionDenied, "A journalist can only write their own articles")
 	}
 	return nil
 }
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"int-service/auth"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"testing"
+	"time"
+)
+
+func TestCheckArticleAuthor(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		allowed   bool
+	}{
+		{name: "no principal", allowed: true},
+		{name: "viewer", principal: &auth.Principal{Roles: []string{auth.RoleViewer}}, allowed: true},
+		{name: "author", principal: &auth.Principal{Roles: []string{auth.RoleJournalist}, JournalistID: "j1"}, allowed: true},
+		{name: "other journalist", principal: &auth.Principal{Roles: []string{auth.RoleJournalist}, JournalistID: "j2"}},
+		{name: "journalist without journalist", principal: &auth.Principal{Roles: []string{auth.RoleJournalist}}},
+		{name: "journalist and editor", principal: &auth.Principal{Roles: []string{auth.RoleJournalist, auth.RoleEditor}, JournalistID: "j2"}, allowed: true},
+		{name: "journalist and admin", principal: &auth.Principal{Roles: []string{auth.RoleJournalist, auth.RoleAdmin}}, allowed: true},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.principal != nil {
+			ctx = auth.ContextWithPrincipal(ctx, tt.principal)
+		}
+		err := checkArticleAuthor(ctx, "j1")
+		if tt.allowed && err != nil {
+			t.Errorf("%s: checkArticleAuthor returned %v", tt.name, err)
+		}
+		if !tt.allowed && !errors.Is(err, models.ErrPermissionDenied) {
+			t.Errorf("%s: checkArticleAuthor returned %v, want permission denied", tt.name, err)
+		}
+	}
+}
+
+// TestArticleOwnership checks a journalist only writes their own articles,
+// and cannot hand an article over to another journalist.
+func TestArticleOwnership(t *testing.T) {
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+	release := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	writes := []error{
+		second(repo.CreateJournalist(context.Background(), &dto.JournalistDTO{ID: "j1", Name: "Ada"})),
+		second(repo.CreateJournalist(context.Background(), &dto.JournalistDTO{ID: "j2", Name: "Grace"})),
+		second(repo.CreateArticle(context.Background(), &dto.ArticleDTO{ID: "a1", Title: "Own", ReleaseDate: release, Journalist: dto.ShortJournalistDTO{ID: "j1"}})),
+		second(repo.CreateArticle(context.Background(), &dto.ArticleDTO{ID: "a2", Title: "Other", ReleaseDate: release, Journalist: dto.ShortJournalistDTO{ID: "j2"}})),
+	}
+	for _, err := range writes {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{Subject: "ada", Roles: []string{auth.RoleJournalist}, JournalistID: "j1"})
+	s := svc.(*projectService)
+
+	if err := s.checkArticleOwner(ctx, "a1"); err != nil {
+		t.Errorf("checkArticleOwner of an own article returned %v", err)
+	}
+	if err := s.checkArticleOwner(ctx, "a2"); !errors.Is(err, models.ErrPermissionDenied) {
+		t.Errorf("checkArticleOwner of another article returned %v, want permission denied", err)
+	}
+	if err := s.checkArticleOwner(ctx, "missing"); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("checkArticleOwner of a missing article returned %v, want not found", err)
+	}
+	if err := s.checkArticleOwner(context.Background(), "missing"); err != nil {
+		t.Errorf("checkArticleOwner without a principal returned %v", err)
+	}
+
+	if _, err := svc.UpdateArticle(ctx, "a1", "Own, revised", release, nil, "", &models.Journalist{ID: "j1"}); err != nil {
+		t.Errorf("UpdateArticle of an own article returned %v", err)
+	}
+	denied := []struct {
+		name string
+		err  error
+	}{
+		{"CreateArticle as another journalist", second(svc.CreateArticle(ctx, "Ghost", release, nil, "", "Grace"))},
+		{"UpdateArticle of another article", second(svc.UpdateArticle(ctx, "a2", "Taken", release, nil, "", &models.Journalist{ID: "j1"}))},
+		{"UpdateArticle handing it over", second(svc.UpdateArticle(ctx, "a1", "Given", release, nil, "", &models.Journalist{ID: "j2"}))},
+		{"DeleteArticle of another article", svc.DeleteArticle(ctx, "a2")},
+	}
+	for _, tt := range denied {
+		if !errors.Is(tt.err, models.ErrPermissionDenied) {
+			t.Errorf("%s returned %v, want permission denied", tt.name, tt.err)
+		}
+	}
+	if article, err := repo.GetArticle(context.Background(), "a2"); err != nil || article.Title != "Other" {
+		t.Errorf("the article of another journalist is %+v, %v", article, err)
+	}
+	if article, err := repo.GetArticle(context.Background(), "a1"); err != nil || article.Journalist.ID != "j1" {
+		t.Errorf("the own article is %+v, %v", article, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,23 +26,36 @@ const (
 type CelebrityServicer interface {
 	CreateCelebrity(ctx context.Context, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string) (models.ResponseModeler, error)
 	GetCelebrity(ctx context.Context, ID string) (models.ResponseModeler, error)
-	UpdateCelebrity(ctx context.Context, ID string, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string) (models.ResponseModeler, error)
+	UpdateCelebrity(ctx context.Context, ID string, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string, updateMask []string) (models.ResponseModeler, error)
 	UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteCelebrityPoster(ctx context.Context, ID string, image string) error
-	ListCelebrities(ctx context.Context) ([]models.ResponseModeler, error)
+	ListCelebrities(ctx context.Context, filter models.CelebrityFilter, options models.ListOptions) (*models.ListResult, error)
+	DeleteCelebrity(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateCelebrity(ctx context.Context, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string) (models.ResponseModeler, error) {
 	err := s.validateCelebrityUniqueness(ctx, name, dateOfBirth)
 	if err != nil {
-		s.logger.Error("Error while creating celebrity")
+		s.log(ctx).Error("Error while creating celebrity")
 		return nil, errors.Wrap(err, "Error while creating celebrity")
 	}
 	celebrity := toCelebrityDTO(uuid.New().String(), name, occupation, postersPath, dateOfBirth, dateOfDeath, placeOfBirth, genderModel, bio)
-	resp, err := s.repository.CreateCelebrity(ctx, celebrity)
+	var resp *dto.CelebrityDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.CreateCelebrity(ctx, celebrity)
+		if err != nil {
+			s.log(ctx).Error("Error while creating celebrity")
+			return errors.Wrap(err, "Error while creating celebrity")
+		}
+		if err := s.addRevision(ctx, repository.CelebrityEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the celebrity revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while creating celebrity")
-		return nil, errors.Wrap(err, "Error while creating celebrity")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -49,100 +63,205 @@ func (s *projectService) CreateCelebrity(ctx context.Context, name string, occup
 func (s *projectService) GetCelebrity(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetCelebrity(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting celebrity by id")
+		s.log(ctx).Error("Error while getting celebrity by id")
 		return nil, errors.Wrap(err, "Error while getting celebrity by id")
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) UpdateCelebrity(ctx context.Context, ID string, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string) (models.ResponseModeler, error) {
+func (s *projectService) UpdateCelebrity(ctx context.Context, ID string, name string, occupation []string, postersPath []string, dateOfBirth time.Time, dateOfDeath time.Time, placeOfBirth string, genderModel *models.Gender, bio string, updateMask []string) (models.ResponseModeler, error) {
 	updatedCelebrity := toCelebrityDTO(ID, name, occupation, postersPath, dateOfBirth, dateOfDeath, placeOfBirth, genderModel, bio)
-	resp, err := s.repository.UpdateCelebrity(ctx, updatedCelebrity)
+	fields, err := toUpdateFields(updateMask, repository.CelebrityFields)
 	if err != nil {
-		s.logger.Error("Error while updating celebrity")
-		return nil, errors.New("Error while updating celebrity")
-	}
-	shortCeleb := &dto.ShortCelebrityDTO{
-		ID:   resp.ID,
-		Name: resp.Name,
+		return nil, err
 	}
-	err = s.updateShortCelebrities(ctx, shortCeleb, occupation)
+	var resp *dto.CelebrityDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.repository.GetCelebrity(ctx, updatedCelebrity.ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting celebrity by id")
+			return errors.Wrap(err, "Error while getting celebrity by id")
+		}
+		resp, err = s.repository.UpdateCelebrity(ctx, updatedCelebrity, fields)
+		if err != nil {
+			s.log(ctx).Error("Error while updating celebrity")
+			return errors.Wrap(err, "Error while updating celebrity")
+		}
+		changed, err := changesAny(stored, resp, shortCelebrityFields)
+		if err != nil {
+			s.log(ctx).Error("Error while comparing the short celebrity")
+			return err
+		}
+		if changed {
+			shortCeleb := &dto.ShortCelebrityDTO{
+				ID:   resp.ID,
+				Name: resp.Name,
+			}
+			err = s.updateShortCelebrities(ctx, shortCeleb, resp.Occupation)
+			if err != nil {
+				s.log(ctx).Error("Error while updating short celebrity")
+				return errors.Wrap(err, "Error while updating short celebrity")
+			}
+		}
+		if err := s.addRevision(ctx, repository.CelebrityEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the celebrity revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating short celebrity")
-		return nil, errors.Wrap(err, "Error while updating short celebrity")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadCelebrityPosters(ctx, ID, postersPath)
-	if err != nil {
-		s.logger.Error("Error while uploading celebrity posters")
-		return nil, errors.Wrap(err, "Error while uploading celebrity posters")
-	}
-	celeb, err := s.repository.GetCelebrity(ctx, ID)
-	if err != nil {
-		s.logger.Error("Error while getting celebrity by id")
-		return nil, errors.Wrap(err, "Error while getting celebrity by id")
-	}
-	shortCeleb := &dto.ShortCelebrityDTO{
-		ID:          ID,
-		Name:        celeb.Name,
-		PostersPath: postersPath,
-	}
-	err = s.updateShortCelebrities(ctx, shortCeleb, celeb.Occupation)
+	var resp *dto.CelebrityDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadCelebrityPosters(ctx, ID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while uploading celebrity posters")
+			return errors.Wrap(err, "Error while uploading celebrity posters")
+		}
+		celeb, err := s.repository.GetCelebrity(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting celebrity by id")
+			return errors.Wrap(err, "Error while getting celebrity by id")
+		}
+		shortCeleb := &dto.ShortCelebrityDTO{
+			ID:          ID,
+			Name:        celeb.Name,
+			PostersPath: postersPath,
+		}
+		err = s.updateShortCelebrities(ctx, shortCeleb, celeb.Occupation)
+		if err != nil {
+			s.log(ctx).Error("Error while updating short celebrity posters")
+			return errors.Wrap(err, "Error while updating short celebrity posters")
+		}
+		if err := s.addRevision(ctx, repository.CelebrityEntity, celeb.ID, celeb); err != nil {
+			s.log(ctx).Error("Error while recording the celebrity revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating short celebrity posters")
-		return nil, errors.Wrap(err, "Error while updating short celebrity posters")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteCelebrityPoster(ctx context.Context, ID string, image string) error {
-	err := s.repository.DeleteCelebrityPoster(ctx, ID, image)
-	if err != nil {
-		s.logger.Error("Error while deleting celebrity poster in database")
-		return errors.Wrap(err, "Error while deleting celebrity poster in database")
-	}
-	celeb, err := s.repository.GetCelebrity(ctx, ID)
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteCelebrityPoster(ctx, ID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while deleting celebrity poster in database")
+			return errors.Wrap(err, "Error while deleting celebrity poster in database")
+		}
+		celeb, err := s.repository.GetCelebrity(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting celebrity by id")
+			return errors.Wrap(err, "Error while getting celebrity by id")
+		}
+		err = s.deleteShortCelebritiesPosters(ctx, ID, image, celeb.Occupation)
+		if err != nil {
+			s.log(ctx).Error("Error while deleting short celebrity posters")
+			return errors.Wrap(err, "Error while deleting short celebrity posters")
+		}
+		if err := s.addRevision(ctx, repository.CelebrityEntity, celeb.ID, celeb); err != nil {
+			s.log(ctx).Error("Error while recording the celebrity revision")
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *projectService) ListCelebrities(ctx context.Context, filter models.CelebrityFilter, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, celebritySortFields)
 	if err != nil {
-		s.logger.Error("Error while getting celebrity by id")
-		errors.Wrap(err, "Error while getting celebrity by id")
+		return nil, err
 	}
-	err = s.deleteShortCelebritiesPosters(ctx, ID, image, celeb.Occupation)
-	if err != nil {
-		s.logger.Error("Error while deleting short celebrity posters")
-		errors.Wrap(err, "Error while deleting short celebrity posters")
+	filterDTO := dto.CelebrityFilterDTO{
+		Occupation: filter.Occupation,
 	}
-	return nil
-}
-
-func (s *projectService) ListCelebrities(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListCelebrities(ctx)
+	resp, total, err := s.repository.ListCelebrities(ctx, filterDTO, page)
 	if err != nil {
-		s.logger.Error("Error while listing all celebrities")
-		return nil, errors.New("Error while listing all celebrities")
+		s.log(ctx).Error("Error while listing all celebrities")
+		return nil, errors.Wrap(err, "Error while listing all celebrities")
 	}
 	celebrities := []models.ResponseModeler{}
 	for _, celebrity := range resp {
 		celebrities = append(celebrities, celebrity.ToModel())
 	}
-	return celebrities, nil
+	return newListResult(celebrities, page, total), nil
+}
+
+func (s *projectService) DeleteCelebrity(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		celebrity, err := s.repository.GetCelebrity(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting celebrity by id")
+			return errors.Wrap(err, "Error while getting celebrity by id")
+		}
+		references, err := s.celebrityReferences(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while finding the credits of the celebrity")
+			return errors.Wrap(err, "Error while finding the credits of the celebrity")
+		}
+		if err := s.repository.DeleteCelebrity(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting celebrity")
+			return errors.Wrap(err, "Error while deleting celebrity")
+		}
+		if err := s.repository.RemoveShortCelebrityInShows(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short celebrity from shows")
+			return errors.Wrap(err, "Error while removing short celebrity from shows")
+		}
+		if err := s.repository.RemoveShortCelebrityInSeasons(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short celebrity from seasons")
+			return errors.Wrap(err, "Error while removing short celebrity from seasons")
+		}
+		if err := s.repository.RemoveShortCelebrityInEpisodes(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short celebrity from episodes")
+			return errors.Wrap(err, "Error while removing short celebrity from episodes")
+		}
+		trashed := &trashedDocuments{Celebrity: celebrity, References: references}
+		if err := s.addToTrash(ctx, repository.CelebrityEntity, ID, deletedAt, celebrity.Name, "", trashed); err != nil {
+			s.log(ctx).Error("Error while adding celebrity to the trash")
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *projectService) validateCelebrityUniqueness(ctx context.Context, name string, dateOfBirth time.Time) error {
-	celebs, err := s.repository.ListCelebrities(ctx)
+	celebs, err := s.findCelebrities(ctx, name)
 	if err != nil {
-		return errors.Wrap(err, "Error while getting celebrities from the Mongo database")
+		return err
 	}
 	for _, celeb := range celebs {
-		if celeb.Name == name && celeb.DateOfBirth == dateOfBirth {
-			return errors.New("There is already a celebrity with that name and date of birth.")
+		if celeb.DateOfBirth.Equal(dateOfBirth) {
+			return errors.Wrap(models.ErrAlreadyExists, "There is already a celebrity with that name and date of birth.")
 		}
 	}
 	return nil
 }
 
+// findCelebrities returns the celebrities named name.
+func (s *projectService) findCelebrities(ctx context.Context, name string) ([]*dto.CelebrityDTO, error) {
+	celebs, _, err := s.repository.ListCelebrities(ctx, dto.CelebrityFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting celebrities from the Mongo database")
+	}
+	named := []*dto.CelebrityDTO{}
+	for _, celeb := range celebs {
+		if celeb.Name == name {
+			named = append(named, celeb)
+		}
+	}
+	return named, nil
+}
+
 func (s *projectService) updateShortCelebrities(ctx context.Context, shortCeleb *dto.ShortCelebrityDTO, occupation []string) error {
 	celebrityType := ""
 	for _, occupationType := range occupation {
@@ -156,16 +275,16 @@ func (s *projectService) updateShortCelebrities(ctx context.Context, shortCeleb
 			celebrityType = producedBy
 		}
 		if _, err := s.repository.UpdateShortCelebritiesInShow(ctx, shortCeleb, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in show")
-			return errors.New("Error while updating short " + celebrityType + " in show")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in show")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in show")
 		}
 		if _, err := s.repository.UpdateShortCelebritiesInEpisode(ctx, shortCeleb, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in episode")
-			return errors.New("Error while updating short " + celebrityType + " in episode")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in episode")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in episode")
 		}
 		if _, err := s.repository.UpdateShortCelebritiesInSeasons(ctx, shortCeleb, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in season")
-			return errors.New("Error while updating short " + celebrityType + " in season")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in season")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in season")
 		}
 	}
 	return nil
@@ -184,16 +303,16 @@ func (s *projectService) deleteShortCelebritiesPosters(ctx context.Context, ID s
 			celebrityType = producedBy
 		}
 		if err := s.repository.DeleteShortCelebritiesPostersInShow(ctx, ID, image, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in show")
-			return errors.New("Error while updating short " + celebrityType + " in show")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in show")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in show")
 		}
 		if err := s.repository.DeleteShortCelebritiesPostersInEpisode(ctx, ID, image, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in episode")
-			return errors.New("Error while updating short " + celebrityType + " in episode")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in episode")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in episode")
 		}
 		if err := s.repository.DeleteShortCelebritiesPostersInSeason(ctx, ID, image, celebrityType); err != nil {
-			s.logger.Error("Error while updating short " + celebrityType + " in season")
-			return errors.New("Error while updating short " + celebrityType + " in season")
+			s.log(ctx).Error("Error while updating short " + celebrityType + " in season")
+			return errors.Wrap(err, "Error while updating short "+celebrityType+" in season")
 		}
 	}
 	return nil
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type ChangeServicer interface {
+	WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *models.ChangeEvent) error) error
+}
+
+// WatchChanges calls fn with the changes of the catalog until ctx is done or
+// fn fails. Errors of fn, such as a closed stream, are returned as is.
+func (s *projectService) WatchChanges(ctx context.Context, entityTypes []string, resumeToken string, fn func(event *models.ChangeEvent) error) error {
+	for _, entityType := range entityTypes {
+		if !containsString(repository.EntityTypes(), entityType) {
+			return models.NewFieldError("entityTypes", "must be one of "+strings.Join(repository.EntityTypes(), ", "))
+		}
+	}
+
+	var sendErr error
+	err := s.repository.WatchChanges(ctx, entityTypes, resumeToken, func(event *dto.ChangeEventDTO) error {
+		sendErr = fn(toChangeEventModel(event))
+		return sendErr
+	})
+	if err == nil || err == sendErr || errors.Is(err, models.ErrInvalidArgument) || errors.Is(err, models.ErrResourceExhausted) || ctx.Err() != nil {
+		return err
+	}
+	s.log(ctx).Error("Error while watching the catalog changes")
+	return errors.Wrap(err, "Error while watching the catalog changes")
+}
+
+func toChangeEventModel(event *dto.ChangeEventDTO) *models.ChangeEvent {
+	changeEvent := &models.ChangeEvent{
+		EntityType:  event.EntityType,
+		Operation:   event.Operation,
+		ID:          event.ID,
+		ResumeToken: event.ResumeToken,
+		Time:        event.Time,
+	}
+	switch document := event.Document.(type) {
+	case *dto.ShowDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.SeasonDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.EpisodeDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.CelebrityDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.ArticleDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.GenreDTO:
+		changeEvent.Document = document.ToModel()
+	case *dto.JournalistDTO:
+		changeEvent.Document = document.ToModel()
+	}
+	return changeEvent
+}
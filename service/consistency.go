@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"int-service/dto"
+	"int-service/models"
+
+	"github.com/pkg/errors"
+)
+
+type ConsistencyServicer interface {
+	CheckConsistency(ctx context.Context, repair bool) (*models.ConsistencyReport, error)
+}
+
+// CheckConsistency compares every short document embedded in shows, seasons
+// and episodes with the entity it copies. With repair, the documents holding
+// stale or dangling copies get their embedded lists rewritten from the
+// sources; dangling copies are dropped.
+func (s *projectService) CheckConsistency(ctx context.Context, repair bool) (*models.ConsistencyReport, error) {
+	shows, _, err := s.repository.ListShows(ctx, dto.ShowFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		s.log(ctx).Error("Error while listing all shows")
+		return nil, errors.Wrap(err, "Error while listing all shows")
+	}
+	seasons, _, err := s.repository.ListSeasonsCollection(ctx, dto.SeasonFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		s.log(ctx).Error("Error while listing all seasons")
+		return nil, errors.Wrap(err, "Error while listing all seasons")
+	}
+	episodes, _, err := s.repository.ListCollectionEpisodes(ctx, dto.EpisodeFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		s.log(ctx).Error("Error while listing all episodes")
+		return nil, errors.Wrap(err, "Error while listing all episodes")
+	}
+	celebrities, _, err := s.repository.ListCelebrities(ctx, dto.CelebrityFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		s.log(ctx).Error("Error while listing all celebrities")
+		return nil, errors.Wrap(err, "Error while listing all celebrities")
+	}
+	genres, _, err := s.repository.ListGenres(ctx, dto.PageDTO{})
+	if err != nil {
+		s.log(ctx).Error("Error while listing all genres")
+		return nil, errors.Wrap(err, "Error while listing all genres")
+	}
+
+	check := newConsistencyCheck(seasons, episodes, celebrities, genres)
+	report := &models.ConsistencyReport{
+		Checked: len(shows) + len(seasons) + len(episodes),
+	}
+	for _, show := range shows {
+		fields := check.show(show)
+		if !repair || len(fields) == 0 {
+			continue
+		}
+		if _, err := s.repository.UpdateShow(ctx, show, fields); err != nil {
+			s.log(ctx).Error("Error while repairing show " + show.ID)
+			return nil, errors.Wrap(err, "Error while repairing show "+show.ID)
+		}
+		report.Repaired++
+	}
+	for _, season := range seasons {
+		fields := check.season(season)
+		if !repair || len(fields) == 0 {
+			continue
+		}
+		if _, err := s.repository.UpdateSeason(ctx, season, fields); err != nil {
+			s.log(ctx).Error("Error while repairing season " + season.ID)
+			return nil, errors.Wrap(err, "Error while repairing season "+season.ID)
+		}
+		report.Repaired++
+	}
+	for _, episode := range episodes {
+		fields := check.episode(episode)
+		if !repair || len(fields) == 0 {
+			continue
+		}
+		if _, err := s.repository.UpdateEpisode(ctx, episode, fields); err != nil {
+			s.log(ctx).Error("Error while repairing episode " + episode.ID)
+			return nil, errors.Wrap(err, "Error while repairing episode "+episode.ID)
+		}
+		report.Repaired++
+	}
+	report.Issues = check.issues
+	return report, nil
+}
+
+// consistencyCheck indexes the source entities by id and collects the issues
+// found in the embedded copies. The check methods fix the copies in place and
+// return the fields they changed.
+type consistencyCheck struct {
+	seasons     map[string]*dto.SeasonDTO
+	episodes    map[string]*dto.EpisodeDTO
+	celebrities map[string]*dto.CelebrityDTO
+	genres      map[string]*dto.GenreDTO
+	issues      []*models.ConsistencyIssue
+}
+
+// embedded locates a list of embedded copies.
+type embedded struct {
+	collection string
+	documentID string
+	field      string
+}
+
+func newConsistencyCheck(seasons dto.SeasonsDTO, episodes dto.EpisodesDTO, celebrities dto.CelebritiesDTO, genres dto.GenresDTO) *consistencyCheck {
+	check := &consistencyCheck{
+		seasons:     map[string]*dto.SeasonDTO{},
+		episodes:    map[string]*dto.EpisodeDTO{},
+		celebrities: map[string]*dto.CelebrityDTO{},
+		genres:      map[string]*dto.GenreDTO{},
+	}
+	for _, season := range seasons {
+		check.seasons[season.ID] = season
+	}
+	for _, episode := range episodes {
+		check.episodes[episode.ID] = episode
+	}
+	for _, celebrity := range celebrities {
+		check.celebrities[celebrity.ID] = celebrity
+	}
+	for _, genre := range genres {
+		check.genres[genre.ID] = genre
+	}
+	return check
+}
+
+func (c *consistencyCheck) show(show *dto.ShowDTO) []string {
+	fields := []string{}
+	if c.shortSeasons(embedded{"shows", show.ID, "seasons"}, &show.Seasons) {
+		fields = append(fields, "seasons")
+	}
+	if c.shortGenres(embedded{"shows", show.ID, "genres"}, &show.Genres) {
+		fields = append(fields, "genres")
+	}
+	if c.filmCrews(embedded{"shows", show.ID, directedBy}, &show.DirectedBy) {
+		fields = append(fields, directedBy)
+	}
+	if c.filmCrews(embedded{"shows", show.ID, producedBy}, &show.ProducedBy) {
+		fields = append(fields, producedBy)
+	}
+	if c.filmCrews(embedded{"shows", show.ID, writtenBy}, &show.WrittenBy) {
+		fields = append(fields, writtenBy)
+	}
+	if c.shortCelebrities(embedded{"shows", show.ID, starring}, &show.Starring) {
+		fields = append(fields, starring)
+	}
+	return fields
+}
+
+func (c *consistencyCheck) season(season *dto.SeasonDTO) []string {
+	fields := []string{}
+	if c.shortEpisodes(embedded{"seasons", season.ID, "episodes"}, &season.Episodes) {
+		fields = append(fields, "episodes")
+	}
+	if c.filmCrews(embedded{"seasons", season.ID, writtenBy}, &season.WrittenBy) {
+		fields = append(fields, writtenBy)
+	}
+	if c.filmCrews(embedded{"seasons", season.ID, producedBy}, &season.ProducedBy) {
+		fields = append(fields, producedBy)
+	}
+	if c.filmCrews(embedded{"seasons", season.ID, directedBy}, &season.DirectedBy) {
+		fields = append(fields, directedBy)
+	}
+	return fields
+}
+
+func (c *consistencyCheck) episode(episode *dto.EpisodeDTO) []string {
+	fields := []string{}
+	if c.filmCrews(embedded{"episodes", episode.ID, writtenBy}, &episode.WrittenBy) {
+		fields = append(fields, writtenBy)
+	}
+	if c.filmCrews(embedded{"episodes", episode.ID, producedBy}, &episode.ProducedBy) {
+		fields = append(fields, producedBy)
+	}
+	if c.filmCrews(embedded{"episodes", episode.ID, directedBy}, &episode.DirectedBy) {
+		fields = append(fields, directedBy)
+	}
+	if c.shortCelebrities(embedded{"episodes", episode.ID, starring}, &episode.Starring) {
+		fields = append(fields, starring)
+	}
+	return fields
+}
+
+func (c *consistencyCheck) shortSeasons(at embedded, seasons *dto.ShortSeasonsDTO) bool {
+	fixed := dto.ShortSeasonsDTO{}
+	changed := false
+	for _, season := range *seasons {
+		source, ok := c.seasons[season.ID]
+		if !ok {
+			changed = c.missing(at, season.ID)
+			continue
+		}
+		changed = c.compare(at, season.ID, "title", source.Title, season.Title) || changed
+		changed = c.compare(at, season.ID, "postersPath", source.PostersPath, season.PostersPath) || changed
+		changed = c.compare(at, season.ID, "rating", source.Rating, season.Rating) || changed
+		fixed = append(fixed, &dto.ShortSeasonDTO{
+			ID:          season.ID,
+			Title:       source.Title,
+			PostersPath: source.PostersPath,
+			Rating:      source.Rating,
+		})
+	}
+	if changed {
+		*seasons = fixed
+	}
+	return changed
+}
+
+func (c *consistencyCheck) shortEpisodes(at embedded, episodes *dto.ShortEpisodesDTO) bool {
+	fixed := dto.ShortEpisodesDTO{}
+	changed := false
+	for _, episode := range *episodes {
+		source, ok := c.episodes[episode.ID]
+		if !ok {
+			changed = c.missing(at, episode.ID)
+			continue
+		}
+		changed = c.compare(at, episode.ID, "title", source.Title, episode.Title) || changed
+		changed = c.compare(at, episode.ID, "postersPath", source.PostersPath, episode.PostersPath) || changed
+		changed = c.compare(at, episode.ID, "rating", source.Rating, episode.Rating) || changed
+		changed = c.compare(at, episode.ID, "resume", source.Resume, episode.Resume) || changed
+		fixed = append(fixed, &dto.ShortEpisodeDTO{
+			ID:          episode.ID,
+			Title:       source.Title,
+			PostersPath: source.PostersPath,
+			Rating:      source.Rating,
+			Resume:      source.Resume,
+		})
+	}
+	if changed {
+		*episodes = fixed
+	}
+	return changed
+}
+
+func (c *consistencyCheck) shortGenres(at embedded, genres *dto.ShortGenresDTO) bool {
+	fixed := dto.ShortGenresDTO{}
+	changed := false
+	for _, genre := range *genres {
+		source, ok := c.genres[genre.ID]
+		if !ok {
+			changed = c.missing(at, genre.ID)
+			continue
+		}
+		changed = c.compare(at, genre.ID, "name", source.Name, genre.Name) || changed
+		fixed = append(fixed, &dto.ShortGenreDTO{
+			ID:   genre.ID,
+			Name: source.Name,
+		})
+	}
+	if changed {
+		*genres = fixed
+	}
+	return changed
+}
+
+func (c *consistencyCheck) filmCrews(at embedded, filmCrews *dto.FilmCrewsDTO) bool {
+	fixed := dto.FilmCrewsDTO{}
+	changed := false
+	for _, filmCrew := range *filmCrews {
+		source, ok := c.celebrities[filmCrew.ID]
+		if !ok {
+			changed = c.missing(at, filmCrew.ID)
+			continue
+		}
+		changed = c.compare(at, filmCrew.ID, "name", source.Name, filmCrew.Name) || changed
+		changed = c.compare(at, filmCrew.ID, "postersPath", source.PostersPath, filmCrew.PostersPath) || changed
+		fixed = append(fixed, &dto.FilmCrewDTO{
+			ID:          filmCrew.ID,
+			Name:        source.Name,
+			PostersPath: source.PostersPath,
+		})
+	}
+	if changed {
+		*filmCrews = fixed
+	}
+	return changed
+}
+
+func (c *consistencyCheck) shortCelebrities(at embedded, celebrities *dto.ShortCelebritiesDTO) bool {
+	fixed := dto.ShortCelebritiesDTO{}
+	changed := false
+	for _, celebrity := range *celebrities {
+		source, ok := c.celebrities[celebrity.ID]
+		if !ok {
+			changed = c.missing(at, celebrity.ID)
+			continue
+		}
+		changed = c.compare(at, celebrity.ID, "name", source.Name, celebrity.Name) || changed
+		changed = c.compare(at, celebrity.ID, "postersPath", source.PostersPath, celebrity.PostersPath) || changed
+		fixed = append(fixed, &dto.ShortCelebrityDTO{
+			ID:          celebrity.ID,
+			Name:        source.Name,
+			RoleName:    celebrity.RoleName,
+			PostersPath: source.PostersPath,
+		})
+	}
+	if changed {
+		*celebrities = fixed
+	}
+	return changed
+}
+
+func (c *consistencyCheck) missing(at embedded, referenceID string) bool {
+	c.issues = append(c.issues, &models.ConsistencyIssue{
+		Collection:  at.collection,
+		DocumentID:  at.documentID,
+		Field:       at.field,
+		ReferenceID: referenceID,
+		Missing:     true,
+	})
+	return true
+}
+
+// compare records an issue when the copied value of property differs from
+// the source one. A nil list equals an empty one.
+func (c *consistencyCheck) compare(at embedded, referenceID string, property string, expected interface{}, found interface{}) bool {
+	expectedValue := fmt.Sprintf("%q", expected)
+	foundValue := fmt.Sprintf("%q", found)
+	if _, isFloat := expected.(float64); isFloat {
+		expectedValue = fmt.Sprint(expected)
+		foundValue = fmt.Sprint(found)
+	}
+	if expectedValue == foundValue {
+		return false
+	}
+	c.issues = append(c.issues, &models.ConsistencyIssue{
+		Collection:  at.collection,
+		DocumentID:  at.documentID,
+		Field:       at.field,
+		ReferenceID: referenceID,
+		Property:    property,
+		Expected:    expectedValue,
+		Found:       foundValue,
+	})
+	return true
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"reflect"
+	"testing"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	seedCatalog(t, repo)
+	svc := newTestService(t, repo)
+	show, err := repo.GetShow(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Make the copies of the show stale: an old season title, a genre that
+	// no longer exists and a renamed star.
+	show.Seasons[0].Title = "Old title"
+	show.Genres = append(show.Genres, &dto.ShortGenreDTO{ID: "g9", Name: "Gone"})
+	show.Starring[0].Name = "L. Hofmann"
+	if _, err := repo.UpdateShow(ctx, show, []string{"seasons", "genres", "starring"}); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := svc.CheckConsistency(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*models.ConsistencyIssue{
+		{Collection: "shows", DocumentID: "s1", Field: "seasons", ReferenceID: "se1", Property: "title", Expected: `"Season 1"`, Found: `"Old title"`},
+		{Collection: "shows", DocumentID: "s1", Field: "genres", ReferenceID: "g9", Missing: true},
+		{Collection: "shows", DocumentID: "s1", Field: starring, ReferenceID: "c1", Property: "name", Expected: `"Louis Hofmann"`, Found: `"L. Hofmann"`},
+	}
+	if report.Checked != 3 || report.Repaired != 0 || !reflect.DeepEqual(report.Issues, want) {
+		t.Errorf("the check reported %+v with the issues %+v, want 3 documents checked and the issues %+v", report, report.Issues, want)
+	}
+	if stored, err := repo.GetShow(ctx, "s1"); err != nil || stored.Seasons[0].Title != "Old title" {
+		t.Errorf("a check without repair changed the show to %+v, %v", stored, err)
+	}
+
+	report, err = svc.CheckConsistency(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Repaired != 1 || len(report.Issues) != 3 {
+		t.Errorf("the repair reported %+v, want 1 document repaired for 3 issues", report)
+	}
+	repaired, err := repo.GetShow(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repaired.Seasons[0].Title != "Season 1" || len(repaired.Genres) != 1 || repaired.Genres[0].ID != "g1" {
+		t.Errorf("the repaired show holds the seasons %+v and the genres %+v", repaired.Seasons[0], repaired.Genres)
+	}
+	if star := repaired.Starring[0]; star.Name != "Louis Hofmann" || star.RoleName != "Jonas" {
+		t.Errorf("the repaired star is %+v, want the source name and the same role", star)
+	}
+
+	report, err = svc.CheckConsistency(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Repaired != 0 || len(report.Issues) != 0 {
+		t.Errorf("the check after the repair reported %+v with the issues %+v", report, report.Issues)
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"testing"
+	"time"
+)
+
+// seedCatalog writes a show of genre g1 starring c1, with the season se1
+// and its episode e1, each embedding the short copies of the others.
+func seedCatalog(t *testing.T, repo repository.ProjectRepository) {
+	t.Helper()
+	ctx := context.Background()
+	release := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+	star := &dto.ShortCelebrityDTO{ID: "c1", Name: "Louis Hofmann", RoleName: "Jonas"}
+	writes := []error{
+		second(repo.CreateGenre(ctx, &dto.GenreDTO{ID: "g1", Name: "Mystery"})),
+		second(repo.CreateCelebrity(ctx, &dto.CelebrityDTO{ID: "c1", Name: "Louis Hofmann", Occupation: []string{Actor}, DateOfBirth: release, Gender: "male"})),
+		second(repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark", Type: "series", ReleaseDate: release,
+			Genres: dto.ShortGenresDTO{{ID: "g1", Name: "Mystery"}}, Starring: dto.ShortCelebritiesDTO{star}})),
+		second(repo.CreateSeason(ctx, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1", ReleaseDate: release})),
+		second(repo.AddShortSeason(ctx, "s1", &dto.ShortSeasonDTO{ID: "se1", Title: "Season 1"})),
+		second(repo.CreateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", SeasonID: "se1", Title: "Secrets", Starring: dto.ShortCelebritiesDTO{star}})),
+		second(repo.AddShortEpisode(ctx, "se1", &dto.ShortEpisodeDTO{ID: "e1", Title: "Secrets"})),
+	}
+	for _, err := range writes {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCascadeDeletion(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		delete func(svc ProjectServicer) error
+		// gone are the documents the deletion removes, by their getter.
+		gone  []func(repo repository.ProjectRepository) error
+		check func(t *testing.T, repo repository.ProjectRepository)
+	}{
+		{
+			name:   "show",
+			delete: func(svc ProjectServicer) error { return svc.DeleteShow(ctx, "s1") },
+			gone:   []func(repository.ProjectRepository) error{getShow, getSeason, getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				if celebrity, err := repo.GetCelebrity(ctx, "c1"); err != nil || celebrity.Name != "Louis Hofmann" {
+					t.Errorf("the celebrity of a deleted show is %+v, %v", celebrity, err)
+				}
+			},
+		},
+		{
+			name:   "season",
+			delete: func(svc ProjectServicer) error { return svc.DeleteSeason(ctx, "se1") },
+			gone:   []func(repository.ProjectRepository) error{getSeason, getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Seasons) != 0 {
+					t.Errorf("the show of a deleted season is %+v, %v, want no seasons", show, err)
+				}
+			},
+		},
+		{
+			name:   "episode",
+			delete: func(svc ProjectServicer) error { return svc.DeleteEpisode(ctx, "e1") },
+			gone:   []func(repository.ProjectRepository) error{getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				season, err := repo.GetSeason(ctx, "se1")
+				if err != nil || len(season.Episodes) != 0 {
+					t.Errorf("the season of a deleted episode is %+v, %v, want no episodes", season, err)
+				}
+			},
+		},
+		{
+			name:   "celebrity",
+			delete: func(svc ProjectServicer) error { return svc.DeleteCelebrity(ctx, "c1") },
+			gone:   []func(repository.ProjectRepository) error{getCelebrity},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Starring) != 0 {
+					t.Errorf("the show of a deleted celebrity is %+v, %v, want no starring", show, err)
+				}
+				episode, err := repo.GetEpisode(ctx, "e1")
+				if err != nil || len(episode.Starring) != 0 {
+					t.Errorf("the episode of a deleted celebrity is %+v, %v, want no starring", episode, err)
+				}
+			},
+		},
+		{
+			name:   "genre",
+			delete: func(svc ProjectServicer) error { return svc.DeleteGenre(ctx, "g1") },
+			gone:   []func(repository.ProjectRepository) error{getGenre},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Genres) != 0 {
+					t.Errorf("the show of a deleted genre is %+v, %v, want no genres", show, err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repository.NewMemoryDB()
+			seedCatalog(t, repo)
+			svc := newTestService(t, repo)
+			if err := tt.delete(svc); err != nil {
+				t.Fatal(err)
+			}
+			for _, get := range tt.gone {
+				if err := get(repo); !errors.Is(err, models.ErrNotFound) {
+					t.Errorf("a deleted document is still found: %v", err)
+				}
+			}
+			tt.check(t, repo)
+			if err := tt.delete(svc); !errors.Is(err, models.ErrNotFound) {
+				t.Errorf("deleting again returned %v, want not found", err)
+			}
+		})
+	}
+}
+
+func getShow(repo repository.ProjectRepository) error {
+	return second(repo.GetShow(context.Background(), "s1"))
+}
+
+func getSeason(repo repository.ProjectRepository) error {
+	return second(repo.GetSeason(context.Background(), "se1"))
+}
+
+func getEpisode(repo repository.ProjectRepository) error {
+	return second(repo.GetEpisode(context.Background(), "e1"))
+}
+
+func getCelebrity(repo repository.ProjectRepository) error {
+	return second(repo.GetCelebrity(context.Background(), "c1"))
+}
+
+func getGenre(repo repository.ProjectRepository) error {
+	return second(repo.GetGenre(context.Background(), "g1"))
+}
+
+// failingShortSeasons fails the removal of short seasons, the last write of
+// the deletion of a season.
+type failingShortSeasons struct {
+	repository.ProjectRepository
+}
+
+var errShortSeasons = errors.New("short seasons unavailable")
+
+func (r *failingShortSeasons) RemoveShortSeason(ctx context.Context, seasonID string) error {
+	return errShortSeasons
+}
+
+// TestDeletionRollback checks a deletion failing after it deleted the
+// season and its episodes keeps all of them.
+func TestDeletionRollback(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	seedCatalog(t, repo)
+	svc := newTestService(t, &failingShortSeasons{ProjectRepository: repo})
+
+	if err := svc.DeleteSeason(ctx, "se1"); !errors.Is(err, errShortSeasons) {
+		t.Fatalf("DeleteSeason returned %v, want the error of the failed write", err)
+	}
+	for _, get := range []func(repository.ProjectRepository) error{getShow, getSeason, getEpisode} {
+		if err := get(repo); err != nil {
+			t.Errorf("a document of the failed deletion is gone: %v", err)
+		}
+	}
+	if _, err := repo.GetTrashItem(ctx, repository.SeasonEntity, "se1"); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("the failed deletion is in the trash: %v", err)
+	}
+}
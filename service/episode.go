@@ -4,6 +4,8 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -12,35 +14,47 @@ import (
 type EpisodeServicer interface {
 	CreateEpisode(ctx context.Context, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities) (models.ResponseModeler, error)
 	GetEpisode(ctx context.Context, ID string) (models.ResponseModeler, error)
-	UpdateEpisode(ctx context.Context, ID string, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities) (models.ResponseModeler, error)
+	UpdateEpisode(ctx context.Context, ID string, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities, updateMask []string) (models.ResponseModeler, error)
 	UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error
 	ListSeasonEpisodes(ctx context.Context, seasonID string) ([]models.ResponseModeler, error)
-	ListCollectionEpisodes(ctx context.Context) ([]models.ResponseModeler, error)
+	ListCollectionEpisodes(ctx context.Context, filter models.EpisodeFilter, options models.ListOptions) (*models.ListResult, error)
+	DeleteEpisode(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateEpisode(ctx context.Context, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities) (models.ResponseModeler, error) {
-	err := s.validateEpisodeUniqueness(ctx, seasonID, title)
-	if err != nil {
-		s.logger.Error("Error while creating episode")
-		return nil, errors.Wrap(err, "Error while creating episode")
-	}
-	episode := toEpisodeDTO(uuid.New().String(), seasonID, title, postersPath, trailerURL, length, rating, resume, writtenBy, producedBy, directedBy, starring)
-	resp, err := s.repository.CreateEpisode(ctx, episode)
-	if err != nil {
-		s.logger.Error("Error while creating episode")
-		return nil, errors.Wrap(err, "Error while creating episode")
-	}
-	_, err = s.repository.AddShortEpisode(ctx, seasonID, &dto.ShortEpisodeDTO{
-		ID:          episode.ID,
-		Title:       episode.Title,
-		PostersPath: episode.PostersPath,
-		Rating:      episode.Rating,
-		Resume:      episode.Resume,
+	var resp *dto.EpisodeDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.validateEpisodeUniqueness(ctx, seasonID, title)
+		if err != nil {
+			s.log(ctx).Error("Error while creating episode")
+			return errors.Wrap(err, "Error while creating episode")
+		}
+		episode := toEpisodeDTO(uuid.New().String(), seasonID, title, postersPath, trailerURL, length, rating, resume, writtenBy, producedBy, directedBy, starring)
+		resp, err = s.repository.CreateEpisode(ctx, episode)
+		if err != nil {
+			s.log(ctx).Error("Error while creating episode")
+			return errors.Wrap(err, "Error while creating episode")
+		}
+		_, err = s.repository.AddShortEpisode(ctx, seasonID, &dto.ShortEpisodeDTO{
+			ID:          episode.ID,
+			Title:       episode.Title,
+			PostersPath: episode.PostersPath,
+			Rating:      episode.Rating,
+			Resume:      episode.Resume,
+		})
+		if err != nil {
+			s.log(ctx).Error("Error while adding short episode in season")
+			return errors.Wrap(err, "Error while adding short episode in season")
+		}
+		if err := s.addRevision(ctx, repository.EpisodeEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the episode revision")
+			return err
+		}
+		return nil
 	})
 	if err != nil {
-		s.logger.Error("Error while adding short episode in season")
-		return nil, errors.Wrap(err, "Error while adding short episode in season")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -48,74 +62,128 @@ func (s *projectService) CreateEpisode(ctx context.Context, seasonID string, tit
 func (s *projectService) GetEpisode(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetEpisode(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting episode by id")
+		s.log(ctx).Error("Error while getting episode by id")
 		return nil, errors.Wrap(err, "Error while getting episode by id")
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) UpdateEpisode(ctx context.Context, ID string, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities) (models.ResponseModeler, error) {
+func (s *projectService) UpdateEpisode(ctx context.Context, ID string, seasonID string, title string, postersPath []string, trailerURL string, length *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities, updateMask []string) (models.ResponseModeler, error) {
 	updatedEpisode := toEpisodeDTO(ID, seasonID, title, postersPath, trailerURL, length, rating, resume, writtenBy, producedBy, directedBy, starring)
-	resp, err := s.repository.UpdateEpisode(ctx, updatedEpisode)
+	fields, err := toUpdateFields(updateMask, repository.EpisodeFields)
 	if err != nil {
-		s.logger.Error("Error while updating episode")
-		return nil, errors.New("Error while updating episode")
-	}
-	_, err = s.repository.UpdateShortEpisode(ctx, &dto.ShortEpisodeDTO{
-		ID:          resp.ID,
-		Title:       resp.Title,
-		PostersPath: resp.PostersPath,
-		Rating:      resp.Rating,
-		Resume:      resp.Resume,
+		return nil, err
+	}
+	var resp *dto.EpisodeDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.repository.GetEpisode(ctx, updatedEpisode.ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting episode by id")
+			return errors.Wrap(err, "Error while getting episode by id")
+		}
+		if err := keepStoredSubFields(stored, updatedEpisode, toSubFields(updateMask)); err != nil {
+			s.log(ctx).Error("Error while merging the episode update")
+			return err
+		}
+		resp, err = s.repository.UpdateEpisode(ctx, updatedEpisode, fields)
+		if err != nil {
+			s.log(ctx).Error("Error while updating episode")
+			return errors.Wrap(err, "Error while updating episode")
+		}
+		changed, err := changesAny(stored, resp, shortEpisodeFields)
+		if err != nil {
+			s.log(ctx).Error("Error while comparing the short episode")
+			return err
+		}
+		if changed {
+			_, err = s.repository.UpdateShortEpisode(ctx, &dto.ShortEpisodeDTO{
+				ID:          resp.ID,
+				Title:       resp.Title,
+				PostersPath: resp.PostersPath,
+				Rating:      resp.Rating,
+				Resume:      resp.Resume,
+			})
+			if err != nil {
+				s.log(ctx).Error("Error while updating short episode")
+				return errors.Wrap(err, "Error while updating short episode")
+			}
+		}
+		if err := s.addRevision(ctx, repository.EpisodeEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the episode revision")
+			return err
+		}
+		return nil
 	})
 	if err != nil {
-		s.logger.Error("Error while updating short episode")
-		return nil, errors.New("Error while updating short episode")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadEpisodePosters(ctx, episodeID, postersPath)
-	if err != nil {
-		s.logger.Error("Error while uploading episode posters")
-		return nil, errors.Wrap(err, "Error while uploading episode posters")
-	}
+	var resp *dto.EpisodeDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadEpisodePosters(ctx, episodeID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while uploading episode posters")
+			return errors.Wrap(err, "Error while uploading episode posters")
+		}
 
-	episode, err := s.repository.GetEpisode(ctx, episodeID)
-	if err != nil {
-		s.logger.Error("Error while getting episode by id")
-		return nil, errors.Wrap(err, "Error while getting episode by id")
-	}
-	shortEpisode := &dto.ShortEpisodeDTO{
-		ID:          episodeID,
-		Title:       episode.Title,
-		Rating:      episode.Rating,
-		PostersPath: episode.PostersPath,
-		Resume:      episode.Resume,
-	}
-	_, err = s.repository.UpdateShortEpisode(ctx, shortEpisode)
+		episode, err := s.repository.GetEpisode(ctx, episodeID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting episode by id")
+			return errors.Wrap(err, "Error while getting episode by id")
+		}
+		shortEpisode := &dto.ShortEpisodeDTO{
+			ID:          episodeID,
+			Title:       episode.Title,
+			Rating:      episode.Rating,
+			PostersPath: episode.PostersPath,
+			Resume:      episode.Resume,
+		}
+		_, err = s.repository.UpdateShortEpisode(ctx, shortEpisode)
+		if err != nil {
+			s.log(ctx).Error("Error while updating short episode posters")
+			return errors.Wrap(err, "Error while updating short episode posters")
+		}
+		if err := s.addRevision(ctx, repository.EpisodeEntity, episode.ID, episode); err != nil {
+			s.log(ctx).Error("Error while recording the episode revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating short episode posters")
-		return nil, errors.Wrap(err, "Error while updating short episode posters")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error {
-	err := s.repository.DeleteEpisodePoster(ctx, seriesID, seasonID, episodeID, image)
-	if err != nil {
-		s.logger.Error("Error while deleting episode poster in database")
-		return errors.Wrap(err, "Error while deleting episode poster in database")
-	}
-	return nil
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteEpisodePoster(ctx, seriesID, seasonID, episodeID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while deleting episode poster in database")
+			return errors.Wrap(err, "Error while deleting episode poster in database")
+		}
+		episode, err := s.repository.GetEpisode(ctx, episodeID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting episode by id")
+			return errors.Wrap(err, "Error while getting episode by id")
+		}
+		if err := s.addRevision(ctx, repository.EpisodeEntity, episode.ID, episode); err != nil {
+			s.log(ctx).Error("Error while recording the episode revision")
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *projectService) ListSeasonEpisodes(ctx context.Context, seasonID string) ([]models.ResponseModeler, error) {
 	resp, err := s.repository.ListSeasonEpisodes(ctx, seasonID)
 	if err != nil {
-		s.logger.Error("Error while listing all season episodes")
-		return nil, errors.New("Error while listing all season episodes")
+		s.log(ctx).Error("Error while listing all season episodes")
+		return nil, errors.Wrap(err, "Error while listing all season episodes")
 	}
 	episodes := []models.ResponseModeler{}
 	for _, episode := range resp {
@@ -124,30 +192,75 @@ func (s *projectService) ListSeasonEpisodes(ctx context.Context, seasonID string
 	return episodes, nil
 }
 
-func (s *projectService) ListCollectionEpisodes(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListCollectionEpisodes(ctx)
+func (s *projectService) ListCollectionEpisodes(ctx context.Context, filter models.EpisodeFilter, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, episodeSortFields)
 	if err != nil {
-		s.logger.Error("Error while listing all episodes")
-		return nil, errors.New("Error while listing all episodes")
+		return nil, err
 	}
-	collection := []models.ResponseModeler{}
+	filterDTO := dto.EpisodeFilterDTO{
+		SeasonID:  filter.SeasonID,
+		MinRating: filter.MinRating,
+	}
+	resp, total, err := s.repository.ListCollectionEpisodes(ctx, filterDTO, page)
+	if err != nil {
+		s.log(ctx).Error("Error while listing all episodes")
+		return nil, errors.Wrap(err, "Error while listing all episodes")
+	}
+	episodes := []models.ResponseModeler{}
 	for _, episode := range resp {
-		collection = append(collection, episode.ToModel())
+		episodes = append(episodes, episode.ToModel())
 	}
-	return collection, nil
+	return newListResult(episodes, page, total), nil
+}
+
+func (s *projectService) DeleteEpisode(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		episode, err := s.repository.GetEpisode(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting episode by id")
+			return errors.Wrap(err, "Error while getting episode by id")
+		}
+		if err := s.repository.DeleteEpisode(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting episode")
+			return errors.Wrap(err, "Error while deleting episode")
+		}
+		if err := s.repository.RemoveShortEpisode(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short episode from season")
+			return errors.Wrap(err, "Error while removing short episode from season")
+		}
+		trashed := &trashedDocuments{Episodes: dto.EpisodesDTO{episode}}
+		if err := s.addToTrash(ctx, repository.EpisodeEntity, ID, deletedAt, episode.Title, episode.SeasonID, trashed); err != nil {
+			s.log(ctx).Error("Error while adding episode to the trash")
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *projectService) validateEpisodeUniqueness(ctx context.Context, seasonID string, title string) error {
+	episode, err := s.findEpisode(ctx, seasonID, title)
+	if err != nil {
+		return err
+	}
+	if episode != nil {
+		return errors.Wrap(models.ErrAlreadyExists, "There is already an episode with that name in the Mongo database with id:"+episode.ID)
+	}
+	return nil
+}
+
+// findEpisode returns the episode of the season with title, or nil.
+func (s *projectService) findEpisode(ctx context.Context, seasonID string, title string) (*dto.EpisodeDTO, error) {
 	episodes, err := s.repository.ListSeasonEpisodes(ctx, seasonID)
 	if err != nil {
-		return errors.Wrap(err, "Error while getting episodes from the Mongo database")
+		return nil, errors.Wrap(err, "Error while getting episodes from the Mongo database")
 	}
 	for _, episode := range episodes {
 		if episode.Title == title {
-			return errors.New("There is already an episode with that name in the Mongo database with id:" + episode.ID)
+			return episode, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 func toEpisodeDTO(ID string, seasonID string, title string, postersPath []string, trailerURL string, lengthModel *models.ShowLength, rating float64, resume string, writtenBy models.FilmCrews, producedBy models.FilmCrews, directedBy models.FilmCrews, starring models.ShortCelebrities) *dto.EpisodeDTO {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -14,13 +16,18 @@ type GenreServicer interface {
 	GetGenre(ctx context.Context, ID string) (models.ResponseModeler, error)
 	GetGenreByName(ctx context.Context, name string) (models.ResponseModeler, error)
 	UpdateGenre(ctx context.Context, ID string, name string, description string) (models.ResponseModeler, error)
-	ListGenres(ctx context.Context) ([]models.ResponseModeler, error)
+	ListGenres(ctx context.Context, options models.ListOptions) (*models.ListResult, error)
+	DeleteGenre(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateGenre(ctx context.Context, name string, description string) (models.ResponseModeler, error) {
 	_, err := s.repository.GetGenreByName(ctx, name)
 	if err == nil {
-		return nil, errors.New("There is already a genre with that name.")
+		return nil, errors.Wrap(models.ErrAlreadyExists, "There is already a genre with that name.")
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		s.log(ctx).Error("Error while getting genre by name")
+		return nil, errors.Wrap(err, "Error while getting genre by name")
 	}
 
 	genre := dto.GenreDTO{
@@ -28,10 +35,22 @@ func (s *projectService) CreateGenre(ctx context.Context, name string, descripti
 		Name:        name,
 		Description: description,
 	}
-	resp, err := s.repository.CreateGenre(ctx, &genre)
+	var resp *dto.GenreDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.CreateGenre(ctx, &genre)
+		if err != nil {
+			s.log(ctx).Error("Error while creating genre")
+			return errors.Wrap(err, "Error while creating genre")
+		}
+		if err := s.addRevision(ctx, repository.GenreEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the genre revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while creating genre")
-		return nil, errors.Wrap(err, "Error while creating genre")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -39,7 +58,7 @@ func (s *projectService) CreateGenre(ctx context.Context, name string, descripti
 func (s *projectService) GetGenreByName(ctx context.Context, name string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetGenreByName(ctx, name)
 	if err != nil {
-		s.logger.Error("Error while getting genre by name")
+		s.log(ctx).Error("Error while getting genre by name")
 		return nil, errors.Wrap(err, "Error while getting genre by name")
 	}
 	return resp.ToModel(), nil
@@ -48,7 +67,7 @@ func (s *projectService) GetGenreByName(ctx context.Context, name string) (model
 func (s *projectService) GetGenre(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetGenre(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting genre by id")
+		s.log(ctx).Error("Error while getting genre by id")
 		return nil, errors.Wrap(err, "Error while getting genre by id")
 	}
 	return resp.ToModel(), nil
@@ -60,25 +79,76 @@ func (s *projectService) UpdateGenre(ctx context.Context, ID string, name string
 		Name:        name,
 		Description: description,
 	}
-	resp, err := s.repository.UpdateGenre(ctx, updatedGenre)
+	var resp *dto.GenreDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if _, err := s.repository.UpdateGenre(ctx, updatedGenre); err != nil {
+			s.log(ctx).Error("Error while updating genre")
+			return errors.Wrap(err, "Error while updating genre")
+		}
+		// The update does not fail on a missing genre, reading it back does.
+		resp, err = s.repository.GetGenre(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting genre by id")
+			return errors.Wrap(err, "Error while getting genre by id")
+		}
+		if err := s.addRevision(ctx, repository.GenreEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the genre revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating genre")
-		return nil, errors.New("Error while updating genre")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) ListGenres(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListGenres(ctx)
+func (s *projectService) ListGenres(ctx context.Context, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, genreSortFields)
+	if err != nil {
+		return nil, err
+	}
+	resp, total, err := s.repository.ListGenres(ctx, page)
 	if err != nil {
-		s.logger.Error("Error while listing all genres")
-		return nil, errors.New("Error while listing all genres")
+		s.log(ctx).Error("Error while listing all genres")
+		return nil, errors.Wrap(err, "Error while listing all genres")
 	}
 	genres := []models.ResponseModeler{}
 	for _, genre := range resp {
 		genres = append(genres, genre.ToModel())
 	}
-	return genres, nil
+	return newListResult(genres, page, total), nil
+}
+
+func (s *projectService) DeleteGenre(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		genre, err := s.repository.GetGenre(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting genre by id")
+			return errors.Wrap(err, "Error while getting genre by id")
+		}
+		references, err := s.genreReferences(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while finding the shows of the genre")
+			return errors.Wrap(err, "Error while finding the shows of the genre")
+		}
+		if err := s.repository.DeleteGenre(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting genre")
+			return errors.Wrap(err, "Error while deleting genre")
+		}
+		if err := s.repository.RemoveShortGenre(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short genre from shows")
+			return errors.Wrap(err, "Error while removing short genre from shows")
+		}
+		trashed := &trashedDocuments{Genre: genre, References: references}
+		if err := s.addToTrash(ctx, repository.GenreEntity, ID, deletedAt, genre.Name, "", trashed); err != nil {
+			s.log(ctx).Error("Error while adding genre to the trash")
+			return err
+		}
+		return nil
+	})
 }
 
 func toShortGenresDTO(genresModel models.ShortGenres) dto.ShortGenresDTO {
@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"int-service/models"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	importShow      = "show"
+	importSeason    = "season"
+	importEpisode   = "episode"
+	importCelebrity = "celebrity"
+	importGenre     = "genre"
+)
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("Dry run")
+
+type ImportServicer interface {
+	BulkImport(ctx context.Context, bundle *models.ImportBundle, dryRun bool) (*models.ImportReport, error)
+}
+
+// BulkImport creates the celebrities and the shows of bundle, with their
+// seasons and episodes, and keeps the records that already exist:
+// celebrities by name and date of birth, shows by title and release date,
+// seasons and episodes by title within their parent. Genres and celebrities
+// referenced by the shows are created when they are missing.
+//
+// Every celebrity, and every show with its seasons and episodes, is imported
+// in its own transaction, so a failed record is reported without stopping
+// the others. A dry run imports the bundle in a transaction rolled back at
+// the end; the writes of a failed show stay visible to the next records of
+// the same dry run.
+func (s *projectService) BulkImport(ctx context.Context, bundle *models.ImportBundle, dryRun bool) (*models.ImportReport, error) {
+	report := &models.ImportReport{DryRun: dryRun}
+	importAll := func(ctx context.Context) {
+		for _, celebrity := range bundle.Celebrities {
+			s.importRecord(ctx, report, importCelebrity, celebrityKey(celebrity.Name, celebrity.DateOfBirth), func(ctx context.Context, im *bundleImport) error {
+				return im.celebrity(ctx, celebrity)
+			})
+		}
+		for _, show := range bundle.Shows {
+			s.importRecord(ctx, report, importShow, showKey(show), func(ctx context.Context, im *bundleImport) error {
+				return im.show(ctx, show)
+			})
+		}
+	}
+	if !dryRun {
+		importAll(ctx)
+		return report, nil
+	}
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		importAll(ctx)
+		return errDryRun
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		s.log(ctx).Error("Error while rolling back the import dry run")
+		return nil, errors.Wrap(err, "Error while rolling back the import dry run")
+	}
+	return report, nil
+}
+
+// importRecord runs fn in a transaction and adds the results it collected to
+// report, or a single failed result for the record when fn fails.
+func (s *projectService) importRecord(ctx context.Context, report *models.ImportReport, kind string, key string, fn func(ctx context.Context, im *bundleImport) error) {
+	im := &bundleImport{projectService: s}
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		im.results = nil
+		return fn(ctx, im)
+	})
+	if err != nil {
+		s.log(ctx).WithError(err).Warn("Error while importing " + kind + " " + key)
+		report.Results = append(report.Results, &models.ImportResult{
+			Kind:   kind,
+			Key:    key,
+			Status: models.ImportFailed,
+			Error:  err.Error(),
+		})
+		return
+	}
+	report.Results = append(report.Results, im.results...)
+}
+
+// bundleImport resolves and creates the records of one transaction of a
+// bulk import.
+type bundleImport struct {
+	*projectService
+	results []*models.ImportResult
+}
+
+func (im *bundleImport) add(kind string, key string, ID string, status string) {
+	im.results = append(im.results, &models.ImportResult{
+		Kind:   kind,
+		Key:    key,
+		ID:     ID,
+		Status: status,
+	})
+}
+
+func (im *bundleImport) celebrity(ctx context.Context, celebrity *models.Celebrity) error {
+	key := celebrityKey(celebrity.Name, celebrity.DateOfBirth)
+	if celebrity.Name == "" {
+		return errors.Wrap(models.ErrInvalidArgument, "A celebrity needs a name")
+	}
+	named, err := im.findCelebrities(ctx, celebrity.Name)
+	if err != nil {
+		return err
+	}
+	for _, existing := range named {
+		if existing.DateOfBirth.Equal(celebrity.DateOfBirth) {
+			im.add(importCelebrity, key, existing.ID, models.ImportExisting)
+			return nil
+		}
+	}
+	resp, err := im.CreateCelebrity(ctx, celebrity.Name, celebrity.Occupation, celebrity.PostersPath, celebrity.DateOfBirth, celebrity.DateOfDeath, celebrity.PlaceOfBirth, &celebrity.Gender, celebrity.Bio)
+	if err != nil {
+		return err
+	}
+	im.add(importCelebrity, key, resp.(*models.Celebrity).ID, models.ImportCreated)
+	return nil
+}
+
+// resolveCelebrity returns the celebrity of ref, created with occupation when
+// it does not exist. Without a date of birth, the name has to be unique.
+func (im *bundleImport) resolveCelebrity(ctx context.Context, ref *models.CelebrityRef, occupation string) (*models.Celebrity, error) {
+	if ref.Name == "" {
+		return nil, errors.Wrap(models.ErrInvalidArgument, "A celebrity reference needs a name")
+	}
+	named, err := im.findCelebrities(ctx, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	if ref.DateOfBirth.IsZero() && len(named) > 1 {
+		return nil, errors.Wrap(models.ErrInvalidArgument, "Several celebrities are named "+ref.Name+", the reference needs a date of birth")
+	}
+	for _, existing := range named {
+		if ref.DateOfBirth.IsZero() || existing.DateOfBirth.Equal(ref.DateOfBirth) {
+			return existing.ToModel(), nil
+		}
+	}
+	gender := models.Gender("")
+	resp, err := im.CreateCelebrity(ctx, ref.Name, []string{occupation}, nil, ref.DateOfBirth, time.Time{}, "", &gender, "")
+	if err != nil {
+		return nil, err
+	}
+	celebrity := resp.(*models.Celebrity)
+	im.add(importCelebrity, celebrityKey(celebrity.Name, celebrity.DateOfBirth), celebrity.ID, models.ImportCreated)
+	return celebrity, nil
+}
+
+func (im *bundleImport) filmCrews(ctx context.Context, refs []*models.CelebrityRef, occupation string) (models.FilmCrews, error) {
+	crews := models.FilmCrews{}
+	for _, ref := range refs {
+		celebrity, err := im.resolveCelebrity(ctx, ref, occupation)
+		if err != nil {
+			return nil, err
+		}
+		crews = append(crews, &models.FilmCrew{
+			ID:          celebrity.ID,
+			Name:        celebrity.Name,
+			PostersPath: celebrity.PostersPath,
+		})
+	}
+	return crews, nil
+}
+
+func (im *bundleImport) starring(ctx context.Context, refs []*models.CelebrityRef) (models.ShortCelebrities, error) {
+	starring := models.ShortCelebrities{}
+	for _, ref := range refs {
+		celebrity, err := im.resolveCelebrity(ctx, ref, Actor)
+		if err != nil {
+			return nil, err
+		}
+		starring = append(starring, &models.ShortCelebrity{
+			ID:          celebrity.ID,
+			Name:        celebrity.Name,
+			RoleName:    ref.RoleName,
+			PostersPath: celebrity.PostersPath,
+		})
+	}
+	return starring, nil
+}
+
+// crews resolves the directors, producers and writers of a record.
+func (im *bundleImport) crews(ctx context.Context, directedBy []*models.CelebrityRef, producedBy []*models.CelebrityRef, writtenBy []*models.CelebrityRef) (models.FilmCrews, models.FilmCrews, models.FilmCrews, error) {
+	directors, err := im.filmCrews(ctx, directedBy, Director)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	producers, err := im.filmCrews(ctx, producedBy, Producer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	writers, err := im.filmCrews(ctx, writtenBy, Writer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return directors, producers, writers, nil
+}
+
+func (im *bundleImport) genres(ctx context.Context, names []string) (models.ShortGenres, error) {
+	genres := models.ShortGenres{}
+	for _, name := range names {
+		genre, err := im.repository.GetGenreByName(ctx, name)
+		if err == nil {
+			genres = append(genres, &models.ShortGenre{ID: genre.ID, Name: genre.Name})
+			continue
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, errors.Wrap(err, "Error while getting genre by name")
+		}
+		resp, err := im.CreateGenre(ctx, name, "")
+		if err != nil {
+			return nil, err
+		}
+		created := resp.(*models.Genre)
+		im.add(importGenre, created.Name, created.ID, models.ImportCreated)
+		genres = append(genres, &models.ShortGenre{ID: created.ID, Name: created.Name})
+	}
+	return genres, nil
+}
+
+func (im *bundleImport) show(ctx context.Context, show *models.ImportShow) error {
+	key := showKey(show)
+	if show.Title == "" {
+		return errors.Wrap(models.ErrInvalidArgument, "A show needs a title")
+	}
+	existing, err := im.findShow(ctx, show.Title, show.ReleaseDate)
+	if err != nil {
+		return err
+	}
+	showID := ""
+	if existing != nil {
+		showID = existing.ID
+		im.add(importShow, key, showID, models.ImportExisting)
+	} else {
+		genres, err := im.genres(ctx, show.Genres)
+		if err != nil {
+			return err
+		}
+		directedBy, producedBy, writtenBy, err := im.crews(ctx, show.DirectedBy, show.ProducedBy, show.WrittenBy)
+		if err != nil {
+			return err
+		}
+		starring, err := im.starring(ctx, show.Starring)
+		if err != nil {
+			return err
+		}
+		resp, err := im.CreateShow(ctx, show.Title, show.Type, show.PostersPath, show.ReleaseDate, show.EndDate, show.Rating, &show.Length, show.TrailerURL, genres, directedBy, producedBy, writtenBy, starring, show.Description, models.ShortSeasons{})
+		if err != nil {
+			return err
+		}
+		showID = resp.(*models.Show).ID
+		im.add(importShow, key, showID, models.ImportCreated)
+	}
+	for _, season := range show.Seasons {
+		if err := im.season(ctx, showID, key, season); err != nil {
+			return errors.Wrap(err, "Error while importing season "+season.Title)
+		}
+	}
+	return nil
+}
+
+func (im *bundleImport) season(ctx context.Context, showID string, showKey string, season *models.ImportSeason) error {
+	key := showKey + " / " + season.Title
+	if season.Title == "" {
+		return errors.Wrap(models.ErrInvalidArgument, "A season needs a title")
+	}
+	existing, err := im.findSeason(ctx, showID, season.Title)
+	if err != nil {
+		return err
+	}
+	seasonID := ""
+	if existing != nil {
+		seasonID = existing.ID
+		im.add(importSeason, key, seasonID, models.ImportExisting)
+	} else {
+		directedBy, producedBy, writtenBy, err := im.crews(ctx, season.DirectedBy, season.ProducedBy, season.WrittenBy)
+		if err != nil {
+			return err
+		}
+		resp, err := im.CreateSeason(ctx, showID, season.Title, season.TrailerURL, season.PostersPath, season.ReleaseDate, season.Rating, season.Resume, directedBy, producedBy, writtenBy, models.ShortEpisodes{})
+		if err != nil {
+			return err
+		}
+		seasonID = resp.(*models.Season).ID
+		im.add(importSeason, key, seasonID, models.ImportCreated)
+	}
+	for _, episode := range season.Episodes {
+		if err := im.episode(ctx, seasonID, key, episode); err != nil {
+			return errors.Wrap(err, "Error while importing episode "+episode.Title)
+		}
+	}
+	return nil
+}
+
+func (im *bundleImport) episode(ctx context.Context, seasonID string, seasonKey string, episode *models.ImportEpisode) error {
+	key := seasonKey + " / " + episode.Title
+	if episode.Title == "" {
+		return errors.Wrap(models.ErrInvalidArgument, "An episode needs a title")
+	}
+	existing, err := im.findEpisode(ctx, seasonID, episode.Title)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		im.add(importEpisode, key, existing.ID, models.ImportExisting)
+		return nil
+	}
+	directedBy, producedBy, writtenBy, err := im.crews(ctx, episode.DirectedBy, episode.ProducedBy, episode.WrittenBy)
+	if err != nil {
+		return err
+	}
+	starring, err := im.starring(ctx, episode.Starring)
+	if err != nil {
+		return err
+	}
+	resp, err := im.CreateEpisode(ctx, seasonID, episode.Title, episode.PostersPath, episode.TrailerURL, &episode.Length, episode.Rating, episode.Resume, writtenBy, producedBy, directedBy, starring)
+	if err != nil {
+		return err
+	}
+	im.add(importEpisode, key, resp.(*models.Episode).ID, models.ImportCreated)
+	return nil
+}
+
+func showKey(show *models.ImportShow) string {
+	return show.Title + " (" + show.ReleaseDate.Format("2006-01-02") + ")"
+}
+
+func celebrityKey(name string, dateOfBirth time.Time) string {
+	if dateOfBirth.IsZero() {
+		return name
+	}
+	return name + " (" + dateOfBirth.Format("2006-01-02") + ")"
+}
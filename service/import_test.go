@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testBundle() *models.ImportBundle {
+	release := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+	birth := time.Date(1997, 6, 3, 0, 0, 0, 0, time.UTC)
+	return &models.ImportBundle{
+		Celebrities: models.Celebrities{
+			{Name: "Louis Hofmann", DateOfBirth: birth, Occupation: []string{Actor}, Gender: "male"},
+		},
+		Shows: []*models.ImportShow{{
+			Title:       "Dark",
+			Type:        "series",
+			ReleaseDate: release,
+			Genres:      []string{"Mystery"},
+			DirectedBy:  []*models.CelebrityRef{{Name: "Baran bo Odar"}},
+			Starring:    []*models.CelebrityRef{{Name: "Louis Hofmann", DateOfBirth: birth, RoleName: "Jonas"}},
+			Seasons: []*models.ImportSeason{{
+				Title:       "Season 1",
+				ReleaseDate: release,
+				Episodes:    []*models.ImportEpisode{{Title: "Secrets", Starring: []*models.CelebrityRef{{Name: "Louis Hofmann"}}}},
+			}},
+		}},
+	}
+}
+
+// importStatuses returns the kind, key and status of each result.
+func importStatuses(report *models.ImportReport) []string {
+	statuses := []string{}
+	for _, result := range report.Results {
+		statuses = append(statuses, result.Kind+" "+result.Key+": "+result.Status)
+	}
+	return statuses
+}
+
+func celebritiesNamed(t *testing.T, repo repository.ProjectRepository, name string) int {
+	t.Helper()
+	celebrities, _, err := repo.ListCelebrities(context.Background(), dto.CelebrityFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	named := 0
+	for _, celebrity := range celebrities {
+		if celebrity.Name == name {
+			named++
+		}
+	}
+	return named
+}
+
+func TestBulkImport(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+
+	report, err := svc.BulkImport(ctx, testBundle(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"celebrity Louis Hofmann (1997-06-03): created",
+		"genre Mystery: created",
+		"celebrity Baran bo Odar: created",
+		"show Dark (2017-12-01): created",
+		"season Dark (2017-12-01) / Season 1: created",
+		"episode Dark (2017-12-01) / Season 1 / Secrets: created",
+	}
+	if got := importStatuses(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("the import reported %q, want %q", got, want)
+	}
+	show, err := repo.GetShow(ctx, report.Results[3].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(show.Genres) != 1 || show.Genres[0].ID != report.Results[1].ID || len(show.DirectedBy) != 1 || len(show.Seasons) != 1 {
+		t.Errorf("the imported show is %+v", show)
+	}
+	if star := show.Starring[0]; star.ID != report.Results[0].ID || star.RoleName != "Jonas" {
+		t.Errorf("the imported show stars %+v, want the imported celebrity", star)
+	}
+	episode, err := repo.GetEpisode(ctx, report.Results[5].ID)
+	if err != nil || len(episode.Starring) != 1 || episode.Starring[0].ID != report.Results[0].ID {
+		t.Errorf("the imported episode is %+v, %v, want it to star the celebrity of the same name", episode, err)
+	}
+
+	// The same bundle again finds every record by its natural key.
+	report, err = svc.BulkImport(ctx, testBundle(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		"celebrity Louis Hofmann (1997-06-03): existing",
+		"show Dark (2017-12-01): existing",
+		"season Dark (2017-12-01) / Season 1: existing",
+		"episode Dark (2017-12-01) / Season 1 / Secrets: existing",
+	}
+	if got := importStatuses(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("the second import reported %q, want %q", got, want)
+	}
+	if named := celebritiesNamed(t, repo, "Louis Hofmann"); named != 1 {
+		t.Errorf("the second import left %d celebrities of the same name", named)
+	}
+}
+
+// TestBulkImportFailure checks a failed show keeps none of its records,
+// without stopping the next ones.
+func TestBulkImportFailure(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+	bundle := testBundle()
+	bundle.Shows[0].Seasons[0].Episodes = append(bundle.Shows[0].Seasons[0].Episodes, &models.ImportEpisode{})
+	bundle.Shows = append(bundle.Shows, &models.ImportShow{Title: "1899", Type: "series", ReleaseDate: time.Date(2022, 11, 17, 0, 0, 0, 0, time.UTC)})
+
+	report, err := svc.BulkImport(ctx, bundle, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"celebrity Louis Hofmann (1997-06-03): created",
+		"show Dark (2017-12-01): failed",
+		"show 1899 (2022-11-17): created",
+	}
+	if got := importStatuses(report); !reflect.DeepEqual(got, want) {
+		t.Fatalf("the import reported %q, want %q", got, want)
+	}
+	if !strings.Contains(report.Results[1].Error, "An episode needs a title") {
+		t.Errorf("the failed show reported %q", report.Results[1].Error)
+	}
+	if _, err := repo.GetGenreByName(ctx, "Mystery"); err == nil {
+		t.Error("the genre created for the failed show is kept")
+	}
+	if celebritiesNamed(t, repo, "Baran bo Odar") != 0 {
+		t.Error("the director created for the failed show is kept")
+	}
+	if _, err := repo.GetShow(ctx, report.Results[2].ID); err != nil {
+		t.Errorf("the show after the failed one is not imported: %v", err)
+	}
+}
+
+func TestBulkImportDryRun(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+
+	report, err := svc.BulkImport(ctx, testBundle(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.DryRun || len(report.Results) != 6 {
+		t.Fatalf("the dry run reported %q", importStatuses(report))
+	}
+	for _, result := range report.Results {
+		if result.Status != models.ImportCreated {
+			t.Errorf("the dry run reported %s %s as %s", result.Kind, result.Key, result.Status)
+		}
+	}
+	if _, err := repo.GetShow(ctx, report.Results[3].ID); err == nil {
+		t.Error("the dry run kept the show")
+	}
+	if named := celebritiesNamed(t, repo, "Louis Hofmann"); named != 0 {
+		t.Errorf("the dry run kept %d celebrities", named)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -13,31 +15,48 @@ type JournalistServicer interface {
 	CreateJournalist(ctx context.Context, name string) (models.ResponseModeler, error)
 	GetJournalist(ctx context.Context, ID string) (models.ResponseModeler, error)
 	UpdateJournalist(ctx context.Context, ID string, name string) (models.ResponseModeler, error)
-	ListJournalists(ctx context.Context) ([]models.ResponseModeler, error)
+	ListJournalists(ctx context.Context, options models.ListOptions) (*models.ListResult, error)
 	GetJournalistByName(ctx context.Context, name string) (models.ResponseModeler, error)
+	DeleteJournalist(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateJournalist(ctx context.Context, name string) (models.ResponseModeler, error) {
 	_, err := s.repository.GetJournalistByName(ctx, name)
 	if err == nil {
-		return nil, errors.New("There is already a journalist with that name.")
+		return nil, errors.Wrap(models.ErrAlreadyExists, "There is already a journalist with that name.")
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		s.log(ctx).Error("Error while getting journalist by name")
+		return nil, errors.Wrap(err, "Error while getting journalist by name")
 	}
 
 	journalist := dto.JournalistDTO{
 		ID:   uuid.New().String(),
 		Name: name,
 	}
-	resp, err := s.repository.CreateJournalist(ctx, &journalist)
+	var resp *dto.JournalistDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.CreateJournalist(ctx, &journalist)
+		if err != nil {
+			s.log(ctx).Error("Error while creating journalist")
+			return errors.Wrap(err, "Error while creating journalist")
+		}
+		if err := s.addRevision(ctx, repository.JournalistEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the journalist revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while creating journalist")
-		return nil, errors.Wrap(err, "Error while creating journalist")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 func (s *projectService) GetJournalistByName(ctx context.Context, name string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetJournalistByName(ctx, name)
 	if err != nil {
-		s.logger.Error("Error while getting journalist by name")
+		s.log(ctx).Error("Error while getting journalist by name")
 		return nil, errors.Wrap(err, "Error while getting journalist by name")
 	}
 	return resp.ToModel(), nil
@@ -46,7 +65,7 @@ func (s *projectService) GetJournalistByName(ctx context.Context, name string) (
 func (s *projectService) GetJournalist(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetJournalist(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting journalist by id")
+		s.log(ctx).Error("Error while getting journalist by id")
 		return nil, errors.Wrap(err, "Error while getting journalist by id")
 	}
 	return resp.ToModel(), nil
@@ -57,23 +76,76 @@ func (s *projectService) UpdateJournalist(ctx context.Context, ID string, name s
 		ID:   ID,
 		Name: name,
 	}
-	resp, err := s.repository.UpdateJournalist(ctx, &journalist)
+	var resp *dto.JournalistDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if _, err := s.repository.UpdateJournalist(ctx, &journalist); err != nil {
+			s.log(ctx).Error("Error while updating journalist")
+			return errors.Wrap(err, "Error while updating journalist")
+		}
+		// The update does not fail on a missing journalist, reading it back does.
+		resp, err = s.repository.GetJournalist(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting journalist by id")
+			return errors.Wrap(err, "Error while getting journalist by id")
+		}
+		if err := s.addRevision(ctx, repository.JournalistEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the journalist revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating journalist")
-		return nil, errors.New("Error while updating journalist")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) ListJournalists(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListJournalists(ctx)
+func (s *projectService) ListJournalists(ctx context.Context, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, journalistSortFields)
+	if err != nil {
+		return nil, err
+	}
+	resp, total, err := s.repository.ListJournalists(ctx, page)
 	if err != nil {
-		s.logger.Error("Error while listing all journalists")
-		return nil, errors.New("Error while listing all journalists")
+		s.log(ctx).Error("Error while listing all journalists")
+		return nil, errors.Wrap(err, "Error while listing all journalists")
 	}
 	journalists := []models.ResponseModeler{}
-	for _, j := range resp {
-		journalists = append(journalists, j.ToModel())
+	for _, journalist := range resp {
+		journalists = append(journalists, journalist.ToModel())
 	}
-	return journalists, nil
+	return newListResult(journalists, page, total), nil
+}
+
+func (s *projectService) DeleteJournalist(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		journalist, err := s.repository.GetJournalist(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting journalist by id")
+			return errors.Wrap(err, "Error while getting journalist by id")
+		}
+		articles, err := s.repository.ListArticlesByJournalist(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while listing all articles by journalist Id")
+			return errors.Wrap(err, "Error while listing all articles by journalist Id")
+		}
+		for _, article := range articles {
+			if err := s.repository.DeleteArticle(ctx, article.ID); err != nil {
+				s.log(ctx).Error("Error while deleting journalist article")
+				return errors.Wrap(err, "Error while deleting journalist article")
+			}
+		}
+		if err := s.repository.DeleteJournalist(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting journalist")
+			return errors.Wrap(err, "Error while deleting journalist")
+		}
+		trashed := &trashedDocuments{Journalist: journalist, Articles: articles}
+		if err := s.addToTrash(ctx, repository.JournalistEntity, ID, deletedAt, journalist.Name, "", trashed); err != nil {
+			s.log(ctx).Error("Error while adding journalist to the trash")
+			return err
+		}
+		return nil
+	})
 }
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"encoding/base64"
+	"int-service/dto"
+	"int-service/models"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+var (
+	showSortFields       = []string{"title", "releaseDate", "rating"}
+	seasonSortFields     = []string{"title", "releaseDate", "rating"}
+	episodeSortFields    = []string{"title", "rating"}
+	celebritySortFields  = []string{"name", "dateOfBirth"}
+	articleSortFields    = []string{"releaseDate", "title"}
+	genreSortFields      = []string{"name"}
+	journalistSortFields = []string{"name"}
+)
+
+// toPageDTO checks the list options against the fields the entity can be
+// sorted by. The first of them is used when no sort field is requested.
+func toPageDTO(options models.ListOptions, sortFields []string) (dto.PageDTO, error) {
+	if options.PageSize < 0 {
+		return dto.PageDTO{}, models.NewFieldError("pageSize", "must not be negative")
+	}
+	limit := options.PageSize
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset, err := decodePageToken(options.PageToken)
+	if err != nil {
+		return dto.PageDTO{}, err
+	}
+
+	sortBy := options.SortBy
+	if sortBy == "" {
+		sortBy = sortFields[0]
+	}
+	if !containsString(sortFields, sortBy) {
+		return dto.PageDTO{}, models.NewFieldError("sortBy", "must be one of "+strings.Join(sortFields, ", "))
+	}
+
+	return dto.PageDTO{
+		Offset:     offset,
+		Limit:      int64(limit),
+		SortBy:     sortBy,
+		Descending: options.Descending,
+	}, nil
+}
+
+func newListResult(items []models.ResponseModeler, page dto.PageDTO, total int64) *models.ListResult {
+	result := &models.ListResult{
+		Items:     items,
+		TotalSize: total,
+	}
+	if next := page.Offset + int64(len(items)); len(items) > 0 && next < total {
+		result.NextPageToken = encodePageToken(next)
+	}
+	return result
+}
+
+// Page tokens are opaque to clients, they only carry the offset of the next page.
+func encodePageToken(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, models.NewFieldError("pageToken", "invalid page token")
+	}
+	offset, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, models.NewFieldError("pageToken", "invalid page token")
+	}
+	return offset, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
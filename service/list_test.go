@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToPageDTO(t *testing.T) {
+	sortFields := []string{"title", "rating"}
+	tests := []struct {
+		name    string
+		options models.ListOptions
+		want    dto.PageDTO
+		// field is the field of the FieldError returned, if any.
+		field string
+	}{
+		{name: "defaults", want: dto.PageDTO{Limit: defaultPageSize, SortBy: "title"}},
+		{name: "page size", options: models.ListOptions{PageSize: 10}, want: dto.PageDTO{Limit: 10, SortBy: "title"}},
+		{name: "largest page", options: models.ListOptions{PageSize: maxPageSize}, want: dto.PageDTO{Limit: maxPageSize, SortBy: "title"}},
+		{name: "page too large", options: models.ListOptions{PageSize: maxPageSize + 1}, want: dto.PageDTO{Limit: maxPageSize, SortBy: "title"}},
+		{name: "negative page size", options: models.ListOptions{PageSize: -1}, field: "pageSize"},
+		{name: "page token", options: models.ListOptions{PageToken: encodePageToken(20)}, want: dto.PageDTO{Offset: 20, Limit: defaultPageSize, SortBy: "title"}},
+		{name: "not base64", options: models.ListOptions{PageToken: "%%"}, field: "pageToken"},
+		{name: "not an offset", options: models.ListOptions{PageToken: "YWJj"}, field: "pageToken"},
+		{name: "negative offset", options: models.ListOptions{PageToken: "LTE"}, field: "pageToken"},
+		{name: "allowed sort", options: models.ListOptions{SortBy: "rating", Descending: true}, want: dto.PageDTO{Limit: defaultPageSize, SortBy: "rating", Descending: true}},
+		{name: "sort outside the allowlist", options: models.ListOptions{SortBy: "description"}, field: "sortBy"},
+		{name: "sort of another case", options: models.ListOptions{SortBy: "Title"}, field: "sortBy"},
+	}
+	for _, tt := range tests {
+		page, err := toPageDTO(tt.options, sortFields)
+		if tt.field != "" {
+			var fieldErr *models.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tt.field {
+				t.Errorf("%s: toPageDTO returned %v, want a %s field error", tt.name, err, tt.field)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: toPageDTO returned %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(page, tt.want) {
+			t.Errorf("%s: toPageDTO = %+v, want %+v", tt.name, page, tt.want)
+		}
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	for _, offset := range []int64{0, 1, 50, 1 << 40} {
+		got, err := decodePageToken(encodePageToken(offset))
+		if err != nil || got != offset {
+			t.Errorf("decodePageToken(encodePageToken(%d)) = %d, %v", offset, got, err)
+		}
+	}
+	if offset, err := decodePageToken(""); err != nil || offset != 0 {
+		t.Errorf("decodePageToken of no token = %d, %v", offset, err)
+	}
+}
+
+// TestListPages follows the page tokens of ListShows through every show.
+func TestListPages(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+	release := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, title := range []string{"Eve", "Alf", "Dot", "Cal", "Bob"} {
+		if _, err := repo.CreateShow(ctx, &dto.ShowDTO{ID: title, Title: title, Type: "series", ReleaseDate: release}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	titles := []string{}
+	options := models.ListOptions{PageSize: 2, SortBy: "title"}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("ListShows returned more than 3 pages of 2 of 5 shows")
+		}
+		result, err := svc.ListShows(ctx, models.ShowFilter{}, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.TotalSize != 5 {
+			t.Errorf("ListShows returned a total of %d, want 5", result.TotalSize)
+		}
+		for _, item := range result.Items {
+			titles = append(titles, item.(*models.Show).Title)
+		}
+		if result.NextPageToken == "" {
+			break
+		}
+		options.PageToken = result.NextPageToken
+	}
+	if want := []string{"Alf", "Bob", "Cal", "Dot", "Eve"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("the pages hold %q, want %q", titles, want)
+	}
+
+	if _, err := svc.ListShows(ctx, models.ShowFilter{}, models.ListOptions{SortBy: "description"}); !errors.Is(err, models.ErrInvalidArgument) {
+		t.Errorf("ListShows sorted by a field outside the allowlist returned %v, want invalid argument", err)
+	}
+}
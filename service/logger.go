@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+type loggerKey struct{}
+
+// ContextWithLogger returns a copy of ctx carrying the logger of the request
+// it belongs to, which the service logs with instead of its own logger.
+func ContextWithLogger(ctx context.Context, logger *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFromContext returns the logger of the request of ctx, or nil.
+func LoggerFromContext(ctx context.Context) *logrus.Entry {
+	logger, _ := ctx.Value(loggerKey{}).(*logrus.Entry)
+	return logger
+}
+
+func (s *projectService) log(ctx context.Context) logrus.FieldLogger {
+	if logger := LoggerFromContext(ctx); logger != nil {
+		return logger
+	}
+	return s.logger
+}
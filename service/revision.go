@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"int-service/auth"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// The history of a document always comes latest first.
+var revisionSortFields = []string{"number"}
+
+type RevisionServicer interface {
+	ListRevisions(ctx context.Context, entityType string, entityID string, options models.ListOptions) (*models.ListResult, error)
+	GetRevisionDiff(ctx context.Context, fromID string, toID string) (*models.RevisionDiff, error)
+	RevertToRevision(ctx context.Context, ID string) (*models.Revision, error)
+}
+
+// addRevision records document as the latest revision of the document ID,
+// to be called in the transaction of the create or update. The actor is the
+// subject of the caller and the method the gRPC method of the call.
+func (s *projectService) addRevision(ctx context.Context, entityType string, ID string, document interface{}) error {
+	snapshot, err := json.Marshal(document)
+	if err != nil {
+		return errors.Wrap(err, "Error while encoding the revision")
+	}
+	latest, _, err := s.repository.ListRevisions(ctx, entityType, ID, dto.PageDTO{Limit: 1})
+	if err != nil {
+		return errors.Wrap(err, "Error while getting the latest revision")
+	}
+	revision := &dto.RevisionDTO{
+		ID:         uuid.New().String(),
+		EntityType: entityType,
+		EntityID:   ID,
+		Number:     1,
+		CreatedAt:  time.Now().UTC(),
+		Snapshot:   string(snapshot),
+	}
+	if len(latest) > 0 {
+		revision.Number = latest[0].Number + 1
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		revision.Actor = principal.Subject
+	}
+	revision.Method, _ = grpc.Method(ctx)
+	if err := s.repository.AddRevision(ctx, revision); err != nil {
+		return errors.Wrap(err, "Error while adding the revision")
+	}
+	return nil
+}
+
+func (s *projectService) ListRevisions(ctx context.Context, entityType string, entityID string, options models.ListOptions) (*models.ListResult, error) {
+	if !containsString(repository.EntityTypes(), entityType) {
+		return nil, models.NewFieldError("entityType", "must be one of "+strings.Join(repository.EntityTypes(), ", "))
+	}
+	page, err := toPageDTO(options, revisionSortFields)
+	if err != nil {
+		return nil, err
+	}
+	resp, total, err := s.repository.ListRevisions(ctx, entityType, entityID, page)
+	if err != nil {
+		s.log(ctx).Error("Error while listing revisions")
+		return nil, errors.Wrap(err, "Error while listing revisions")
+	}
+	revisions := []models.ResponseModeler{}
+	for _, revision := range resp {
+		revisions = append(revisions, toRevisionModel(revision))
+	}
+	return newListResult(revisions, page, total), nil
+}
+
+// GetRevisionDiff compares two revisions of a document field by field, in
+// either order.
+func (s *projectService) GetRevisionDiff(ctx context.Context, fromID string, toID string) (*models.RevisionDiff, error) {
+	from, err := s.repository.GetRevision(ctx, fromID)
+	if err != nil {
+		s.log(ctx).Error("Error while getting revision by id")
+		return nil, errors.Wrap(err, "Error while getting revision by id")
+	}
+	to, err := s.repository.GetRevision(ctx, toID)
+	if err != nil {
+		s.log(ctx).Error("Error while getting revision by id")
+		return nil, errors.Wrap(err, "Error while getting revision by id")
+	}
+	if from.EntityType != to.EntityType || from.EntityID != to.EntityID {
+		return nil, errors.Wrap(models.ErrInvalidArgument, "The revisions are not revisions of the same document")
+	}
+	changes, err := diffSnapshots(from.Snapshot, to.Snapshot)
+	if err != nil {
+		s.log(ctx).Error("Error while comparing revisions")
+		return nil, err
+	}
+	return &models.RevisionDiff{
+		From:    toRevisionModel(from),
+		To:      toRevisionModel(to),
+		Changes: changes,
+	}, nil
+}
+
+// RevertToRevision writes the fields of a revision back through the update
+// of its entity type, so the update refreshes the copies of the document
+// embedded in others and records a new revision, which is returned. Posters
+// and the short seasons of shows and short episodes of seasons are kept, as
+// they follow their own files and documents.
+func (s *projectService) RevertToRevision(ctx context.Context, ID string) (*models.Revision, error) {
+	var latest *dto.RevisionDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		revision, err := s.repository.GetRevision(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting revision by id")
+			return errors.Wrap(err, "Error while getting revision by id")
+		}
+		if err := s.revert(ctx, revision); err != nil {
+			return err
+		}
+		revisions, _, err := s.repository.ListRevisions(ctx, revision.EntityType, revision.EntityID, dto.PageDTO{Limit: 1})
+		if err != nil {
+			s.log(ctx).Error("Error while getting the latest revision")
+			return errors.Wrap(err, "Error while getting the latest revision")
+		}
+		latest = revisions[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRevisionModel(latest), nil
+}
+
+func (s *projectService) revert(ctx context.Context, revision *dto.RevisionDTO) error {
+	var err error
+	switch revision.EntityType {
+	case repository.ShowEntity:
+		show := &dto.ShowDTO{}
+		if err := decodeSnapshot(revision, show); err != nil {
+			return err
+		}
+		m := show.ToModel()
+		_, err = s.UpdateShow(ctx, m.ID, m.Title, m.Type, m.PostersPath, m.ReleaseDate, m.EndDate, m.Rating, &m.Length, m.TrailerURL, m.Genres, m.DirectedBy, m.ProducedBy, m.WrittenBy, m.Starring, m.Description, m.Seasons, revertFields(repository.ShowFields, "postersPath", "seasons"))
+	case repository.SeasonEntity:
+		season := &dto.SeasonDTO{}
+		if err := decodeSnapshot(revision, season); err != nil {
+			return err
+		}
+		m := season.ToModel()
+		_, err = s.UpdateSeason(ctx, m.ID, m.ShowID, m.Title, m.TrailerURL, m.PostersPath, m.ReleaseDate, m.Rating, m.Resume, m.DirectedBy, m.ProducedBy, m.WrittenBy, m.Episodes, revertFields(repository.SeasonFields, "postersPath", "episodes"))
+	case repository.EpisodeEntity:
+		episode := &dto.EpisodeDTO{}
+		if err := decodeSnapshot(revision, episode); err != nil {
+			return err
+		}
+		m := episode.ToModel()
+		_, err = s.UpdateEpisode(ctx, m.ID, m.SeasonID, m.Title, m.PostersPath, m.TrailerURL, &m.Length, m.Rating, m.Resume, m.WrittenBy, m.ProducedBy, m.DirectedBy, m.Starring, revertFields(repository.EpisodeFields, "postersPath"))
+	case repository.CelebrityEntity:
+		celebrity := &dto.CelebrityDTO{}
+		if err := decodeSnapshot(revision, celebrity); err != nil {
+			return err
+		}
+		m := celebrity.ToModel()
+		_, err = s.UpdateCelebrity(ctx, m.ID, m.Name, m.Occupation, m.PostersPath, m.DateOfBirth, m.DateOfDeath, m.PlaceOfBirth, &m.Gender, m.Bio, revertFields(repository.CelebrityFields, "postersPath"))
+	case repository.ArticleEntity:
+		article := &dto.ArticleDTO{}
+		if err := decodeSnapshot(revision, article); err != nil {
+			return err
+		}
+		if err := s.checkParent(ctx, repository.JournalistEntity, article.Journalist.ID, s.journalistExists); err != nil {
+			return err
+		}
+		current, err := s.repository.GetArticle(ctx, article.ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting article by id")
+			return errors.Wrap(err, "Error while getting article by id")
+		}
+		m := article.ToModel()
+		_, err = s.UpdateArticle(ctx, m.ID, m.Title, m.ReleaseDate, current.PostersPath, m.Description, &models.Journalist{ID: m.Journalist.ID})
+		if err != nil {
+			return err
+		}
+	case repository.GenreEntity:
+		genre := &dto.GenreDTO{}
+		if err := decodeSnapshot(revision, genre); err != nil {
+			return err
+		}
+		_, err = s.UpdateGenre(ctx, genre.ID, genre.Name, genre.Description)
+	case repository.JournalistEntity:
+		if principal := auth.PrincipalFromContext(ctx); principal != nil && !principal.HasRole(auth.RoleAdmin) {
+			return errors.Wrap(models.ErrPermissionDenied, "Only admins can revert journalists")
+		}
+		journalist := &dto.JournalistDTO{}
+		if err := decodeSnapshot(revision, journalist); err != nil {
+			return err
+		}
+		_, err = s.UpdateJournalist(ctx, journalist.ID, journalist.Name)
+	default:
+		return errors.Wrap(models.ErrInvalidArgument, "Unknown entity type "+revision.EntityType)
+	}
+	return err
+}
+
+func decodeSnapshot(revision *dto.RevisionDTO, document interface{}) error {
+	if err := json.Unmarshal([]byte(revision.Snapshot), document); err != nil {
+		return errors.Wrap(err, "Error while decoding the revision")
+	}
+	return nil
+}
+
+// revertFields are the fields of an entity type but the given ones.
+func revertFields(fields []string, kept ...string) []string {
+	reverted := []string{}
+	for _, field := range fields {
+		if !containsString(kept, field) {
+			reverted = append(reverted, field)
+		}
+	}
+	return reverted
+}
+
+// diffSnapshots returns the top-level fields whose JSON differs between two
+// snapshots, by name.
+func diffSnapshots(from string, to string) ([]*models.FieldChange, error) {
+	fromFields := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(from), &fromFields); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding the revision")
+	}
+	toFields := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(to), &toFields); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding the revision")
+	}
+	names := []string{}
+	for name := range fromFields {
+		names = append(names, name)
+	}
+	for name := range toFields {
+		if _, ok := fromFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*models.FieldChange{}
+	for _, name := range names {
+		fromValue, toValue := string(fromFields[name]), string(toFields[name])
+		if fromValue != toValue {
+			changes = append(changes, &models.FieldChange{Field: name, From: fromValue, To: toValue})
+		}
+	}
+	return changes, nil
+}
+
+func toRevisionModel(revision *dto.RevisionDTO) *models.Revision {
+	return &models.Revision{
+		ID:         revision.ID,
+		EntityType: revision.EntityType,
+		EntityID:   revision.EntityID,
+		Number:     revision.Number,
+		Actor:      revision.Actor,
+		Method:     revision.Method,
+		CreatedAt:  revision.CreatedAt,
+		Snapshot:   revision.Snapshot,
+	}
+}
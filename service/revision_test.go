@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"int-service/auth"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	changes, err := diffSnapshots(`{"id":"g1","name":"Mystery","tags":["a"],"old":1}`, `{"id":"g1","name":"Thriller","tags":["a"],"new":true}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*models.FieldChange{
+		{Field: "name", From: `"Mystery"`, To: `"Thriller"`},
+		{Field: "new", To: "true"},
+		{Field: "old", From: "1"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("diffSnapshots returned %+v, want %+v", changes, want)
+	}
+	if _, err := diffSnapshots(`{}`, `[]`); err == nil {
+		t.Error("diffSnapshots of a snapshot that is not an object returned no error")
+	}
+}
+
+// TestRevisionHistory checks each write of a document records its next
+// revision, and the diff of two of them.
+func TestRevisionHistory(t *testing.T) {
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+	ctx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{Subject: "ada", Roles: []string{auth.RoleEditor}})
+	created, err := svc.CreateGenre(ctx, "Mystery", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ID := created.(*models.Genre).ID
+	for _, name := range []string{"Thriller", "Drama"} {
+		if _, err := svc.UpdateGenre(ctx, ID, name, "Stories"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history, err := svc.ListRevisions(ctx, repository.GenreEntity, ID, models.ListOptions{})
+	if err != nil || len(history.Items) != 3 || history.TotalSize != 3 {
+		t.Fatalf("ListRevisions returned %+v, %v, want 3 revisions", history, err)
+	}
+	revisions := []*models.Revision{}
+	for i, item := range history.Items {
+		revision := item.(*models.Revision)
+		if revision.Number != int64(3-i) || revision.Actor != "ada" {
+			t.Errorf("revision %d is number %d by %q, want number %d by ada", i, revision.Number, revision.Actor, 3-i)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	diff, err := svc.GetRevisionDiff(ctx, revisions[2].ID, revisions[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*models.FieldChange{
+		{Field: "description", From: `""`, To: `"Stories"`},
+		{Field: "name", From: `"Mystery"`, To: `"Drama"`},
+	}
+	if !reflect.DeepEqual(diff.Changes, want) {
+		t.Errorf("the diff of the first and last revisions is %+v, want %+v", diff.Changes, want)
+	}
+
+	if _, err := svc.ListRevisions(ctx, "movie", ID, models.ListOptions{}); err == nil {
+		t.Error("ListRevisions of an unknown entity type returned no error")
+	}
+	other, err := svc.CreateGenre(ctx, "Comedy", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherHistory, err := svc.ListRevisions(ctx, repository.GenreEntity, other.(*models.Genre).ID, models.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.GetRevisionDiff(ctx, revisions[0].ID, otherHistory.Items[0].(*models.Revision).ID); !errors.Is(err, models.ErrInvalidArgument) {
+		t.Errorf("the diff of revisions of two documents returned %v, want %v", err, models.ErrInvalidArgument)
+	}
+}
+
+// addSnapshot records document as the next revision of a document, as an
+// earlier write would have.
+func addSnapshot(t *testing.T, repo repository.ProjectRepository, ID string, entityType string, entityID string, number int64, document interface{}) {
+	t.Helper()
+	snapshot, err := json.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revision := &dto.RevisionDTO{ID: ID, EntityType: entityType, EntityID: entityID, Number: number, Snapshot: string(snapshot)}
+	if err := repo.AddRevision(context.Background(), revision); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRevertToRevision checks a revert writes the fields of the revision back
+// but the posters and seasons, refreshes the short copies and records a new
+// revision.
+func TestRevertToRevision(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	seedCatalog(t, repo)
+	svc := newTestService(t, repo)
+	if _, err := repo.UploadSeriesPosters(ctx, "s1", []string{"s1.jpg"}); err != nil {
+		t.Fatal(err)
+	}
+	show, err := repo.GetShow(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := *show
+	old.Title, old.Description, old.PostersPath, old.Seasons = "Dark Matter", "The first cut", nil, nil
+	addSnapshot(t, repo, "r1", repository.ShowEntity, "s1", 1, &old)
+	addSnapshot(t, repo, "r2", repository.ShowEntity, "s1", 2, show)
+
+	revision, err := svc.RevertToRevision(ctx, "r1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Number != 3 {
+		t.Errorf("the revert recorded the revision %d, want 3", revision.Number)
+	}
+	reverted, err := repo.GetShow(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverted.Title != "Dark Matter" || reverted.Description != "The first cut" || len(reverted.PostersPath) != 1 || len(reverted.Seasons) != 1 {
+		t.Errorf("the reverted show is %+v", reverted)
+	}
+
+	celebrity, err := repo.GetCelebrity(ctx, "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	celebrity.Name = "Louis H."
+	addSnapshot(t, repo, "r3", repository.CelebrityEntity, "c1", 1, celebrity)
+	if _, err := svc.RevertToRevision(ctx, "r3"); err != nil {
+		t.Fatal(err)
+	}
+	if show, err := repo.GetShow(ctx, "s1"); err != nil || len(show.Starring) != 1 || show.Starring[0].Name != "Louis H." || show.Starring[0].RoleName != "Jonas" {
+		t.Errorf("the show of the reverted celebrity is %+v, %v", show, err)
+	}
+
+	if _, err := svc.RevertToRevision(ctx, "missing"); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("reverting a missing revision returned %v, want %v", err, models.ErrNotFound)
+	}
+}
+
+// TestRevertRestrictions checks an article is not reverted to a deleted
+// journalist, and only admins revert journalists.
+func TestRevertRestrictions(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	svc := newTestService(t, repo)
+	writes := []error{
+		second(repo.CreateJournalist(ctx, &dto.JournalistDTO{ID: "j1", Name: "Ada"})),
+		second(repo.CreateJournalist(ctx, &dto.JournalistDTO{ID: "j2", Name: "Grace"})),
+		second(repo.CreateArticle(ctx, &dto.ArticleDTO{ID: "a1", Title: "Review", Journalist: dto.ShortJournalistDTO{ID: "j1"}})),
+	}
+	for _, err := range writes {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	addSnapshot(t, repo, "r1", repository.ArticleEntity, "a1", 1, &dto.ArticleDTO{ID: "a1", Title: "Draft", Journalist: dto.ShortJournalistDTO{ID: "j2"}})
+	addSnapshot(t, repo, "r2", repository.JournalistEntity, "j1", 1, &dto.JournalistDTO{ID: "j1", Name: "Ann"})
+	if err := repo.DeleteJournalist(ctx, "j2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RevertToRevision(ctx, "r1"); !errors.Is(err, models.ErrFailedPrecondition) {
+		t.Errorf("reverting an article to a deleted journalist returned %v, want %v", err, models.ErrFailedPrecondition)
+	}
+	if article, err := repo.GetArticle(ctx, "a1"); err != nil || article.Title != "Review" {
+		t.Errorf("the failed revert left the article %+v, %v", article, err)
+	}
+
+	editor := auth.ContextWithPrincipal(ctx, &auth.Principal{Subject: "ada", Roles: []string{auth.RoleEditor}})
+	if _, err := svc.RevertToRevision(editor, "r2"); !errors.Is(err, models.ErrPermissionDenied) {
+		t.Errorf("an editor reverting a journalist got %v, want %v", err, models.ErrPermissionDenied)
+	}
+	admin := auth.ContextWithPrincipal(ctx, &auth.Principal{Subject: "root", Roles: []string{auth.RoleAdmin}})
+	if _, err := svc.RevertToRevision(admin, "r2"); err != nil {
+		t.Fatal(err)
+	}
+	if journalist, err := repo.GetJournalist(ctx, "j1"); err != nil || journalist.Name != "Ann" {
+		t.Errorf("the reverted journalist is %+v, %v", journalist, err)
+	}
+}
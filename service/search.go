@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"html"
+	"int-service/models"
+	"int-service/repository"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	snippetWords  = 30
+	snippetBefore = 8
+)
+
+var (
+	searchSortFields = []string{"score"}
+	searchTypes      = []string{repository.ShowSearchType, repository.CelebritySearchType, repository.EpisodeSearchType, repository.ArticleSearchType}
+)
+
+type SearchServicer interface {
+	Search(ctx context.Context, query string, types []string, options models.ListOptions) (*models.ListResult, error)
+}
+
+func (s *projectService) Search(ctx context.Context, query string, types []string, options models.ListOptions) (*models.ListResult, error) {
+	terms := repository.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, models.NewFieldError("query", "must contain at least one word")
+	}
+	for _, searchType := range types {
+		if !containsString(searchTypes, searchType) {
+			return nil, models.NewFieldError("types", "must be one of "+strings.Join(searchTypes, ", "))
+		}
+	}
+	page, err := toPageDTO(options, searchSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, total, err := s.repository.Search(ctx, query, types, page)
+	if err != nil {
+		s.log(ctx).Error("Error while searching the catalog")
+		return nil, errors.Wrap(err, "Error while searching the catalog")
+	}
+	hits := []models.ResponseModeler{}
+	for _, hit := range resp {
+		snippet, ok := highlight(hit.Text, terms)
+		if titleSnippet, titleOk := highlight(hit.Title, terms); !ok && titleOk {
+			snippet = titleSnippet
+		}
+		hits = append(hits, &models.SearchHit{
+			Type:    hit.Type,
+			ID:      hit.ID,
+			Title:   hit.Title,
+			Snippet: snippet,
+			Score:   hit.Score,
+		})
+	}
+	return newListResult(hits, page, total), nil
+}
+
+type word struct {
+	start int
+	end   int
+}
+
+// highlight cuts a window of words around the first match of the terms out of
+// text and wraps every matched word of the window in <em> tags. Without a
+// match, which happens when Mongo matched a stemmed form, the window starts at
+// the first word and highlight reports false. The snippet is HTML escaped.
+func highlight(text string, terms []string) (string, bool) {
+	words := []word{}
+	start := -1
+	for i, r := range text {
+		isWordRune := unicode.IsLetter(r) || unicode.IsNumber(r)
+		if isWordRune && start < 0 {
+			start = i
+		}
+		if !isWordRune && start >= 0 {
+			words = append(words, word{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{start, len(text)})
+	}
+
+	matches := map[int]bool{}
+	first := -1
+	for i, w := range words {
+		if containsString(terms, strings.ToLower(text[w.start:w.end])) {
+			matches[i] = true
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+	if len(words) == 0 {
+		return "", false
+	}
+
+	from := first - snippetBefore
+	if from < 0 {
+		from = 0
+	}
+	to := from + snippetWords
+	if to > len(words) {
+		to = len(words)
+	}
+
+	var snippet strings.Builder
+	position := 0
+	if from > 0 {
+		snippet.WriteString("...")
+		position = words[from].start
+	}
+	for i := from; i < to; i++ {
+		w := words[i]
+		snippet.WriteString(html.EscapeString(text[position:w.start]))
+		if matches[i] {
+			snippet.WriteString("<em>" + html.EscapeString(text[w.start:w.end]) + "</em>")
+		} else {
+			snippet.WriteString(html.EscapeString(text[w.start:w.end]))
+		}
+		position = w.end
+	}
+	if to < len(words) {
+		snippet.WriteString("...")
+	} else {
+		snippet.WriteString(html.EscapeString(text[position:]))
+	}
+	return snippet.String(), first >= 0
+}
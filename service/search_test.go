@@ -0,0 +1,46 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	long := strings.Repeat("word ", 20) + "needle " + strings.Repeat("word ", 40)
+	tests := []struct {
+		name    string
+		text    string
+		terms   []string
+		want    string
+		matched bool
+	}{
+		{name: "empty text", text: "", terms: []string{"dark"}, want: "", matched: false},
+		{name: "whole text", text: "The dark past of Winden.", terms: []string{"dark"}, want: "The <em>dark</em> past of Winden.", matched: true},
+		{name: "any case", text: "DARK and Dark", terms: []string{"dark"}, want: "<em>DARK</em> and <em>Dark</em>", matched: true},
+		{name: "several terms", text: "A German mystery about time travel.", terms: []string{"time", "mystery"}, want: "A German <em>mystery</em> about <em>time</em> travel.", matched: true},
+		{name: "whole words only", text: "Darkness falls", terms: []string{"dark"}, want: "Darkness falls", matched: false},
+		{name: "escaped", text: "<b>Dark</b> & light", terms: []string{"dark"}, want: "&lt;b&gt;<em>Dark</em>&lt;/b&gt; &amp; light", matched: true},
+		{name: "unicode words", text: "Café Müller opens", terms: []string{"müller"}, want: "Café <em>Müller</em> opens", matched: true},
+		{
+			name:    "window around the match",
+			text:    long,
+			terms:   []string{"needle"},
+			want:    "..." + strings.Repeat("word ", 8) + "<em>needle</em>" + strings.Repeat(" word", 21) + "...",
+			matched: true,
+		},
+		{
+			name:    "first words without a match",
+			text:    long,
+			terms:   []string{"missing"},
+			want:    strings.TrimSuffix(strings.Repeat("word ", 20)+"needle "+strings.Repeat("word ", 9), " ") + "...",
+			matched: false,
+		},
+		{name: "match at the end", text: "Ends with dark", terms: []string{"dark"}, want: "Ends with <em>dark</em>", matched: true},
+	}
+	for _, tt := range tests {
+		got, matched := highlight(tt.text, tt.terms)
+		if got != tt.want || matched != tt.matched {
+			t.Errorf("%s: highlight(%q, %q) = %q, %v, want %q, %v", tt.name, tt.text, tt.terms, got, matched, tt.want, tt.matched)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,34 +14,46 @@ import (
 type SeasonServicer interface {
 	CreateSeason(ctx context.Context, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes) (models.ResponseModeler, error)
 	GetSeason(ctx context.Context, ID string) (models.ResponseModeler, error)
-	UpdateSeason(ctx context.Context, ID string, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes) (models.ResponseModeler, error)
+	UpdateSeason(ctx context.Context, ID string, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes, updateMask []string) (models.ResponseModeler, error)
 	UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error
 	ListShowSeasons(ctx context.Context, ID string) ([]models.ResponseModeler, error)
-	ListSeasonsCollection(ctx context.Context) ([]models.ResponseModeler, error)
+	ListSeasonsCollection(ctx context.Context, filter models.SeasonFilter, options models.ListOptions) (*models.ListResult, error)
+	DeleteSeason(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateSeason(ctx context.Context, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes) (models.ResponseModeler, error) {
-	err := s.validateSeasonUniqueness(ctx, showID, title)
-	if err != nil {
-		s.logger.Error("Error while creating season")
-		return nil, errors.Wrap(err, "Error while creating season")
-	}
-	season := toSeasonDTO(uuid.New().String(), showID, title, trailerURL, postersPath, releaseDate, rating, resume, directedBy, producedBy, writtenBy, episodes)
-	resp, err := s.repository.CreateSeason(ctx, season)
-	if err != nil {
-		s.logger.Error("Error while creating season")
-		return nil, errors.Wrap(err, "Error while creating season")
-	}
-	_, err = s.repository.AddShortSeason(ctx, showID, &dto.ShortSeasonDTO{
-		ID:          season.ID,
-		Title:       season.Title,
-		PostersPath: season.PostersPath,
-		Rating:      season.Rating,
+	var resp *dto.SeasonDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.validateSeasonUniqueness(ctx, showID, title)
+		if err != nil {
+			s.log(ctx).Error("Error while creating season")
+			return errors.Wrap(err, "Error while creating season")
+		}
+		season := toSeasonDTO(uuid.New().String(), showID, title, trailerURL, postersPath, releaseDate, rating, resume, directedBy, producedBy, writtenBy, episodes)
+		resp, err = s.repository.CreateSeason(ctx, season)
+		if err != nil {
+			s.log(ctx).Error("Error while creating season")
+			return errors.Wrap(err, "Error while creating season")
+		}
+		_, err = s.repository.AddShortSeason(ctx, showID, &dto.ShortSeasonDTO{
+			ID:          season.ID,
+			Title:       season.Title,
+			PostersPath: season.PostersPath,
+			Rating:      season.Rating,
+		})
+		if err != nil {
+			s.log(ctx).Error("Error while adding short season in show")
+			return errors.Wrap(err, "Error while adding short season in show")
+		}
+		if err := s.addRevision(ctx, repository.SeasonEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the season revision")
+			return err
+		}
+		return nil
 	})
 	if err != nil {
-		s.logger.Error("Error while adding short season in show")
-		return nil, errors.Wrap(err, "Error while adding short season in show")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -48,79 +61,128 @@ func (s *projectService) CreateSeason(ctx context.Context, showID string, title
 func (s *projectService) GetSeason(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetSeason(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting season by id")
+		s.log(ctx).Error("Error while getting season by id")
 		return nil, errors.Wrap(err, "Error while getting season by id")
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) UpdateSeason(ctx context.Context, ID string, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes) (models.ResponseModeler, error) {
+func (s *projectService) UpdateSeason(ctx context.Context, ID string, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes, updateMask []string) (models.ResponseModeler, error) {
 	updatedSeason := toSeasonDTO(ID, showID, title, trailerURL, postersPath, releaseDate, rating, resume, directedBy, producedBy, writtenBy, episodes)
-	resp, err := s.repository.UpdateSeason(ctx, updatedSeason)
+	fields, err := toUpdateFields(updateMask, repository.SeasonFields)
 	if err != nil {
-		s.logger.Error("Error while updating season")
-		return nil, errors.New("Error while updating season")
-	}
-	_, err = s.repository.UpdateShortSeason(ctx, &dto.ShortSeasonDTO{
-		ID:          resp.ID,
-		Title:       resp.Title,
-		PostersPath: resp.PostersPath,
-		Rating:      resp.Rating,
-	})
+		return nil, err
+	}
+	var resp *dto.SeasonDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.repository.GetSeason(ctx, updatedSeason.ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting season by id")
+			return errors.Wrap(err, "Error while getting season by id")
+		}
+		resp, err = s.repository.UpdateSeason(ctx, updatedSeason, fields)
+		if err != nil {
+			s.log(ctx).Error("Error while updating season")
+			return errors.Wrap(err, "Error while updating season")
+		}
+		changed, err := changesAny(stored, resp, shortSeasonFields)
+		if err != nil {
+			s.log(ctx).Error("Error while comparing the short season")
+			return err
+		}
+		if changed {
+			_, err = s.repository.UpdateShortSeason(ctx, &dto.ShortSeasonDTO{
+				ID:          resp.ID,
+				Title:       resp.Title,
+				PostersPath: resp.PostersPath,
+				Rating:      resp.Rating,
+			})
+			if err != nil {
+				s.log(ctx).Error("Error while updating short season")
+				return errors.Wrap(err, "Error while updating short season")
+			}
+		}
+		if err := s.addRevision(ctx, repository.SeasonEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the season revision")
+			return err
+		}
 
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while updating short season")
-		return nil, errors.New("Error while updating short season")
+		return nil, err
 	}
-
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadSeasonPosters(ctx, seasonID, postersPath)
-	if err != nil {
-		s.logger.Error("Error while uploading season posters")
-		return nil, errors.Wrap(err, "Error while uploading season posters")
-	}
+	var resp *dto.SeasonDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadSeasonPosters(ctx, seasonID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while uploading season posters")
+			return errors.Wrap(err, "Error while uploading season posters")
+		}
+
+		season, err := s.repository.GetSeason(ctx, seasonID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting season by id")
+			return errors.Wrap(err, "Error while getting season by id")
+		}
+		shortSeason := &dto.ShortSeasonDTO{
+			ID:          seasonID,
+			Title:       season.Title,
+			Rating:      season.Rating,
+			PostersPath: season.PostersPath,
+		}
+		if _, err := s.repository.UpdateShortSeason(ctx, shortSeason); err != nil {
+			s.log(ctx).Error("Error while updating short season in show")
+			return errors.Wrap(err, "Error while updating short season in show")
+		}
+		if err := s.addRevision(ctx, repository.SeasonEntity, season.ID, season); err != nil {
+			s.log(ctx).Error("Error while recording the season revision")
+			return err
+		}
 
-	season, err := s.repository.GetSeason(ctx, seasonID)
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while getting season by id")
-		return nil, errors.Wrap(err, "Error while getting season by id")
-	}
-	shortSeason := &dto.ShortSeasonDTO{
-		ID:          seasonID,
-		Title:       season.Title,
-		Rating:      season.Rating,
-		PostersPath: season.PostersPath,
-	}
-	if _, err := s.repository.UpdateShortSeason(ctx, shortSeason); err != nil {
-		s.logger.Error("Error while updating short season in show")
-		return nil, errors.New("Error while updating short season in show")
+		return nil, err
 	}
-
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error {
-	err := s.repository.DeleteSeasonPoster(ctx, seriesID, seasonID, image)
-	if err != nil {
-		s.logger.Error("Error while deleting season poster in database")
-		return errors.Wrap(err, "Error while deleting season poster in database")
-	}
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteSeasonPoster(ctx, seriesID, seasonID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while deleting season poster in database")
+			return errors.Wrap(err, "Error while deleting season poster in database")
+		}
 
-	if err := s.repository.DeleteShortSeasonPostersInShow(ctx, seriesID, seasonID, image); err != nil {
-		s.logger.Error("Error while deleting short season poster in show")
-		return errors.New("Error while deleting short season poster in show")
-	}
-	return nil
+		if err := s.repository.DeleteShortSeasonPostersInShow(ctx, seriesID, seasonID, image); err != nil {
+			s.log(ctx).Error("Error while deleting short season poster in show")
+			return errors.Wrap(err, "Error while deleting short season poster in show")
+		}
+		season, err := s.repository.GetSeason(ctx, seasonID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting season by id")
+			return errors.Wrap(err, "Error while getting season by id")
+		}
+		if err := s.addRevision(ctx, repository.SeasonEntity, season.ID, season); err != nil {
+			s.log(ctx).Error("Error while recording the season revision")
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *projectService) ListShowSeasons(ctx context.Context, ID string) ([]models.ResponseModeler, error) {
 	resp, err := s.repository.ListShowSeasons(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while listing all show seasons")
-		return nil, errors.New("Error while listing all show seasons")
+		s.log(ctx).Error("Error while listing all show seasons")
+		return nil, errors.Wrap(err, "Error while listing all show seasons")
 	}
 	seasons := []models.ResponseModeler{}
 	for _, season := range resp {
@@ -129,30 +191,97 @@ func (s *projectService) ListShowSeasons(ctx context.Context, ID string) ([]mode
 	return seasons, nil
 }
 
-func (s *projectService) ListSeasonsCollection(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListSeasonsCollection(ctx)
+func (s *projectService) ListSeasonsCollection(ctx context.Context, filter models.SeasonFilter, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, seasonSortFields)
 	if err != nil {
-		s.logger.Error("Error while listing all seasons")
-		return nil, errors.New("Error while listing all seasons")
+		return nil, err
+	}
+	filterDTO := dto.SeasonFilterDTO{
+		ShowID:         filter.ShowID,
+		ReleasedAfter:  filter.ReleasedAfter,
+		ReleasedBefore: filter.ReleasedBefore,
+		MinRating:      filter.MinRating,
+	}
+	resp, total, err := s.repository.ListSeasonsCollection(ctx, filterDTO, page)
+	if err != nil {
+		s.log(ctx).Error("Error while listing all seasons")
+		return nil, errors.Wrap(err, "Error while listing all seasons")
 	}
 	seasons := []models.ResponseModeler{}
 	for _, season := range resp {
 		seasons = append(seasons, season.ToModel())
 	}
-	return seasons, nil
+	return newListResult(seasons, page, total), nil
+}
+
+func (s *projectService) DeleteSeason(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		season, err := s.repository.GetSeason(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting season by id")
+			return errors.Wrap(err, "Error while getting season by id")
+		}
+		trashed := &trashedDocuments{}
+		if err := s.deleteSeasonWithEpisodes(ctx, season, trashed); err != nil {
+			s.log(ctx).Error("Error while deleting season")
+			return errors.Wrap(err, "Error while deleting season")
+		}
+		if err := s.repository.RemoveShortSeason(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while removing short season from show")
+			return errors.Wrap(err, "Error while removing short season from show")
+		}
+		if err := s.addToTrash(ctx, repository.SeasonEntity, ID, deletedAt, season.Title, season.ShowID, trashed); err != nil {
+			s.log(ctx).Error("Error while adding season to the trash")
+			return err
+		}
+		return nil
+	})
+}
+
+// deleteSeasonWithEpisodes deletes a season and its episodes, and adds them
+// to trashed.
+func (s *projectService) deleteSeasonWithEpisodes(ctx context.Context, season *dto.SeasonDTO, trashed *trashedDocuments) error {
+	episodes, err := s.repository.ListSeasonEpisodes(ctx, season.ID)
+	if err != nil {
+		return errors.Wrap(err, "Error while listing season episodes")
+	}
+	for _, episode := range episodes {
+		if err := s.repository.DeleteEpisode(ctx, episode.ID); err != nil {
+			return errors.Wrap(err, "Error while deleting season episode")
+		}
+	}
+	if err := s.repository.DeleteSeason(ctx, season.ID); err != nil {
+		return errors.Wrap(err, "Error while deleting season")
+	}
+	trashed.Seasons = append(trashed.Seasons, season)
+	trashed.Episodes = append(trashed.Episodes, episodes...)
+	return nil
 }
 
 func (s *projectService) validateSeasonUniqueness(ctx context.Context, showID string, title string) error {
+	season, err := s.findSeason(ctx, showID, title)
+	if err != nil {
+		return err
+	}
+	if season != nil {
+		return errors.Wrap(models.ErrAlreadyExists, "There is already a season with that name in the Mongo database with id:"+season.ID)
+	}
+	return nil
+}
+
+// findSeason returns the season of the show with title, or nil.
+func (s *projectService) findSeason(ctx context.Context, showID string, title string) (*dto.SeasonDTO, error) {
 	seasons, err := s.repository.ListShowSeasons(ctx, showID)
 	if err != nil {
-		return errors.Wrap(err, "Error while getting seasons from the Mongo database")
+		return nil, errors.Wrap(err, "Error while getting seasons from the Mongo database")
 	}
 	for _, season := range seasons {
 		if season.Title == title {
-			return errors.New("There is already a season with that name in the Mongo database with id:" + season.ID)
+			return season, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 func toSeasonDTO(ID string, showID string, title string, trailerURL string, postersPath []string, releaseDate time.Time, rating float64, resume string, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, episodes models.ShortEpisodes) *dto.SeasonDTO {
@@ -35,6 +35,13 @@ type ProjectServicer interface {
 	GenreServicer
 	SeasonServicer
 	JournalistServicer
+	SearchServicer
+	ConsistencyServicer
+	ImportServicer
+	ChangeServicer
+	WebhookServicer
+	TrashServicer
+	RevisionServicer
 }
 
 func New(logger *logrus.Logger, repository repository.Repository) Servicer {
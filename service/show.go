@@ -4,6 +4,7 @@ import (
 	"context"
 	"int-service/dto"
 	"int-service/models"
+	"int-service/repository"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,25 +14,38 @@ import (
 type ShowServicer interface {
 	CreateShow(ctx context.Context, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons) (models.ResponseModeler, error)
 	GetShow(ctx context.Context, ID string) (models.ResponseModeler, error)
-	UpdateShow(ctx context.Context, ID string, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons) (models.ResponseModeler, error)
-	ListShows(ctx context.Context) ([]models.ResponseModeler, error)
+	UpdateShow(ctx context.Context, ID string, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons, updateMask []string) (models.ResponseModeler, error)
+	ListShows(ctx context.Context, filter models.ShowFilter, options models.ListOptions) (*models.ListResult, error)
 	UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteSeriesPoster(ctx context.Context, ID string, image string) error
 	UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error)
 	DeleteMoviePoster(ctx context.Context, ID string, image string) error
+	DeleteShow(ctx context.Context, ID string) error
 }
 
 func (s *projectService) CreateShow(ctx context.Context, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons) (models.ResponseModeler, error) {
 	err := s.validateShowUniqueness(ctx, title, releaseDate)
 	if err != nil {
-		s.logger.Error("Error while creating show")
+		s.log(ctx).Error("Error while creating show")
 		return nil, errors.Wrap(err, "Error while creating show")
 	}
 	show := toShowDTO(uuid.New().String(), title, sType, postersPath, releaseDate, endDate, rating, length, trailerURL, genres, directedBy, producedBy, writtenBy, starring, description, seasons)
-	resp, err := s.repository.CreateShow(ctx, show)
+	var resp *dto.ShowDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.CreateShow(ctx, show)
+		if err != nil {
+			s.log(ctx).Error("Error while creating show")
+			return errors.Wrap(err, "Error while creating show")
+		}
+		if err := s.addRevision(ctx, repository.ShowEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the show revision")
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Error while creating show")
-		return nil, errors.Wrap(err, "Error while creating show")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
@@ -39,82 +53,200 @@ func (s *projectService) CreateShow(ctx context.Context, title string, sType str
 func (s *projectService) GetShow(ctx context.Context, ID string) (models.ResponseModeler, error) {
 	resp, err := s.repository.GetShow(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while getting show by id")
+		s.log(ctx).Error("Error while getting show by id")
 		return nil, errors.Wrap(err, "Error while getting show by id")
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) UpdateShow(ctx context.Context, ID string, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons) (models.ResponseModeler, error) {
+func (s *projectService) UpdateShow(ctx context.Context, ID string, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, length *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons, updateMask []string) (models.ResponseModeler, error) {
 	updatedShow := toShowDTO(ID, title, sType, postersPath, releaseDate, endDate, rating, length, trailerURL, genres, directedBy, producedBy, writtenBy, starring, description, seasons)
-	resp, err := s.repository.UpdateShow(ctx, updatedShow)
+	fields, err := toUpdateFields(updateMask, repository.ShowFields)
 	if err != nil {
-		s.logger.Error("Error while updating show")
-		return nil, errors.New("Error while updating show")
+		return nil, err
+	}
+	subFields := toSubFields(updateMask)
+	var resp *dto.ShowDTO
+	err = s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		if len(subFields) > 0 {
+			stored, err := s.repository.GetShow(ctx, ID)
+			if err != nil {
+				s.log(ctx).Error("Error while getting show by id")
+				return errors.Wrap(err, "Error while getting show by id")
+			}
+			if err := keepStoredSubFields(stored, updatedShow, subFields); err != nil {
+				s.log(ctx).Error("Error while merging the show update")
+				return err
+			}
+		}
+		var err error
+		resp, err = s.repository.UpdateShow(ctx, updatedShow, fields)
+		if err != nil {
+			s.log(ctx).Error("Error while updating show")
+			return errors.Wrap(err, "Error while updating show")
+		}
+		if err := s.addRevision(ctx, repository.ShowEntity, resp.ID, resp); err != nil {
+			s.log(ctx).Error("Error while recording the show revision")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
-func (s *projectService) ListShows(ctx context.Context) ([]models.ResponseModeler, error) {
-	resp, err := s.repository.ListShows(ctx)
+func (s *projectService) ListShows(ctx context.Context, filter models.ShowFilter, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, showSortFields)
+	if err != nil {
+		return nil, err
+	}
+	filterDTO := dto.ShowFilterDTO{
+		Type:           filter.Type,
+		GenreID:        filter.GenreID,
+		ReleasedAfter:  filter.ReleasedAfter,
+		ReleasedBefore: filter.ReleasedBefore,
+		MinRating:      filter.MinRating,
+	}
+	resp, total, err := s.repository.ListShows(ctx, filterDTO, page)
 	if err != nil {
-		s.logger.Error("Error while listing all shows")
-		return nil, errors.New("Error while listing all shows")
+		s.log(ctx).Error("Error while listing all shows")
+		return nil, errors.Wrap(err, "Error while listing all shows")
 	}
 	shows := []models.ResponseModeler{}
 	for _, show := range resp {
 		shows = append(shows, show.ToModel())
 	}
-	return shows, nil
+	return newListResult(shows, page, total), nil
 }
 
 func (s *projectService) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadSeriesPosters(ctx, ID, postersPath)
+	var resp *dto.ShowDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadSeriesPosters(ctx, ID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while updating series posters")
+			return errors.Wrap(err, "Error while updating series posters")
+		}
+		return s.addShowRevision(ctx, ID)
+	})
 	if err != nil {
-		s.logger.Error("Error while updating series posters")
-		return nil, errors.Wrap(err, "Error while updating series posters")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteSeriesPoster(ctx context.Context, ID string, image string) error {
-	err := s.repository.DeleteSeriesPoster(ctx, ID, image)
-	if err != nil {
-		s.logger.Error("Error while updating deleted series poster in database")
-		return errors.Wrap(err, "Error while updating deleted series poster in database")
-	}
-	return nil
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteSeriesPoster(ctx, ID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while updating deleted series poster in database")
+			return errors.Wrap(err, "Error while updating deleted series poster in database")
+		}
+		return s.addShowRevision(ctx, ID)
+	})
 }
 
 func (s *projectService) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (models.ResponseModeler, error) {
-	resp, err := s.repository.UploadMoviePosters(ctx, ID, postersPath)
+	var resp *dto.ShowDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = s.repository.UploadMoviePosters(ctx, ID, postersPath)
+		if err != nil {
+			s.log(ctx).Error("Error while updating movie posters")
+			return errors.Wrap(err, "Error while updating movie posters")
+		}
+		return s.addShowRevision(ctx, ID)
+	})
 	if err != nil {
-		s.logger.Error("Error while updating movie posters")
-		return nil, errors.Wrap(err, "Error while updating movie posters")
+		return nil, err
 	}
 	return resp.ToModel(), nil
 }
 
 func (s *projectService) DeleteMoviePoster(ctx context.Context, ID string, image string) error {
-	err := s.repository.DeleteMoviePoster(ctx, ID, image)
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		err := s.repository.DeleteMoviePoster(ctx, ID, image)
+		if err != nil {
+			s.log(ctx).Error("Error while deleting movie poster in database")
+			return errors.Wrap(err, "Error while deleting movie poster in database")
+		}
+		return s.addShowRevision(ctx, ID)
+	})
+}
+
+// addShowRevision records the show ID as stored after a change of its
+// posters.
+func (s *projectService) addShowRevision(ctx context.Context, ID string) error {
+	show, err := s.repository.GetShow(ctx, ID)
 	if err != nil {
-		s.logger.Error("Error while deleting movie poster in database")
-		return errors.Wrap(err, "Error while deleting movie poster in database")
+		s.log(ctx).Error("Error while getting show by id")
+		return errors.Wrap(err, "Error while getting show by id")
+	}
+	if err := s.addRevision(ctx, repository.ShowEntity, ID, show); err != nil {
+		s.log(ctx).Error("Error while recording the show revision")
+		return err
 	}
 	return nil
 }
 
+func (s *projectService) DeleteShow(ctx context.Context, ID string) error {
+	return s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+		show, err := s.repository.GetShow(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while getting show by id")
+			return errors.Wrap(err, "Error while getting show by id")
+		}
+		seasons, err := s.repository.ListShowSeasons(ctx, ID)
+		if err != nil {
+			s.log(ctx).Error("Error while listing show seasons")
+			return errors.Wrap(err, "Error while listing show seasons")
+		}
+		trashed := &trashedDocuments{Show: show}
+		for _, season := range seasons {
+			if err := s.deleteSeasonWithEpisodes(ctx, season, trashed); err != nil {
+				s.log(ctx).Error("Error while deleting show season")
+				return errors.Wrap(err, "Error while deleting show season")
+			}
+		}
+		if err := s.repository.DeleteShow(ctx, ID); err != nil {
+			s.log(ctx).Error("Error while deleting show")
+			return errors.Wrap(err, "Error while deleting show")
+		}
+		if err := s.addToTrash(ctx, repository.ShowEntity, ID, deletedAt, show.Title, "", trashed); err != nil {
+			s.log(ctx).Error("Error while adding show to the trash")
+			return err
+		}
+		return nil
+	})
+}
+
 func (s *projectService) validateShowUniqueness(ctx context.Context, title string, releaseDate time.Time) error {
-	shows, err := s.repository.ListShows(ctx)
+	show, err := s.findShow(ctx, title, releaseDate)
+	if err != nil {
+		return err
+	}
+	if show != nil {
+		return errors.Wrap(models.ErrAlreadyExists, "There is already a show with that name and release date.")
+	}
+	return nil
+}
+
+// findShow returns the show with title and releaseDate, or nil.
+func (s *projectService) findShow(ctx context.Context, title string, releaseDate time.Time) (*dto.ShowDTO, error) {
+	shows, _, err := s.repository.ListShows(ctx, dto.ShowFilterDTO{}, dto.PageDTO{})
 	if err != nil {
-		return errors.Wrap(err, "Error while getting shows from the Mongo database")
+		return nil, errors.Wrap(err, "Error while getting shows from the Mongo database")
 	}
 	for _, show := range shows {
-		if show.Title == title && show.ReleaseDate == releaseDate {
-			return errors.New("There is already a show with that name and release date.")
+		if show.Title == title && show.ReleaseDate.Equal(releaseDate) {
+			return show, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 func toShowDTO(ID string, title string, sType string, postersPath []string, releaseDate time.Time, endDate time.Time, rating float64, lengthModel *models.ShowLength, trailerURL string, genres models.ShortGenres, directedBy models.FilmCrews, producedBy models.FilmCrews, writtenBy models.FilmCrews, starring models.ShortCelebrities, description string, seasons models.ShortSeasons) *dto.ShowDTO {
@@ -0,0 +1,544 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"int-service/auth"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The trash always comes most recently deleted first.
+var trashSortFields = []string{"deletedAt"}
+
+type TrashServicer interface {
+	ListTrash(ctx context.Context, entityType string, options models.ListOptions) (*models.ListResult, error)
+	RestoreShow(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreSeason(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreEpisode(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreCelebrity(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreArticle(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreGenre(ctx context.Context, ID string) (models.ResponseModeler, error)
+	RestoreJournalist(ctx context.Context, ID string) (models.ResponseModeler, error)
+}
+
+// trashedDocuments are the documents a delete removed: the deleted document,
+// the ones the delete cascaded to, and the short copies it removed from the
+// documents that are kept.
+type trashedDocuments struct {
+	Show       *dto.ShowDTO        `json:"show,omitempty"`
+	Seasons    dto.SeasonsDTO      `json:"seasons,omitempty"`
+	Episodes   dto.EpisodesDTO     `json:"episodes,omitempty"`
+	Celebrity  *dto.CelebrityDTO   `json:"celebrity,omitempty"`
+	Articles   dto.ArticlesDTO     `json:"articles,omitempty"`
+	Genre      *dto.GenreDTO       `json:"genre,omitempty"`
+	Journalist *dto.JournalistDTO  `json:"journalist,omitempty"`
+	References []*trashedReference `json:"references,omitempty"`
+}
+
+// trashedReference is a short genre, celebrity or film crew removed from the
+// Field list of a show, season or episode.
+type trashedReference struct {
+	EntityType string                 `json:"entityType"`
+	ID         string                 `json:"id"`
+	Field      string                 `json:"field"`
+	Genre      *dto.ShortGenreDTO     `json:"genre,omitempty"`
+	Celebrity  *dto.ShortCelebrityDTO `json:"celebrity,omitempty"`
+	Crew       *dto.FilmCrewDTO       `json:"crew,omitempty"`
+}
+
+// addToTrash stores the documents of a delete, to be called in the
+// transaction of the delete with the time it started. The documents are
+// marked deleted after that time, so purging the trash never removes them
+// before their item.
+func (s *projectService) addToTrash(ctx context.Context, entityType string, ID string, deletedAt time.Time, name string, parentID string, trashed *trashedDocuments) error {
+	payload, err := json.Marshal(trashed)
+	if err != nil {
+		return errors.Wrap(err, "Error while encoding the deleted documents")
+	}
+	item := &dto.TrashItemDTO{
+		ID:         ID,
+		EntityType: entityType,
+		Name:       name,
+		ParentID:   parentID,
+		DeletedAt:  deletedAt,
+		Payload:    string(payload),
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		item.DeletedBy = principal.Subject
+	}
+	if err := s.repository.AddTrashItem(ctx, item); err != nil {
+		return errors.Wrap(err, "Error while adding the deleted documents to the trash")
+	}
+	return nil
+}
+
+// takeFromTrash removes a trash item, to be called in the transaction of the
+// restore, and returns its documents.
+func (s *projectService) takeFromTrash(ctx context.Context, entityType string, ID string) (*trashedDocuments, error) {
+	item, err := s.repository.GetTrashItem(ctx, entityType, ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while getting the "+entityType+" from the trash")
+	}
+	trashed := &trashedDocuments{}
+	if err := json.Unmarshal([]byte(item.Payload), trashed); err != nil {
+		return nil, errors.Wrap(err, "Error while decoding the deleted documents")
+	}
+	if err := s.repository.DeleteTrashItem(ctx, entityType, ID); err != nil {
+		return nil, errors.Wrap(err, "Error while removing the "+entityType+" from the trash")
+	}
+	return trashed, nil
+}
+
+func (s *projectService) ListTrash(ctx context.Context, entityType string, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, trashSortFields)
+	if err != nil {
+		return nil, err
+	}
+	filter := dto.TrashFilterDTO{EntityType: entityType}
+	if principal := auth.PrincipalFromContext(ctx); entityType == repository.ArticleEntity && principal != nil && principal.OwnArticlesOnly() {
+		if principal.JournalistID == "" {
+			return nil, errors.Wrap(models.ErrPermissionDenied, "A journalist can only list their own deleted articles")
+		}
+		filter.ParentID = principal.JournalistID
+	}
+	resp, total, err := s.repository.ListTrashItems(ctx, filter, page)
+	if err != nil {
+		s.log(ctx).Error("Error while listing the trash")
+		return nil, errors.Wrap(err, "Error while listing the trash")
+	}
+	items := []models.ResponseModeler{}
+	for _, item := range resp {
+		items = append(items, &models.TrashItem{
+			ID:         item.ID,
+			EntityType: item.EntityType,
+			Name:       item.Name,
+			ParentID:   item.ParentID,
+			DeletedAt:  item.DeletedAt,
+			DeletedBy:  item.DeletedBy,
+		})
+	}
+	return newListResult(items, page, total), nil
+}
+
+func (s *projectService) RestoreShow(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var show *dto.ShowDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.ShowEntity, ID)
+		if err != nil {
+			return err
+		}
+		show = trashed.Show
+		if err := s.validateShowUniqueness(ctx, show.Title, show.ReleaseDate); err != nil {
+			return err
+		}
+		return s.restoreDocuments(ctx, trashed)
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring show")
+		return nil, errors.Wrap(err, "Error while restoring show")
+	}
+	return show.ToModel(), nil
+}
+
+func (s *projectService) RestoreSeason(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var season *dto.SeasonDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.SeasonEntity, ID)
+		if err != nil {
+			return err
+		}
+		season = trashed.Seasons[0]
+		if err := s.checkParent(ctx, "show", season.ShowID, s.showExists); err != nil {
+			return err
+		}
+		if err := s.validateSeasonUniqueness(ctx, season.ShowID, season.Title); err != nil {
+			return err
+		}
+		if err := s.restoreDocuments(ctx, trashed); err != nil {
+			return err
+		}
+		_, err = s.repository.AddShortSeason(ctx, season.ShowID, &dto.ShortSeasonDTO{
+			ID:          season.ID,
+			Title:       season.Title,
+			PostersPath: season.PostersPath,
+			Rating:      season.Rating,
+		})
+		if err != nil {
+			return errors.Wrap(err, "Error while adding short season in show")
+		}
+		return nil
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring season")
+		return nil, errors.Wrap(err, "Error while restoring season")
+	}
+	return season.ToModel(), nil
+}
+
+func (s *projectService) RestoreEpisode(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var episode *dto.EpisodeDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.EpisodeEntity, ID)
+		if err != nil {
+			return err
+		}
+		episode = trashed.Episodes[0]
+		if err := s.checkParent(ctx, "season", episode.SeasonID, s.seasonExists); err != nil {
+			return err
+		}
+		if err := s.validateEpisodeUniqueness(ctx, episode.SeasonID, episode.Title); err != nil {
+			return err
+		}
+		if err := s.restoreDocuments(ctx, trashed); err != nil {
+			return err
+		}
+		_, err = s.repository.AddShortEpisode(ctx, episode.SeasonID, &dto.ShortEpisodeDTO{
+			ID:          episode.ID,
+			Title:       episode.Title,
+			PostersPath: episode.PostersPath,
+			Rating:      episode.Rating,
+			Resume:      episode.Resume,
+		})
+		if err != nil {
+			return errors.Wrap(err, "Error while adding short episode in season")
+		}
+		return nil
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring episode")
+		return nil, errors.Wrap(err, "Error while restoring episode")
+	}
+	return episode.ToModel(), nil
+}
+
+func (s *projectService) RestoreCelebrity(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var celebrity *dto.CelebrityDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.CelebrityEntity, ID)
+		if err != nil {
+			return err
+		}
+		celebrity = trashed.Celebrity
+		if err := s.validateCelebrityUniqueness(ctx, celebrity.Name, celebrity.DateOfBirth); err != nil {
+			return err
+		}
+		return s.restoreDocuments(ctx, trashed)
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring celebrity")
+		return nil, errors.Wrap(err, "Error while restoring celebrity")
+	}
+	return celebrity.ToModel(), nil
+}
+
+func (s *projectService) RestoreArticle(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var article *dto.ArticleDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.ArticleEntity, ID)
+		if err != nil {
+			return err
+		}
+		article = trashed.Articles[0]
+		if err := checkArticleAuthor(ctx, article.Journalist.ID); err != nil {
+			return err
+		}
+		if err := s.checkParent(ctx, "journalist", article.Journalist.ID, s.journalistExists); err != nil {
+			return err
+		}
+		return s.restoreDocuments(ctx, trashed)
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring article")
+		return nil, errors.Wrap(err, "Error while restoring article")
+	}
+	return article.ToModel(), nil
+}
+
+func (s *projectService) RestoreGenre(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var genre *dto.GenreDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.GenreEntity, ID)
+		if err != nil {
+			return err
+		}
+		genre = trashed.Genre
+		_, err = s.repository.GetGenreByName(ctx, genre.Name)
+		if err == nil {
+			return errors.Wrap(models.ErrAlreadyExists, "There is already a genre with that name.")
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return errors.Wrap(err, "Error while getting genre by name")
+		}
+		return s.restoreDocuments(ctx, trashed)
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring genre")
+		return nil, errors.Wrap(err, "Error while restoring genre")
+	}
+	return genre.ToModel(), nil
+}
+
+func (s *projectService) RestoreJournalist(ctx context.Context, ID string) (models.ResponseModeler, error) {
+	var journalist *dto.JournalistDTO
+	err := s.repository.WithTransaction(ctx, func(ctx context.Context) error {
+		trashed, err := s.takeFromTrash(ctx, repository.JournalistEntity, ID)
+		if err != nil {
+			return err
+		}
+		journalist = trashed.Journalist
+		_, err = s.repository.GetJournalistByName(ctx, journalist.Name)
+		if err == nil {
+			return errors.Wrap(models.ErrAlreadyExists, "There is already a journalist with that name.")
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			return errors.Wrap(err, "Error while getting journalist by name")
+		}
+		return s.restoreDocuments(ctx, trashed)
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while restoring journalist")
+		return nil, errors.Wrap(err, "Error while restoring journalist")
+	}
+	return journalist.ToModel(), nil
+}
+
+// checkParent fails with models.ErrFailedPrecondition when the document a
+// restored document belongs to is deleted too, as it has to be restored
+// first.
+func (s *projectService) checkParent(ctx context.Context, parentType string, parentID string, exists func(ctx context.Context, ID string) error) error {
+	err := exists(ctx, parentID)
+	if errors.Is(err, models.ErrNotFound) {
+		return errors.Wrap(models.ErrFailedPrecondition, "The "+parentType+" "+parentID+" is deleted, restore it first")
+	}
+	return err
+}
+
+func (s *projectService) showExists(ctx context.Context, ID string) error {
+	_, err := s.repository.GetShow(ctx, ID)
+	return err
+}
+
+func (s *projectService) seasonExists(ctx context.Context, ID string) error {
+	_, err := s.repository.GetSeason(ctx, ID)
+	return err
+}
+
+func (s *projectService) journalistExists(ctx context.Context, ID string) error {
+	_, err := s.repository.GetJournalist(ctx, ID)
+	return err
+}
+
+// restoreDocuments clears the deleted mark of the trashed documents, which
+// kept their fields and posters in place, then adds the trashed references
+// back to the documents still holding them.
+func (s *projectService) restoreDocuments(ctx context.Context, trashed *trashedDocuments) error {
+	restore := func(entityType string, ID string) error {
+		if err := s.repository.RestoreDocument(ctx, entityType, ID); err != nil {
+			return errors.Wrap(err, "Error while restoring "+entityType)
+		}
+		return nil
+	}
+	if trashed.Journalist != nil {
+		if err := restore(repository.JournalistEntity, trashed.Journalist.ID); err != nil {
+			return err
+		}
+	}
+	if trashed.Genre != nil {
+		if err := restore(repository.GenreEntity, trashed.Genre.ID); err != nil {
+			return err
+		}
+	}
+	if trashed.Show != nil {
+		if err := restore(repository.ShowEntity, trashed.Show.ID); err != nil {
+			return err
+		}
+	}
+	for _, season := range trashed.Seasons {
+		if err := restore(repository.SeasonEntity, season.ID); err != nil {
+			return err
+		}
+	}
+	for _, episode := range trashed.Episodes {
+		if err := restore(repository.EpisodeEntity, episode.ID); err != nil {
+			return err
+		}
+	}
+	if trashed.Celebrity != nil {
+		if err := restore(repository.CelebrityEntity, trashed.Celebrity.ID); err != nil {
+			return err
+		}
+	}
+	for _, article := range trashed.Articles {
+		if err := restore(repository.ArticleEntity, article.ID); err != nil {
+			return err
+		}
+	}
+	for _, reference := range trashed.References {
+		if err := s.restoreReference(ctx, reference); err != nil {
+			return errors.Wrap(err, "Error while restoring a reference in "+reference.EntityType+" "+reference.ID)
+		}
+	}
+	return nil
+}
+
+// restoreReference adds a trashed reference back to its list, unless the
+// document holding it was deleted since or already holds it again.
+func (s *projectService) restoreReference(ctx context.Context, reference *trashedReference) error {
+	var err error
+	switch reference.EntityType {
+	case repository.ShowEntity:
+		var show *dto.ShowDTO
+		if show, err = s.repository.GetShow(ctx, reference.ID); err == nil {
+			genres, crews, starring := showReferences(show)
+			if addReference(reference, genres, crews, starring) {
+				_, err = s.repository.UpdateShow(ctx, show, []string{reference.Field})
+			}
+		}
+	case repository.SeasonEntity:
+		var season *dto.SeasonDTO
+		if season, err = s.repository.GetSeason(ctx, reference.ID); err == nil {
+			if addReference(reference, nil, seasonCrews(season), nil) {
+				_, err = s.repository.UpdateSeason(ctx, season, []string{reference.Field})
+			}
+		}
+	case repository.EpisodeEntity:
+		var episode *dto.EpisodeDTO
+		if episode, err = s.repository.GetEpisode(ctx, reference.ID); err == nil {
+			if addReference(reference, nil, episodeCrews(episode), &episode.Starring) {
+				_, err = s.repository.UpdateEpisode(ctx, episode, []string{reference.Field})
+			}
+		}
+	}
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// addReference adds a reference to the list of its field, and reports
+// whether the list changed.
+func addReference(reference *trashedReference, genres *dto.ShortGenresDTO, crews map[string]*dto.FilmCrewsDTO, starring *dto.ShortCelebritiesDTO) bool {
+	switch {
+	case reference.Genre != nil && genres != nil && reference.Field == "genres":
+		for _, genre := range *genres {
+			if genre.ID == reference.Genre.ID {
+				return false
+			}
+		}
+		*genres = append(*genres, reference.Genre)
+		return true
+	case reference.Celebrity != nil && starring != nil && reference.Field == "starring":
+		for _, celebrity := range *starring {
+			if celebrity.ID == reference.Celebrity.ID {
+				return false
+			}
+		}
+		*starring = append(*starring, reference.Celebrity)
+		return true
+	case reference.Crew != nil && crews[reference.Field] != nil:
+		list := crews[reference.Field]
+		for _, crew := range *list {
+			if crew.ID == reference.Crew.ID {
+				return false
+			}
+		}
+		*list = append(*list, reference.Crew)
+		return true
+	}
+	return false
+}
+
+func showReferences(show *dto.ShowDTO) (*dto.ShortGenresDTO, map[string]*dto.FilmCrewsDTO, *dto.ShortCelebritiesDTO) {
+	crews := map[string]*dto.FilmCrewsDTO{
+		"directedBy": &show.DirectedBy,
+		"producedBy": &show.ProducedBy,
+		"writtenBy":  &show.WrittenBy,
+	}
+	return &show.Genres, crews, &show.Starring
+}
+
+func seasonCrews(season *dto.SeasonDTO) map[string]*dto.FilmCrewsDTO {
+	return map[string]*dto.FilmCrewsDTO{
+		"directedBy": &season.DirectedBy,
+		"producedBy": &season.ProducedBy,
+		"writtenBy":  &season.WrittenBy,
+	}
+}
+
+func episodeCrews(episode *dto.EpisodeDTO) map[string]*dto.FilmCrewsDTO {
+	return map[string]*dto.FilmCrewsDTO{
+		"directedBy": &episode.DirectedBy,
+		"producedBy": &episode.ProducedBy,
+		"writtenBy":  &episode.WrittenBy,
+	}
+}
+
+// celebrityReferences returns the credits of a celebrity in the shows,
+// seasons and episodes, which deleting the celebrity removes.
+func (s *projectService) celebrityReferences(ctx context.Context, ID string) ([]*trashedReference, error) {
+	references := []*trashedReference{}
+	addCredits := func(entityType string, documentID string, crews map[string]*dto.FilmCrewsDTO, starring *dto.ShortCelebritiesDTO) {
+		for field, list := range crews {
+			for _, crew := range *list {
+				if crew.ID == ID {
+					references = append(references, &trashedReference{EntityType: entityType, ID: documentID, Field: field, Crew: crew})
+				}
+			}
+		}
+		if starring == nil {
+			return
+		}
+		for _, celebrity := range *starring {
+			if celebrity.ID == ID {
+				references = append(references, &trashedReference{EntityType: entityType, ID: documentID, Field: "starring", Celebrity: celebrity})
+			}
+		}
+	}
+
+	shows, _, err := s.repository.ListShows(ctx, dto.ShowFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while listing shows")
+	}
+	for _, show := range shows {
+		_, crews, starring := showReferences(show)
+		addCredits(repository.ShowEntity, show.ID, crews, starring)
+	}
+	seasons, _, err := s.repository.ListSeasonsCollection(ctx, dto.SeasonFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while listing seasons")
+	}
+	for _, season := range seasons {
+		addCredits(repository.SeasonEntity, season.ID, seasonCrews(season), nil)
+	}
+	episodes, _, err := s.repository.ListCollectionEpisodes(ctx, dto.EpisodeFilterDTO{}, dto.PageDTO{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while listing episodes")
+	}
+	for _, episode := range episodes {
+		addCredits(repository.EpisodeEntity, episode.ID, episodeCrews(episode), &episode.Starring)
+	}
+	return references, nil
+}
+
+// genreReferences returns the short copies of a genre in the shows, which
+// deleting the genre removes.
+func (s *projectService) genreReferences(ctx context.Context, ID string) ([]*trashedReference, error) {
+	shows, _, err := s.repository.ListShows(ctx, dto.ShowFilterDTO{GenreID: ID}, dto.PageDTO{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while listing shows")
+	}
+	references := []*trashedReference{}
+	for _, show := range shows {
+		for _, genre := range show.Genres {
+			if genre.ID == ID {
+				references = append(references, &trashedReference{EntityType: repository.ShowEntity, ID: show.ID, Field: "genres", Genre: genre})
+			}
+		}
+	}
+	return references, nil
+}
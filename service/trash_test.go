@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"int-service/models"
+	"int-service/repository"
+	"testing"
+)
+
+// TestRestore checks a restore brings back the documents of a delete and the
+// short copies it removed from the documents kept.
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		ID      string
+		delete  func(svc ProjectServicer) error
+		restore func(svc ProjectServicer) (models.ResponseModeler, error)
+		// back are the documents the restore brings back, by their getter.
+		back  []func(repo repository.ProjectRepository) error
+		check func(t *testing.T, repo repository.ProjectRepository)
+	}{
+		{
+			name:    "show",
+			ID:      "s1",
+			delete:  func(svc ProjectServicer) error { return svc.DeleteShow(ctx, "s1") },
+			restore: func(svc ProjectServicer) (models.ResponseModeler, error) { return svc.RestoreShow(ctx, "s1") },
+			back:    []func(repository.ProjectRepository) error{getShow, getSeason, getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Seasons) != 1 || len(show.Genres) != 1 || len(show.Starring) != 1 {
+					t.Errorf("the restored show is %+v, %v", show, err)
+				}
+			},
+		},
+		{
+			name:    "season",
+			ID:      "se1",
+			delete:  func(svc ProjectServicer) error { return svc.DeleteSeason(ctx, "se1") },
+			restore: func(svc ProjectServicer) (models.ResponseModeler, error) { return svc.RestoreSeason(ctx, "se1") },
+			back:    []func(repository.ProjectRepository) error{getSeason, getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Seasons) != 1 || show.Seasons[0].ID != "se1" {
+					t.Errorf("the show of the restored season is %+v, %v", show, err)
+				}
+				season, err := repo.GetSeason(ctx, "se1")
+				if err != nil || len(season.Episodes) != 1 {
+					t.Errorf("the restored season is %+v, %v", season, err)
+				}
+			},
+		},
+		{
+			name:    "episode",
+			ID:      "e1",
+			delete:  func(svc ProjectServicer) error { return svc.DeleteEpisode(ctx, "e1") },
+			restore: func(svc ProjectServicer) (models.ResponseModeler, error) { return svc.RestoreEpisode(ctx, "e1") },
+			back:    []func(repository.ProjectRepository) error{getEpisode},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				season, err := repo.GetSeason(ctx, "se1")
+				if err != nil || len(season.Episodes) != 1 || season.Episodes[0].ID != "e1" {
+					t.Errorf("the season of the restored episode is %+v, %v", season, err)
+				}
+			},
+		},
+		{
+			name:    "celebrity",
+			ID:      "c1",
+			delete:  func(svc ProjectServicer) error { return svc.DeleteCelebrity(ctx, "c1") },
+			restore: func(svc ProjectServicer) (models.ResponseModeler, error) { return svc.RestoreCelebrity(ctx, "c1") },
+			back:    []func(repository.ProjectRepository) error{getCelebrity},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Starring) != 1 || show.Starring[0].RoleName != "Jonas" {
+					t.Errorf("the show of the restored celebrity is %+v, %v", show, err)
+				}
+				episode, err := repo.GetEpisode(ctx, "e1")
+				if err != nil || len(episode.Starring) != 1 || episode.Starring[0].ID != "c1" {
+					t.Errorf("the episode of the restored celebrity is %+v, %v", episode, err)
+				}
+			},
+		},
+		{
+			name:    "genre",
+			ID:      "g1",
+			delete:  func(svc ProjectServicer) error { return svc.DeleteGenre(ctx, "g1") },
+			restore: func(svc ProjectServicer) (models.ResponseModeler, error) { return svc.RestoreGenre(ctx, "g1") },
+			back:    []func(repository.ProjectRepository) error{getGenre},
+			check: func(t *testing.T, repo repository.ProjectRepository) {
+				show, err := repo.GetShow(ctx, "s1")
+				if err != nil || len(show.Genres) != 1 || show.Genres[0].Name != "Mystery" {
+					t.Errorf("the show of the restored genre is %+v, %v", show, err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repository.NewMemoryDB()
+			seedCatalog(t, repo)
+			svc := newTestService(t, repo)
+			if err := tt.delete(svc); err != nil {
+				t.Fatal(err)
+			}
+			trash, err := svc.ListTrash(ctx, tt.name, models.ListOptions{})
+			if err != nil || len(trash.Items) != 1 || trash.Items[0].(*models.TrashItem).ID != tt.ID {
+				t.Errorf("the trash holds %+v, %v", trash, err)
+			}
+			if _, err := tt.restore(svc); err != nil {
+				t.Fatal(err)
+			}
+			for _, get := range tt.back {
+				if err := get(repo); err != nil {
+					t.Errorf("a restored document is not found: %v", err)
+				}
+			}
+			tt.check(t, repo)
+			if trash, err := svc.ListTrash(ctx, tt.name, models.ListOptions{}); err != nil || len(trash.Items) != 0 {
+				t.Errorf("the trash after the restore holds %+v, %v", trash, err)
+			}
+			if _, err := tt.restore(svc); !errors.Is(err, models.ErrNotFound) {
+				t.Errorf("restoring again returned %v, want not found", err)
+			}
+		})
+	}
+}
+
+// TestRestoreDeletedParent checks an episode cannot be restored into a
+// deleted season, and can once the season is restored.
+func TestRestoreDeletedParent(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryDB()
+	seedCatalog(t, repo)
+	svc := newTestService(t, repo)
+	if err := svc.DeleteEpisode(ctx, "e1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DeleteSeason(ctx, "se1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RestoreEpisode(ctx, "e1"); !errors.Is(err, models.ErrFailedPrecondition) {
+		t.Fatalf("restoring into a deleted season returned %v, want %v", err, models.ErrFailedPrecondition)
+	}
+	if err := getEpisode(repo); !errors.Is(err, models.ErrNotFound) {
+		t.Errorf("the failed restore brought the episode back: %v", err)
+	}
+
+	if _, err := svc.RestoreSeason(ctx, "se1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RestoreEpisode(ctx, "e1"); err != nil {
+		t.Fatal(err)
+	}
+	season, err := repo.GetSeason(ctx, "se1")
+	if err != nil || len(season.Episodes) != 1 || season.Episodes[0].ID != "e1" {
+		t.Errorf("the season after both restores is %+v, %v", season, err)
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"encoding/json"
+	"int-service/models"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// subDocumentFields are the fields of the sub-documents an update mask can
+// name on their own, such as length.hours.
+var subDocumentFields = map[string][]string{
+	"length": {"hours", "minutes"},
+}
+
+// toUpdateFields checks the paths of an update mask against the fields an
+// update can write. An empty mask writes every field. A path into a
+// sub-document writes the field holding it, see keepStoredSubFields.
+func toUpdateFields(updateMask []string, fields []string) ([]string, error) {
+	if len(updateMask) == 0 {
+		return fields, nil
+	}
+	updateFields := []string{}
+	for _, path := range updateMask {
+		field, subField, nested := strings.Cut(path, ".")
+		if !containsString(fields, field) {
+			return nil, models.NewFieldError("updateMask", "unknown field "+path+", must be one of "+strings.Join(fields, ", "))
+		}
+		if nested && !containsString(subDocumentFields[field], subField) {
+			if len(subDocumentFields[field]) == 0 {
+				return nil, models.NewFieldError("updateMask", "unknown field "+path+", "+field+" can only be written whole")
+			}
+			return nil, models.NewFieldError("updateMask", "unknown field "+path+", must be one of "+field+"."+strings.Join(subDocumentFields[field], ", "+field+"."))
+		}
+		if !containsString(updateFields, field) {
+			updateFields = append(updateFields, field)
+		}
+	}
+	return updateFields, nil
+}
+
+// toSubFields returns the fields of each sub-document the paths of an update
+// mask write, leaving out the sub-documents the mask writes whole.
+func toSubFields(updateMask []string) map[string][]string {
+	subFields := map[string][]string{}
+	for _, path := range updateMask {
+		if field, subField, nested := strings.Cut(path, "."); nested {
+			subFields[field] = append(subFields[field], subField)
+		}
+	}
+	for _, path := range updateMask {
+		delete(subFields, path)
+	}
+	return subFields
+}
+
+// keepStoredSubFields sets the fields of the sub-documents of updated that
+// subFields does not name to their stored values, so writing a sub-document
+// only changes the fields named by the update mask.
+func keepStoredSubFields(stored interface{}, updated interface{}, subFields map[string][]string) error {
+	if len(subFields) == 0 {
+		return nil
+	}
+	storedFields := map[string]json.RawMessage{}
+	if err := remarshal(stored, &storedFields); err != nil {
+		return err
+	}
+	updatedFields := map[string]json.RawMessage{}
+	if err := remarshal(updated, &updatedFields); err != nil {
+		return err
+	}
+	for field, written := range subFields {
+		storedSubFields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(storedFields[field], &storedSubFields); err != nil {
+			return errors.Wrap(err, "Error while decoding the stored "+field)
+		}
+		updatedSubFields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(updatedFields[field], &updatedSubFields); err != nil {
+			return errors.Wrap(err, "Error while decoding the updated "+field)
+		}
+		for _, subField := range written {
+			storedSubFields[subField] = updatedSubFields[subField]
+		}
+		value, err := json.Marshal(storedSubFields)
+		if err != nil {
+			return errors.Wrap(err, "Error while encoding the updated "+field)
+		}
+		updatedFields[field] = value
+	}
+	return remarshal(updatedFields, updated)
+}
+
+func remarshal(from interface{}, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return errors.Wrap(err, "Error while encoding the document")
+	}
+	if err := json.Unmarshal(data, to); err != nil {
+		return errors.Wrap(err, "Error while decoding the document")
+	}
+	return nil
+}
+
+// Fields of the short copies embedded in other documents. An update only
+// refreshes the copies when it changes one of them.
+var (
+	shortSeasonFields    = []string{"title", "rating", "postersPath"}
+	shortEpisodeFields   = []string{"title", "rating", "postersPath", "resume"}
+	shortCelebrityFields = []string{"name", "postersPath", "occupation"}
+)
+
+// changesAny reports whether one of shortFields differs between the stored
+// document and the updated one. A missing list and an empty one are equal.
+func changesAny(stored interface{}, updated interface{}, shortFields []string) (bool, error) {
+	from, err := json.Marshal(stored)
+	if err != nil {
+		return false, errors.Wrap(err, "Error while encoding the stored document")
+	}
+	to, err := json.Marshal(updated)
+	if err != nil {
+		return false, errors.Wrap(err, "Error while encoding the updated document")
+	}
+	changes, err := diffSnapshots(string(from), string(to))
+	if err != nil {
+		return false, err
+	}
+	for _, change := range changes {
+		if containsString(shortFields, change.Field) && !(isEmptyJSON(change.From) && isEmptyJSON(change.To)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isEmptyJSON(value string) bool {
+	return value == "" || value == "null" || value == "[]"
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// shortCopies counts the writes to the short copies of seasons, episodes and
+// celebrities.
+type shortCopies struct {
+	repository.ProjectRepository
+	seasons     int
+	episodes    int
+	celebrities int
+}
+
+func (c *shortCopies) UpdateShortSeason(ctx context.Context, updatedSeason *dto.ShortSeasonDTO) (*dto.ShortSeasonDTO, error) {
+	c.seasons++
+	return c.ProjectRepository.UpdateShortSeason(ctx, updatedSeason)
+}
+
+func (c *shortCopies) UpdateShortEpisode(ctx context.Context, updatedEpisode *dto.ShortEpisodeDTO) (*dto.ShortEpisodeDTO, error) {
+	c.episodes++
+	return c.ProjectRepository.UpdateShortEpisode(ctx, updatedEpisode)
+}
+
+func (c *shortCopies) UpdateShortCelebritiesInShow(ctx context.Context, updatedCelebrity *dto.ShortCelebrityDTO, celebrityType string) (*dto.ShortCelebrityDTO, error) {
+	c.celebrities++
+	return c.ProjectRepository.UpdateShortCelebritiesInShow(ctx, updatedCelebrity, celebrityType)
+}
+
+func newTestService(t *testing.T, repo repository.ProjectRepository) ProjectServicer {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewSvc(logger, repo)
+}
+
+func TestToUpdateFields(t *testing.T) {
+	fields := []string{"title", "length"}
+	tests := []struct {
+		mask      []string
+		want      []string
+		subFields map[string][]string
+		invalid   bool
+	}{
+		{mask: nil, want: fields, subFields: map[string][]string{}},
+		{mask: []string{"title", "title"}, want: []string{"title"}, subFields: map[string][]string{}},
+		{mask: []string{"length.hours"}, want: []string{"length"}, subFields: map[string][]string{"length": {"hours"}}},
+		{mask: []string{"length.hours", "length.minutes"}, want: []string{"length"}, subFields: map[string][]string{"length": {"hours", "minutes"}}},
+		{mask: []string{"length.hours", "length"}, want: []string{"length"}, subFields: map[string][]string{}},
+		{mask: []string{"rating"}, invalid: true},
+		{mask: []string{"length.seconds"}, invalid: true},
+		{mask: []string{"length.hours.tens"}, invalid: true},
+		{mask: []string{"title.size"}, invalid: true},
+	}
+	for _, tt := range tests {
+		got, err := toUpdateFields(tt.mask, fields)
+		if tt.invalid {
+			if !errors.Is(err, models.ErrInvalidArgument) {
+				t.Errorf("toUpdateFields(%q) returned %v, want an invalid argument", tt.mask, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("toUpdateFields(%q) = %q, want %q", tt.mask, got, tt.want)
+		}
+		if subFields := toSubFields(tt.mask); !reflect.DeepEqual(subFields, tt.subFields) {
+			t.Errorf("toSubFields(%q) = %q, want %q", tt.mask, subFields, tt.subFields)
+		}
+	}
+}
+
+func TestKeepStoredSubFields(t *testing.T) {
+	stored := &dto.EpisodeDTO{ID: "e1", Title: "Secrets", Length: dto.ShowLengthDTO{Hours: 1, Minutes: 30}}
+	updated := &dto.EpisodeDTO{ID: "e1", Title: "Lies", Length: dto.ShowLengthDTO{Hours: 2}}
+	if err := keepStoredSubFields(stored, updated, map[string][]string{"length": {"hours"}}); err != nil {
+		t.Fatal(err)
+	}
+	if updated.Length != (dto.ShowLengthDTO{Hours: 2, Minutes: 30}) || updated.Title != "Lies" {
+		t.Errorf("the merged update is %+v", updated)
+	}
+}
+
+func TestChangesAny(t *testing.T) {
+	stored := &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 8, Resume: "Old.", PostersPath: []string{}}
+	tests := []struct {
+		name    string
+		updated *dto.SeasonDTO
+		want    bool
+	}{
+		{name: "same", updated: &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 8, Resume: "Old.", PostersPath: []string{}}, want: false},
+		{name: "other field", updated: &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 8, Resume: "New.", PostersPath: []string{}}, want: false},
+		{name: "missing posters", updated: &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 8, Resume: "Old."}, want: false},
+		{name: "title", updated: &dto.SeasonDTO{ID: "se1", Title: "Season One", Rating: 8, Resume: "Old.", PostersPath: []string{}}, want: true},
+		{name: "rating", updated: &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 9, Resume: "Old.", PostersPath: []string{}}, want: true},
+		{name: "posters", updated: &dto.SeasonDTO{ID: "se1", Title: "Season 1", Rating: 8, Resume: "Old.", PostersPath: []string{"/series/s1/se1/a.jpg"}}, want: true},
+	}
+	for _, tt := range tests {
+		got, err := changesAny(stored, tt.updated, shortSeasonFields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: changesAny = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestUpdatesRefreshChangedShortCopies checks the short copies are written
+// only when an update changes one of their fields, whatever the mask names.
+func TestUpdatesRefreshChangedShortCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := &shortCopies{ProjectRepository: repository.NewMemoryDB()}
+	svc := newTestService(t, repo)
+	release := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+	writes := []error{
+		second(repo.CreateShow(ctx, &dto.ShowDTO{ID: "s1", Title: "Dark", Type: "series"})),
+		second(repo.CreateSeason(ctx, &dto.SeasonDTO{ID: "se1", ShowID: "s1", Title: "Season 1", Rating: 8})),
+		second(repo.AddShortSeason(ctx, "s1", &dto.ShortSeasonDTO{ID: "se1", Title: "Season 1", Rating: 8})),
+		second(repo.CreateEpisode(ctx, &dto.EpisodeDTO{ID: "e1", SeasonID: "se1", Title: "Secrets", Rating: 8})),
+		second(repo.AddShortEpisode(ctx, "se1", &dto.ShortEpisodeDTO{ID: "e1", Title: "Secrets", Rating: 8})),
+		second(repo.CreateCelebrity(ctx, &dto.CelebrityDTO{ID: "c1", Name: "Louis Hofmann", Occupation: []string{Actor}, DateOfBirth: release, Gender: "male"})),
+	}
+	for _, err := range writes {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	updateSeason := func(title string, resume string, mask ...string) {
+		t.Helper()
+		if _, err := svc.UpdateSeason(ctx, "se1", "s1", title, "", nil, release, 8, resume, nil, nil, nil, nil, mask); err != nil {
+			t.Fatal(err)
+		}
+	}
+	updateEpisode := func(title string, resume string, mask ...string) {
+		t.Helper()
+		if _, err := svc.UpdateEpisode(ctx, "e1", "se1", title, nil, "", &models.ShowLength{}, 8, resume, nil, nil, nil, nil, mask); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gender := models.Gender("male")
+	updateCelebrity := func(name string, bio string, mask ...string) {
+		t.Helper()
+		if _, err := svc.UpdateCelebrity(ctx, "c1", name, []string{Actor}, nil, release, time.Time{}, "", &gender, bio, mask); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		update      func()
+		seasons     int
+		episodes    int
+		celebrities int
+	}{
+		{name: "season field outside the copy", update: func() { updateSeason("Season 1", "New.", "resume") }},
+		{name: "season title left as it is", update: func() { updateSeason("Season 1", "", "title") }},
+		{name: "season title", update: func() { updateSeason("Season One", "", "title") }, seasons: 1},
+		{name: "episode field outside the copy", update: func() { updateEpisode("Secrets", "", "trailerUrl") }},
+		{name: "episode title left as it is", update: func() { updateEpisode("Secrets", "", "title") }},
+		{name: "episode resume", update: func() { updateEpisode("Secrets", "Mikkel goes missing.", "resume") }, episodes: 1},
+		{name: "celebrity field outside the copy", update: func() { updateCelebrity("Louis Hofmann", "New.", "bio") }},
+		{name: "celebrity name left as it is", update: func() { updateCelebrity("Louis Hofmann", "", "name") }},
+		{name: "celebrity name", update: func() { updateCelebrity("Louis H.", "", "name") }, celebrities: 1},
+	}
+	for _, tt := range tests {
+		repo.seasons, repo.episodes, repo.celebrities = 0, 0, 0
+		tt.update()
+		if repo.seasons != tt.seasons || repo.episodes != tt.episodes || repo.celebrities != tt.celebrities {
+			t.Errorf("%s wrote %d short seasons, %d short episodes and %d short celebrities, want %d, %d and %d",
+				tt.name, repo.seasons, repo.episodes, repo.celebrities, tt.seasons, tt.episodes, tt.celebrities)
+		}
+	}
+
+	show, err := repo.GetShow(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(show.Seasons) != 1 || show.Seasons[0].Title != "Season One" {
+		t.Errorf("the short season is %+v, want the new title", show.Seasons)
+	}
+}
+
+func second(_ interface{}, err error) error {
+	return err
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"int-service/webhook"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Dead letters always come newest first.
+var deadLetterSortFields = []string{"createdAt"}
+
+type WebhookServicer interface {
+	RegisterWebhook(ctx context.Context, URL string, secret string, eventTypes []string) (models.ResponseModeler, error)
+	ListWebhooks(ctx context.Context) ([]models.ResponseModeler, error)
+	DeleteWebhook(ctx context.Context, ID string) error
+	ListDeadLetters(ctx context.Context, options models.ListOptions) (*models.ListResult, error)
+}
+
+// webhookEventTypes are the types of the events the outbox records.
+func webhookEventTypes() []string {
+	eventTypes := []string{}
+	for _, entityType := range repository.EntityTypes() {
+		for _, operation := range []string{repository.OperationCreated, repository.OperationUpdated, repository.OperationDeleted} {
+			eventTypes = append(eventTypes, webhook.EventType(entityType, operation))
+		}
+	}
+	return eventTypes
+}
+
+func (s *projectService) RegisterWebhook(ctx context.Context, URL string, secret string, eventTypes []string) (models.ResponseModeler, error) {
+	endpoint, err := url.Parse(URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, models.NewFieldError("url", "must be an absolute http or https URL")
+	}
+	for _, eventType := range eventTypes {
+		if !containsString(webhookEventTypes(), eventType) {
+			return nil, models.NewFieldError("eventTypes", "must be ENTITY.OPERATION, such as article.created, with an operation among created, updated and deleted")
+		}
+	}
+	if secret == "" {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, errors.Wrap(err, "Error while generating the webhook secret")
+		}
+		secret = hex.EncodeToString(key)
+	}
+
+	resp, err := s.repository.CreateWebhook(ctx, &dto.WebhookDTO{
+		ID:         uuid.New().String(),
+		URL:        URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		s.log(ctx).Error("Error while registering webhook")
+		return nil, errors.Wrap(err, "Error while registering webhook")
+	}
+	webhook := toWebhookModel(resp)
+	webhook.Secret = resp.Secret
+	return webhook, nil
+}
+
+func (s *projectService) ListWebhooks(ctx context.Context) ([]models.ResponseModeler, error) {
+	resp, err := s.repository.ListWebhooks(ctx)
+	if err != nil {
+		s.log(ctx).Error("Error while listing webhooks")
+		return nil, errors.Wrap(err, "Error while listing webhooks")
+	}
+	webhooks := []models.ResponseModeler{}
+	for _, webhook := range resp {
+		webhooks = append(webhooks, toWebhookModel(webhook))
+	}
+	return webhooks, nil
+}
+
+func (s *projectService) DeleteWebhook(ctx context.Context, ID string) error {
+	if err := s.repository.DeleteWebhook(ctx, ID); err != nil {
+		s.log(ctx).Error("Error while deleting webhook")
+		return errors.Wrap(err, "Error while deleting webhook")
+	}
+	return nil
+}
+
+func (s *projectService) ListDeadLetters(ctx context.Context, options models.ListOptions) (*models.ListResult, error) {
+	page, err := toPageDTO(options, deadLetterSortFields)
+	if err != nil {
+		return nil, err
+	}
+	resp, total, err := s.repository.ListDeadLetters(ctx, page)
+	if err != nil {
+		s.log(ctx).Error("Error while listing dead letters")
+		return nil, errors.Wrap(err, "Error while listing dead letters")
+	}
+	deadLetters := []models.ResponseModeler{}
+	for _, event := range resp {
+		deadLetter := &models.DeadLetter{
+			ID:         event.ID,
+			Type:       event.Type,
+			EntityType: event.EntityType,
+			EntityID:   event.EntityID,
+			Payload:    event.Payload,
+			CreatedAt:  event.CreatedAt,
+		}
+		for _, delivery := range event.Deliveries {
+			deadLetter.Deliveries = append(deadLetter.Deliveries, &models.WebhookDelivery{
+				WebhookID: delivery.WebhookID,
+				URL:       delivery.URL,
+				Status:    delivery.Status,
+				Attempts:  delivery.Attempts,
+				LastError: delivery.LastError,
+			})
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return newListResult(deadLetters, page, total), nil
+}
+
+// toWebhookModel leaves the secret out, it is only shown on registration.
+func toWebhookModel(webhook *dto.WebhookDTO) *models.Webhook {
+	return &models.Webhook{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
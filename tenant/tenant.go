@@ -0,0 +1,36 @@
+package tenant
+
+import "context"
+
+// Header is the metadata key of the tenant whose catalog a call works on.
+const Header = "x-tenant-id"
+
+const maxIDLength = 32
+
+type tenantKey struct{}
+
+func ContextWithID(ctx context.Context, ID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, ID)
+}
+
+// FromContext returns the tenant of the request of ctx, or "" for the default
+// catalog.
+func FromContext(ctx context.Context) string {
+	ID, _ := ctx.Value(tenantKey{}).(string)
+	return ID
+}
+
+// ValidID reports whether ID can name a tenant: 1 to 32 lower case letters,
+// digits, dashes or underscores, which keeps the database names derived from
+// it valid.
+func ValidID(ID string) bool {
+	if ID == "" || len(ID) > maxIDLength {
+		return false
+	}
+	for _, r := range ID {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
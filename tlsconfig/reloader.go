@@ -0,0 +1,142 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Reloader holds a certificate and a CA pool loaded from PEM files, and
+// loads them again when the files change, so certificates can be rotated
+// without a restart.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes []time.Time
+}
+
+// NewReloader loads the certificate of certFile and keyFile and the CA
+// certificates of caFile. Every file is optional, and keyFile defaults to
+// certFile for PEM files holding both the certificate and its key.
+func NewReloader(certFile string, keyFile string, caFile string) (*Reloader, error) {
+	if keyFile == "" {
+		keyFile = certFile
+	}
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) load() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return errors.Wrap(err, "Error while loading the certificate "+r.certFile)
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return errors.Wrap(err, "Error while reading the CA file "+r.caFile)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.New("No certificate found in the CA file " + r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	return nil
+}
+
+func (r *Reloader) stat() ([]time.Time, error) {
+	modTimes := []time.Time{}
+	for _, name := range []string{r.certFile, r.keyFile, r.caFile} {
+		if name == "" {
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error while reading "+name)
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+func (r *Reloader) changed() (bool, error) {
+	modTimes, err := r.stat()
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[i]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Watch checks the files every interval until ctx is done, and reloads them
+// when one changed. A failed reload is logged and the previous certificates
+// stay in use.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration, logger logrus.FieldLogger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := r.changed()
+		if err == nil && changed {
+			err = r.load()
+			if err == nil {
+				logger.Info("Reloaded the TLS certificates of " + r.certFile)
+			}
+		}
+		if err != nil {
+			logger.WithError(err).Error("Error while reloading the TLS certificates")
+		}
+	}
+}
+
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pool
+}
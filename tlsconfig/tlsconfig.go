@@ -0,0 +1,82 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// Server returns the TLS config of a server presenting the certificate of
+// reloader and offering the given ALPN protocols. When reloader has a CA
+// pool, clients must present a certificate signed by one of its CAs and,
+// unless clientNames is empty, whose common name or one of whose DNS names
+// is in clientNames.
+func Server(reloader *Reloader, clientNames []string, protocols ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: protocols,
+		// net/http only serves a config holding a certificate, which the
+		// config of each client replaces.
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return reloader.Certificate(), nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   protocols,
+				Certificates: []tls.Certificate{*reloader.Certificate()},
+			}
+			if pool := reloader.CAPool(); pool != nil {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = pool
+				config.VerifyConnection = func(state tls.ConnectionState) error {
+					return checkClientName(state.PeerCertificates[0], clientNames)
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+// Client returns the TLS config of a client trusting the CA pool of
+// reloader, or the system roots when it has none, and presenting its
+// certificate when it has one.
+func Client(reloader *Reloader) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    reloader.CAPool(),
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := reloader.Certificate(); cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+	}
+}
+
+func checkClientName(cert *x509.Certificate, clientNames []string) error {
+	if len(clientNames) == 0 {
+		return nil
+	}
+	for _, name := range clientNames {
+		if cert.Subject.CommonName == name {
+			return nil
+		}
+		for _, dnsName := range cert.DNSNames {
+			if dnsName == name {
+				return nil
+			}
+		}
+	}
+	return errors.New("Client certificate " + cert.Subject.String() + " is not allowed")
+}
+
+// ClientName returns the name a client certificate is known by: its common
+// name, or its first DNS name without one.
+func ClientName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" || len(cert.DNSNames) == 0 {
+		return cert.Subject.CommonName
+	}
+	return cert.DNSNames[0]
+}
@@ -0,0 +1,241 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"int-service/dto"
+	"int-service/repository"
+	"int-service/tenant"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Headers of the webhook requests.
+const (
+	EventIDHeader   = "X-Webhook-Id"
+	EventTypeHeader = "X-Webhook-Event"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+const (
+	// batchSize is the number of outbox events handled per pass.
+	batchSize = 100
+	// requestTimeout bounds a delivery, including reading the response.
+	requestTimeout = 10 * time.Second
+	firstBackoff   = 10 * time.Second
+	maxBackoff     = time.Hour
+)
+
+// Sign returns the signature of a request body sent at timestamp, the hex
+// HMAC-SHA256 of "TIMESTAMP.BODY" keyed with the secret of the webhook.
+// Receivers compute it again to check the X-Webhook-Signature header, which
+// holds it prefixed with "sha256=".
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher delivers the events of the outbox to the webhooks. Deliveries
+// are at least once: a receiver may get an event again, with the same
+// X-Webhook-Id, when the dispatcher stops between posting it and recording
+// it, or when several servers share the repository.
+type Dispatcher struct {
+	repo         repository.ProjectRepository
+	logger       *logrus.Logger
+	client       *http.Client
+	pollInterval time.Duration
+	maxAttempts  int
+}
+
+func NewDispatcher(repo repository.ProjectRepository, logger *logrus.Logger, pollInterval time.Duration, maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		repo:         repo,
+		logger:       logger,
+		client:       &http.Client{Timeout: requestTimeout},
+		pollInterval: pollInterval,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// log returns the logger of the dispatcher, with the tenant of ctx if any.
+func (d *Dispatcher) log(ctx context.Context) *logrus.Entry {
+	entry := logrus.NewEntry(d.logger)
+	if tenantID := tenant.FromContext(ctx); tenantID != "" {
+		entry = entry.WithField("tenant", tenantID)
+	}
+	return entry
+}
+
+// Run dispatches the due events every poll interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.Dispatch(ctx); err != nil && ctx.Err() == nil {
+			d.log(ctx).WithError(err).Warn("Error while dispatching the webhook events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch makes one pass over the due events of the outbox, until none is
+// left. Events delivered to all their webhooks leave the outbox, and the
+// ones with a delivery given up stay in it as dead letters.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	for {
+		now := time.Now().UTC()
+		events, err := d.repo.ListDueOutboxEvents(ctx, now, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		webhooks, err := d.repo.ListWebhooks(ctx)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := d.dispatchEvent(ctx, event, webhooks, now); err != nil {
+				return err
+			}
+		}
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchEvent(ctx context.Context, event *dto.OutboxEventDTO, webhooks dto.WebhooksDTO, now time.Time) error {
+	byID := map[string]*dto.WebhookDTO{}
+	for _, webhook := range webhooks {
+		byID[webhook.ID] = webhook
+	}
+	// The webhooks of an event are the ones registered when it is first
+	// dispatched.
+	if !event.Dispatched {
+		for _, webhook := range webhooks {
+			if subscribes(webhook, event.Type) {
+				event.Deliveries = append(event.Deliveries, &dto.WebhookDeliveryDTO{
+					WebhookID:     webhook.ID,
+					URL:           webhook.URL,
+					Status:        repository.DeliveryPending,
+					NextAttemptAt: now,
+				})
+			}
+		}
+		event.Dispatched = true
+	}
+
+	for _, delivery := range event.Deliveries {
+		if delivery.Status != repository.DeliveryPending || delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		webhook, ok := byID[delivery.WebhookID]
+		if !ok {
+			delivery.Status = repository.DeliveryDead
+			delivery.LastError = "The webhook was deleted"
+			continue
+		}
+		delivery.Attempts++
+		err := d.post(ctx, webhook, event)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		switch {
+		case err == nil:
+			delivery.Status = repository.DeliveryDelivered
+			delivery.LastError = ""
+		case delivery.Attempts >= d.maxAttempts:
+			delivery.Status = repository.DeliveryDead
+			delivery.LastError = err.Error()
+			d.log(ctx).WithError(err).WithField("webhook", webhook.URL).WithField("event", event.ID).Warn("Giving up a webhook delivery")
+		default:
+			delivery.NextAttemptAt = now.Add(backoff(delivery.Attempts))
+			delivery.LastError = err.Error()
+		}
+	}
+
+	event.Pending, event.Dead, event.NextAttemptAt = false, false, time.Time{}
+	for _, delivery := range event.Deliveries {
+		switch delivery.Status {
+		case repository.DeliveryPending:
+			if !event.Pending || delivery.NextAttemptAt.Before(event.NextAttemptAt) {
+				event.NextAttemptAt = delivery.NextAttemptAt
+			}
+			event.Pending = true
+		case repository.DeliveryDead:
+			event.Dead = true
+		}
+	}
+	if !event.Pending && !event.Dead {
+		return d.repo.DeleteOutboxEvent(ctx, event.ID)
+	}
+	return d.repo.UpdateOutboxEvent(ctx, event)
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook *dto.WebhookDTO, event *dto.OutboxEventDTO) error {
+	body := []byte(event.Payload)
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, event.ID)
+	req.Header.Set(EventTypeHeader, event.Type)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, "sha256="+Sign(webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "Error while posting the event")
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("The webhook answered %s", resp.Status)
+	}
+	return nil
+}
+
+func subscribes(webhook *dto.WebhookDTO, eventType string) bool {
+	if len(webhook.EventTypes) == 0 {
+		return true
+	}
+	for _, subscribed := range webhook.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff is the wait before the attempt following the given number of
+// attempts, doubling from firstBackoff up to maxBackoff.
+func backoff(attempts int) time.Duration {
+	wait := firstBackoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
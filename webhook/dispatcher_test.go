@@ -0,0 +1,430 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const testSecret = "webhook-secret"
+
+// receiver is a webhook endpoint answering the statuses it is given in turn,
+// then 200.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []*receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, &receivedRequest{header: req.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) received() []*receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*receivedRequest{}, r.requests...)
+}
+
+// testRepositories returns the backends the outbox is tested on, all empty.
+func testRepositories(t *testing.T) map[string]repository.ProjectRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "webhook.db")+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := repository.MigrateSQL(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	return map[string]repository.ProjectRepository{
+		"memory": repository.NewMemoryDB(),
+		"sqlite": repository.NewSQLDB(db),
+	}
+}
+
+func newTestDispatcher(repo repository.ProjectRepository, maxAttempts int) *Dispatcher {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewDispatcher(repo, logger, time.Second, maxAttempts)
+}
+
+func addWebhook(t *testing.T, repo repository.ProjectRepository, ID string, URL string, eventTypes ...string) {
+	t.Helper()
+	webhook := &dto.WebhookDTO{ID: ID, URL: URL, Secret: testSecret, EventTypes: eventTypes, CreatedAt: time.Now().UTC()}
+	if _, err := repo.CreateWebhook(context.Background(), webhook); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func createGenre(t *testing.T, repo repository.ProjectRepository) {
+	t.Helper()
+	if _, err := Outbox(repo).CreateGenre(context.Background(), &dto.GenreDTO{ID: "genre-1", Name: "Drama"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// outboxEvents returns every event left in the outbox, due or not.
+func outboxEvents(t *testing.T, repo repository.ProjectRepository) dto.OutboxEventsDTO {
+	t.Helper()
+	events, err := repo.ListDueOutboxEvents(context.Background(), time.Now().Add(1000*time.Hour), batchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+// makeDue moves the next attempts of the outbox to the past, as if their
+// backoff was over.
+func makeDue(t *testing.T, repo repository.ProjectRepository) {
+	t.Helper()
+	past := time.Now().UTC().Add(-time.Second)
+	for _, event := range outboxEvents(t, repo) {
+		event.NextAttemptAt = past
+		for _, delivery := range event.Deliveries {
+			delivery.NextAttemptAt = past
+		}
+		if err := repo.UpdateOutboxEvent(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func dispatch(t *testing.T, d *Dispatcher) {
+	t.Helper()
+	if err := d.Dispatch(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDispatchSignsDeliveries(t *testing.T) {
+	for name, repo := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			subscribed, other := newReceiver(t), newReceiver(t)
+			addWebhook(t, repo, "subscribed", subscribed.URL, "genre.created")
+			addWebhook(t, repo, "other", other.URL, "show.created")
+			createGenre(t, repo)
+
+			dispatch(t, newTestDispatcher(repo, 3))
+
+			if got := len(other.received()); got != 0 {
+				t.Errorf("a webhook of other events got %d requests", got)
+			}
+			requests := subscribed.received()
+			if len(requests) != 1 {
+				t.Fatalf("the webhook got %d requests, want 1", len(requests))
+			}
+			req := requests[0]
+			timestamp, err := strconv.ParseInt(req.header.Get(TimestampHeader), 10, 64)
+			if err != nil {
+				t.Fatalf("bad %s header: %v", TimestampHeader, err)
+			}
+			mac := hmac.New(sha256.New, []byte(testSecret))
+			mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "." + string(req.body)))
+			want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+			if got := req.header.Get(SignatureHeader); got != want {
+				t.Errorf("signature %s, want %s", got, want)
+			}
+			if got := req.header.Get(EventTypeHeader); got != "genre.created" {
+				t.Errorf("event type %s, want genre.created", got)
+			}
+
+			payload := Payload{}
+			if err := json.Unmarshal(req.body, &payload); err != nil {
+				t.Fatal(err)
+			}
+			if payload.ID != req.header.Get(EventIDHeader) || payload.Type != "genre.created" || payload.EntityID != "genre-1" {
+				t.Errorf("unexpected payload %+v", payload)
+			}
+			if !strings.Contains(string(payload.Data), `"Drama"`) {
+				t.Errorf("payload data %s misses the genre", payload.Data)
+			}
+			if events := outboxEvents(t, repo); len(events) != 0 {
+				t.Errorf("%d events left in the outbox after their delivery", len(events))
+			}
+		})
+	}
+}
+
+func TestSignCoversSecretTimestampAndBody(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	if Sign("a", 1, body) == Sign("b", 1, body) {
+		t.Error("the signature does not depend on the secret")
+	}
+	if Sign("a", 1, body) == Sign("a", 2, body) {
+		t.Error("the signature does not depend on the timestamp")
+	}
+	if Sign("a", 1, body) == Sign("a", 1, []byte(`{"id":"2"}`)) {
+		t.Error("the signature does not depend on the body")
+	}
+}
+
+func TestDispatchRetriesWithBackoff(t *testing.T) {
+	for name, repo := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			receiver := newReceiver(t, http.StatusServiceUnavailable, http.StatusBadGateway)
+			addWebhook(t, repo, "webhook", receiver.URL)
+			createGenre(t, repo)
+			d := newTestDispatcher(repo, 5)
+
+			for attempt, wait := range []time.Duration{firstBackoff, 2 * firstBackoff} {
+				before := time.Now().UTC()
+				dispatch(t, d)
+				if got := len(receiver.received()); got != attempt+1 {
+					t.Fatalf("attempt %d: the webhook got %d requests", attempt+1, got)
+				}
+				events := outboxEvents(t, repo)
+				if len(events) != 1 || len(events[0].Deliveries) != 1 {
+					t.Fatalf("attempt %d: outbox holds %d events", attempt+1, len(events))
+				}
+				delivery := events[0].Deliveries[0]
+				if delivery.Status != repository.DeliveryPending || delivery.Attempts != attempt+1 || delivery.LastError == "" {
+					t.Fatalf("attempt %d: delivery %+v", attempt+1, delivery)
+				}
+				if next := delivery.NextAttemptAt.Sub(before); next < wait-time.Second || next > wait+time.Minute {
+					t.Errorf("attempt %d: next attempt in %v, want %v", attempt+1, next, wait)
+				}
+
+				// The delivery is not retried before its backoff is over.
+				dispatch(t, d)
+				if got := len(receiver.received()); got != attempt+1 {
+					t.Fatalf("attempt %d: retried before the backoff, %d requests", attempt+1, got)
+				}
+				makeDue(t, repo)
+			}
+
+			dispatch(t, d)
+			if got := len(receiver.received()); got != 3 {
+				t.Fatalf("the webhook got %d requests, want 3", got)
+			}
+			if events := outboxEvents(t, repo); len(events) != 0 {
+				t.Errorf("%d events left in the outbox after their delivery", len(events))
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, firstBackoff},
+		{2, 2 * firstBackoff},
+		{3, 4 * firstBackoff},
+		{9, 256 * firstBackoff},
+		{10, maxBackoff},
+		{100, maxBackoff},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDispatchDeadLetters(t *testing.T) {
+	const maxAttempts = 3
+	for name, repo := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			failing := newReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+			working := newReceiver(t)
+			addWebhook(t, repo, "failing", failing.URL)
+			addWebhook(t, repo, "working", working.URL)
+			createGenre(t, repo)
+			d := newTestDispatcher(repo, maxAttempts)
+
+			for i := 0; i < maxAttempts+2; i++ {
+				dispatch(t, d)
+				makeDue(t, repo)
+			}
+			if got := len(failing.received()); got != maxAttempts {
+				t.Errorf("the failing webhook got %d requests, want %d", got, maxAttempts)
+			}
+			if got := len(working.received()); got != 1 {
+				t.Errorf("the working webhook got %d requests, want 1", got)
+			}
+
+			dead, total, err := repo.ListDeadLetters(context.Background(), dto.PageDTO{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 1 || len(dead) != 1 {
+				t.Fatalf("%d dead letters, want 1", total)
+			}
+			if dead[0].Pending || !dead[0].Dead {
+				t.Errorf("dead letter %+v is still pending", dead[0])
+			}
+			for _, delivery := range dead[0].Deliveries {
+				switch delivery.WebhookID {
+				case "failing":
+					if delivery.Status != repository.DeliveryDead || delivery.Attempts != maxAttempts || !strings.Contains(delivery.LastError, "500") {
+						t.Errorf("failing delivery %+v", delivery)
+					}
+				case "working":
+					if delivery.Status != repository.DeliveryDelivered || delivery.Attempts != 1 {
+						t.Errorf("working delivery %+v", delivery)
+					}
+				}
+			}
+			due, err := repo.ListDueOutboxEvents(context.Background(), time.Now().Add(1000*time.Hour), batchSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(due) != 0 {
+				t.Errorf("dead letter is still due")
+			}
+		})
+	}
+}
+
+func TestOutboxFollowsTheTransaction(t *testing.T) {
+	errRollback := errors.New("rollback")
+	for name, repo := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			outbox := Outbox(repo)
+
+			err := outbox.WithTransaction(ctx, func(ctx context.Context) error {
+				if _, err := outbox.CreateGenre(ctx, &dto.GenreDTO{ID: "rolled-back", Name: "Drama"}); err != nil {
+					return err
+				}
+				return errRollback
+			})
+			if !errors.Is(err, errRollback) {
+				t.Fatalf("transaction returned %v", err)
+			}
+			if events := outboxEvents(t, repo); len(events) != 0 {
+				t.Fatalf("the rolled back write left %d outbox events", len(events))
+			}
+			if _, err := repo.GetGenre(ctx, "rolled-back"); !errors.Is(err, models.ErrNotFound) {
+				t.Fatalf("the rolled back genre was stored: %v", err)
+			}
+
+			err = outbox.WithTransaction(ctx, func(ctx context.Context) error {
+				_, err := outbox.CreateGenre(ctx, &dto.GenreDTO{ID: "committed", Name: "Drama"})
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			events := outboxEvents(t, repo)
+			if len(events) != 1 || events[0].EntityID != "committed" || events[0].Type != "genre.created" {
+				t.Fatalf("the committed write left the outbox events %+v", events)
+			}
+		})
+	}
+}
+
+// TestOutboxEvents checks the events of the poster changes and restores, and
+// that every event holds the document as stored rather than the write.
+func TestOutboxEvents(t *testing.T) {
+	for name, repo := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			outbox := Outbox(repo)
+			if _, err := outbox.CreateJournalist(ctx, &dto.JournalistDTO{ID: "j1", Name: "Ann"}); err != nil {
+				t.Fatal(err)
+			}
+			article := &dto.ArticleDTO{ID: "a1", Title: "Review", ReleaseDate: time.Now().UTC(), Journalist: dto.ShortJournalistDTO{ID: "j1"}}
+			if _, err := outbox.CreateArticle(ctx, article); err != nil {
+				t.Fatal(err)
+			}
+			writes := []func() error{
+				func() error {
+					_, err := outbox.UploadArticlePosters(ctx, "a1", []string{"/articles/a1/a1.jpg", "/articles/a1/a1-wide.jpg"})
+					return err
+				},
+				func() error { return outbox.DeleteArticlePoster(ctx, "a1", "a1.jpg") },
+				func() error {
+					_, err := outbox.UpdateArticle(ctx, &dto.ArticleDTO{ID: "a1", Title: "Preview", ReleaseDate: article.ReleaseDate, PostersPath: []string{"/articles/a1/a1-wide.jpg"}, Journalist: dto.ShortJournalistDTO{ID: "j1"}})
+					return err
+				},
+				func() error { return outbox.DeleteArticle(ctx, "a1") },
+				func() error { return outbox.RestoreDocument(ctx, repository.ArticleEntity, "a1") },
+			}
+			for _, write := range writes {
+				if err := write(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			events := outboxEvents(t, repo)
+			want := []string{"journalist.created", "article.created", "article.updated", "article.updated", "article.updated", "article.deleted", "article.created"}
+			if len(events) != len(want) {
+				t.Fatalf("the outbox holds %d events, want %d", len(events), len(want))
+			}
+			for i, event := range events {
+				payload := struct {
+					Type string `json:"type"`
+					Data *struct {
+						Title       string   `json:"title"`
+						PostersPath []string `json:"postersPath"`
+						Journalist  struct {
+							ID string `json:"id"`
+						} `json:"journalist"`
+					} `json:"data"`
+				}{}
+				if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+					t.Fatal(err)
+				}
+				if payload.Type != want[i] {
+					t.Errorf("event %d is a %s, want a %s", i, payload.Type, want[i])
+				}
+				if event.EntityType != repository.ArticleEntity {
+					continue
+				}
+				switch {
+				case payload.Type == "article.deleted":
+					if payload.Data != nil {
+						t.Errorf("the deletion holds the document %+v", payload.Data)
+					}
+				case payload.Data == nil || payload.Data.Journalist.ID != "j1":
+					t.Errorf("event %d holds the article %+v", i, payload.Data)
+				case i == 2 && len(payload.Data.PostersPath) != 2, i == 3 && strings.Join(payload.Data.PostersPath, ",") != "/articles/a1/a1-wide.jpg":
+					t.Errorf("the poster deletion holds the posters %v", payload.Data.PostersPath)
+				case i >= 4 && payload.Data.Title != "Preview":
+					t.Errorf("event %d holds the title %q", i, payload.Data.Title)
+				}
+			}
+		})
+	}
+}
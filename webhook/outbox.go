@@ -0,0 +1,437 @@
+// Package webhook delivers the events of the catalog to the HTTP endpoints
+// registered as webhooks. Writes add their event to an outbox in the same
+// transaction, and a dispatcher posts the events of the outbox to every
+// matching webhook, retrying failed deliveries with an exponential backoff.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"int-service/dto"
+	"int-service/models"
+	"int-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Payload is the JSON body posted to the webhooks. Data is the document
+// after the change, in the JSON form of the API, and is absent for
+// deletions.
+type Payload struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// EventType is the type of the events of an operation on an entity, such as
+// article.created.
+func EventType(entityType string, operation string) string {
+	return entityType + "." + operation
+}
+
+// outboxRepository adds an outbox event to every create, update and delete
+// of the catalog documents, poster change and restore from the trash, in the
+// transaction of the write.
+type outboxRepository struct {
+	repository.ProjectRepository
+}
+
+// Outbox wraps repo so its writes record their events in the outbox.
+func Outbox(repo repository.ProjectRepository) repository.ProjectRepository {
+	return &outboxRepository{repo}
+}
+
+// record runs write and adds the event of its document to the outbox, both
+// in one transaction. write returns the ID of the document, which is read
+// back after the change unless it was deleted, so the event holds the whole
+// document as stored.
+func (r *outboxRepository) record(ctx context.Context, entityType string, operation string, write func(ctx context.Context) (string, error)) error {
+	return r.ProjectRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		ID, err := write(ctx)
+		if err != nil {
+			return err
+		}
+		payload := Payload{
+			ID:         uuid.New().String(),
+			Type:       EventType(entityType, operation),
+			EntityType: entityType,
+			EntityID:   ID,
+			OccurredAt: time.Now().UTC(),
+		}
+		if operation != repository.OperationDeleted {
+			document, err := r.document(ctx, entityType, ID)
+			if err != nil {
+				return errors.Wrap(err, "Error while reading the document of the outbox event")
+			}
+			payload.Data, err = protojson.Marshal(document.ToGrpc().(proto.Message))
+			if err != nil {
+				return errors.Wrap(err, "Error while encoding the outbox event")
+			}
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "Error while encoding the outbox event")
+		}
+		return r.ProjectRepository.AddOutboxEvent(ctx, &dto.OutboxEventDTO{
+			ID:         payload.ID,
+			Type:       payload.Type,
+			EntityType: entityType,
+			EntityID:   ID,
+			Payload:    string(data),
+			CreatedAt:  payload.OccurredAt,
+			Deliveries: []*dto.WebhookDeliveryDTO{},
+		})
+	})
+}
+
+// document returns the model of a document of the catalog.
+func (r *outboxRepository) document(ctx context.Context, entityType string, ID string) (models.ResponseModeler, error) {
+	switch entityType {
+	case repository.ShowEntity:
+		show, err := r.ProjectRepository.GetShow(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return show.ToModel(), nil
+	case repository.SeasonEntity:
+		season, err := r.ProjectRepository.GetSeason(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return season.ToModel(), nil
+	case repository.EpisodeEntity:
+		episode, err := r.ProjectRepository.GetEpisode(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return episode.ToModel(), nil
+	case repository.CelebrityEntity:
+		celebrity, err := r.ProjectRepository.GetCelebrity(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return celebrity.ToModel(), nil
+	case repository.ArticleEntity:
+		article, err := r.ProjectRepository.GetArticle(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return article.ToModel(), nil
+	case repository.GenreEntity:
+		genre, err := r.ProjectRepository.GetGenre(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return genre.ToModel(), nil
+	case repository.JournalistEntity:
+		journalist, err := r.ProjectRepository.GetJournalist(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		return journalist.ToModel(), nil
+	}
+	return nil, errors.Wrap(models.ErrInvalidArgument, "Unknown entity type "+entityType)
+}
+
+func (r *outboxRepository) remove(ctx context.Context, entityType string, ID string, remove func(ctx context.Context, ID string) error) error {
+	return r.record(ctx, entityType, repository.OperationDeleted, func(ctx context.Context) (string, error) {
+		return ID, remove(ctx, ID)
+	})
+}
+
+//------SHOWS------
+
+func (r *outboxRepository) CreateShow(ctx context.Context, newShow *dto.ShowDTO) (show *dto.ShowDTO, err error) {
+	err = r.record(ctx, repository.ShowEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		show, err = r.ProjectRepository.CreateShow(ctx, newShow)
+		if err != nil {
+			return "", err
+		}
+		return show.ID, nil
+	})
+	return show, err
+}
+
+func (r *outboxRepository) UpdateShow(ctx context.Context, updatedShow *dto.ShowDTO, fields []string) (show *dto.ShowDTO, err error) {
+	err = r.record(ctx, repository.ShowEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		show, err = r.ProjectRepository.UpdateShow(ctx, updatedShow, fields)
+		if err != nil {
+			return "", err
+		}
+		return show.ID, nil
+	})
+	return show, err
+}
+
+func (r *outboxRepository) DeleteShow(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.ShowEntity, ID, r.ProjectRepository.DeleteShow)
+}
+
+func (r *outboxRepository) UploadSeriesPosters(ctx context.Context, ID string, postersPath []string) (show *dto.ShowDTO, err error) {
+	err = r.record(ctx, repository.ShowEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		show, err = r.ProjectRepository.UploadSeriesPosters(ctx, ID, postersPath)
+		return ID, err
+	})
+	return show, err
+}
+
+func (r *outboxRepository) DeleteSeriesPoster(ctx context.Context, ID string, image string) error {
+	return r.record(ctx, repository.ShowEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return ID, r.ProjectRepository.DeleteSeriesPoster(ctx, ID, image)
+	})
+}
+
+func (r *outboxRepository) UploadMoviePosters(ctx context.Context, ID string, postersPath []string) (show *dto.ShowDTO, err error) {
+	err = r.record(ctx, repository.ShowEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		show, err = r.ProjectRepository.UploadMoviePosters(ctx, ID, postersPath)
+		return ID, err
+	})
+	return show, err
+}
+
+func (r *outboxRepository) DeleteMoviePoster(ctx context.Context, ID string, image string) error {
+	return r.record(ctx, repository.ShowEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return ID, r.ProjectRepository.DeleteMoviePoster(ctx, ID, image)
+	})
+}
+
+//------SEASONS------
+
+func (r *outboxRepository) CreateSeason(ctx context.Context, newSeason *dto.SeasonDTO) (season *dto.SeasonDTO, err error) {
+	err = r.record(ctx, repository.SeasonEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		season, err = r.ProjectRepository.CreateSeason(ctx, newSeason)
+		if err != nil {
+			return "", err
+		}
+		return season.ID, nil
+	})
+	return season, err
+}
+
+func (r *outboxRepository) UpdateSeason(ctx context.Context, updatedSeason *dto.SeasonDTO, fields []string) (season *dto.SeasonDTO, err error) {
+	err = r.record(ctx, repository.SeasonEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		season, err = r.ProjectRepository.UpdateSeason(ctx, updatedSeason, fields)
+		if err != nil {
+			return "", err
+		}
+		return season.ID, nil
+	})
+	return season, err
+}
+
+func (r *outboxRepository) DeleteSeason(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.SeasonEntity, ID, r.ProjectRepository.DeleteSeason)
+}
+
+func (r *outboxRepository) UploadSeasonPosters(ctx context.Context, seasonID string, postersPath []string) (season *dto.SeasonDTO, err error) {
+	err = r.record(ctx, repository.SeasonEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		season, err = r.ProjectRepository.UploadSeasonPosters(ctx, seasonID, postersPath)
+		return seasonID, err
+	})
+	return season, err
+}
+
+func (r *outboxRepository) DeleteSeasonPoster(ctx context.Context, seriesID string, seasonID string, image string) error {
+	return r.record(ctx, repository.SeasonEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return seasonID, r.ProjectRepository.DeleteSeasonPoster(ctx, seriesID, seasonID, image)
+	})
+}
+
+//------EPISODES------
+
+func (r *outboxRepository) CreateEpisode(ctx context.Context, newEpisode *dto.EpisodeDTO) (episode *dto.EpisodeDTO, err error) {
+	err = r.record(ctx, repository.EpisodeEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		episode, err = r.ProjectRepository.CreateEpisode(ctx, newEpisode)
+		if err != nil {
+			return "", err
+		}
+		return episode.ID, nil
+	})
+	return episode, err
+}
+
+func (r *outboxRepository) UpdateEpisode(ctx context.Context, updatedEpisode *dto.EpisodeDTO, fields []string) (episode *dto.EpisodeDTO, err error) {
+	err = r.record(ctx, repository.EpisodeEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		episode, err = r.ProjectRepository.UpdateEpisode(ctx, updatedEpisode, fields)
+		if err != nil {
+			return "", err
+		}
+		return episode.ID, nil
+	})
+	return episode, err
+}
+
+func (r *outboxRepository) DeleteEpisode(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.EpisodeEntity, ID, r.ProjectRepository.DeleteEpisode)
+}
+
+func (r *outboxRepository) UploadEpisodePosters(ctx context.Context, episodeID string, postersPath []string) (episode *dto.EpisodeDTO, err error) {
+	err = r.record(ctx, repository.EpisodeEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		episode, err = r.ProjectRepository.UploadEpisodePosters(ctx, episodeID, postersPath)
+		return episodeID, err
+	})
+	return episode, err
+}
+
+func (r *outboxRepository) DeleteEpisodePoster(ctx context.Context, seriesID string, seasonID string, episodeID string, image string) error {
+	return r.record(ctx, repository.EpisodeEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return episodeID, r.ProjectRepository.DeleteEpisodePoster(ctx, seriesID, seasonID, episodeID, image)
+	})
+}
+
+//------CELEBRITIES------
+
+func (r *outboxRepository) CreateCelebrity(ctx context.Context, newCelebrity *dto.CelebrityDTO) (celebrity *dto.CelebrityDTO, err error) {
+	err = r.record(ctx, repository.CelebrityEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		celebrity, err = r.ProjectRepository.CreateCelebrity(ctx, newCelebrity)
+		if err != nil {
+			return "", err
+		}
+		return celebrity.ID, nil
+	})
+	return celebrity, err
+}
+
+func (r *outboxRepository) UpdateCelebrity(ctx context.Context, updatedCelebrity *dto.CelebrityDTO, fields []string) (celebrity *dto.CelebrityDTO, err error) {
+	err = r.record(ctx, repository.CelebrityEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		celebrity, err = r.ProjectRepository.UpdateCelebrity(ctx, updatedCelebrity, fields)
+		if err != nil {
+			return "", err
+		}
+		return celebrity.ID, nil
+	})
+	return celebrity, err
+}
+
+func (r *outboxRepository) DeleteCelebrity(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.CelebrityEntity, ID, r.ProjectRepository.DeleteCelebrity)
+}
+
+func (r *outboxRepository) UploadCelebrityPosters(ctx context.Context, ID string, postersPath []string) (celebrity *dto.CelebrityDTO, err error) {
+	err = r.record(ctx, repository.CelebrityEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		celebrity, err = r.ProjectRepository.UploadCelebrityPosters(ctx, ID, postersPath)
+		return ID, err
+	})
+	return celebrity, err
+}
+
+func (r *outboxRepository) DeleteCelebrityPoster(ctx context.Context, ID string, image string) error {
+	return r.record(ctx, repository.CelebrityEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return ID, r.ProjectRepository.DeleteCelebrityPoster(ctx, ID, image)
+	})
+}
+
+//------ARTICLES------
+
+func (r *outboxRepository) CreateArticle(ctx context.Context, newArticle *dto.ArticleDTO) (article *dto.ArticleDTO, err error) {
+	err = r.record(ctx, repository.ArticleEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		article, err = r.ProjectRepository.CreateArticle(ctx, newArticle)
+		if err != nil {
+			return "", err
+		}
+		return article.ID, nil
+	})
+	return article, err
+}
+
+func (r *outboxRepository) UpdateArticle(ctx context.Context, updatedArticle *dto.ArticleDTO) (article *dto.ArticleDTO, err error) {
+	err = r.record(ctx, repository.ArticleEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		article, err = r.ProjectRepository.UpdateArticle(ctx, updatedArticle)
+		if err != nil {
+			return "", err
+		}
+		return article.ID, nil
+	})
+	return article, err
+}
+
+func (r *outboxRepository) DeleteArticle(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.ArticleEntity, ID, r.ProjectRepository.DeleteArticle)
+}
+
+func (r *outboxRepository) UploadArticlePosters(ctx context.Context, ID string, postersPath []string) (article *dto.ArticleDTO, err error) {
+	err = r.record(ctx, repository.ArticleEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		article, err = r.ProjectRepository.UploadArticlePosters(ctx, ID, postersPath)
+		return ID, err
+	})
+	return article, err
+}
+
+func (r *outboxRepository) DeleteArticlePoster(ctx context.Context, ID string, image string) error {
+	return r.record(ctx, repository.ArticleEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		return ID, r.ProjectRepository.DeleteArticlePoster(ctx, ID, image)
+	})
+}
+
+//------GENRES------
+
+func (r *outboxRepository) CreateGenre(ctx context.Context, newGenre *dto.GenreDTO) (genre *dto.GenreDTO, err error) {
+	err = r.record(ctx, repository.GenreEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		genre, err = r.ProjectRepository.CreateGenre(ctx, newGenre)
+		if err != nil {
+			return "", err
+		}
+		return genre.ID, nil
+	})
+	return genre, err
+}
+
+func (r *outboxRepository) UpdateGenre(ctx context.Context, updatedGenre *dto.GenreDTO) (genre *dto.GenreDTO, err error) {
+	err = r.record(ctx, repository.GenreEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		genre, err = r.ProjectRepository.UpdateGenre(ctx, updatedGenre)
+		if err != nil {
+			return "", err
+		}
+		return genre.ID, nil
+	})
+	return genre, err
+}
+
+func (r *outboxRepository) DeleteGenre(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.GenreEntity, ID, r.ProjectRepository.DeleteGenre)
+}
+
+//------JOURNALISTS------
+
+func (r *outboxRepository) CreateJournalist(ctx context.Context, newJournalist *dto.JournalistDTO) (journalist *dto.JournalistDTO, err error) {
+	err = r.record(ctx, repository.JournalistEntity, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		journalist, err = r.ProjectRepository.CreateJournalist(ctx, newJournalist)
+		if err != nil {
+			return "", err
+		}
+		return journalist.ID, nil
+	})
+	return journalist, err
+}
+
+func (r *outboxRepository) UpdateJournalist(ctx context.Context, updatedJournalist *dto.JournalistDTO) (journalist *dto.JournalistDTO, err error) {
+	err = r.record(ctx, repository.JournalistEntity, repository.OperationUpdated, func(ctx context.Context) (string, error) {
+		journalist, err = r.ProjectRepository.UpdateJournalist(ctx, updatedJournalist)
+		if err != nil {
+			return "", err
+		}
+		return journalist.ID, nil
+	})
+	return journalist, err
+}
+
+func (r *outboxRepository) DeleteJournalist(ctx context.Context, ID string) error {
+	return r.remove(ctx, repository.JournalistEntity, ID, r.ProjectRepository.DeleteJournalist)
+}
+
+//------TRASH------
+
+// RestoreDocument reports the restored document as created again, as the
+// change feed does.
+func (r *outboxRepository) RestoreDocument(ctx context.Context, entityType string, ID string) error {
+	return r.record(ctx, entityType, repository.OperationCreated, func(ctx context.Context) (string, error) {
+		return ID, r.ProjectRepository.RestoreDocument(ctx, entityType, ID)
+	})
+}